@@ -87,6 +87,21 @@ type BlockFactory interface {
 	AssembleBlock(basics.Round) (ValidatedBlock, error)
 }
 
+// A SpeculativeBlockFactory is a BlockFactory which can start assembling the
+// block of the next round before the block of the current round is committed.
+type SpeculativeBlockFactory interface {
+	BlockFactory
+
+	// StartSpeculativeBlockAssembly starts assembling, in the background, the
+	// block which follows the given ValidatedBlock, for a later call to
+	// AssembleBlock for the next round. The assembled block is only used if
+	// the given ValidatedBlock is the one committed next.
+	//
+	// The passed-in context is canceled when the given ValidatedBlock is no
+	// longer expected to be committed.
+	StartSpeculativeBlockAssembly(context.Context, ValidatedBlock)
+}
+
 // A Ledger represents the sequence of Entries agreed upon by the protocol.
 // The Ledger consists of two parts: a LedgerReader and a LedgerWriter, which
 // provide read and write access to the ledger, respectively.
@@ -58,6 +58,9 @@ const (
 
 	// disk
 	checkpoint
+
+	// logical, appended after checkpoint to keep the values of the persisted action types
+	speculate
 )
 
 type action interface {
@@ -221,6 +224,8 @@ func (a ensureAction) String() string {
 }
 
 func (a ensureAction) do(ctx context.Context, s *Service) {
+	s.abandonSpeculation(a.Certificate.Round, a.Certificate.Proposal)
+
 	logEvent := logspec.AgreementEvent{
 		Hash:   a.Certificate.Proposal.BlockDigest.String(),
 		Round:  uint64(a.Certificate.Round),
@@ -278,6 +283,8 @@ func (a stageDigestAction) String() string {
 }
 
 func (a stageDigestAction) do(ctx context.Context, service *Service) {
+	service.abandonSpeculation(a.Certificate.Round, a.Certificate.Proposal)
+
 	logEvent := logspec.AgreementEvent{
 		Hash:   a.Certificate.Proposal.BlockDigest.String(),
 		Round:  uint64(a.Certificate.Round),
@@ -289,6 +296,39 @@ func (a stageDigestAction) do(ctx context.Context, service *Service) {
 	service.Ledger.EnsureDigest(a.Certificate, service.voteVerifier)
 }
 
+type speculateAction struct {
+	nonpersistent
+
+	Round round
+	// the payload which is committable in Round, on top of which the proposals of the next round are assembled
+	Payload proposal
+}
+
+func (a speculateAction) t() actionType {
+	return speculate
+}
+
+func (a speculateAction) String() string {
+	return fmt.Sprintf("%s: %.5s: %v", a.t().String(), a.Payload.Digest().String(), a.Round)
+}
+
+func (a speculateAction) do(ctx context.Context, s *Service) {
+	if a.Payload.ve == nil {
+		// the payload was not validated by this node, e.g. in recovery
+		return
+	}
+	value := a.Payload.value()
+	if s.speculation.cancel != nil {
+		if s.speculation.round == a.Round && s.speculation.value == value {
+			return
+		}
+		s.speculation.cancel()
+	}
+	speculationCtx, cancel := context.WithCancel(ctx)
+	s.speculation = speculation{round: a.Round, value: value, cancel: cancel}
+	s.loopback.SpeculateProposals(speculationCtx, a.Round, a.Payload.ve)
+}
+
 type rezeroAction struct {
 	nonpersistent
 
@@ -467,6 +507,8 @@ func zeroAction(t actionType) action {
 		return pseudonodeAction{}
 	case checkpoint:
 		return checkpointAction{}
+	case speculate:
+		return speculateAction{}
 	default:
 		err := fmt.Errorf("bad action type: %v", t)
 		panic(err)
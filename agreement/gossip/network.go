@@ -97,12 +97,15 @@ func messageMetadataFromHandle(h agreement.MessageHandle) *messageMetadata {
 }
 
 func (i *networkImpl) processVoteMessage(raw network.IncomingMessage) network.OutgoingMessage {
+	if i.trace != nil {
+		messagetracer.TraceHop(i.trace, messagetracer.Vote, raw, i.net)
+	}
 	return i.processMessage(raw, i.voteCh, agreementVoteMessageType)
 }
 
 func (i *networkImpl) processProposalMessage(raw network.IncomingMessage) network.OutgoingMessage {
 	if i.trace != nil {
-		i.trace.HashTrace(messagetracer.Proposal, raw.Data)
+		messagetracer.TraceHop(i.trace, messagetracer.Proposal, raw, i.net)
 	}
 	return i.processMessage(raw, i.proposalCh, agreementProposalMessageType)
 }
@@ -177,6 +180,11 @@ func (i *networkImpl) Disconnect(h agreement.MessageHandle) {
 		return
 	}
 
+	signal := network.PeerSignalInvalidVote
+	if metadata.raw.Tag == protocol.ProposalPayloadTag {
+		signal = network.PeerSignalInvalidProposal
+	}
+	i.net.ReportPeer(metadata.raw.Sender, signal)
 	i.net.Disconnect(metadata.raw.Sender)
 }
 
@@ -139,6 +139,9 @@ func (w *whiteholeNetwork) BroadcastSimple(tag protocol.Tag, data []byte) error
 func (w *whiteholeNetwork) Disconnect(badnode network.Peer) {
 	return
 }
+func (w *whiteholeNetwork) ReportPeer(badnode network.Peer, signal network.PeerSignal) {
+	return
+}
 func (w *whiteholeNetwork) DisconnectPeers() {
 	return
 }
@@ -87,7 +87,7 @@ func (p *player) handle(r routerHandle, e event) []action {
 		switch p.Step {
 		case soft:
 			// precondition: nap = false
-			actions = p.issueSoftVote(r)
+			actions = p.issueSoftVote(r, e.Proto.Version)
 			p.Step = cert
 			// update tracer state to match player
 			r.t.setMetadata(tracerMetadata{p.Round, p.Period, p.Step})
@@ -97,16 +97,16 @@ func (p *player) handle(r routerHandle, e event) []action {
 			p.Step = next
 			// update tracer state to match player
 			r.t.setMetadata(tracerMetadata{p.Round, p.Period, p.Step})
-			return p.issueNextVote(r)
+			return p.issueNextVote(r, e.Proto.Version)
 		default:
 			if p.Napping {
-				return p.issueNextVote(r) // sets p.Napping to false
+				return p.issueNextVote(r, e.Proto.Version) // sets p.Napping to false
 			}
 			// not napping, so we should enter a new step
 			p.Step++ // note: this must happen before next timeout setting.
 			// TODO add unit test to ensure that deadlines increase monotonically here
 
-			lower, upper := p.Step.nextVoteRanges()
+			lower, upper := p.Step.nextVoteRanges(e.Proto.Version)
 			delta := time.Duration(e.RandomEntropy % uint64(upper-lower))
 
 			p.Napping = true
@@ -142,9 +142,9 @@ func (p *player) handleFastTimeout(r routerHandle, e timeoutEvent) []action {
 	return p.issueFastVote(r)
 }
 
-func (p *player) issueSoftVote(r routerHandle) (actions []action) {
+func (p *player) issueSoftVote(r routerHandle, v protocol.ConsensusVersion) (actions []action) {
 	defer func() {
-		p.Deadline = deadlineTimeout
+		p.Deadline = DeadlineTimeout(v)
 	}()
 
 	e := r.dispatch(*p, proposalFrozenEvent{}, proposalMachinePeriod, p.Round, p.Period, 0)
@@ -186,7 +186,15 @@ func (p *player) issueCertVote(r routerHandle, e committableEvent) action {
 	return pseudonodeAction{T: attest, Round: p.Round, Period: p.Period, Step: cert, Proposal: e.Proposal}
 }
 
-func (p *player) issueNextVote(r routerHandle) []action {
+// speculateNextRound starts assembling the proposals of the next round on top
+// of the committable payload of the current period, without waiting for a cert
+// threshold.
+func (p *player) speculateNextRound(r routerHandle) action {
+	res := stagedValue(*p, r, p.Round, p.Period)
+	return speculateAction{Round: p.Round, Payload: res.Payload}
+}
+
+func (p *player) issueNextVote(r routerHandle, v protocol.ConsensusVersion) []action {
 	actions := p.partitionPolicy(r)
 
 	a := pseudonodeAction{T: attest, Round: p.Round, Period: p.Period, Step: p.Step, Proposal: bottom}
@@ -210,7 +218,7 @@ func (p *player) issueNextVote(r routerHandle) []action {
 
 	r.t.timeR().RecStep(p.Period, p.Step, a.Proposal)
 
-	_, upper := p.Step.nextVoteRanges()
+	_, upper := p.Step.nextVoteRanges(v)
 	p.Napping = false
 	p.Deadline = upper
 	return actions
@@ -295,8 +303,11 @@ func (p *player) handleThresholdEvent(r routerHandle, e thresholdEvent) []action
 			return p.enterPeriod(r, e, e.Period)
 		}
 		ec := r.dispatch(*p, e, proposalMachine, p.Round, p.Period, 0)
-		if ec.t() == proposalCommittable && p.Step <= cert {
-			actions = append(actions, p.issueCertVote(r, ec.(committableEvent)))
+		if ec.t() == proposalCommittable {
+			if p.Step <= cert {
+				actions = append(actions, p.issueCertVote(r, ec.(committableEvent)))
+			}
+			actions = append(actions, p.speculateNextRound(r))
 		}
 		return actions
 
@@ -334,7 +345,7 @@ func (p *player) enterPeriod(r routerHandle, source thresholdEvent, target perio
 	actions = append(actions, rezeroAction{Round: p.Round})
 
 	if e.t() == proposalCommittable { // implies source.t() == softThreshold
-		return append(actions, p.issueCertVote(r, e.(committableEvent)))
+		return append(actions, p.issueCertVote(r, e.(committableEvent)), p.speculateNextRound(r))
 	}
 	if source.t() == nextThreshold {
 		proposal := source.Proposal
@@ -598,8 +609,11 @@ func (p *player) handleMessageEvent(r routerHandle, e messageEvent) (actions []a
 			}
 		}
 
-		if ef.t() == proposalCommittable && p.Step <= cert {
-			actions = append(actions, p.issueCertVote(r, ef.(committableEvent)))
+		if ef.t() == proposalCommittable {
+			if p.Step <= cert {
+				actions = append(actions, p.issueCertVote(r, ef.(committableEvent)))
+			}
+			actions = append(actions, speculateAction{Round: p.Round, Payload: e.Input.Proposal})
 		}
 		return actions
 
@@ -148,6 +148,7 @@ const (
 	proposeVoteVerifiedErrorEventSamePeriod
 	bundleVerifiedErrorEvent
 	payloadVerifiedErrorEvent
+	softBundleVerifiedEventSamePeriod
 )
 
 func getMessageEventPermutation(t *testing.T, n int, helper *voteMakerHelper) (e messageEvent) {
@@ -316,6 +317,28 @@ func getMessageEventPermutation(t *testing.T, n int, helper *voteMakerHelper) (e
 			},
 			Err: errTestVerifyFailed,
 		}
+	case softBundleVerifiedEventSamePeriod:
+		votes := make([]vote, int(soft.threshold(config.Consensus[protocol.ConsensusCurrentVersion])))
+		for i := 0; i < int(soft.threshold(config.Consensus[protocol.ConsensusCurrentVersion])); i++ {
+			votes[i] = helper.MakeVerifiedVote(t, i, r, p, soft, pV)
+		}
+		bun := unauthenticatedBundle{
+			Round:    r,
+			Period:   p,
+			Step:     soft,
+			Proposal: pV,
+		}
+		e = messageEvent{
+			T: bundleVerified,
+			Input: message{
+				Bundle: bundle{
+					U:     bun,
+					Votes: votes,
+				},
+				UnauthenticatedBundle: bun,
+			},
+			Proto: ConsensusVersionView{Version: protocol.ConsensusCurrentVersion},
+		}
 	default:
 		require.Fail(t, "messageEvent permutation %v does not exist", n)
 	}
@@ -426,12 +449,17 @@ func verifyPermutationExpectedActions(t *testing.T, playerN int, eventN int, hel
 		case payloadVerifiedErrorEvent:
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore malformed proposal, player: %v, event: %v", playerN, eventN)
+		case softBundleVerifiedEventSamePeriod:
+			requireActionCount(t, trace, 1, playerN, eventN)
+			bun := unauthenticatedBundle{Round: r, Period: p, Step: soft, Proposal: pV}
+			ra := networkAction{T: relay, Tag: protocol.VoteBundleTag, UnauthenticatedBundle: bun}
+			requireTraceContains(t, trace, ev(ra), playerN, eventN)
 		default:
 			require.Fail(t, "event permutation %v does not exist", eventN)
 		}
 	case playerNextRound:
 		switch eventN {
-		case softVoteVerifiedEventSamePeriod, softVotePresentEventSamePeriod, proposeVoteVerifiedEventNextPeriod, proposeVoteVerifiedEventSamePeriod, proposeVotePresentEventSamePeriod, payloadPresentEvent, payloadVerifiedEvent, payloadVerifiedEventNoMessageHandle, bundleVerifiedEventSamePeriod, bundlePresentEventSamePeriod:
+		case softVoteVerifiedEventSamePeriod, softVotePresentEventSamePeriod, proposeVoteVerifiedEventNextPeriod, proposeVoteVerifiedEventSamePeriod, proposeVotePresentEventSamePeriod, payloadPresentEvent, payloadVerifiedEvent, payloadVerifiedEventNoMessageHandle, bundleVerifiedEventSamePeriod, bundlePresentEventSamePeriod, softBundleVerifiedEventSamePeriod:
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore msg from past rounds, player: %v, event: %v", playerN, eventN)
 		case softVoteVerifiedErrorEventSamePeriod, proposeVoteVerifiedErrorEventSamePeriod, bundleVerifiedErrorEvent:
@@ -473,7 +501,7 @@ func verifyPermutationExpectedActions(t *testing.T, playerN int, eventN int, hel
 		case payloadPresentEvent, payloadVerifiedEvent, payloadVerifiedEventNoMessageHandle:
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore proposal with no vvote, player: %v, event: %v", playerN, eventN)
-		case bundleVerifiedEventSamePeriod, bundlePresentEventSamePeriod:
+		case bundleVerifiedEventSamePeriod, bundlePresentEventSamePeriod, softBundleVerifiedEventSamePeriod:
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore bundle from different round, player: %v, event: %v", playerN, eventN)
 		case softVoteVerifiedErrorEventSamePeriod, proposeVoteVerifiedErrorEventSamePeriod, bundleVerifiedErrorEvent:
@@ -544,6 +572,11 @@ func verifyPermutationExpectedActions(t *testing.T, playerN int, eventN int, hel
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore malformed proposal, player: %v, event: %v", playerN, eventN)
 
+		case softBundleVerifiedEventSamePeriod:
+			requireActionCount(t, trace, 1, playerN, eventN)
+			bun := unauthenticatedBundle{Round: r, Period: p, Step: soft, Proposal: pV}
+			ra := networkAction{T: relay, Tag: protocol.VoteBundleTag, UnauthenticatedBundle: bun}
+			requireTraceContains(t, trace, ev(ra), playerN, eventN)
 		default:
 			require.Fail(t, "event permutation %v does not exist", eventN)
 		}
@@ -574,15 +607,19 @@ func verifyPermutationExpectedActions(t *testing.T, playerN int, eventN int, hel
 			na := networkAction{T: relay, Tag: protocol.ProposalPayloadTag, CompoundMessage: compoundMessage{Proposal: payload.u()}}
 			requireTraceContains(t, trace, ev(na), playerN, eventN)
 		case payloadVerifiedEvent:
-			requireActionCount(t, trace, 1, playerN, eventN)
+			requireActionCount(t, trace, 2, playerN, eventN)
 			pa := pseudonodeAction{T: attest, Round: r, Period: p, Step: cert, Proposal: pV}
 			requireTraceContains(t, trace, ev(pa), playerN, eventN)
+			sa := speculateAction{Round: r, Payload: *payload}
+			requireTraceContains(t, trace, ev(sa), playerN, eventN)
 		case payloadVerifiedEventNoMessageHandle:
-			requireActionCount(t, trace, 2, playerN, eventN)
+			requireActionCount(t, trace, 3, playerN, eventN)
 			na := networkAction{T: relay, Tag: protocol.ProposalPayloadTag, CompoundMessage: compoundMessage{Proposal: payload.u()}}
 			requireTraceContains(t, trace, ev(na), playerN, eventN)
 			pa := pseudonodeAction{T: attest, Round: r, Period: p, Step: cert, Proposal: pV}
 			requireTraceContains(t, trace, ev(pa), playerN, eventN)
+			sa := speculateAction{Round: r, Payload: *payload}
+			requireTraceContains(t, trace, ev(sa), playerN, eventN)
 		case bundleVerifiedEventSamePeriod:
 			requireActionCount(t, trace, 2, playerN, eventN)
 			votes := make([]vote, int(cert.threshold(config.Consensus[protocol.ConsensusCurrentVersion])))
@@ -610,6 +647,11 @@ func verifyPermutationExpectedActions(t *testing.T, playerN int, eventN int, hel
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore malformed proposal, player: %v, event: %v", playerN, eventN)
 
+		case softBundleVerifiedEventSamePeriod:
+			requireActionCount(t, trace, 1, playerN, eventN)
+			bun := unauthenticatedBundle{Round: r, Period: p, Step: soft, Proposal: pV}
+			ra := networkAction{T: relay, Tag: protocol.VoteBundleTag, UnauthenticatedBundle: bun}
+			requireTraceContains(t, trace, ev(ra), playerN, eventN)
 		default:
 			require.Fail(t, "event permutation %v does not exist", eventN)
 		}
@@ -676,6 +718,9 @@ func verifyPermutationExpectedActions(t *testing.T, playerN int, eventN int, hel
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore malformed proposal, player: %v, event: %v", playerN, eventN)
 
+		case softBundleVerifiedEventSamePeriod:
+			requireActionCount(t, trace, 1, playerN, eventN)
+			expectIgnore(t, trace, "Player should ignore soft bundle without state change, player: %v, event: %v", playerN, eventN)
 		default:
 			require.Fail(t, "event permutation %v does not exist", eventN)
 		}
@@ -733,6 +778,15 @@ func verifyPermutationExpectedActions(t *testing.T, playerN int, eventN int, hel
 			requireActionCount(t, trace, 1, playerN, eventN)
 			expectIgnore(t, trace, "Player should ignore malformed proposal, player: %v, event: %v", playerN, eventN)
 
+		case softBundleVerifiedEventSamePeriod:
+			requireActionCount(t, trace, 3, playerN, eventN)
+			bun := unauthenticatedBundle{Round: r, Period: p, Step: soft, Proposal: pV}
+			ra := networkAction{T: relay, Tag: protocol.VoteBundleTag, UnauthenticatedBundle: bun}
+			requireTraceContains(t, trace, ev(ra), playerN, eventN)
+			pa := pseudonodeAction{T: attest, Round: r, Period: p, Step: cert, Proposal: pV}
+			requireTraceContains(t, trace, ev(pa), playerN, eventN)
+			sa := speculateAction{Round: r, Payload: *payload}
+			requireTraceContains(t, trace, ev(sa), playerN, eventN)
 		default:
 			require.Fail(t, "event permutation %v does not exist", eventN)
 		}
@@ -747,7 +801,7 @@ func TestPlayerPermutation(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
 	for i := 0; i < 7; i++ {
-		for j := 0; j < 14; j++ {
+		for j := 0; j < 15; j++ {
 			_, pMachine, helper := getPlayerPermutation(t, i)
 			inMsg := getMessageEventPermutation(t, j, helper)
 			err, panicErr := pMachine.transition(inMsg)
@@ -19,6 +19,7 @@ package agreement
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -455,6 +456,42 @@ func TestPlayerOffsetStart(t *testing.T) {
 	}
 }
 
+func TestPlayerDeadlinesFromConsensusParams(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testConsensusProtocolVersion := protocol.ConsensusVersion("TestPlayerDeadlinesFromConsensusParams-testversion")
+	testConsensusParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	testConsensusParams.AgreementFilterTimeoutPeriod0 = 100 * time.Millisecond
+	testConsensusParams.AgreementFilterTimeout = 200 * time.Millisecond
+	testConsensusParams.AgreementDeadlineTimeout = 300 * time.Millisecond
+	testConsensusParams.AgreementRecoveryExtraTimeout = 50 * time.Millisecond
+	config.Consensus[testConsensusProtocolVersion] = testConsensusParams
+	defer func() {
+		delete(config.Consensus, testConsensusProtocolVersion)
+	}()
+
+	player, router, _, _, _ := testPlayerSetup()
+	e := makeTimeoutEvent()
+	e.Proto = ConsensusVersionView{Version: testConsensusProtocolVersion}
+
+	// soft vote, then wait for the deadline
+	player, _ = router.submitTop(&playerTracer, player, e)
+	require.Equal(t, cert, player.Step)
+	require.Equal(t, 300*time.Millisecond, player.Deadline)
+
+	// first next vote
+	player, _ = router.submitTop(&playerTracer, player, e)
+	require.Equal(t, next, player.Step)
+	require.Equal(t, 350*time.Millisecond, player.Deadline)
+
+	// the following next-vote step lasts twice the recovery extra timeout
+	player, _ = router.submitTop(&playerTracer, player, e)
+	require.Equal(t, next+1, player.Step)
+	require.True(t, player.Napping)
+	require.GreaterOrEqual(t, player.Deadline, 350*time.Millisecond)
+	require.Less(t, player.Deadline, 450*time.Millisecond)
+}
+
 func TestPlayerLateBlockProposalPeriod0(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
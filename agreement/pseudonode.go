@@ -67,6 +67,12 @@ type pseudonode interface {
 	// It returns an error if the pseudonode is unable to perform this.
 	MakeVotes(ctx context.Context, r round, p period, s step, prop proposalValue, persistStateDone chan error) (chan externalEvent, error)
 
+	// SpeculateProposals starts assembling the proposals of the round following r on top of ve, the block of round r
+	// which is expected to be committed, if the block factory supports it.
+	//
+	// The passed-in context may be used to abandon the assembly.
+	SpeculateProposals(ctx context.Context, r round, ve ValidatedBlock)
+
 	// Quit directs the pseudonode to exit.
 	Quit()
 }
@@ -203,6 +209,18 @@ func (n asyncPseudonode) MakeVotes(ctx context.Context, r round, p period, s ste
 	}
 }
 
+func (n asyncPseudonode) SpeculateProposals(ctx context.Context, r round, ve ValidatedBlock) {
+	factory, ok := n.factory.(SpeculativeBlockFactory)
+	if !ok {
+		return
+	}
+	if len(n.loadRoundParticipationKeys(r+1)) == 0 {
+		// no proposals would be made for the next round.
+		return
+	}
+	factory.StartSpeculativeBlockAssembly(ctx, ve)
+}
+
 // load the participation keys from the account manager ( as needed ) for the
 // current round.
 func (n *asyncPseudonode) loadRoundParticipationKeys(voteRound basics.Round) []account.ParticipationRecordForRound {
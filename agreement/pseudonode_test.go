@@ -548,3 +548,61 @@ func TestPseudonodeFailedEnqueuedTasks(t *testing.T) {
 	require.Equal(t, enqueuedVotes*len(accounts), subStrLogger.instancesFound[0])
 	require.Equal(t, enqueuedProposals*len(accounts), subStrLogger.instancesFound[1])
 }
+
+type speculativeTestBlockFactory struct {
+	testBlockFactory
+	speculated chan ValidatedBlock
+}
+
+func (f speculativeTestBlockFactory) StartSpeculativeBlockAssembly(ctx context.Context, ve ValidatedBlock) {
+	f.speculated <- ve
+}
+
+func TestPseudonodeSpeculateProposals(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	t.Parallel()
+
+	// generate a nice, fixed hash.
+	rootSeed := sha256.Sum256([]byte(t.Name()))
+	accounts, balances := createTestAccountsAndBalances(t, 10, rootSeed[:])
+	ledger := makeTestLedger(balances)
+
+	voteVerifier := MakeAsyncVoteVerifier(nil)
+	defer voteVerifier.Quit()
+
+	factory := speculativeTestBlockFactory{speculated: make(chan ValidatedBlock, 1)}
+	makeNode := func(factory BlockFactory, keys KeyManager) pseudonode {
+		return makePseudonode(pseudonodeParams{
+			factory:      factory,
+			validator:    testBlockValidator{},
+			keys:         keys,
+			ledger:       ledger,
+			voteVerifier: voteVerifier,
+			log:          serviceLogger{logging.Base()},
+			monitor:      nil,
+		})
+	}
+
+	r := ledger.NextRound()
+	ve, err := factory.AssembleBlock(r)
+	require.NoError(t, err)
+
+	// the block of the next round is assembled on top of ve when the node has participation keys
+	pb := makeNode(factory, makeRecordingKeyManager(accounts))
+	defer pb.Quit()
+	pb.SpeculateProposals(context.Background(), r, ve)
+	require.Len(t, factory.speculated, 1)
+	require.Equal(t, ve, <-factory.speculated)
+
+	// nothing is assembled without participation keys for the next round
+	pbNoKeys := makeNode(factory, makeRecordingKeyManager(nil))
+	defer pbNoKeys.Quit()
+	pbNoKeys.SpeculateProposals(context.Background(), r, ve)
+	require.Empty(t, factory.speculated)
+
+	// nor if the block factory does not support it
+	pbNotSpeculative := makeNode(testBlockFactory{}, makeRecordingKeyManager(accounts))
+	defer pbNotSpeculative.Quit()
+	pbNotSpeculative.SpeculateProposals(context.Background(), r, ve)
+}
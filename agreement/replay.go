@@ -0,0 +1,349 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// A ReplayDivergence is an input event of a cadaver on which the replayed
+// state machine did not behave as expected.
+type ReplayDivergence struct {
+	// Run is the sequence number of the cadaver-generating process, and
+	// Iteration the fuzzing iteration (zero for a replay).
+	Run       int
+	Iteration int
+
+	// Index is the position of the event among the events of the run, in
+	// the order they were delivered.
+	Index int
+
+	// Round, Period and Step are the state of the player when the event
+	// was delivered.
+	Round  basics.Round
+	Period uint64
+	Step   uint64
+
+	Event  string
+	Reason string
+}
+
+func (d ReplayDivergence) String() string {
+	return fmt.Sprintf("run %d iteration %d event %d at (%d, %d, %d): %s: %s", d.Run, d.Iteration, d.Index, d.Round, d.Period, d.Step, d.Event, d.Reason)
+}
+
+// A ReplayReport summarizes a replay or a fuzzing of an autopsy.
+type ReplayReport struct {
+	Runs        int
+	Events      int
+	Divergences []ReplayDivergence
+}
+
+// autopsyRun holds the traces of a cadaver-generating process.
+type autopsyRun struct {
+	traces []autopsyRunTrace
+}
+
+type autopsyRunTrace struct {
+	x     player
+	pairs []autopsyPair
+}
+
+// collect reads all the runs of the autopsy in memory.
+func (a *Autopsy) collect() (runs []autopsyRun) {
+	for cdv := range a.cdvs {
+		var run autopsyRun
+		for tr := range cdv {
+			trace := autopsyRunTrace{x: tr.x}
+			for pair := range tr.p {
+				trace.pairs = append(trace.pairs, pair)
+			}
+			run.traces = append(run.traces, trace)
+		}
+		if len(run.traces) > 0 {
+			runs = append(runs, run)
+		}
+	}
+	return
+}
+
+func makeReplayTracer() *tracer {
+	log := logging.NewLogger()
+	log.SetOutput(io.Discard)
+	log.SetLevel(logging.Error)
+	return &tracer{log: serviceLogger{log}}
+}
+
+// Replay feeds the recorded events of the autopsy into a fresh player and
+// checks that it emits the recorded actions, and that it reaches the
+// recorded player state whenever the cadaver has one.
+//
+// Since the cadaver records the events delivered to the player after
+// cryptographic verification, replaying them does not verify any signature:
+// the recorded verification results are used instead.
+//
+// Each run starts with an empty router, as the router of a restarted process
+// is not part of the cadaver. Divergences outside of the filter are not
+// reported.
+func (a *Autopsy) Replay(filter AutopsyFilter) (report ReplayReport) {
+	t := makeReplayTracer()
+	for n, run := range a.collect() {
+		report.Runs++
+		report.Divergences = append(report.Divergences, replayRun(t, n, run, filter, &report.Events)...)
+	}
+	return
+}
+
+func replayRun(t *tracer, n int, run autopsyRun, filter AutopsyFilter, events *int) (divergences []ReplayDivergence) {
+	var router rootRouter
+	var predicted player
+	index := 0
+	report := func(p player, e event, reason string) {
+		if filter.Enabled && (p.Round < filter.First || p.Round > filter.Last) {
+			return
+		}
+		divergences = append(divergences, ReplayDivergence{
+			Run:    n,
+			Index:  index,
+			Round:  p.Round,
+			Period: uint64(p.Period),
+			Step:   uint64(p.Step),
+			Event:  e.String(),
+			Reason: reason,
+		})
+	}
+
+	for i, trace := range run.traces {
+		if i > 0 && len(trace.pairs) > 0 && !bytes.Equal(protocol.EncodeReflect(predicted), protocol.EncodeReflect(trace.x)) {
+			report(trace.x, trace.pairs[0].e, fmt.Sprintf("replayed player state %+v differs from the recorded %+v", predicted, trace.x))
+		}
+
+		player := trace.x
+		router.root = checkedActor{actor: &player, actorContract: playerContract{}}
+		for _, pair := range trace.pairs {
+			before := player
+			var as []action
+			var err error
+			player, as, err = submitTopRecovered(t, &router, player, pair.e)
+			*events++
+			if err != nil {
+				report(before, pair.e, err.Error())
+				return
+			}
+			if pair.aok {
+				if reason := compareActions(pair.a, as); reason != "" {
+					report(before, pair.e, reason)
+				}
+			}
+			index++
+		}
+		predicted = player
+	}
+	return
+}
+
+// submitTopRecovered is like submitTop, but turns a panic of the state
+// machine into an error.
+func submitTopRecovered(t *tracer, router *rootRouter, p player, e event) (res player, as []action, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("state machine panicked: %v", r)
+		}
+	}()
+	res, as = router.submitTop(t, p, e)
+	return
+}
+
+// compareActions returns why the replayed actions differ from the recorded
+// ones, or the empty string if they do not.
+func compareActions(recorded, replayed []action) string {
+	if len(recorded) == len(replayed) {
+		same := true
+		for i := range recorded {
+			if recorded[i].t() != replayed[i].t() || !bytes.Equal(protocol.EncodeReflect(recorded[i]), protocol.EncodeReflect(replayed[i])) {
+				same = false
+				break
+			}
+		}
+		if same {
+			return ""
+		}
+	}
+	return fmt.Sprintf("replayed actions %v differ from the recorded %v", replayed, recorded)
+}
+
+// Fuzz replays each run of the autopsy iterations times, each time after
+// swapping some of its events with one of the window events following them,
+// and checks
+// that the player neither panics nor breaks safety: it must not attest to
+// two values at the same round, period and step, nor ensure two values at
+// the same round. The reorderings are drawn from seed, so that a divergence
+// can be reproduced.
+//
+// The replay starts from the first recorded player state of each run, and a
+// verified message event is never moved ahead of a message event that
+// presents a message, since the player only gets verification results for
+// the messages it was presented.
+func (a *Autopsy) Fuzz(seed int64, iterations int, window int) (report ReplayReport) {
+	if window < 1 {
+		window = 1
+	}
+	t := makeReplayTracer()
+	rng := rand.New(rand.NewSource(seed))
+	for n, run := range a.collect() {
+		report.Runs++
+
+		var events []event
+		for _, trace := range run.traces {
+			for _, pair := range trace.pairs {
+				events = append(events, pair.e)
+			}
+		}
+
+		for it := 1; it <= iterations; it++ {
+			mutated := reorderEvents(rng, events, window)
+			divergences := fuzzRun(t, run.traces[0].x, mutated)
+			report.Events += len(mutated)
+			for _, d := range divergences {
+				d.Run = n
+				d.Iteration = it
+				report.Divergences = append(report.Divergences, d)
+			}
+		}
+	}
+	return
+}
+
+// reorderEvents returns a copy of events where some events are swapped with
+// one of the window events following them.
+func reorderEvents(rng *rand.Rand, events []event, window int) []event {
+	mutated := append([]event(nil), events...)
+	for i := range mutated {
+		if rng.Intn(4) != 0 {
+			continue
+		}
+		j := i + 1 + rng.Intn(window)
+		if j >= len(mutated) {
+			continue
+		}
+		if movesVerifiedAheadOfPresent(mutated[i : j+1]) {
+			continue
+		}
+		mutated[i], mutated[j] = mutated[j], mutated[i]
+	}
+	return mutated
+}
+
+// movesVerifiedAheadOfPresent returns whether swapping the first and the
+// last of the events moves a verified event ahead of a present event.
+func movesVerifiedAheadOfPresent(events []event) bool {
+	first, last := events[0], events[len(events)-1]
+	for _, e := range events[1:] {
+		if isPresentEvent(first) && isVerifiedEvent(e) {
+			return true
+		}
+	}
+	for _, e := range events[:len(events)-1] {
+		if isVerifiedEvent(last) && isPresentEvent(e) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPresentEvent(e event) bool {
+	switch e.t() {
+	case votePresent, payloadPresent, bundlePresent:
+		return true
+	}
+	return false
+}
+
+func isVerifiedEvent(e event) bool {
+	switch e.t() {
+	case voteVerified, payloadVerified, bundleVerified:
+		return true
+	}
+	return false
+}
+
+type attestKey struct {
+	Round  round
+	Period period
+	Step   step
+}
+
+func fuzzRun(t *tracer, start player, events []event) (divergences []ReplayDivergence) {
+	var router rootRouter
+	player := start
+	router.root = checkedActor{actor: &player, actorContract: playerContract{}}
+
+	attested := make(map[attestKey]proposalValue)
+	ensured := make(map[round]proposalValue)
+	for index, e := range events {
+		before := player
+		var as []action
+		var err error
+		player, as, err = submitTopRecovered(t, &router, player, e)
+
+		var reasons []string
+		if err != nil {
+			reasons = append(reasons, err.Error())
+		}
+		for _, a := range as {
+			switch a := a.(type) {
+			case pseudonodeAction:
+				if a.T != attest {
+					continue
+				}
+				key := attestKey{a.Round, a.Period, a.Step}
+				if prev, ok := attested[key]; ok && prev != a.Proposal {
+					reasons = append(reasons, fmt.Sprintf("attested to %v after attesting to %v at (%d, %d, %d)", a.Proposal, prev, a.Round, a.Period, a.Step))
+				}
+				attested[key] = a.Proposal
+			case ensureAction:
+				r := a.Certificate.Round
+				if prev, ok := ensured[r]; ok && prev != a.Certificate.Proposal {
+					reasons = append(reasons, fmt.Sprintf("ensured %v after ensuring %v at round %d", a.Certificate.Proposal, prev, r))
+				}
+				ensured[r] = a.Certificate.Proposal
+			}
+		}
+
+		for _, reason := range reasons {
+			divergences = append(divergences, ReplayDivergence{
+				Index:  index,
+				Round:  before.Round,
+				Period: uint64(before.Period),
+				Step:   uint64(before.Step),
+				Event:  e.String(),
+				Reason: reason,
+			})
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// recordCadaver runs a few rounds of agreement between numNodes nodes and
+// returns the base filename of the cadaver of the first node.
+func recordCadaver(t *testing.T, numNodes int, numRounds int) string {
+	_, _, cleanupFn, services, clocks, _, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	defer cleanupFn()
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(clocks, 0)
+	for j := 0; j < numRounds; j++ {
+		zeroes = runRound(clocks, activityMonitor, zeroes, FilterTimeout(0, protocol.ConsensusCurrentVersion))
+	}
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+	return services[0].tracer.cadaver.filename()
+}
+
+func prepareTestAutopsy(t *testing.T, filename string) *Autopsy {
+	f, err := os.Open(filename)
+	require.NoError(t, err)
+	autopsy, err := PrepareAutopsyFromStream(f, func(int, AutopsyBounds) {}, func(n int, err error) {
+		require.NoError(t, err)
+	})
+	require.NoError(t, err)
+	return autopsy
+}
+
+func TestAutopsyReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	filename := recordCadaver(t, 3, 3)
+
+	autopsy := prepareTestAutopsy(t, filename)
+	defer autopsy.Close()
+	report := autopsy.Replay(AutopsyFilter{})
+	require.Equal(t, 1, report.Runs)
+	require.NotZero(t, report.Events)
+	require.Empty(t, report.Divergences, fmt.Sprint(report.Divergences))
+
+	// a recording where an event which made the player vote is missing diverges
+	autopsy = prepareTestAutopsy(t, filename)
+	defer autopsy.Close()
+	runs := autopsy.collect()
+	require.Len(t, runs, 1)
+	var removed bool
+	for i, trace := range runs[0].traces {
+		for j, pair := range trace.pairs {
+			for _, a := range pair.a {
+				if a.t() == attest {
+					removed = true
+				}
+			}
+			if removed {
+				runs[0].traces[i].pairs = append(trace.pairs[:j], trace.pairs[j+1:]...)
+				break
+			}
+		}
+		if removed {
+			break
+		}
+	}
+	require.True(t, removed)
+	var events int
+	divergences := replayRun(makeReplayTracer(), 0, runs[0], AutopsyFilter{}, &events)
+	require.NotEmpty(t, divergences)
+}
+
+func TestAutopsyFuzz(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	filename := recordCadaver(t, 3, 3)
+
+	autopsy := prepareTestAutopsy(t, filename)
+	defer autopsy.Close()
+	report := autopsy.Fuzz(1, 20, 4)
+	require.Equal(t, 1, report.Runs)
+	require.NotZero(t, report.Events)
+	require.Empty(t, report.Divergences, fmt.Sprint(report.Divergences))
+}
+
+func TestReorderEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var events []event
+	for i := 0; i < 100; i++ {
+		events = append(events,
+			messageEvent{T: votePresent, TaskIndex: i},
+			messageEvent{T: voteVerified, TaskIndex: i},
+			timeoutEvent{T: timeout, RandomEntropy: uint64(i)})
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	mutated := reorderEvents(rng, events, 3)
+	require.Len(t, mutated, len(events))
+	require.NotEqual(t, events, mutated)
+
+	// the events are permuted, and no verified event is moved ahead of a
+	// present event it followed
+	counts := make(map[string]int)
+	for _, e := range events {
+		counts[e.String()]++
+	}
+	for i, e := range mutated {
+		counts[e.String()]--
+		if e.t() == votePresent {
+			for _, later := range mutated[:i] {
+				if later.t() == voteVerified && later.(messageEvent).TaskIndex >= e.(messageEvent).TaskIndex {
+					t.Fatalf("verified event %v moved ahead of present event %v", later, e)
+				}
+			}
+		}
+	}
+	for _, n := range counts {
+		require.Zero(t, n)
+	}
+
+	// the reorderings only depend on the seed
+	require.Equal(t, mutated, reorderEvents(rand.New(rand.NewSource(1)), events, 3))
+}
@@ -57,6 +57,30 @@ type Service struct {
 	persistRouter  rootRouter
 	persistStatus  player
 	persistActions []action
+
+	// the proposal value on top of which the next round is being assembled
+	speculation speculation
+}
+
+// speculation tracks the proposal value of the current round on top of which
+// the pseudonode assembles the proposals of the next round.
+type speculation struct {
+	round  round
+	value  proposalValue
+	cancel context.CancelFunc
+}
+
+// abandonSpeculation cancels the assembly of the proposals of the next round
+// unless they are assembled on top of the given value of round r.
+func (s *Service) abandonSpeculation(r round, value proposalValue) {
+	if s.speculation.cancel == nil {
+		return
+	}
+	if s.speculation.round == r && s.speculation.value == value {
+		return
+	}
+	s.speculation.cancel()
+	s.speculation = speculation{}
 }
 
 // Parameters holds the parameters necessary to run the agreement protocol.
@@ -110,6 +134,12 @@ func (s *Service) SetTracerFilename(filename string) {
 	s.tracer.cadaver.baseFilename = filename
 }
 
+// Timeline returns the timelines of the recent rounds, by increasing round.
+// It is safe to call while the service runs.
+func (s *Service) Timeline() []RoundTimeline {
+	return s.tracer.timeline.snapshot()
+}
+
 // Start executing the agreement protocol.
 func (s *Service) Start() {
 	s.parameters.Network.Start()
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging/logspec"
+)
+
+const (
+	// timelineRounds is the number of recent rounds kept in the timeline.
+	timelineRounds = 64
+	// timelineRoundEvents bounds the number of events kept for a round, in
+	// case it goes through many periods.
+	timelineRoundEvents = 1024
+)
+
+// TimelineEvent is an event of the agreement protocol in the timeline of a round.
+type TimelineEvent struct {
+	// Time is when the event happened.
+	Time time.Time
+
+	// Type is one of RoundStart, ProposalAccepted, BlockValidated,
+	// BlockCommittable, ThresholdReached, BundleAccepted, PeriodConcluded
+	// or StepTimeout.
+	Type logspec.AgreementType
+
+	// Period and Step are those of the threshold or bundle for
+	// ThresholdReached and BundleAccepted, the new period for
+	// PeriodConcluded, and those of the player otherwise.
+	Period uint64
+	Step   uint64
+
+	// Proposal is the block digest of the proposal the event is about, if any.
+	Proposal string
+
+	// Sender is the original proposer of the proposal, if known.
+	Sender string
+
+	// Weight is the weight of the votes which reached a threshold, and
+	// Threshold is the threshold they reached. They are only set for
+	// ThresholdReached.
+	Weight    uint64
+	Threshold uint64
+}
+
+// RoundTimeline is the timeline of the agreement protocol in a round.
+type RoundTimeline struct {
+	Round  basics.Round
+	Events []TimelineEvent
+}
+
+// timeline keeps the RoundTimelines of the recent rounds in a ring buffer.
+//
+// Unlike the tracer, it is safe for concurrent use: the main state machine
+// loop records events while the REST API reads them.
+type timeline struct {
+	mu deadlock.Mutex
+
+	// rounds holds at most timelineRounds rounds; a new round replaces the
+	// oldest one once it is full.
+	rounds []RoundTimeline
+}
+
+func makeTimeline() *timeline {
+	return &timeline{rounds: make([]RoundTimeline, 0, timelineRounds)}
+}
+
+// record adds an event to the timeline of round r. Events of rounds older
+// than the ones in the buffer are dropped.
+func (tl *timeline) record(r round, e TimelineEvent) {
+	if tl == nil {
+		return
+	}
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	rt := tl.find(basics.Round(r))
+	if rt == nil {
+		rt = tl.add(basics.Round(r))
+		if rt == nil {
+			return
+		}
+	}
+	if len(rt.Events) < timelineRoundEvents {
+		rt.Events = append(rt.Events, e)
+	}
+}
+
+// find returns the timeline of round r, or nil if it is not in the buffer.
+func (tl *timeline) find(r basics.Round) *RoundTimeline {
+	for i := range tl.rounds {
+		if tl.rounds[i].Round == r {
+			return &tl.rounds[i]
+		}
+	}
+	return nil
+}
+
+// add makes room for the timeline of round r, evicting the oldest round if
+// the buffer is full. It returns nil if r is older than all the rounds in a
+// full buffer.
+func (tl *timeline) add(r basics.Round) *RoundTimeline {
+	if len(tl.rounds) < timelineRounds {
+		tl.rounds = append(tl.rounds, RoundTimeline{Round: r})
+		return &tl.rounds[len(tl.rounds)-1]
+	}
+
+	// the oldest round is the one to replace, unless r is older still
+	oldest := 0
+	for i := range tl.rounds {
+		if tl.rounds[i].Round < tl.rounds[oldest].Round {
+			oldest = i
+		}
+	}
+	if r < tl.rounds[oldest].Round {
+		return nil
+	}
+	tl.rounds[oldest] = RoundTimeline{Round: r}
+	return &tl.rounds[oldest]
+}
+
+// snapshot returns a copy of the timelines in the buffer, by increasing round.
+func (tl *timeline) snapshot() []RoundTimeline {
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+
+	out := make([]RoundTimeline, len(tl.rounds))
+	for i, rt := range tl.rounds {
+		out[i] = RoundTimeline{Round: rt.Round, Events: append([]TimelineEvent(nil), rt.Events...)}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Round < out[j].Round })
+	return out
+}
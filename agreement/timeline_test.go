@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging/logspec"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTimelineRingBuffer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tl := makeTimeline()
+	for r := round(timelineRounds + 10); r > 10; r-- {
+		tl.record(r, TimelineEvent{Type: logspec.RoundStart})
+	}
+	rounds := tl.snapshot()
+	require.Len(t, rounds, timelineRounds)
+	require.Equal(t, basics.Round(11), rounds[0].Round)
+	require.Equal(t, basics.Round(timelineRounds+10), rounds[timelineRounds-1].Round)
+
+	// a new round evicts the oldest one, and an older round is dropped
+	tl.record(timelineRounds+11, TimelineEvent{Type: logspec.RoundStart})
+	tl.record(5, TimelineEvent{Type: logspec.RoundStart})
+	rounds = tl.snapshot()
+	require.Len(t, rounds, timelineRounds)
+	require.Equal(t, basics.Round(12), rounds[0].Round)
+	require.Equal(t, basics.Round(timelineRounds+11), rounds[timelineRounds-1].Round)
+
+	// the events of a round are bounded
+	for i := 0; i < timelineRoundEvents+10; i++ {
+		tl.record(timelineRounds+11, TimelineEvent{Type: logspec.StepTimeout, Period: uint64(i)})
+	}
+	rounds = tl.snapshot()
+	require.Len(t, rounds[timelineRounds-1].Events, timelineRoundEvents)
+	require.False(t, rounds[timelineRounds-1].Events[0].Time.IsZero())
+
+	// the snapshot is a copy
+	rounds[0].Events[0].Type = logspec.BundleAccepted
+	require.Equal(t, logspec.RoundStart, tl.snapshot()[0].Events[0].Type)
+
+	var nilTimeline *timeline
+	nilTimeline.record(1, TimelineEvent{Type: logspec.RoundStart})
+}
+
+func TestAgreementTimeline(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numNodes := 2
+	_, baseLedger, cleanupFn, services, clocks, _, activityMonitor := setupAgreement(t, numNodes, disabled, makeTestLedger)
+	startRound := round(baseLedger.NextRound())
+	defer cleanupFn()
+	for i := 0; i < numNodes; i++ {
+		services[i].Start()
+	}
+	activityMonitor.waitForActivity()
+	activityMonitor.waitForQuiet()
+	zeroes := expectNewPeriod(clocks, 0)
+	zeroes = runRound(clocks, activityMonitor, zeroes, FilterTimeout(0, protocol.ConsensusCurrentVersion))
+	runRound(clocks, activityMonitor, zeroes, FilterTimeout(0, protocol.ConsensusCurrentVersion))
+	for i := 0; i < numNodes; i++ {
+		services[i].Shutdown()
+	}
+
+	var found bool
+	for _, rt := range services[0].Timeline() {
+		if rt.Round != basics.Round(startRound+1) {
+			continue
+		}
+		found = true
+		types := make(map[logspec.AgreementType]TimelineEvent)
+		for _, e := range rt.Events {
+			if _, ok := types[e.Type]; !ok {
+				types[e.Type] = e
+			}
+		}
+		require.Contains(t, types, logspec.RoundStart)
+		require.Contains(t, types, logspec.ProposalAccepted)
+		require.Contains(t, types, logspec.BlockValidated)
+		require.Contains(t, types, logspec.ThresholdReached)
+
+		threshold := types[logspec.ThresholdReached]
+		require.Equal(t, uint64(soft), threshold.Step)
+		require.GreaterOrEqual(t, threshold.Weight, threshold.Threshold)
+		require.NotZero(t, threshold.Threshold)
+		require.False(t, types[logspec.RoundStart].Time.After(threshold.Time))
+	}
+	require.True(t, found)
+}
@@ -65,6 +65,9 @@ type tracer struct {
 	tR      *timingInfoGenerator
 	tRPlus1 *timingInfoGenerator // pipelining
 
+	// timeline keeps the key events of the recent rounds. Optional.
+	timeline *timeline
+
 	// Logs Config
 	// if verboseReports is true, telemtrize new period entries
 	verboseReports bool
@@ -80,6 +83,7 @@ func makeTracer(log serviceLogger, cadaverFilename string, cadaverSizeTarget uin
 	t.verboseReports = verboseReportFlag
 	t.timingReports = timingReportFlag
 	t.w = os.Stdout
+	t.timeline = makeTimeline()
 
 	fileSizeTarget := int64(cadaverSizeTarget)
 	if fileSizeTarget == 0 {
@@ -179,6 +183,7 @@ func (t *tracer) aoutTop(src, dest stateMachineTag, as []action, r round, p peri
 /* Ad-hoc logging */
 
 func (t *tracer) logTimeout(p player) {
+	t.timeline.record(p.Round, TimelineEvent{Type: logspec.StepTimeout, Period: uint64(p.Period), Step: uint64(p.Step)})
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -192,6 +197,7 @@ func (t *tracer) logTimeout(p player) {
 }
 
 func (t *tracer) logFastTimeout(p player) {
+	t.timeline.record(p.Round, TimelineEvent{Type: logspec.StepTimeout, Period: uint64(p.Period), Step: uint64(p.Step)})
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -215,6 +221,12 @@ func (t *tracer) logProposalFrozen(prop proposalValue, propRound round, propPeri
 }
 
 func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue) {
+	t.timeline.record(p.Round, TimelineEvent{
+		Type:     logspec.PeriodConcluded,
+		Period:   uint64(target),
+		Proposal: prop.BlockDigest.String(),
+	})
+
 	logEvent := logspec.AgreementEvent{
 		Type:         logspec.PeriodConcluded,
 		Hash:         prop.BlockDigest.String(),
@@ -241,6 +253,8 @@ func (t *tracer) logPeriodConcluded(p player, target period, prop proposalValue)
 }
 
 func (t *tracer) logRoundStart(p player, target round) {
+	t.timeline.record(target, TimelineEvent{Type: logspec.RoundStart})
+
 	// Log timing telemetry.
 	if t.tR != nil && t.timingReports {
 		timeInfo := t.tR.Build(p.Step)
@@ -342,11 +356,17 @@ func (t *tracer) logProposalManagerResult(p player, input messageEvent, output e
 		t.log.with(logEvent).Infof("pipelined block for (%v, %v): %v", pipelinedRound, pipelinedPeriod, output.(payloadProcessedEvent).Err)
 
 	case proposalAccepted:
+		uv := input.Input.UnauthenticatedVote
+		pev := output.(proposalAcceptedEvent)
+		t.timeline.record(pev.Round, TimelineEvent{
+			Type:     logspec.ProposalAccepted,
+			Period:   uint64(pev.Period),
+			Proposal: pev.Proposal.BlockDigest.String(),
+			Sender:   pev.Proposal.OriginalProposer.String(),
+		})
 		if !t.log.IsLevelEnabled(logging.Info) {
 			return
 		}
-		uv := input.Input.UnauthenticatedVote
-		pev := output.(proposalAcceptedEvent)
 		logEvent := logspec.AgreementEvent{
 			Type:         logspec.ProposalAccepted,
 			Round:        uint64(p.Round),
@@ -360,14 +380,19 @@ func (t *tracer) logProposalManagerResult(p player, input messageEvent, output e
 		t.log.with(logEvent).Infof("proposal %v accepted at (%v, %v)", pev.Proposal, pev.Round, pev.Period)
 
 	case payloadAccepted, proposalCommittable:
-		if !t.log.IsLevelEnabled(logging.Info) {
-			return
-		}
 		var prop proposalValue
+		timelineEvent := TimelineEvent{Type: logspec.BlockValidated, Period: uint64(p.Period), Step: uint64(p.Step)}
 		if output.t() == payloadAccepted {
 			prop = output.(payloadProcessedEvent).Proposal
 		} else {
 			prop = output.(committableEvent).Proposal
+			timelineEvent.Type = logspec.BlockCommittable
+		}
+		timelineEvent.Proposal = prop.BlockDigest.String()
+		timelineEvent.Sender = prop.OriginalProposer.String()
+		t.timeline.record(p.Round, timelineEvent)
+		if !t.log.IsLevelEnabled(logging.Info) {
+			return
 		}
 
 		logEvent := logspec.AgreementEvent{
@@ -380,8 +405,8 @@ func (t *tracer) logProposalManagerResult(p player, input messageEvent, output e
 		}
 
 		if output.t() == payloadAccepted {
-			logEvent.Type = logspec.BlockAssembled
-			t.log.with(logEvent).Infof("block assembled for %v at (%v, %v)", logEvent.Hash, p.Round, p.Period)
+			logEvent.Type = logspec.BlockValidated
+			t.log.with(logEvent).Infof("block validated for %v at (%v, %v)", logEvent.Hash, p.Round, p.Period)
 		} else {
 			logEvent.Type = logspec.BlockCommittable
 			t.log.with(logEvent).Infof("block committable for %v at (%v, %v)", logEvent.Hash, p.Round, p.Period)
@@ -440,11 +465,17 @@ func (t *tracer) logVoteAggregatorResult(input filterableMessageEvent, output ev
 		if input.t() != bundleVerified {
 			return
 		}
+		b := output.(thresholdEvent).Bundle
+		t.timeline.record(b.Round, TimelineEvent{
+			Type:     logspec.BundleAccepted,
+			Period:   uint64(b.Period),
+			Step:     uint64(b.Step),
+			Proposal: b.Proposal.BlockDigest.String(),
+		})
 		if !t.log.IsLevelEnabled(logging.Info) {
 			return
 		}
 
-		b := output.(thresholdEvent).Bundle
 		logEvent := logspec.AgreementEvent{
 			Type:   logspec.BundleAccepted,
 			Round:  uint64(b.Round),
@@ -457,6 +488,17 @@ func (t *tracer) logVoteAggregatorResult(input filterableMessageEvent, output ev
 }
 
 func (t *tracer) logVoteTrackerResult(p player, input voteAcceptedEvent, output thresholdEvent, weight uint64, inputTotal uint64, outputTotal uint64, proto config.ConsensusParams) {
+	if output.T != none {
+		t.timeline.record(output.Round, TimelineEvent{
+			Type:      logspec.ThresholdReached,
+			Period:    uint64(output.Period),
+			Step:      uint64(output.Step),
+			Proposal:  output.Proposal.BlockDigest.String(),
+			Sender:    output.Proposal.OriginalProposer.String(),
+			Weight:    outputTotal,
+			Threshold: output.Step.threshold(proto),
+		})
+	}
 	if !t.log.IsLevelEnabled(logging.Info) {
 		return
 	}
@@ -25,9 +25,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
-var deadlineTimeout = config.Protocol.BigLambda + config.Protocol.SmallLambda
 var partitionStep = next + 3
-var recoveryExtraTimeout = config.Protocol.SmallLambda
 
 // FilterTimeout is the duration of the first agreement step.
 func FilterTimeout(p period, v protocol.ConsensusVersion) time.Duration {
@@ -39,8 +37,20 @@ func FilterTimeout(p period, v protocol.ConsensusVersion) time.Duration {
 }
 
 // DeadlineTimeout is the duration of the second agreement step.
-func DeadlineTimeout() time.Duration {
-	return deadlineTimeout
+func DeadlineTimeout(v protocol.ConsensusVersion) time.Duration {
+	if timeout := config.Consensus[v].AgreementDeadlineTimeout; timeout != 0 {
+		return timeout
+	}
+	// a player which cannot read its consensus version still needs a deadline to make progress
+	return config.Protocol.BigLambda + config.Protocol.SmallLambda
+}
+
+// recoveryExtraTimeout is the duration of the first next-vote step.
+func recoveryExtraTimeout(v protocol.ConsensusVersion) time.Duration {
+	if timeout := config.Consensus[v].AgreementRecoveryExtraTimeout; timeout != 0 {
+		return timeout
+	}
+	return config.Protocol.SmallLambda
 }
 
 type (
@@ -67,10 +77,10 @@ const (
 	down
 )
 
-func (s step) nextVoteRanges() (lower, upper time.Duration) {
-	extra := recoveryExtraTimeout // eg  2500 ms
-	lower = deadlineTimeout       // eg 17500 ms (15000 + 2500)
-	upper = lower + extra         // eg 20000 ms
+func (s step) nextVoteRanges(v protocol.ConsensusVersion) (lower, upper time.Duration) {
+	extra := recoveryExtraTimeout(v) // eg  2500 ms
+	lower = DeadlineTimeout(v)       // eg 17500 ms (15000 + 2500)
+	upper = lower + extra            // eg 20000 ms
 
 	for i := next; i < s; i++ {
 		extra *= 2
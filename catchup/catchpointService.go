@@ -18,6 +18,7 @@ package catchup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -25,6 +26,7 @@ import (
 	"github.com/algorand/go-deadlock"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/ledger"
@@ -37,6 +39,9 @@ const (
 	// noPeersAvailableSleepInterval is the sleep interval that the node would wait if no peers are available to download the next block from.
 	// this delay is intended to ensure to give the network package some time to download the list of relays.
 	noPeersAvailableSleepInterval = 50 * time.Millisecond
+	// maxCatchpointSourceLoadAttempts is the number of attempts to load the ledger from a catchpoint source. Unlike downloads from
+	// the peers, a failure to load a catchpoint source is likely to repeat itself, so it's not worth many attempts.
+	maxCatchpointSourceLoadAttempts = 3
 )
 
 // CatchpointCatchupNodeServices defines the extenal node support needed
@@ -93,10 +98,15 @@ type CatchpointCatchupService struct {
 	abortCtxFunc context.CancelFunc
 	// blocksDownloadPeerSelector is the peer selector used for downloading blocks.
 	blocksDownloadPeerSelector *peerSelector
+	// source is the location of the catchpoint file the ledger is loaded from, or an empty string if the
+	// catchpoint file is downloaded from the peers.
+	source string
+	// dataDir is the directory of the ledger, where the catchpoint files stored in s3 are downloaded into.
+	dataDir string
 }
 
 // MakeResumedCatchpointCatchupService creates a catchpoint catchup service for a node that is already in catchpoint catchup mode
-func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, l *ledger.Ledger, cfg config.Local) (service *CatchpointCatchupService, err error) {
+func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, l *ledger.Ledger, cfg config.Local, dataDir string) (service *CatchpointCatchupService, err error) {
 	service = &CatchpointCatchupService{
 		stats: CatchpointCatchupStats{
 			StartTime: time.Now(),
@@ -108,6 +118,7 @@ func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCat
 		net:            net,
 		ledger:         l,
 		config:         cfg,
+		dataDir:        dataDir,
 	}
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
 	if err != nil {
@@ -121,11 +132,18 @@ func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCat
 	return service, nil
 }
 
-// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode
-func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, l *ledger.Ledger, cfg config.Local) (service *CatchpointCatchupService, err error) {
+// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode.
+// When source isn't empty, the ledger is loaded from the catchpoint file at that location - either a local path, a file:// URL
+// or an s3://bucket/key URL - rather than downloaded from the peers. Catchpoint files stored in s3 are downloaded into dataDir.
+func MakeNewCatchpointCatchupService(catchpoint string, source string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, l *ledger.Ledger, cfg config.Local, dataDir string) (service *CatchpointCatchupService, err error) {
 	if catchpoint == "" {
 		return nil, fmt.Errorf("MakeNewCatchpointCatchupService: catchpoint is invalid")
 	}
+	if source != "" {
+		if _, err = parseCatchpointSource(source); err != nil {
+			return nil, err
+		}
+	}
 	service = &CatchpointCatchupService{
 		stats: CatchpointCatchupStats{
 			CatchpointLabel: catchpoint,
@@ -139,6 +157,8 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 		net:            net,
 		ledger:         l,
 		config:         cfg,
+		source:         source,
+		dataDir:        dataDir,
 	}
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
 	if err != nil {
@@ -222,7 +242,7 @@ func (cs *CatchpointCatchupService) run() {
 	}
 }
 
-// loadStateVariables loads the current stage and catchpoint label from disk. It's used only in the case of catchpoint catchup recovery.
+// loadStateVariables loads the current stage, catchpoint label and catchpoint source from disk. It's used only in the case of catchpoint catchup recovery.
 // ( i.e. the node never completed the catchup, and the node was shutdown )
 func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err error) {
 	var label string
@@ -234,6 +254,11 @@ func (cs *CatchpointCatchupService) loadStateVariables(ctx context.Context) (err
 	cs.stats.CatchpointLabel = label
 	cs.statsMu.Unlock()
 
+	cs.source, err = cs.ledgerAccessor.GetSource(ctx)
+	if err != nil {
+		return err
+	}
+
 	cs.stage, err = cs.ledgerAccessor.GetState(ctx)
 	if err != nil {
 		return err
@@ -251,6 +276,10 @@ func (cs *CatchpointCatchupService) processStageInactive() (err error) {
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageInactive failed to set a catchpoint label : %v", err))
 	}
+	err = cs.ledgerAccessor.SetSource(cs.ctx, cs.source)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageInactive failed to set a catchpoint source : %v", err))
+	}
 	err = cs.updateStage(ledger.CatchpointCatchupStateLedgerDownload)
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageInactive failed to update stage : %v", err))
@@ -273,6 +302,18 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to patse label : %v", err0))
 	}
 
+	if cs.source != "" {
+		return cs.loadLedgerFromSource()
+	}
+
+	if cs.config.CatchupLedgerDownloadParallelism > 0 {
+		downloaded, err := cs.downloadLedgerChunks(round)
+		if downloaded || err != nil {
+			return err
+		}
+		// none of the peers serves a manifest of the catchpoint file; download the catchpoint file as a single stream.
+	}
+
 	// download balances file.
 	peerSelector := makePeerSelector(cs.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
 	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
@@ -331,6 +372,160 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 	return nil
 }
 
+// downloadLedgerChunks downloads the catchpoint file in verified chunks, concurrently from the peers serving the same
+// catchpoint file manifest. The manifest and the download progress are persisted, so that a restarted node resumes the
+// download rather than starting it over. It returns false, with no error, if none of the peers serves a manifest.
+func (cs *CatchpointCatchupService) downloadLedgerChunks(round basics.Round) (downloaded bool, err error) {
+	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	manifest, resume, err := cs.ledgerAccessor.GetChunksManifest(cs.ctx)
+	if err != nil {
+		return true, cs.abort(fmt.Errorf("processStageLedgerDownload failed to read the catchpoint file manifest : %v", err))
+	}
+	attemptsCount := 0
+	// failedManifests are the digests of the manifests of catchpoint files that failed to be processed or verified.
+	failedManifests := make(map[crypto.Digest]bool)
+
+	for {
+		attemptsCount++
+
+		peers := cs.relayHTTPPeers()
+
+		var progress ledger.CatchpointCatchupChunksProgress
+		if resume {
+			peers = ledgerFetcher.peersWithManifest(cs.ctx, peers, round, &manifest)
+			if len(peers) > 0 {
+				progress, err = cs.ledgerAccessor.GetChunksProgress(cs.ctx)
+				if err != nil {
+					return true, cs.abort(fmt.Errorf("processStageLedgerDownload failed to read the catchpoint file download progress : %v", err))
+				}
+				cs.log.Infof("resuming the catchpoint file download from chunk %d out of %d", progress.NextChunk, len(manifest.Chunks))
+			} else {
+				cs.log.Infof("none of the peers serves the manifest of the interrupted catchpoint file download; starting it over")
+				resume = false
+				peers = cs.relayHTTPPeers()
+			}
+		}
+		if !resume {
+			manifest, peers, err = ledgerFetcher.selectManifest(cs.ctx, peers, round, failedManifests)
+			if err != nil {
+				if cs.ctx.Err() != nil {
+					return true, cs.stopOrAbort()
+				}
+				cs.log.Infof("processStageLedgerDownload: %v", err)
+				return false, nil
+			}
+			err = cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+			if err == nil {
+				err = cs.ledgerAccessor.SetChunksManifest(cs.ctx, &manifest)
+			}
+			if err != nil {
+				if cs.ctx.Err() != nil {
+					return true, cs.stopOrAbort()
+				}
+				return true, cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err))
+			}
+		}
+
+		start := time.Now()
+		err = ledgerFetcher.downloadChunks(cs.ctx, round, &manifest, peers, cs.config.CatchupLedgerDownloadParallelism, progress)
+		if err == nil {
+			cs.log.Infof("ledger downloaded in %d chunks from %d peers in %d seconds", len(manifest.Chunks), len(peers), time.Since(start)/time.Second)
+			start = time.Now()
+			err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedAccounts)
+			if err == nil {
+				cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+				break
+			}
+			// the chunks match the manifest, but the catchpoint file itself is invalid; start over with another manifest.
+			failedManifests[manifest.Digest()] = true
+			resume = false
+		} else if errors.Is(err, errProcessCatchpointChunk) {
+			// the staging balances might hold part of the chunk, and the chunk content is likely invalid; discard the
+			// staged chunks and start over with another manifest.
+			failedManifests[manifest.Digest()] = true
+			resume = false
+		} else {
+			// none of the chunks was partially processed; the next attempt resumes from the next chunk.
+			resume = true
+		}
+
+		if cs.ctx.Err() != nil {
+			return true, cs.stopOrAbort()
+		}
+
+		if attemptsCount >= cs.config.CatchupLedgerDownloadRetryAttempts {
+			err = fmt.Errorf("processStageLedgerDownload: catchpoint catchup exceeded number of attempts to retrieve ledger")
+			return true, cs.abort(err)
+		}
+		cs.log.Warnf("unable to download ledger : %v", err)
+	}
+
+	err = cs.updateStage(ledger.CatchpointCatchupStateLastestBlockDownload)
+	if err != nil {
+		return true, cs.abort(fmt.Errorf("processStageLedgerDownload failed to update stage to CatchpointCatchupStateLastestBlockDownload : %v", err))
+	}
+	return true, nil
+}
+
+// relayHTTPPeers returns the relays the catchpoint file could be downloaded from.
+func (cs *CatchpointCatchupService) relayHTTPPeers() (peers []network.HTTPPeer) {
+	for _, peer := range cs.net.GetPeers(network.PeersPhonebookRelays) {
+		if httpPeer, ok := peer.(network.HTTPPeer); ok {
+			peers = append(peers, httpPeer)
+		}
+	}
+	return
+}
+
+// loadLedgerFromSource loads the ledger from the catchpoint file at the catchpoint source. The loaded ledger is verified
+// against the catchpoint label once the latest block is downloaded from the peers, in the next stage.
+func (cs *CatchpointCatchupService) loadLedgerFromSource() (err error) {
+	source, err := parseCatchpointSource(cs.source)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to parse catchpoint source : %v", err))
+	}
+	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	attemptsCount := 0
+
+	for {
+		attemptsCount++
+
+		err = cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+		if err != nil {
+			if cs.ctx.Err() != nil {
+				return cs.stopOrAbort()
+			}
+			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to reset staging balances : %v", err))
+		}
+		start := time.Now()
+		err = ledgerFetcher.loadLedger(cs.ctx, source, cs.dataDir)
+		if err == nil {
+			cs.log.Infof("ledger loaded from %s in %d seconds", source, time.Since(start)/time.Second)
+			start = time.Now()
+			err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedAccounts)
+			if err == nil {
+				cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+				break
+			}
+		}
+
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+
+		if attemptsCount >= maxCatchpointSourceLoadAttempts {
+			return cs.abort(fmt.Errorf("processStageLedgerDownload: catchpoint catchup exceeded number of attempts to load ledger from %s : %v", source, err))
+		}
+		cs.log.Warnf("unable to load ledger from %s : %v", source, err)
+	}
+
+	err = cs.updateStage(ledger.CatchpointCatchupStateLastestBlockDownload)
+	if err != nil {
+		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to update stage to CatchpointCatchupStateLastestBlockDownload : %v", err))
+	}
+	return nil
+}
+
 // updateVerifiedAccounts update the user's statistics for the given verified accounts
 func (cs *CatchpointCatchupService) updateVerifiedAccounts(addedTrieHashes uint64) {
 	cs.statsMu.Lock()
@@ -0,0 +1,194 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/algorand/go-algorand/util/s3"
+)
+
+const (
+	catchpointSourceFileScheme = "file"
+	catchpointSourceS3Scheme   = "s3"
+)
+
+// catchpointSourceDownloadPattern is the pattern of the names of the files the catchpoint files stored in s3
+// are downloaded into.
+const catchpointSourceDownloadPattern = "catchpoint-*.tar.download"
+
+// catchpointSource is the location of a catchpoint file the ledger could be loaded from
+// instead of downloading it from the peers.
+type catchpointSource struct {
+	// scheme is either catchpointSourceFileScheme or catchpointSourceS3Scheme
+	scheme string
+	// bucket is the s3 bucket of the catchpoint file. It's empty for local files.
+	bucket string
+	// path is the local path of the catchpoint file, or its key in the s3 bucket
+	path string
+}
+
+// parseCatchpointSource parses the location of a catchpoint file, which could be either
+// a local path, a file:// URL or an s3://bucket/key URL.
+func parseCatchpointSource(source string) (catchpointSource, error) {
+	if !strings.Contains(source, "://") {
+		if source == "" {
+			return catchpointSource{}, fmt.Errorf("parseCatchpointSource: empty catchpoint source")
+		}
+		return catchpointSource{scheme: catchpointSourceFileScheme, path: source}, nil
+	}
+	parsedURL, err := url.Parse(source)
+	if err != nil {
+		return catchpointSource{}, fmt.Errorf("parseCatchpointSource: unable to parse '%s' : %v", source, err)
+	}
+	switch parsedURL.Scheme {
+	case catchpointSourceFileScheme:
+		if parsedURL.Host != "" && parsedURL.Host != "localhost" {
+			return catchpointSource{}, fmt.Errorf("parseCatchpointSource: non-local file URL '%s'", source)
+		}
+		if parsedURL.Path == "" {
+			return catchpointSource{}, fmt.Errorf("parseCatchpointSource: file URL '%s' has no path", source)
+		}
+		return catchpointSource{scheme: catchpointSourceFileScheme, path: parsedURL.Path}, nil
+	case catchpointSourceS3Scheme:
+		key := strings.TrimPrefix(parsedURL.Path, "/")
+		if parsedURL.Host == "" || key == "" {
+			return catchpointSource{}, fmt.Errorf("parseCatchpointSource: s3 URL '%s' should be of the form s3://bucket/key", source)
+		}
+		return catchpointSource{scheme: catchpointSourceS3Scheme, bucket: parsedURL.Host, path: key}, nil
+	default:
+		return catchpointSource{}, fmt.Errorf("parseCatchpointSource: unsupported scheme '%s' in '%s'", parsedURL.Scheme, source)
+	}
+}
+
+// ValidateCatchpointSource verifies that the given catchpoint file location is either a local path,
+// a file:// URL or an s3://bucket/key URL.
+func ValidateCatchpointSource(source string) error {
+	_, err := parseCatchpointSource(source)
+	return err
+}
+
+func (cps catchpointSource) String() string {
+	if cps.scheme == catchpointSourceS3Scheme {
+		return fmt.Sprintf("s3://%s/%s", cps.bucket, cps.path)
+	}
+	return cps.path
+}
+
+// catchpointSourceReader is the tar stream of a catchpoint file opened from a catchpointSource.
+type catchpointSourceReader struct {
+	io.Reader
+	file       *os.File
+	gzipReader *gzip.Reader
+	// temporary is set when the file was downloaded into a temporary file, which is deleted on close.
+	temporary bool
+}
+
+// open opens the catchpoint file. Catchpoint files stored in s3 are first downloaded into a temporary file in
+// downloadDir. The catchpoint files are gzip compressed tar files as written by the catchpoint tracker, however,
+// uncompressed tar files ( such as those served by the relays ) are supported as well.
+func (cps catchpointSource) open(downloadDir string) (reader *catchpointSourceReader, err error) {
+	reader = &catchpointSourceReader{}
+	switch cps.scheme {
+	case catchpointSourceFileScheme:
+		reader.file, err = os.Open(cps.path)
+		if err != nil {
+			return nil, err
+		}
+	case catchpointSourceS3Scheme:
+		reader.file, err = cps.downloadS3(downloadDir)
+		if err != nil {
+			return nil, err
+		}
+		reader.temporary = true
+	default:
+		return nil, fmt.Errorf("catchpointSource: unsupported scheme '%s'", cps.scheme)
+	}
+
+	bufferedReader := bufio.NewReader(reader.file)
+	magic, err := bufferedReader.Peek(2)
+	if err != nil {
+		reader.Close()
+		return nil, fmt.Errorf("catchpointSource: unable to read the catchpoint file %s : %v", cps, err)
+	}
+	if magic[0] == 0x1f && magic[1] == 0x8b {
+		reader.gzipReader, err = gzip.NewReader(bufferedReader)
+		if err != nil {
+			reader.Close()
+			return nil, fmt.Errorf("catchpointSource: unable to decompress the catchpoint file %s : %v", cps, err)
+		}
+		reader.Reader = reader.gzipReader
+	} else {
+		reader.Reader = bufferedReader
+	}
+	return reader, nil
+}
+
+// downloadS3 downloads the catchpoint file from s3 into a temporary file in the given directory. The file is
+// removed if the download fails.
+func (cps catchpointSource) downloadS3(dir string) (file *os.File, err error) {
+	helper, err := s3.MakeS3SessionForDownloadWithBucket(cps.bucket)
+	if err != nil {
+		return nil, fmt.Errorf("catchpointSource: unable to create s3 session for bucket %s : %v", cps.bucket, err)
+	}
+	removeCatchpointSourceDownloads(dir)
+	file, err = os.CreateTemp(dir, catchpointSourceDownloadPattern)
+	if err != nil {
+		return nil, fmt.Errorf("catchpointSource: unable to create a file to download %s into : %v", cps, err)
+	}
+	err = helper.DownloadFile(cps.path, file)
+	if err == nil {
+		_, err = file.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, fmt.Errorf("catchpointSource: unable to download %s : %v", cps, err)
+	}
+	return file, nil
+}
+
+// removeCatchpointSourceDownloads removes the files left in dir by the downloads that were interrupted before
+// they could clean up, such as by a crash of the node.
+func removeCatchpointSourceDownloads(dir string) {
+	leftovers, err := filepath.Glob(filepath.Join(dir, catchpointSourceDownloadPattern))
+	if err != nil {
+		return
+	}
+	for _, leftover := range leftovers {
+		os.Remove(leftover)
+	}
+}
+
+// Close closes the catchpoint file, and deletes it if it was a temporary file.
+func (r *catchpointSourceReader) Close() error {
+	if r.gzipReader != nil {
+		r.gzipReader.Close()
+	}
+	err := r.file.Close()
+	if r.temporary {
+		os.Remove(r.file.Name())
+	}
+	return err
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseCatchpointSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source, err := parseCatchpointSource("/var/catchpoints/100.catchpoint")
+	require.NoError(t, err)
+	require.Equal(t, catchpointSource{scheme: catchpointSourceFileScheme, path: "/var/catchpoints/100.catchpoint"}, source)
+
+	source, err = parseCatchpointSource("file:///var/catchpoints/100.catchpoint")
+	require.NoError(t, err)
+	require.Equal(t, catchpointSource{scheme: catchpointSourceFileScheme, path: "/var/catchpoints/100.catchpoint"}, source)
+
+	source, err = parseCatchpointSource("s3://catchpoints/mainnet/100.catchpoint")
+	require.NoError(t, err)
+	require.Equal(t, catchpointSource{scheme: catchpointSourceS3Scheme, bucket: "catchpoints", path: "mainnet/100.catchpoint"}, source)
+	require.Equal(t, "s3://catchpoints/mainnet/100.catchpoint", source.String())
+
+	for _, bad := range []string{"", "s3://catchpoints", "s3:///100.catchpoint", "file://relay.algorand.network/100.catchpoint", "http://relay/100.catchpoint"} {
+		_, err = parseCatchpointSource(bad)
+		require.Error(t, err, bad)
+		require.Error(t, ValidateCatchpointSource(bad), bad)
+	}
+}
+
+// recordingCatchupAccessor records the catchpoint file chunks it is given.
+type recordingCatchupAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	sections map[string][]byte
+}
+
+func (a *recordingCatchupAccessor) ProgressStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) (err error) {
+	a.sections[sectionName] = bytes
+	return nil
+}
+
+func writeTestCatchpointFile(t *testing.T, filename string, compressed bool, sections map[string][]byte) {
+	f, err := os.Create(filename)
+	require.NoError(t, err)
+	defer f.Close()
+	var w io.Writer = f
+	if compressed {
+		gzipWriter := gzip.NewWriter(f)
+		defer gzipWriter.Close()
+		w = gzipWriter
+	}
+	tarWriter := tar.NewWriter(w)
+	defer tarWriter.Close()
+	for name, bytes := range sections {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(bytes))}))
+		_, err = tarWriter.Write(bytes)
+		require.NoError(t, err)
+	}
+}
+
+func TestLoadLedgerFromSource(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	sections := map[string][]byte{
+		"content.msgpack":      []byte("header"),
+		"balances.1.1.msgpack": []byte("first chunk"),
+		"balances.1.2.msgpack": []byte("second chunk"),
+	}
+	dir := t.TempDir()
+	for _, compressed := range []bool{true, false} {
+		filename := filepath.Join(dir, "100.catchpoint")
+		writeTestCatchpointFile(t, filename, compressed, sections)
+
+		for _, location := range []string{filename, "file://" + filename} {
+			accessor := &recordingCatchupAccessor{sections: make(map[string][]byte)}
+			lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+			source, err := parseCatchpointSource(location)
+			require.NoError(t, err)
+			err = lf.loadLedger(context.Background(), source, dir)
+			require.NoError(t, err)
+			require.Equal(t, sections, accessor.sections)
+		}
+	}
+
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &mocks.MockCatchpointCatchupAccessor{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	err := lf.loadLedger(context.Background(), catchpointSource{scheme: catchpointSourceFileScheme, path: filepath.Join(dir, "missing.catchpoint")}, dir)
+	require.Error(t, err)
+
+	// an empty chunk is rejected, as it is when downloading from a peer
+	filename := filepath.Join(dir, "empty.catchpoint")
+	writeTestCatchpointFile(t, filename, true, map[string][]byte{"content.msgpack": {}})
+	err = lf.loadLedger(context.Background(), catchpointSource{scheme: catchpointSourceFileScheme, path: filename}, dir)
+	require.Error(t, err)
+}
+
+func TestCatchpointSourceDownloadCleanup(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	kept := filepath.Join(dir, "100.catchpoint")
+	require.NoError(t, os.WriteFile(kept, []byte("catchpoint"), 0600))
+
+	// the files of the interrupted downloads are removed before the next one
+	leftover, err := os.CreateTemp(dir, catchpointSourceDownloadPattern)
+	require.NoError(t, err)
+	require.NoError(t, leftover.Close())
+	removeCatchpointSourceDownloads(dir)
+	require.NoFileExists(t, leftover.Name())
+	require.FileExists(t, kept)
+
+	// a downloaded file is removed once closed
+	file, err := os.CreateTemp(dir, catchpointSourceDownloadPattern)
+	require.NoError(t, err)
+	reader := &catchpointSourceReader{Reader: file, file: file, temporary: true}
+	require.NoError(t, reader.Close())
+	require.NoFileExists(t, file.Name())
+}
@@ -0,0 +1,257 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/network"
+)
+
+// maxCatchpointManifestPeers is the maximum number of peers we would query for the catchpoint file manifest
+// before selecting the one to download.
+const maxCatchpointManifestPeers = 16
+
+var errNoCatchpointManifestPeers = errors.New("no peer serves a manifest of the catchpoint file")
+
+// errProcessCatchpointChunk is returned when a verified chunk could not be processed into the staging balances, or its
+// progress could not be persisted. The staging balances might then hold part of the chunk, so the download cannot be
+// resumed from the persisted progress.
+var errProcessCatchpointChunk = errors.New("unable to process catchpoint file chunk")
+
+// selectManifest queries the given peers for the manifest of the catchpoint file for the given round. Since every relay
+// writes its own catchpoint file, the manifests of different relays might differ; the manifest served by the largest
+// number of peers is selected, and returned along with these peers. Manifests whose digests are in excluded, such as
+// the ones of catchpoint files that already failed to verify, are never selected.
+func (lf *ledgerFetcher) selectManifest(ctx context.Context, peers []network.HTTPPeer, round basics.Round, excluded map[crypto.Digest]bool) (manifest ledger.CatchpointFileManifest, manifestPeers []network.HTTPPeer, err error) {
+	if len(peers) > maxCatchpointManifestPeers {
+		peers = peers[:maxCatchpointManifestPeers]
+	}
+	manifests := make(map[crypto.Digest]ledger.CatchpointFileManifest)
+	servingPeers := make(map[crypto.Digest][]network.HTTPPeer)
+	for _, peer := range peers {
+		peerManifest, err := lf.getPeerManifest(ctx, peer, round)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ledger.CatchpointFileManifest{}, nil, ctx.Err()
+			}
+			lf.log.Debugf("selectManifest: unable to retrieve the catchpoint file manifest from peer %s : %v", peer.GetAddress(), err)
+			continue
+		}
+		digest := peerManifest.Digest()
+		if excluded[digest] {
+			lf.log.Debugf("selectManifest: peer %s serves the manifest of a catchpoint file that failed to verify", peer.GetAddress())
+			continue
+		}
+		manifests[digest] = peerManifest
+		servingPeers[digest] = append(servingPeers[digest], peer)
+	}
+	for digest, digestPeers := range servingPeers {
+		if len(digestPeers) > len(manifestPeers) {
+			manifest, manifestPeers = manifests[digest], digestPeers
+		}
+	}
+	if len(manifestPeers) == 0 {
+		return ledger.CatchpointFileManifest{}, nil, errNoCatchpointManifestPeers
+	}
+	return manifest, manifestPeers, nil
+}
+
+// peersWithManifest returns the given peers that serve the given catchpoint file manifest for the given round.
+func (lf *ledgerFetcher) peersWithManifest(ctx context.Context, peers []network.HTTPPeer, round basics.Round, manifest *ledger.CatchpointFileManifest) (manifestPeers []network.HTTPPeer) {
+	digest := manifest.Digest()
+	for _, peer := range peers {
+		if len(manifestPeers) >= maxCatchpointManifestPeers || ctx.Err() != nil {
+			break
+		}
+		peerManifest, err := lf.getPeerManifest(ctx, peer, round)
+		if err == nil && peerManifest.Digest() == digest {
+			manifestPeers = append(manifestPeers, peer)
+		}
+	}
+	return
+}
+
+// chunkPeers are the peers serving a catchpoint file manifest, from which the chunks of the catchpoint file are downloaded.
+type chunkPeers struct {
+	mu      deadlock.Mutex
+	peers   []network.HTTPPeer
+	invalid map[network.HTTPPeer]bool
+}
+
+// candidates returns the peers to download the chunk at the given index from, in order. The peers are rotated per chunk
+// so that concurrent downloads are spread across the peers. Peers that served an invalid chunk are excluded.
+func (cp *chunkPeers) candidates(chunkIndex int) (out []network.HTTPPeer) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	for i := range cp.peers {
+		peer := cp.peers[(chunkIndex+i)%len(cp.peers)]
+		if !cp.invalid[peer] {
+			out = append(out, peer)
+		}
+	}
+	return
+}
+
+func (cp *chunkPeers) markInvalid(peer network.HTTPPeer) {
+	cp.mu.Lock()
+	defer cp.mu.Unlock()
+	cp.invalid[peer] = true
+}
+
+// chunkResult is a verified chunk of the catchpoint file, or the error of downloading it.
+type chunkResult struct {
+	index   int
+	content []byte
+	err     error
+}
+
+// downloadChunks downloads the chunks of the catchpoint file described by the manifest from the given peers, starting
+// at the chunk the given progress resumes from. Up to parallelism chunks are downloaded concurrently; each of the chunks
+// is verified against the manifest, and the chunks are processed into the staging balances in order. The progress is
+// persisted after each processed chunk, so that a restarted node resumes the download from the next chunk. If a chunk
+// fails to be processed, the returned error wraps errProcessCatchpointChunk.
+func (lf *ledgerFetcher) downloadChunks(ctx context.Context, round basics.Round, manifest *ledger.CatchpointFileManifest, peers []network.HTTPPeer, parallelism int, resume ledger.CatchpointCatchupChunksProgress) error {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	downloadProgress := resume.AccessorProgress()
+	nextChunk := int(resume.NextChunk)
+	if nextChunk > len(manifest.Chunks) {
+		return fmt.Errorf("downloadChunks: resuming from chunk %d of a manifest of %d chunks", nextChunk, len(manifest.Chunks))
+	}
+	sources := &chunkPeers{peers: peers, invalid: make(map[network.HTTPPeer]bool)}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	// window bounds the number of chunks held in memory, downloaded ahead of the chunk being processed.
+	window := make(chan struct{}, 2*parallelism)
+	indices := make(chan int)
+	results := make(chan chunkResult, 2*parallelism)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(indices)
+		for i := nextChunk; i < len(manifest.Chunks); i++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case indices <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	for w := 0; w < parallelism; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indices {
+				content, err := lf.fetchChunk(ctx, sources, round, i, manifest.Chunks[i])
+				select {
+				case results <- chunkResult{index: i, content: content, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	var writeDuration time.Duration
+	pending := make(map[int][]byte)
+	for nextChunk < len(manifest.Chunks) {
+		var result chunkResult
+		select {
+		case result = <-results:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if result.err != nil {
+			return result.err
+		}
+		pending[result.index] = result.content
+		for content, has := pending[nextChunk]; has; content, has = pending[nextChunk] {
+			delete(pending, nextChunk)
+			start := time.Now()
+			err := lf.processBalancesBlock(ctx, manifest.Chunks[nextChunk].Name, content, &downloadProgress)
+			if err != nil {
+				return fmt.Errorf("%w '%s' : %v", errProcessCatchpointChunk, manifest.Chunks[nextChunk].Name, err)
+			}
+			nextChunk++
+			err = lf.accessor.SetChunksProgress(ctx, ledger.MakeCatchpointCatchupChunksProgress(uint64(nextChunk), &downloadProgress))
+			if err != nil {
+				return fmt.Errorf("%w '%s' : unable to persist the download progress : %v", errProcessCatchpointChunk, manifest.Chunks[nextChunk-1].Name, err)
+			}
+			writeDuration += time.Since(start)
+			if lf.reporter != nil {
+				lf.reporter.updateLedgerFetcherProgress(&downloadProgress)
+			}
+			<-window
+		}
+	}
+	lf.log.Infof(
+		"writing balances to disk took %d seconds, "+
+			"writing creatables to disk took %d seconds, "+
+			"writing hashes to disk took %d seconds, "+
+			"total duration is %d seconds",
+		downloadProgress.BalancesWriteDuration/time.Second,
+		downloadProgress.CreatablesWriteDuration/time.Second,
+		downloadProgress.HashesWriteDuration/time.Second,
+		writeDuration/time.Second)
+	return nil
+}
+
+// fetchChunk downloads the chunk at the given index, trying each of the peers in turn until one of them serves
+// a valid chunk.
+func (lf *ledgerFetcher) fetchChunk(ctx context.Context, sources *chunkPeers, round basics.Round, chunkIndex int, chunk ledger.CatchpointFileChunk) (content []byte, err error) {
+	candidates := sources.candidates(chunkIndex)
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("fetchChunk: no peer left to download chunk '%s' from", chunk.Name)
+	}
+	for _, peer := range candidates {
+		content, err = lf.getPeerChunk(ctx, peer, round, chunkIndex, chunk)
+		if err == nil {
+			return content, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, errInvalidCatchpointFileChunk) {
+			sources.markInvalid(peer)
+		}
+		lf.log.Infof("fetchChunk: unable to download chunk '%s' from peer %s : %v", chunk.Name, peer.GetAddress(), err)
+	}
+	return nil, err
+}
@@ -0,0 +1,189 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// chunksCatchupAccessor records the catchpoint file chunks it is given, in order, along with the persisted progress.
+type chunksCatchupAccessor struct {
+	mocks.MockCatchpointCatchupAccessor
+	sections []string
+	progress ledger.CatchpointCatchupChunksProgress
+	// failSection is the name of the section that fails to be processed, if any.
+	failSection string
+}
+
+func (a *chunksCatchupAccessor) ProgressStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) (err error) {
+	if sectionName == a.failSection {
+		return fmt.Errorf("unable to process section %s", sectionName)
+	}
+	a.sections = append(a.sections, sectionName)
+	progress.ProcessedChunks++
+	return nil
+}
+
+func (a *chunksCatchupAccessor) SetChunksProgress(ctx context.Context, progress ledger.CatchpointCatchupChunksProgress) (err error) {
+	a.progress = progress
+	return nil
+}
+
+// makeTestChunkedCatchpointFile writes a catchpoint file with the given sections, each as an independent gzip
+// member, and returns it along with its manifest.
+func makeTestChunkedCatchpointFile(t *testing.T, names []string) (file []byte, manifest ledger.CatchpointFileManifest) {
+	var buffer bytes.Buffer
+	for _, name := range names {
+		content := []byte("content of " + name)
+		offset := buffer.Len()
+		gzipWriter := gzip.NewWriter(&buffer)
+		tarWriter := tar.NewWriter(gzipWriter)
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content))}))
+		_, err := tarWriter.Write(content)
+		require.NoError(t, err)
+		require.NoError(t, tarWriter.Flush())
+		require.NoError(t, gzipWriter.Close())
+		manifest.Chunks = append(manifest.Chunks, ledger.CatchpointFileChunk{
+			Name:   name,
+			Offset: uint64(offset),
+			Length: uint64(buffer.Len() - offset),
+			Size:   uint64(len(content)),
+			Hash:   crypto.Hash(content),
+		})
+	}
+	return buffer.Bytes(), manifest
+}
+
+// serveTestChunkedCatchpointFile serves the manifest and the chunks of the given catchpoint file. Chunks listed in
+// corrupted are served with a flipped byte.
+func serveTestChunkedCatchpointFile(t *testing.T, file []byte, manifest ledger.CatchpointFileManifest, corrupted map[int]bool) network.HTTPPeer {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if strings.HasSuffix(req.URL.Path, "/manifest") {
+			w.Header().Set("Content-Type", rpcs.LedgerManifestResponseContentType)
+			w.Write(protocol.Encode(&manifest))
+			return
+		}
+		index, err := strconv.Atoi(req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])
+		if err != nil || index >= len(manifest.Chunks) {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		chunk := manifest.Chunks[index]
+		member := append([]byte{}, file[chunk.Offset:chunk.Offset+chunk.Length]...)
+		if corrupted[index] {
+			member[len(member)/2] ^= 0xff
+		}
+		w.Header().Set("Content-Type", rpcs.LedgerChunkResponseContentType)
+		w.Write(member)
+	}))
+	t.Cleanup(server.Close)
+	peer := testHTTPPeer(server.URL)
+	return &peer
+}
+
+func TestDownloadChunks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	names := []string{"content.msgpack"}
+	for i := 1; i <= 20; i++ {
+		names = append(names, fmt.Sprintf("balances.%d.%d.msgpack", i, 20))
+	}
+	file, manifest := makeTestChunkedCatchpointFile(t, names)
+
+	// every other chunk served by the first peer is corrupted; these are downloaded from the second peer instead.
+	corrupted := make(map[int]bool)
+	for i := 0; i < len(names); i += 2 {
+		corrupted[i] = true
+	}
+	peers := []network.HTTPPeer{
+		serveTestChunkedCatchpointFile(t, file, manifest, corrupted),
+		serveTestChunkedCatchpointFile(t, file, manifest, nil),
+	}
+
+	accessor := &chunksCatchupAccessor{}
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, accessor, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	selected, manifestPeers, err := lf.selectManifest(context.Background(), peers, basics.Round(100), nil)
+	require.NoError(t, err)
+	require.Equal(t, manifest, selected)
+	require.Len(t, manifestPeers, 2)
+
+	// a manifest that failed to verify is never selected again, even if most of the peers serve it.
+	otherFile, otherManifest := makeTestChunkedCatchpointFile(t, names[:5])
+	otherPeer := serveTestChunkedCatchpointFile(t, otherFile, otherManifest, nil)
+	selected, manifestPeers, err = lf.selectManifest(context.Background(), append(peers, otherPeer), basics.Round(100), map[crypto.Digest]bool{manifest.Digest(): true})
+	require.NoError(t, err)
+	require.Equal(t, otherManifest, selected)
+	require.Equal(t, []network.HTTPPeer{otherPeer}, manifestPeers)
+	_, _, err = lf.selectManifest(context.Background(), peers, basics.Round(100), map[crypto.Digest]bool{manifest.Digest(): true})
+	require.ErrorIs(t, err, errNoCatchpointManifestPeers)
+
+	err = lf.downloadChunks(context.Background(), basics.Round(100), &manifest, peers, 4, ledger.CatchpointCatchupChunksProgress{})
+	require.NoError(t, err)
+	require.Equal(t, names, accessor.sections)
+	require.Equal(t, uint64(len(names)), accessor.progress.NextChunk)
+	require.Equal(t, uint64(len(names)), accessor.progress.ProcessedChunks)
+
+	// resume an interrupted download.
+	accessor.sections = nil
+	resume := ledger.CatchpointCatchupChunksProgress{NextChunk: 12, SeenHeader: true, ProcessedChunks: 12}
+	err = lf.downloadChunks(context.Background(), basics.Round(100), &manifest, peers[1:], 3, resume)
+	require.NoError(t, err)
+	require.Equal(t, names[12:], accessor.sections)
+	require.Equal(t, uint64(len(names)), accessor.progress.NextChunk)
+	require.True(t, accessor.progress.SeenHeader)
+
+	// a chunk no peer serves intact fails the download, leaving the progress at the last verified chunk.
+	accessor.sections = nil
+	accessor.progress = ledger.CatchpointCatchupChunksProgress{}
+	err = lf.downloadChunks(context.Background(), basics.Round(100), &manifest, peers[:1], 2, ledger.CatchpointCatchupChunksProgress{})
+	require.Error(t, err)
+	require.Empty(t, accessor.sections)
+	require.Equal(t, uint64(0), accessor.progress.NextChunk)
+	require.NotErrorIs(t, err, errProcessCatchpointChunk)
+
+	// a chunk that fails to be processed is reported as such, so that the download is not resumed over it.
+	accessor.failSection = names[3]
+	err = lf.downloadChunks(context.Background(), basics.Round(100), &manifest, peers, 2, ledger.CatchpointCatchupChunksProgress{})
+	require.ErrorIs(t, err, errProcessCatchpointChunk)
+	require.Equal(t, names[:3], accessor.sections)
+	require.Equal(t, uint64(3), accessor.progress.NextChunk)
+
+	// peers serving a different catchpoint file are left out.
+	require.Equal(t, peers, lf.peersWithManifest(context.Background(), append(peers, otherPeer), basics.Round(100), &manifest))
+}
@@ -32,12 +32,15 @@ import (
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-algorand/util"
 )
 
 var errNoLedgerForRound = errors.New("No ledger available for given round")
 
+var errInvalidCatchpointFileChunk = errors.New("invalid catchpoint file chunk")
+
 const (
 	// maxCatchpointFileChunkSize is a rough estimate for the worst-case scenario we're going to have of all the accounts data per a single catchpoint file chunk.
 	maxCatchpointFileChunkSize = ledger.BalancesPerCatchpointFileChunk * basics.MaxEncodedAccountDataSize
@@ -45,6 +48,8 @@ const (
 	defaultMinCatchpointFileDownloadBytesPerSecond = 20 * 1024
 	// catchpointFileStreamReadSize defines the number of bytes we would attempt to read at each itration from the incoming http data stream
 	catchpointFileStreamReadSize = 4096
+	// maxCatchpointFileManifestSize is the maximum size of a catchpoint file manifest we would download
+	maxCatchpointFileManifestSize = 64 * 1024 * 1024
 )
 
 var errNonHTTPPeer = fmt.Errorf("downloadLedger : non-HTTPPeer encountered")
@@ -80,14 +85,33 @@ func (lf *ledgerFetcher) downloadLedger(ctx context.Context, peer network.Peer,
 	return lf.getPeerLedger(ctx, httpPeer, round)
 }
 
-func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+// peerLedgerURL returns the URL of the catchpoint file for the given round on the peer, followed by the given suffix.
+func (lf *ledgerFetcher) peerLedgerURL(peer network.HTTPPeer, round basics.Round, suffix string) (string, error) {
 	parsedURL, err := network.ParseHostOrURL(peer.GetAddress())
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	parsedURL.Path = lf.net.SubstituteGenesisID(path.Join(parsedURL.Path, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36)+suffix))
+	return parsedURL.String(), nil
+}
+
+// maxChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
+func (lf *ledgerFetcher) maxChunkDownloadDuration() time.Duration {
+	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
+	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
+	} else {
+		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
 	}
+	return maxCatchpointFileChunkDownloadDuration
+}
 
-	parsedURL.Path = lf.net.SubstituteGenesisID(path.Join(parsedURL.Path, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36)))
-	ledgerURL := parsedURL.String()
+func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+	ledgerURL, err := lf.peerLedgerURL(peer, round, "")
+	if err != nil {
+		return err
+	}
 	lf.log.Debugf("ledger GET %#v peer %#v %T", ledgerURL, peer, peer)
 	request, err := http.NewRequest(http.MethodGet, ledgerURL, nil)
 	if err != nil {
@@ -127,17 +151,108 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		return err
 	}
 
-	// maxCatchpointFileChunkDownloadDuration is the maximum amount of time we would wait to download a single chunk off a catchpoint file
-	maxCatchpointFileChunkDownloadDuration := 2 * time.Minute
-	if lf.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / time.Duration(lf.config.MinCatchpointFileDownloadBytesPerSecond)
-	} else {
-		maxCatchpointFileChunkDownloadDuration += maxCatchpointFileChunkSize * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
+	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, lf.maxChunkDownloadDuration())
+	defer watchdogReader.Close()
+	return lf.processCatchpointStream(ctx, tar.NewReader(watchdogReader), watchdogReader.Reset)
+}
+
+// getPeerManifest retrieves the manifest of the catchpoint file for the given round from the peer.
+func (lf *ledgerFetcher) getPeerManifest(ctx context.Context, peer network.HTTPPeer, round basics.Round) (manifest ledger.CatchpointFileManifest, err error) {
+	encoded, err := lf.getPeerLedgerResource(ctx, peer, round, "/manifest", rpcs.LedgerManifestResponseContentType, maxCatchpointFileManifestSize)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(encoded, &manifest)
+	if err != nil {
+		return ledger.CatchpointFileManifest{}, fmt.Errorf("getPeerManifest : unable to decode the catchpoint file manifest : %v", err)
+	}
+	if len(manifest.Chunks) == 0 {
+		return ledger.CatchpointFileManifest{}, fmt.Errorf("getPeerManifest : empty catchpoint file manifest")
 	}
+	for _, chunk := range manifest.Chunks {
+		if chunk.Size < 1 || chunk.Size > maxCatchpointFileChunkSize || chunk.Length > 2*maxCatchpointFileChunkSize {
+			return ledger.CatchpointFileManifest{}, fmt.Errorf("getPeerManifest : chunk '%s' has a size of %d bytes and a length of %d bytes", chunk.Name, chunk.Size, chunk.Length)
+		}
+	}
+	return manifest, nil
+}
 
-	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
-	defer watchdogReader.Close()
-	tarReader := tar.NewReader(watchdogReader)
+// getPeerChunk retrieves the given chunk of the catchpoint file for the given round from the peer, and verifies it
+// against the manifest. It returns the content of the chunk.
+func (lf *ledgerFetcher) getPeerChunk(ctx context.Context, peer network.HTTPPeer, round basics.Round, chunkIndex int, chunk ledger.CatchpointFileChunk) ([]byte, error) {
+	member, err := lf.getPeerLedgerResource(ctx, peer, round, "/chunk/"+strconv.Itoa(chunkIndex), rpcs.LedgerChunkResponseContentType, chunk.Length)
+	if err != nil {
+		return nil, err
+	}
+	content, err := ledger.DecodeCatchpointFileChunk(chunk, member)
+	if err != nil {
+		return nil, fmt.Errorf("%w : %v", errInvalidCatchpointFileChunk, err)
+	}
+	return content, nil
+}
+
+// getPeerLedgerResource retrieves the resource at the given suffix of the catchpoint file URL from the peer. It reads
+// up to maxSize bytes of the response body.
+func (lf *ledgerFetcher) getPeerLedgerResource(ctx context.Context, peer network.HTTPPeer, round basics.Round, suffix string, contentType string, maxSize uint64) ([]byte, error) {
+	resourceURL, err := lf.peerLedgerURL(peer, round, suffix)
+	if err != nil {
+		return nil, err
+	}
+	lf.log.Debugf("ledger GET %#v peer %#v %T", resourceURL, peer, peer)
+	request, err := http.NewRequest(http.MethodGet, resourceURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, lf.maxChunkDownloadDuration())
+	defer timeoutContextCancel()
+	request = request.WithContext(timeoutContext)
+	network.SetUserAgentHeader(request.Header)
+	response, err := peer.GetHTTPClient().Do(request)
+	if err != nil {
+		lf.log.Debugf("getPeerLedgerResource GET %v : %s", resourceURL, err)
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, errNoLedgerForRound
+	default:
+		return nil, fmt.Errorf("getPeerLedgerResource error response status code %d", response.StatusCode)
+	}
+
+	contentTypes := response.Header["Content-Type"]
+	if len(contentTypes) != 1 || contentTypes[0] != contentType {
+		return nil, fmt.Errorf("getPeerLedgerResource : http ledger fetcher response has an invalid content type : %v", contentTypes)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, int64(maxSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if uint64(len(body)) > maxSize {
+		return nil, fmt.Errorf("getPeerLedgerResource : response exceeds %d bytes", maxSize)
+	}
+	return body, nil
+}
+
+// loadLedger loads the ledger from the catchpoint file at the given source instead of downloading it from a peer.
+// Catchpoint files stored in s3 are downloaded into downloadDir first.
+func (lf *ledgerFetcher) loadLedger(ctx context.Context, source catchpointSource, downloadDir string) error {
+	reader, err := source.open(downloadDir)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	return lf.processCatchpointStream(ctx, tar.NewReader(reader), nil)
+}
+
+// processCatchpointStream writes the chunks of the given catchpoint file tar stream into the staging balances.
+// If chunkProcessed isn't nil, it is called after each chunk is processed; an io.EOF error it returns ends
+// the stream.
+func (lf *ledgerFetcher) processCatchpointStream(ctx context.Context, tarReader *tar.Reader, chunkProcessed func() error) error {
 	var downloadProgress ledger.CatchpointCatchupAccessorProgress
 	var writeDuration time.Duration
 
@@ -154,6 +269,9 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 	}
 
 	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
 		header, err := tarReader.Next()
 		if err != nil {
 			if err == io.EOF {
@@ -163,7 +281,7 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 			return err
 		}
 		if header.Size > maxCatchpointFileChunkSize || header.Size < 1 {
-			return fmt.Errorf("getPeerLedger received a tar header with data size of %d", header.Size)
+			return fmt.Errorf("processCatchpointStream received a tar header with data size of %d", header.Size)
 		}
 		balancesBlockBytes := make([]byte, header.Size)
 		readComplete := int64(0)
@@ -176,7 +294,7 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 					if readComplete == header.Size {
 						break
 					}
-					err = fmt.Errorf("getPeerLedger received io.EOF while reading from tar file stream prior of reaching chunk size %d / %d", readComplete, header.Size)
+					err = fmt.Errorf("processCatchpointStream received io.EOF while reading from tar file stream prior of reaching chunk size %d / %d", readComplete, header.Size)
 				}
 				return err
 			}
@@ -190,12 +308,15 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		if lf.reporter != nil {
 			lf.reporter.updateLedgerFetcherProgress(&downloadProgress)
 		}
-		if err = watchdogReader.Reset(); err != nil {
+		if chunkProcessed == nil {
+			continue
+		}
+		if err = chunkProcessed(); err != nil {
 			if err == io.EOF {
 				printLogsFunc()
 				return nil
 			}
-			err = fmt.Errorf("getPeerLedger received the following error while reading the catchpoint file : %v", err)
+			err = fmt.Errorf("processCatchpointStream received the following error while reading the catchpoint file : %v", err)
 			return err
 		}
 	}
@@ -74,7 +74,7 @@ type Service struct {
 	net                 network.GossipNode
 	auth                BlockAuthenticator
 	parallelBlocks      uint64
-	deadlineTimeout     time.Duration
+	deadlineTimeout     time.Duration // overrides the deadline timeout of the protocol when set
 	blockValidationPool execpool.BacklogPool
 
 	// suspendForCatchpointWriting defines whether we've ran into a state where the ledger is currently busy writing the
@@ -114,7 +114,6 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.unmatchedPendingCertificates = unmatchedPendingCertificates
 	s.log = log.With("Context", "sync")
 	s.parallelBlocks = config.CatchupParallelBlocks
-	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
 
 	return s
@@ -531,9 +530,11 @@ func (s *Service) periodicSync() {
 		s.sync()
 	}
 	stuckInARow := 0
-	sleepDuration := s.deadlineTimeout
+	sleepDuration := s.currentDeadlineTimeout()
 	for {
 		currBlock := s.ledger.LastRound()
+		// the protocol, and with it the deadline timeout, may change as the ledger advances.
+		deadlineTimeout := s.currentDeadlineTimeout()
 		select {
 		case <-s.ctx.Done():
 			return
@@ -542,11 +543,11 @@ func (s *Service) periodicSync() {
 			stuckInARow = 0
 			// go to sleep for a short while, for a random duration.
 			// we want to sleep for a random duration since it would "de-syncronize" us from the ledger advance sync
-			sleepDuration = time.Duration(crypto.RandUint63()) % s.deadlineTimeout
+			sleepDuration = time.Duration(crypto.RandUint63()) % deadlineTimeout
 			continue
 		case <-time.After(sleepDuration):
-			if sleepDuration < s.deadlineTimeout || s.cfg.DisableNetworking {
-				sleepDuration = s.deadlineTimeout
+			if sleepDuration < deadlineTimeout || s.cfg.DisableNetworking {
+				sleepDuration = deadlineTimeout
 				continue
 			}
 			// if the catchup is disabled in the config file, just skip it.
@@ -583,6 +584,18 @@ func (s *Service) periodicSync() {
 	}
 }
 
+// currentDeadlineTimeout returns the agreement deadline timeout of the protocol of the round being agreed upon.
+func (s *Service) currentDeadlineTimeout() time.Duration {
+	if s.deadlineTimeout != 0 {
+		return s.deadlineTimeout
+	}
+	proto, err := s.ledger.ConsensusVersion(s.ledger.LastRound() + 1)
+	if err != nil {
+		proto = protocol.ConsensusCurrentVersion
+	}
+	return agreement.DeadlineTimeout(proto)
+}
+
 // Syncs the client with the network. sync asks the network for last known block and tries to sync the system
 // up the to the highest number it gets.
 func (s *Service) sync() {
@@ -972,3 +972,39 @@ func TestSynchronizingTime(t *testing.T) {
 	atomic.StoreInt64(&s.syncStartNS, 1000000)
 	require.NotEqual(t, time.Duration(0), s.SynchronizingTime())
 }
+
+// upgradingLedger is a mockedLedger whose protocol changes at upgradeRound.
+type upgradingLedger struct {
+	*mockedLedger
+	upgradeRound basics.Round
+	upgradeProto protocol.ConsensusVersion
+}
+
+func (l *upgradingLedger) ConsensusVersion(r basics.Round) (protocol.ConsensusVersion, error) {
+	if r >= l.upgradeRound {
+		return l.upgradeProto, nil
+	}
+	return l.mockedLedger.ConsensusVersion(r)
+}
+
+func TestDeadlineTimeoutFollowsProtocol(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const upgradeProto = protocol.ConsensusVersion("test-catchup-deadline-timeout")
+	params := config.Consensus[protocol.ConsensusCurrentVersion]
+	params.AgreementDeadlineTimeout = 42 * time.Second
+	config.Consensus[upgradeProto] = params
+	defer delete(config.Consensus, upgradeProto)
+
+	ledger := &upgradingLedger{mockedLedger: new(mockedLedger), upgradeRound: 2, upgradeProto: upgradeProto}
+	ledger.blocks = append(ledger.blocks, bookkeeping.Block{})
+	s := MakeService(logging.Base(), defaultConfig, &httpTestPeerSource{}, ledger, &mockedAuthenticator{errorRound: -1}, nil, nil)
+	require.Equal(t, agreement.DeadlineTimeout(protocol.ConsensusCurrentVersion), s.currentDeadlineTimeout())
+
+	// the deadline of the new protocol applies once the round being agreed upon is on it
+	ledger.blocks = append(ledger.blocks, bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: 1}})
+	require.Equal(t, 42*time.Second, s.currentDeadlineTimeout())
+
+	s.deadlineTimeout = time.Second
+	require.Equal(t, time.Second, s.currentDeadlineTimeout())
+}
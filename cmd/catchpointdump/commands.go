@@ -44,6 +44,8 @@ func init() {
 	rootCmd.AddCommand(fileCmd)
 	rootCmd.AddCommand(netCmd)
 	rootCmd.AddCommand(databaseCmd)
+	rootCmd.AddCommand(verifyCmd)
+	rootCmd.AddCommand(diffCmd)
 }
 
 var rootCmd = &cobra.Command{
@@ -0,0 +1,331 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var diffTarFiles []string
+var diffTrackerFilename string
+
+func init() {
+	diffCmd.Flags().StringArrayVarP(&diffTarFiles, "tar", "t", nil, "Specify a catchpoint file to compare; could be given twice to compare two catchpoint files")
+	diffCmd.Flags().StringVarP(&diffTrackerFilename, "tracker", "d", "", "Specify a ledger tracker database to compare the catchpoint file with ( i.e. ./ledger.tracker.sqlite )")
+	diffCmd.Flags().StringVarP(&outFileName, "output", "o", "", "Specify an outfile for the differences ( i.e. catchpoint.diff.txt )")
+}
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show the differences between two catchpoints, or between a catchpoint and a ledger tracker database",
+	Long:  "Show the account and resource level differences between two catchpoint files, or between a catchpoint file and a ledger tracker database",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(diffTarFiles)+boolToInt(diffTrackerFilename != "") != 2 || len(diffTarFiles) == 0 {
+			cmd.HelpFunc()(cmd, args)
+			return
+		}
+
+		// the temporary ledgers are deleted before exiting on errors as well.
+		var cpLedgers []*catchpointLedger
+		closeLedgers := func() {
+			for _, cpLedger := range cpLedgers {
+				cpLedger.close()
+			}
+		}
+		defer closeLedgers()
+		fail := func(format string, args ...interface{}) {
+			closeLedgers()
+			reportErrorf(format, args...)
+		}
+
+		var sources []accountsSource
+		for _, tarFile := range diffTarFiles {
+			cpLedger, err := loadCatchpointLedger(context.Background(), tarFile)
+			if err != nil {
+				fail("Unable to load catchpoint file '%s' : %v", tarFile, err)
+			}
+			cpLedgers = append(cpLedgers, cpLedger)
+			sources = append(sources, accountsSource{
+				name:           tarFile,
+				databaseName:   cpLedger.trackerFilename(),
+				balancesTable:  "catchpointbalances",
+				resourcesTable: "catchpointresources",
+				round:          cpLedger.fileHeader.BalancesRound,
+				totals:         cpLedger.fileHeader.Totals,
+			})
+		}
+		if diffTrackerFilename != "" {
+			source, err := makeTrackerAccountsSource(diffTrackerFilename)
+			if err != nil {
+				fail("Unable to read ledger tracker database '%s' : %v", diffTrackerFilename, err)
+			}
+			sources = append(sources, source)
+		}
+
+		outFile := os.Stdout
+		var err error
+		if outFileName != "" {
+			outFile, err = os.OpenFile(outFileName, os.O_RDWR|os.O_TRUNC|os.O_CREATE, 0755)
+			if err != nil {
+				fail("Unable to create file '%s' : %v", outFileName, err)
+			}
+			defer outFile.Close()
+		}
+		stats, err := diffAccounts(context.Background(), sources[0], sources[1], outFile)
+		if err != nil {
+			fail("Unable to compare '%s' with '%s' : %v", sources[0].name, sources[1].name, err)
+		}
+		reportInfof("Compared %d accounts: %d only in '%s', %d only in '%s', %d differ", stats.accounts, stats.onlyFirst, sources[0].name, stats.onlySecond, sources[1].name, stats.differ)
+	},
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// accountsSource is a database holding the accounts and resources tables of a catchpoint or of a ledger tracker.
+type accountsSource struct {
+	name           string
+	databaseName   string
+	balancesTable  string
+	resourcesTable string
+	round          basics.Round
+	totals         ledgercore.AccountTotals
+}
+
+// makeTrackerAccountsSource reads the round and the account totals of the given ledger tracker database.
+func makeTrackerAccountsSource(databaseName string) (source accountsSource, err error) {
+	source = accountsSource{
+		name:           databaseName,
+		databaseName:   databaseName,
+		balancesTable:  "accountbase",
+		resourcesTable: "resources",
+	}
+	dbAccessor, err := db.MakeAccessor(databaseName, true, false)
+	if err != nil {
+		return
+	}
+	defer dbAccessor.Close()
+	err = dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		err = tx.QueryRow("SELECT rnd FROM acctrounds WHERE id='acctbase'").Scan(&source.round)
+		if err != nil {
+			return err
+		}
+		source.totals, err = readAccountTotals(tx)
+		return err
+	})
+	return
+}
+
+// sourceAccount is a single account read off an accountsSource. The last sourceAccount read off a source carries
+// the error of reading the source, if any.
+type sourceAccount struct {
+	addr basics.Address
+	data basics.AccountData
+	err  error
+}
+
+// streamAccounts reads the accounts of the given source in address order into the returned channel.
+func streamAccounts(ctx context.Context, source accountsSource) <-chan sourceAccount {
+	out := make(chan sourceAccount, 64)
+	go func() {
+		defer close(out)
+		send := func(acct sourceAccount) {
+			select {
+			case out <- acct:
+			case <-ctx.Done():
+			}
+		}
+		dbAccessor, err := db.MakeAccessor(source.databaseName, true, false)
+		if err != nil {
+			send(sourceAccount{err: err})
+			return
+		}
+		defer dbAccessor.Close()
+		err = dbAccessor.Atomic(func(_ context.Context, tx *sql.Tx) (err error) {
+			_, err = ledger.LoadAllFullAccounts(ctx, tx, source.balancesTable, source.resourcesTable, func(addr basics.Address, data basics.AccountData) {
+				send(sourceAccount{addr: addr, data: data})
+			})
+			return err
+		})
+		if err != nil {
+			send(sourceAccount{err: err})
+		}
+	}()
+	return out
+}
+
+// diffStats counts the accounts compared by diffAccounts.
+type diffStats struct {
+	accounts   int
+	onlyFirst  int
+	onlySecond int
+	differ     int
+}
+
+// diffAccounts writes the differences between the accounts of the two sources into outFile.
+func diffAccounts(ctx context.Context, first, second accountsSource, outFile io.Writer) (stats diffStats, err error) {
+	fileWriter := bufio.NewWriterSize(outFile, 1024*1024)
+	defer fileWriter.Flush()
+
+	fmt.Fprintf(fileWriter, "--- %s ( round %d )\n+++ %s ( round %d )\n", first.name, first.round, second.name, second.round)
+	if !reflect.DeepEqual(first.totals, second.totals) {
+		firstTotals, _ := json.Marshal(first.totals)
+		secondTotals, _ := json.Marshal(second.totals)
+		fmt.Fprintf(fileWriter, "AccountTotals\n- %s\n+ %s\n", firstTotals, secondTotals)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	return diffAccountStreams(streamAccounts(ctx, first), streamAccounts(ctx, second), fileWriter)
+}
+
+// diffAccountStreams writes the differences between the two given streams of accounts, both in address order, into
+// fileWriter. The streams are merged as they are read, so that no more than an account of each is held at a time.
+func diffAccountStreams(firstAccounts, secondAccounts <-chan sourceAccount, fileWriter io.Writer) (stats diffStats, err error) {
+	next := func(accounts <-chan sourceAccount) (acct *sourceAccount, err error) {
+		a, ok := <-accounts
+		if !ok {
+			return nil, nil
+		}
+		return &a, a.err
+	}
+	firstAcct, err := next(firstAccounts)
+	if err != nil {
+		return
+	}
+	secondAcct, err := next(secondAccounts)
+	if err != nil {
+		return
+	}
+	for firstAcct != nil || secondAcct != nil {
+		stats.accounts++
+		switch {
+		case secondAcct == nil || (firstAcct != nil && bytes.Compare(firstAcct.addr[:], secondAcct.addr[:]) < 0):
+			stats.onlyFirst++
+			writeAccount(fileWriter, "-", firstAcct)
+			firstAcct, err = next(firstAccounts)
+		case firstAcct == nil || bytes.Compare(firstAcct.addr[:], secondAcct.addr[:]) > 0:
+			stats.onlySecond++
+			writeAccount(fileWriter, "+", secondAcct)
+			secondAcct, err = next(secondAccounts)
+		default:
+			diffs := diffAccountData(firstAcct.data, secondAcct.data)
+			if len(diffs) > 0 {
+				stats.differ++
+				fmt.Fprintf(fileWriter, "%v\n", firstAcct.addr)
+				for _, diff := range diffs {
+					fmt.Fprintf(fileWriter, "  %s\n  - %s\n  + %s\n", diff.field, diff.first, diff.second)
+				}
+			}
+			firstAcct, err = next(firstAccounts)
+			if err == nil {
+				secondAcct, err = next(secondAccounts)
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func writeAccount(w io.Writer, prefix string, acct *sourceAccount) {
+	jsonData, _ := json.Marshal(acct.data)
+	fmt.Fprintf(w, "%s %v : %s\n", prefix, acct.addr, jsonData)
+}
+
+// fieldDiff is a single differing field of an account, or a single differing resource of an account.
+type fieldDiff struct {
+	field  string
+	first  string
+	second string
+}
+
+// diffAccountData compares the fields of the given accounts. Resources are compared one by one, so that an account
+// holding many assets or applications would only report the ones that differ.
+func diffAccountData(first, second basics.AccountData) (diffs []fieldDiff) {
+	firstValue := reflect.ValueOf(first)
+	secondValue := reflect.ValueOf(second)
+	for i := 0; i < firstValue.NumField(); i++ {
+		field := firstValue.Type().Field(i)
+		if field.PkgPath != "" {
+			// unexported field
+			continue
+		}
+		firstField, secondField := firstValue.Field(i), secondValue.Field(i)
+		if field.Type.Kind() != reflect.Map {
+			if !bytes.Equal(protocol.EncodeReflect(firstField.Interface()), protocol.EncodeReflect(secondField.Interface())) {
+				diffs = append(diffs, fieldDiff{field: field.Name, first: jsonValue(firstField), second: jsonValue(secondField)})
+			}
+			continue
+		}
+
+		keys := make(map[uint64]reflect.Value)
+		for _, key := range append(firstField.MapKeys(), secondField.MapKeys()...) {
+			keys[key.Uint()] = key
+		}
+		sortedKeys := make([]uint64, 0, len(keys))
+		for key := range keys {
+			sortedKeys = append(sortedKeys, key)
+		}
+		sort.Slice(sortedKeys, func(i, j int) bool { return sortedKeys[i] < sortedKeys[j] })
+		for _, key := range sortedKeys {
+			firstResource := firstField.MapIndex(keys[key])
+			secondResource := secondField.MapIndex(keys[key])
+			if firstResource.IsValid() && secondResource.IsValid() &&
+				bytes.Equal(protocol.EncodeReflect(firstResource.Interface()), protocol.EncodeReflect(secondResource.Interface())) {
+				continue
+			}
+			diffs = append(diffs, fieldDiff{field: fmt.Sprintf("%s[%d]", field.Name, key), first: jsonValue(firstResource), second: jsonValue(secondResource)})
+		}
+	}
+	return
+}
+
+// jsonValue formats the given value for the diff output; missing values are formatted as "(none)".
+func jsonValue(value reflect.Value) string {
+	if !value.IsValid() {
+		return "(none)"
+	}
+	jsonData, err := json.Marshal(value.Interface())
+	if err != nil {
+		return fmt.Sprintf("%v", value.Interface())
+	}
+	return string(jsonData)
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func accountsStream(accounts ...sourceAccount) <-chan sourceAccount {
+	out := make(chan sourceAccount, len(accounts))
+	for _, acct := range accounts {
+		out <- acct
+	}
+	close(out)
+	return out
+}
+
+func testAccount(addr byte, microAlgos uint64) sourceAccount {
+	return sourceAccount{
+		addr: basics.Address{addr},
+		data: basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: microAlgos}},
+	}
+}
+
+func TestDiffAccountStreams(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var out bytes.Buffer
+	stats, err := diffAccountStreams(
+		accountsStream(testAccount(1, 10), testAccount(2, 20), testAccount(4, 40), testAccount(6, 60)),
+		accountsStream(testAccount(2, 20), testAccount(3, 30), testAccount(4, 41), testAccount(7, 70)),
+		&out)
+	require.NoError(t, err)
+	require.Equal(t, diffStats{accounts: 6, onlyFirst: 2, onlySecond: 2, differ: 1}, stats)
+
+	diff := out.String()
+	require.Contains(t, diff, "- "+basics.Address{1}.String())
+	require.Contains(t, diff, "+ "+basics.Address{3}.String())
+	require.Contains(t, diff, basics.Address{4}.String()+"\n  MicroAlgos\n  - {\"Raw\":40}\n  + {\"Raw\":41}\n")
+	require.Contains(t, diff, "- "+basics.Address{6}.String())
+	require.Contains(t, diff, "+ "+basics.Address{7}.String())
+	require.NotContains(t, diff, basics.Address{2}.String())
+
+	// one of the sources being empty
+	out.Reset()
+	stats, err = diffAccountStreams(accountsStream(), accountsStream(testAccount(1, 10), testAccount(2, 20)), &out)
+	require.NoError(t, err)
+	require.Equal(t, diffStats{accounts: 2, onlySecond: 2}, stats)
+
+	// the errors of reading a source end the comparison
+	readErr := errors.New("read error")
+	_, err = diffAccountStreams(
+		accountsStream(testAccount(1, 10), sourceAccount{err: readErr}),
+		accountsStream(testAccount(1, 10), testAccount(2, 20)),
+		&out)
+	require.ErrorIs(t, err, readErr)
+	_, err = diffAccountStreams(accountsStream(testAccount(1, 10)), accountsStream(sourceAccount{err: readErr}), &out)
+	require.ErrorIs(t, err, readErr)
+}
+
+func TestDiffAccountData(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	first := basics.AccountData{
+		MicroAlgos: basics.MicroAlgos{Raw: 10},
+		Assets: map[basics.AssetIndex]basics.AssetHolding{
+			1: {Amount: 1},
+			2: {Amount: 2},
+		},
+	}
+	second := basics.AccountData{
+		MicroAlgos: basics.MicroAlgos{Raw: 10},
+		Assets: map[basics.AssetIndex]basics.AssetHolding{
+			2: {Amount: 3},
+			3: {Amount: 3},
+		},
+	}
+	require.Empty(t, diffAccountData(first, first))
+
+	// resources are compared one by one
+	diffs := diffAccountData(first, second)
+	require.Len(t, diffs, 3)
+	require.Equal(t, "Assets[1]", diffs[0].field)
+	require.Equal(t, "(none)", diffs[0].second)
+	require.Equal(t, "Assets[2]", diffs[1].field)
+	require.Equal(t, "Assets[3]", diffs[2].field)
+	require.Equal(t, "(none)", diffs[2].first)
+}
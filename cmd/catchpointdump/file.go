@@ -19,6 +19,7 @@ package main
 import (
 	"archive/tar"
 	"bufio"
+	"compress/gzip"
 	"context"
 	"database/sql"
 	"encoding/json"
@@ -62,26 +63,14 @@ var fileCmd = &cobra.Command{
 			cmd.HelpFunc()(cmd, args)
 			return
 		}
-		stats, err := os.Stat(tarFile)
+		reader, tarSize, err := openCatchpointFile(tarFile)
 		if err != nil {
-			reportErrorf("Unable to stat '%s' : %v", tarFile, err)
+			reportErrorf("Unable to read '%s' : %v", tarFile, err)
 		}
+		defer reader.Close()
 
-		tarSize := stats.Size()
-		if tarSize == 0 {
-			reportErrorf("Empty file '%s' : %v", tarFile, err)
-		}
-		// TODO: store CurrentProtocol in catchpoint file header.
-		// As a temporary workaround use a current protocol version.
-		genesisInitState := ledgercore.InitState{
-			Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
-				UpgradeState: bookkeeping.UpgradeState{
-					CurrentProtocol: protocol.ConsensusCurrentVersion,
-				},
-			}},
-		}
 		cfg := config.GetDefaultLocal()
-		l, err := ledger.OpenLedger(logging.Base(), "./ledger", false, genesisInitState, cfg)
+		l, err := ledger.OpenLedger(logging.Base(), "./ledger", false, catchpointGenesisInitState(), cfg)
 		if err != nil {
 			reportErrorf("Unable to open ledger : %v", err)
 		}
@@ -101,15 +90,7 @@ var fileCmd = &cobra.Command{
 		if err != nil {
 			reportErrorf("Unable to initialize catchup database : %v", err)
 		}
-		var fileHeader ledger.CatchpointFileHeader
-
-		reader, err := os.Open(tarFile)
-		if err != nil {
-			reportErrorf("Unable to read '%s' : %v", tarFile, err)
-		}
-		defer reader.Close()
-
-		fileHeader, err = loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, tarSize)
+		fileHeader, err := loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, tarSize)
 		if err != nil {
 			reportErrorf("Unable to load catchpoint file into in-memory database : %v", err)
 		}
@@ -132,6 +113,96 @@ var fileCmd = &cobra.Command{
 	},
 }
 
+// catchpointGenesisInitState returns the initial state of the ledgers the catchpoint files are loaded into.
+func catchpointGenesisInitState() ledgercore.InitState {
+	// TODO: store CurrentProtocol in catchpoint file header.
+	// As a temporary workaround use a current protocol version.
+	return ledgercore.InitState{
+		Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		}},
+	}
+}
+
+// catchpointFileReader is the tar stream of a catchpoint file.
+type catchpointFileReader struct {
+	io.Reader
+	file       *os.File
+	gzipReader *gzip.Reader
+}
+
+// Close closes the catchpoint file.
+func (r *catchpointFileReader) Close() error {
+	if r.gzipReader != nil {
+		r.gzipReader.Close()
+	}
+	return r.file.Close()
+}
+
+// openCatchpointFile opens the tar stream of the given catchpoint file. Both gzip compressed catchpoint files, as
+// written by the catchpoint tracker, and uncompressed ones, as downloaded by the net command, are supported. The
+// size of the uncompressed content of compressed files isn't known, so the returned tar size is zero for these.
+func openCatchpointFile(catchpointFile string) (reader *catchpointFileReader, tarSize int64, err error) {
+	file, err := os.Open(catchpointFile)
+	if err != nil {
+		return nil, 0, err
+	}
+	reader = &catchpointFileReader{file: file}
+	defer func() {
+		if err != nil {
+			reader.Close()
+			reader = nil
+		}
+	}()
+	stats, err := file.Stat()
+	if err != nil {
+		return
+	}
+	if stats.Size() == 0 {
+		err = fmt.Errorf("empty file '%s'", catchpointFile)
+		return
+	}
+	tarSize = stats.Size()
+	bufferedReader := bufio.NewReader(file)
+	magic, err := bufferedReader.Peek(2)
+	if err != nil {
+		return
+	}
+	if magic[0] == 0x1f && magic[1] == 0x8b {
+		reader.gzipReader, err = gzip.NewReader(bufferedReader)
+		if err != nil {
+			return
+		}
+		reader.Reader = reader.gzipReader
+		tarSize = 0
+	} else {
+		reader.Reader = bufferedReader
+	}
+	return
+}
+
+// readAccountTotals reads the account totals of a ledger tracker database.
+func readAccountTotals(tx *sql.Tx) (totals ledgercore.AccountTotals, err error) {
+	id := ""
+	err = tx.QueryRow("SELECT online, onlinerewardunits, offline, offlinerewardunits, notparticipating, notparticipatingrewardunits, rewardslevel FROM accounttotals WHERE id=?", id).Scan(
+		&totals.Online.Money.Raw, &totals.Online.RewardUnits,
+		&totals.Offline.Money.Raw, &totals.Offline.RewardUnits,
+		&totals.NotParticipating.Money.Raw, &totals.NotParticipating.RewardUnits,
+		&totals.RewardsLevel)
+	return
+}
+
+// printAccountTotals writes the given account totals, as printed in the dumps.
+func printAccountTotals(w io.Writer, totals ledgercore.AccountTotals) {
+	fmt.Fprintf(w, "AccountTotals - Online Money: %d\nAccountTotals - Online RewardUnits : %d\nAccountTotals - Offline Money: %d\nAccountTotals - Offline RewardUnits : %d\nAccountTotals - Not Participating Money: %d\nAccountTotals - Not Participating Money RewardUnits: %d\nAccountTotals - Rewards Level: %d\n",
+		totals.Online.Money.Raw, totals.Online.RewardUnits,
+		totals.Offline.Money.Raw, totals.Offline.RewardUnits,
+		totals.NotParticipating.Money.Raw, totals.NotParticipating.RewardUnits,
+		totals.RewardsLevel)
+}
+
 func printLoadCatchpointProgressLine(progress int, barLength int, dld int64) {
 	if barLength == 0 {
 		fmt.Printf(escapeCursorUp + escapeDeleteLine + "[ Done ] Loaded\n")
@@ -266,12 +337,7 @@ func printAccountsDatabase(databaseName string, fileHeader ledger.CatchpointFile
 
 		fmt.Fprintf(fileWriter, strings.Join(actualFields, "\n")+"\n", actualValues...)
 
-		totals := fileHeader.Totals
-		fmt.Fprintf(fileWriter, "AccountTotals - Online Money: %d\nAccountTotals - Online RewardUnits : %d\nAccountTotals - Offline Money: %d\nAccountTotals - Offline RewardUnits : %d\nAccountTotals - Not Participating Money: %d\nAccountTotals - Not Participating Money RewardUnits: %d\nAccountTotals - Rewards Level: %d\n",
-			totals.Online.Money.Raw, totals.Online.RewardUnits,
-			totals.Offline.Money.Raw, totals.Offline.RewardUnits,
-			totals.NotParticipating.Money.Raw, totals.NotParticipating.RewardUnits,
-			totals.RewardsLevel)
+		printAccountTotals(fileWriter, fileHeader.Totals)
 	}
 	return dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
 		fmt.Printf("\n")
@@ -279,20 +345,11 @@ func printAccountsDatabase(databaseName string, fileHeader ledger.CatchpointFile
 
 		if fileHeader.Version == 0 {
 			var totals ledgercore.AccountTotals
-			id := ""
-			row := tx.QueryRow("SELECT online, onlinerewardunits, offline, offlinerewardunits, notparticipating, notparticipatingrewardunits, rewardslevel FROM accounttotals WHERE id=?", id)
-			err = row.Scan(&totals.Online.Money.Raw, &totals.Online.RewardUnits,
-				&totals.Offline.Money.Raw, &totals.Offline.RewardUnits,
-				&totals.NotParticipating.Money.Raw, &totals.NotParticipating.RewardUnits,
-				&totals.RewardsLevel)
+			totals, err = readAccountTotals(tx)
 			if err != nil {
 				return err
 			}
-			fmt.Fprintf(fileWriter, "AccountTotals - Online Money: %d\nAccountTotals - Online RewardUnits : %d\nAccountTotals - Offline Money: %d\nAccountTotals - Offline RewardUnits : %d\nAccountTotals - Not Participating Money: %d\nAccountTotals - Not Participating Money RewardUnits: %d\nAccountTotals - Rewards Level: %d\n",
-				totals.Online.Money.Raw, totals.Online.RewardUnits,
-				totals.Offline.Money.Raw, totals.Offline.RewardUnits,
-				totals.NotParticipating.Money.Raw, totals.NotParticipating.RewardUnits,
-				totals.RewardsLevel)
+			printAccountTotals(fileWriter, totals)
 		}
 
 		balancesTable := "accountbase"
@@ -0,0 +1,192 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var catchpointLabel string
+
+func init() {
+	verifyCmd.Flags().StringVarP(&tarFile, "tar", "t", "", "Specify the catchpoint file to verify")
+	verifyCmd.Flags().StringVarP(&catchpointLabel, "catchpoint", "c", "", "Specify the catchpoint label to verify the file against ( i.e. 7700000#IZSYTG... ); defaults to the label in the file header")
+}
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify a catchpoint file against its catchpoint label",
+	Long:  "Rebuild the accounts merkle trie from the given catchpoint file, and verify that the catchpoint label calculated from its root matches the given catchpoint label",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if tarFile == "" {
+			cmd.HelpFunc()(cmd, args)
+			return
+		}
+		fileLabel, calculatedLabel, err := verifyCatchpoint(context.Background(), tarFile, catchpointLabel)
+		if calculatedLabel != "" {
+			reportInfof("Catchpoint file label : %s", fileLabel)
+			reportInfof("Calculated label      : %s", calculatedLabel)
+		}
+		if err != nil {
+			reportErrorf("%v", err)
+		}
+		reportInfof("Catchpoint file '%s' matches catchpoint label %s", tarFile, calculatedLabel)
+	},
+}
+
+// errCatchpointLabelMismatch is returned by verifyCatchpoint when the catchpoint file does not match the label.
+var errCatchpointLabelMismatch = errors.New("catchpoint file does not match the catchpoint label")
+
+// verifyCatchpoint calculates the catchpoint label of the given catchpoint file, and verifies it matches the
+// expected label, or the label in the file header if expectedLabel is empty. The labels are returned once known.
+func verifyCatchpoint(ctx context.Context, catchpointFile string, expectedLabel string) (fileLabel string, calculatedLabel string, err error) {
+	if expectedLabel != "" {
+		_, _, err = ledgercore.ParseCatchpointLabel(expectedLabel)
+		if err != nil {
+			return "", "", fmt.Errorf("unable to parse catchpoint label '%s' : %v", expectedLabel, err)
+		}
+	}
+
+	cpLedger, err := loadCatchpointLedger(ctx, catchpointFile)
+	if err != nil {
+		return "", "", fmt.Errorf("unable to load catchpoint file '%s' : %v", catchpointFile, err)
+	}
+	defer cpLedger.close()
+	fileLabel = cpLedger.fileHeader.Catchpoint
+	calculatedLabel, err = cpLedger.calculateLabel(ctx)
+	if err != nil {
+		return fileLabel, "", fmt.Errorf("unable to calculate catchpoint label : %v", err)
+	}
+
+	if expectedLabel == "" {
+		expectedLabel = fileLabel
+	}
+	if calculatedLabel != expectedLabel {
+		return fileLabel, calculatedLabel, fmt.Errorf("%w: '%s' does not match %s", errCatchpointLabelMismatch, catchpointFile, expectedLabel)
+	}
+	return fileLabel, calculatedLabel, nil
+}
+
+// catchpointLedger is a temporary ledger holding the content of a catchpoint file in its staging tables.
+type catchpointLedger struct {
+	ledger     *ledger.Ledger
+	accessor   ledger.CatchpointCatchupAccessor
+	fileHeader ledger.CatchpointFileHeader
+	// dir is the temporary directory holding the ledger files
+	dir string
+}
+
+// loadCatchpointLedger loads the given catchpoint file into a new temporary ledger.
+func loadCatchpointLedger(ctx context.Context, catchpointFile string) (cpLedger *catchpointLedger, err error) {
+	reader, tarSize, err := openCatchpointFile(catchpointFile)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	dir, err := os.MkdirTemp("", "catchpointdump")
+	if err != nil {
+		return nil, err
+	}
+	cpLedger = &catchpointLedger{dir: dir}
+	defer func() {
+		if err != nil {
+			cpLedger.close()
+			cpLedger = nil
+		}
+	}()
+
+	cpLedger.ledger, err = ledger.OpenLedger(logging.Base(), filepath.Join(dir, "ledger"), false, catchpointGenesisInitState(), config.GetDefaultLocal())
+	if err != nil {
+		return
+	}
+	cpLedger.accessor = ledger.MakeCatchpointCatchupAccessor(cpLedger.ledger, logging.Base())
+	err = cpLedger.accessor.ResetStagingBalances(ctx, true)
+	if err != nil {
+		return
+	}
+	cpLedger.fileHeader, err = loadCatchpointIntoDatabase(ctx, cpLedger.accessor, reader, tarSize)
+	if err != nil {
+		return
+	}
+	if cpLedger.fileHeader.Version == 0 {
+		err = fmt.Errorf("catchpoint file '%s' has no header", catchpointFile)
+	}
+	return
+}
+
+// trackerFilename returns the name of the tracker database holding the staging tables.
+func (cpl *catchpointLedger) trackerFilename() string {
+	return filepath.Join(cpl.dir, "ledger.tracker.sqlite")
+}
+
+// calculateLabel rebuilds the accounts merkle trie from the staged accounts, and calculates the catchpoint label
+// out of its root and the catchpoint file header.
+func (cpl *catchpointLedger) calculateLabel(ctx context.Context) (string, error) {
+	err := cpl.accessor.BuildMerkleTrie(ctx, func(uint64) {})
+	if err != nil {
+		return "", err
+	}
+
+	dbAccessor, err := db.MakeAccessor(cpl.trackerFilename(), true, false)
+	if err != nil {
+		return "", err
+	}
+	defer dbAccessor.Close()
+	var balancesHash crypto.Digest
+	err = dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		committer, err := ledger.MakeMerkleCommitter(tx, true)
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(committer, ledger.TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		balancesHash, err = trie.RootHash()
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	label := ledgercore.MakeCatchpointLabel(cpl.fileHeader.BlocksRound, cpl.fileHeader.BlockHeaderDigest, balancesHash, cpl.fileHeader.Totals)
+	return label.String(), nil
+}
+
+// close closes the ledger and deletes its files.
+func (cpl *catchpointLedger) close() {
+	if cpl.ledger != nil {
+		cpl.ledger.Close()
+	}
+	os.RemoveAll(cpl.dir)
+}
@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"archive/tar"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// writeCatchpointFile writes an uncompressed catchpoint file holding the given sections.
+func writeCatchpointFile(t *testing.T, filename string, sections map[string][]byte) {
+	file, err := os.Create(filename)
+	require.NoError(t, err)
+	defer file.Close()
+	tarWriter := tar.NewWriter(file)
+	for name, data := range sections {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(data))}))
+		_, err = tarWriter.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+}
+
+func TestVerifyCatchpointFailures(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	_, _, err := verifyCatchpoint(ctx, filepath.Join(dir, "missing.catchpoint"), "")
+	require.Error(t, err)
+
+	empty := filepath.Join(dir, "empty.catchpoint")
+	require.NoError(t, os.WriteFile(empty, nil, 0600))
+	_, _, err = verifyCatchpoint(ctx, empty, "")
+	require.ErrorContains(t, err, "empty file")
+
+	noHeader := filepath.Join(dir, "noheader.catchpoint")
+	writeCatchpointFile(t, noHeader, map[string][]byte{"unknown.msgpack": []byte("data")})
+	_, _, err = verifyCatchpoint(ctx, noHeader, "")
+	require.ErrorContains(t, err, "has no header")
+
+	_, _, err = verifyCatchpoint(ctx, noHeader, "not a label")
+	require.ErrorContains(t, err, "unable to parse catchpoint label")
+
+	// a catchpoint file whose header declares a label its content does not match
+	const label = "100#IZSYTG7HJ2HLY7TRJJUTNHCX5OF3G3ZPC4VHG2RJWGBDAFMQK3PQ"
+	header := ledger.CatchpointFileHeader{
+		Version:       ledger.CatchpointFileVersionV6,
+		BalancesRound: 99,
+		BlocksRound:   100,
+		Catchpoint:    label,
+	}
+	mismatch := filepath.Join(dir, "mismatch.catchpoint")
+	writeCatchpointFile(t, mismatch, map[string][]byte{"content.msgpack": protocol.Encode(&header)})
+	fileLabel, calculatedLabel, err := verifyCatchpoint(ctx, mismatch, "")
+	require.ErrorIs(t, err, errCatchpointLabelMismatch)
+	require.Equal(t, label, fileLabel)
+	require.NotEmpty(t, calculatedLabel)
+	require.NotEqual(t, label, calculatedLabel)
+
+	// the calculated label is the one the file is verified against when given
+	fileLabel, verifiedLabel, err := verifyCatchpoint(ctx, mismatch, calculatedLabel)
+	require.NoError(t, err)
+	require.Equal(t, label, fileLabel)
+	require.Equal(t, calculatedLabel, verifiedLabel)
+}
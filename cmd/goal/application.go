@@ -18,7 +18,6 @@ package main
 
 import (
 	"bytes"
-	"crypto/sha512"
 	"encoding/base32"
 	"encoding/base64"
 	"encoding/binary"
@@ -49,9 +48,11 @@ var (
 	approvalProgFile string
 	clearProgFile    string
 
-	method           string
-	methodArgs       []string
-	methodCreatesApp bool
+	method             string
+	methodArgs         []string
+	methodCreatesApp   bool
+	methodContractFile string
+	methodArgsByName   bool
 
 	approvalProgRawFile string
 	clearProgRawFile    string
@@ -123,8 +124,10 @@ func init() {
 	updateAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to send update transaction from")
 	methodAppCmd.Flags().StringVarP(&account, "from", "f", "", "Account to call method from")
 
-	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called")
+	methodAppCmd.Flags().StringVar(&method, "method", "", "Method to be called, either as a signature or, with --contract, as a method name")
 	methodAppCmd.Flags().StringArrayVar(&methodArgs, "arg", nil, "Args to pass in for calling a method")
+	methodAppCmd.Flags().StringVar(&methodContractFile, "contract", "", "ARC-4 JSON contract description declaring the method. The app ID is taken from it when --app-id is omitted")
+	methodAppCmd.Flags().BoolVar(&methodArgsByName, "args-by-name", false, "Pass every --arg as name=value, where name is an argument name declared by the --contract description")
 	methodAppCmd.Flags().StringVar(&onCompletion, "on-completion", "NoOp", "OnCompletion action for application transaction")
 	methodAppCmd.Flags().BoolVar(&methodCreatesApp, "create", false, "Create an application in this method call")
 	methodAppCmd.Flags().Uint64Var(&globalSchemaUints, "global-ints", 0, "Maximum number of integer values that may be stored in the global key/value store. Immutable, only valid when passed with --create.")
@@ -1057,6 +1060,64 @@ var infoAppCmd = &cobra.Command{
 	},
 }
 
+// mustParseMethod resolves the method to call. Without --contract, --method is
+// a method signature; with it, --method is looked up in the contract description
+// and the contract is returned as well.
+func mustParseMethod() (abi.Method, *abi.Contract) {
+	if methodContractFile == "" {
+		abiMethod, err := abi.MethodFromSignature(method)
+		if err != nil {
+			reportErrorf("cannot parse method signature: %v", err)
+		}
+		return abiMethod, nil
+	}
+
+	data, err := readFile(methodContractFile)
+	if err != nil {
+		reportErrorf(fileReadError, methodContractFile, err)
+	}
+	contract, err := abi.ParseContract(data)
+	if err != nil {
+		reportErrorf("cannot parse contract description %s: %v", methodContractFile, err)
+	}
+	abiMethod, err := contract.GetMethodByName(method)
+	if err != nil {
+		reportErrorf("contract %s: %v", contract.Name, err)
+	}
+	return abiMethod, &contract
+}
+
+// orderMethodArgs returns the argument values in the order the method declares
+// them. If byName is set, every value is given as name=value, where name is the
+// name of a method argument; otherwise the values are positional.
+func orderMethodArgs(abiMethod abi.Method, values []string, byName bool) ([]string, error) {
+	if len(values) != len(abiMethod.Args) {
+		return nil, fmt.Errorf("incorrect number of arguments, method expected %d but got %d", len(abiMethod.Args), len(values))
+	}
+	if !byName {
+		return values, nil
+	}
+
+	ordered := make([]string, len(values))
+	seen := make([]bool, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("argument %s is not of the form name=value", value)
+		}
+		index := abiMethod.GetArgIndex(parts[0])
+		if index == -1 {
+			return nil, fmt.Errorf("method %s has no argument named %s", abiMethod.Name, parts[0])
+		}
+		if seen[index] {
+			return nil, fmt.Errorf("argument %s is provided more than once", parts[0])
+		}
+		seen[index] = true
+		ordered[index] = parts[1]
+	}
+	return ordered, nil
+}
+
 // populateMethodCallTxnArgs parses and loads transactions from the files indicated by the values
 // slice. An error will occur if the transaction does not matched the expected type, it has a nonzero
 // group ID, or if it is signed by a normal signature or Msig signature (but not Lsig signature)
@@ -1197,6 +1258,25 @@ var methodAppCmd = &cobra.Command{
 
 		onCompletionEnum := mustParseOnCompletion(onCompletion)
 
+		abiMethod, contract := mustParseMethod()
+		if methodArgsByName && contract == nil {
+			reportErrorf("--args-by-name requires --contract, which declares the argument names")
+		}
+		// report the full signature even if the method was given by name
+		method = abiMethod.Signature()
+		if contract != nil && !methodCreatesApp && appIdx == 0 {
+			params, err := client.SuggestedParams()
+			if err != nil {
+				reportErrorf(errorRequestFail, err)
+			}
+			genesisHash := base64.StdEncoding.EncodeToString(params.GenesisHash)
+			contractAppID, ok := contract.AppID(genesisHash)
+			if !ok {
+				reportErrorf("contract %s is not deployed on the network with genesis hash %s, provide --app-id", contract.Name, genesisHash)
+			}
+			appIdx = contractAppID
+		}
+
 		if methodCreatesApp {
 			if appIdx != 0 {
 				reportErrorf("--app-id and --create are mutually exclusive, only provide one")
@@ -1228,26 +1308,20 @@ var methodAppCmd = &cobra.Command{
 		var applicationArgs [][]byte
 
 		// insert the method selector hash
-		hash := sha512.Sum512_256([]byte(method))
-		applicationArgs = append(applicationArgs, hash[0:4])
-
-		// parse down the ABI type from method signature
-		_, argTypes, retTypeStr, err := abi.ParseMethodSignature(method)
-		if err != nil {
-			reportErrorf("cannot parse method signature: %v", err)
-		}
+		applicationArgs = append(applicationArgs, abiMethod.Selector())
 
 		var retType *abi.Type
-		if retTypeStr != abi.VoidReturnType {
-			theRetType, err := abi.TypeOf(retTypeStr)
+		if abiMethod.Returns.Type != abi.VoidReturnType {
+			theRetType, err := abiMethod.ReturnType()
 			if err != nil {
-				reportErrorf("cannot cast %s to abi type: %v", retTypeStr, err)
+				reportErrorf("cannot cast %s to abi type: %v", abiMethod.Returns.Type, err)
 			}
 			retType = &theRetType
 		}
 
-		if len(methodArgs) != len(argTypes) {
-			reportErrorf("incorrect number of arguments, method expected %d but got %d", len(argTypes), len(methodArgs))
+		orderedArgs, err := orderMethodArgs(abiMethod, methodArgs, methodArgsByName)
+		if err != nil {
+			reportErrorf("%v", err)
 		}
 
 		var txnArgTypes []string
@@ -1257,8 +1331,9 @@ var methodAppCmd = &cobra.Command{
 		var refArgTypes []string
 		var refArgValues []string
 		refArgIndexToBasicArgIndex := make(map[int]int)
-		for i, argType := range argTypes {
-			argValue := methodArgs[i]
+		for i, arg := range abiMethod.Args {
+			argType := arg.Type
+			argValue := orderedArgs[i]
 			if abi.IsTransactionType(argType) {
 				txnArgTypes = append(txnArgTypes, argType)
 				txnArgValues = append(txnArgValues, argValue)
@@ -1420,20 +1495,17 @@ var methodAppCmd = &cobra.Command{
 				return
 			}
 
-			// the 4-byte prefix for logged return values, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
-			var abiReturnHash = []byte{0x15, 0x1f, 0x7c, 0x75}
-
 			if resp.Logs == nil || len(*resp.Logs) == 0 {
 				reportErrorf("method %s succeed but did not log a return value", method)
 			}
 
 			lastLog := (*resp.Logs)[len(*resp.Logs)-1]
-			if !bytes.HasPrefix(lastLog, abiReturnHash) {
+			if !bytes.HasPrefix(lastLog, abi.MethodReturnPrefix) {
 				reportErrorf("method %s succeed but did not log a return value", method)
 			}
 
-			rawReturnValue := lastLog[len(abiReturnHash):]
-			decoded, err := retType.Decode(rawReturnValue)
+			rawReturnValue := lastLog[len(abi.MethodReturnPrefix):]
+			decoded, err := abiMethod.DecodeReturnLog(lastLog)
 			if err != nil {
 				reportErrorf("method %s succeed but its return value could not be decoded.\nThe raw return value in hex is:%s\nThe error is: %s", method, hex.EncodeToString(rawReturnValue), err)
 			}
@@ -1444,6 +1516,9 @@ var methodAppCmd = &cobra.Command{
 			}
 
 			reportInfof("method %s succeeded with output: %s", method, string(decodedJSON))
+			if abiMethod.Returns.Desc != "" {
+				reportInfof("output description: %s", abiMethod.Returns.Desc)
+			}
 		}
 	},
 }
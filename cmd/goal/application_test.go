@@ -0,0 +1,63 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/algorand/go-algorand/data/abi"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderMethodArgs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	method := abi.Method{
+		Name: "transfer",
+		Args: []abi.Arg{
+			{Type: "account", Name: "receiver"},
+			{Type: "uint64", Name: "amount"},
+			{Type: "string"},
+		},
+		Returns: abi.Return{Type: "void"},
+	}
+
+	positional := []string{"AAAA", "5", `"memo=1"`}
+	ordered, err := orderMethodArgs(method, positional, false)
+	require.NoError(t, err)
+	require.Equal(t, positional, ordered)
+
+	// positional values are never taken as named, even if they name an argument
+	ordered, err = orderMethodArgs(method, []string{"amount=5", "receiver=AAAA", `"x"`}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"amount=5", "receiver=AAAA", `"x"`}, ordered)
+
+	method.Args[2].Name = "memo"
+	ordered, err = orderMethodArgs(method, []string{`memo="x=y"`, "receiver=AAAA", "amount=5"}, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"AAAA", "5", `"x=y"`}, ordered)
+
+	_, err = orderMethodArgs(method, []string{"amount=5", "receiver=AAAA", `"x"`}, true)
+	require.Error(t, err)
+	_, err = orderMethodArgs(method, []string{"amount=5", "receiver=AAAA", `note="x"`}, true)
+	require.Error(t, err)
+	_, err = orderMethodArgs(method, []string{"amount=5", "amount=6", "memo=\"x\""}, true)
+	require.Error(t, err)
+	_, err = orderMethodArgs(method, []string{"receiver=AAAA", "amount=5"}, true)
+	require.Error(t, err)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	v1 "github.com/algorand/go-algorand/daemon/algod/api/spec/v1"
+	"github.com/algorand/go-algorand/data/abi"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
@@ -64,6 +65,8 @@ var (
 	rekeyToAddress  string
 	signerAddress   string
 	rawOutput       bool
+
+	inspectContractFile string
 )
 
 func init() {
@@ -130,6 +133,8 @@ func init() {
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
 
+	inspectCmd.Flags().StringVar(&inspectContractFile, "contract", "", "ARC-4 JSON contract description used to decode the method calls of application call transactions")
+
 	dryrunCmd.Flags().StringVarP(&txFilename, "txfile", "t", "", "transaction or transaction-group to test")
 	dryrunCmd.Flags().StringVarP(&protoVersion, "proto", "P", "", "consensus protocol version id string")
 	dryrunCmd.Flags().BoolVar(&dumpForDryrun, "dryrun-dump", false, "Dump in dryrun format acceptable by dryrun REST api instead of running")
@@ -648,6 +653,19 @@ var inspectCmd = &cobra.Command{
 	Short: "Print a transaction file",
 	Long:  `Loads a transaction file, attempts to decode the transaction, and displays the decoded information.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		var contract *abi.Contract
+		if inspectContractFile != "" {
+			data, err := readFile(inspectContractFile)
+			if err != nil {
+				reportErrorf(fileReadError, inspectContractFile, err)
+			}
+			parsed, err := abi.ParseContract(data)
+			if err != nil {
+				reportErrorf("cannot parse contract description %s: %v", inspectContractFile, err)
+			}
+			contract = &parsed
+		}
+
 		for _, txFilename := range args {
 			data, err := readFile(txFilename)
 			if err != nil {
@@ -670,6 +688,14 @@ var inspectCmd = &cobra.Command{
 					reportErrorf(txDecodeError, txFilename, err)
 				}
 				fmt.Printf("%s[%d]\n%s\n\n", txFilename, count, string(protocol.EncodeJSON(sti)))
+				if contract != nil && txn.Txn.Type == protocol.ApplicationCallTx {
+					call, err := inspectMethodCall(*contract, txn.Txn)
+					if err != nil {
+						fmt.Printf("%s[%d] is not a call to contract %s: %v\n\n", txFilename, count, contract.Name, err)
+					} else {
+						fmt.Printf("%s[%d] method call\n%s\n\n", txFilename, count, call)
+					}
+				}
 				count++
 			}
 		}
@@ -17,10 +17,13 @@
 package main
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"reflect"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/abi"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
@@ -183,3 +186,41 @@ func lsigFromInspect(lsig inspectLogicSig) transactions.LogicSig {
 		Args:  lsig.Args,
 	}
 }
+
+// inspectMethodCall decodes an application call transaction as a call to a
+// method of the contract, returning the decoded call as indented JSON.
+func inspectMethodCall(contract abi.Contract, txn transactions.Transaction) (string, error) {
+	if genesisHash := txn.GenesisHash; !genesisHash.IsZero() && txn.ApplicationID != 0 {
+		if appID, ok := contract.AppID(base64.StdEncoding.EncodeToString(genesisHash[:])); ok && basics.AppIndex(appID) != txn.ApplicationID {
+			return "", fmt.Errorf("the contract is deployed at app %d, not app %d", appID, txn.ApplicationID)
+		}
+	}
+
+	refs := abi.CallReferences{
+		Sender:        txn.Sender[:],
+		ApplicationID: uint64(txn.ApplicationID),
+	}
+	for i := range txn.Accounts {
+		refs.Accounts = append(refs.Accounts, txn.Accounts[i][:])
+	}
+	for _, app := range txn.ForeignApps {
+		refs.ForeignApps = append(refs.ForeignApps, uint64(app))
+	}
+	for _, asset := range txn.ForeignAssets {
+		refs.ForeignAssets = append(refs.ForeignAssets, uint64(asset))
+	}
+
+	call, err := contract.DecodeCall(txn.ApplicationArgs, refs, nil)
+	if err != nil {
+		return "", err
+	}
+	out, err := json.MarshalIndent(struct {
+		Method string           `json:"method"`
+		Desc   string           `json:"desc,omitempty"`
+		Args   []abi.DecodedArg `json:"args"`
+	}{call.Method.Signature(), call.Method.Desc, call.Args}, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
@@ -17,11 +17,13 @@
 package main
 
 import (
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/abi"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/protocol"
@@ -89,3 +91,42 @@ func TestInspect(t *testing.T) {
 	_, err = inspectTxn(full)
 	require.NoError(t, err)
 }
+
+func TestInspectMethodCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	method, err := abi.MethodFromSignature("send(account,uint64)void")
+	require.NoError(t, err)
+	method.Args[0].Name = "receiver"
+	contract := abi.Contract{Name: "Sender", Methods: []abi.Method{method}}
+
+	var txn transactions.Transaction
+	txn.Type = protocol.ApplicationCallTx
+	txn.ApplicationID = 5
+	crypto.RandBytes(txn.Sender[:])
+	crypto.RandBytes(txn.GenesisHash[:])
+	receiver := basics.Address{1}
+	txn.Accounts = []basics.Address{receiver}
+	txn.ApplicationArgs = [][]byte{method.Selector()}
+	err = abi.ParseArgJSONtoByteSlice([]string{"uint8", "uint64"}, []string{"1", "42"}, &txn.ApplicationArgs)
+	require.NoError(t, err)
+
+	out, err := inspectMethodCall(contract, txn)
+	require.NoError(t, err)
+	require.Contains(t, out, `"method": "send(account,uint64)void"`)
+	require.Contains(t, out, `"name": "receiver"`)
+	require.Contains(t, out, `"value": "`+receiver.String()+`"`)
+	require.Contains(t, out, `"value": 42`)
+
+	// the contract is known to be deployed at another app on this network
+	contract.Networks = map[string]abi.ContractNetworkInfo{
+		base64.StdEncoding.EncodeToString(txn.GenesisHash[:]): {AppID: 6},
+	}
+	_, err = inspectMethodCall(contract, txn)
+	require.Error(t, err)
+
+	txn.ApplicationArgs[0] = []byte{0, 0, 0, 0}
+	contract.Networks = nil
+	_, err = inspectMethodCall(contract, txn)
+	require.Error(t, err)
+}
@@ -23,6 +23,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/lightclient"
 	"github.com/algorand/go-algorand/protocol/transcode"
 )
 
@@ -31,16 +33,23 @@ var (
 	rawBlock       bool
 	base32Encoding bool
 	strictJSON     bool
+
+	compactCertFilename   string
+	compactCertVotersHash string
 )
 
 func init() {
 	ledgerCmd.AddCommand(supplyCmd)
 	ledgerCmd.AddCommand(blockCmd)
+	ledgerCmd.AddCommand(compactCertCmd)
 
 	blockCmd.Flags().StringVarP(&blockFilename, "out", "o", stdoutFilenameValue, "The filename to dump the block to (if not set, use stdout)")
 	blockCmd.Flags().BoolVarP(&rawBlock, "raw", "r", false, "Format block as msgpack")
 	blockCmd.Flags().BoolVar(&base32Encoding, "b32", false, "Encode binary blobs using base32 instead of base64")
 	blockCmd.Flags().BoolVar(&strictJSON, "strict", false, "Strict JSON decode: turn all keys into strings")
+
+	compactCertCmd.Flags().StringVarP(&compactCertFilename, "out", "o", "", "The filename to write the msgpack encoded compact certificate to")
+	compactCertCmd.Flags().StringVar(&compactCertVotersHash, "voters-hash", "", "The trusted hash of the block header preceding the certified interval, which commits to the voters signing the compact certificate")
 }
 
 var ledgerCmd = &cobra.Command{
@@ -110,3 +119,58 @@ var blockCmd = &cobra.Command{
 		}
 	},
 }
+
+var compactCertCmd = &cobra.Command{
+	Use:   "compactcert [round number]",
+	Short: "Fetch and check the compact certificate covering a round",
+	Long:  "Fetch the compact certificate covering the given round, and check locally that it is signed by the voters committed to by the block header preceding its interval, and that the block header of the given round is linked to the certified block header. The voters block header is reported by the node itself, so the compact certificate is only verified if the hash of that block header is trusted and given with --voters-hash.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		round, err := strconv.ParseUint(args[0], 10, 64)
+		if err != nil {
+			reportErrorf(errParsingRoundNumber, err)
+		}
+
+		dataDir := ensureSingleDataDir()
+		client := ensureAlgodClient(dataDir)
+		response, err := client.CompactCert(round)
+		if err != nil {
+			reportErrorf(errorRequestFail, err)
+		}
+		certified, err := lightclient.DecodeCertifiedHeaders(response)
+		if err != nil {
+			reportErrorf(errDecodingCompactCert, err)
+		}
+		votersHash := crypto.Digest(certified.VotersHeader.Hash())
+		if compactCertVotersHash != "" {
+			trustedHash, err := crypto.DigestFromString(compactCertVotersHash)
+			if err != nil {
+				reportErrorf(errParsingVotersHash, err)
+			}
+			if votersHash != trustedHash {
+				reportErrorf(errVotersHashMismatch, certified.VotersHeader.Round, votersHash.String(), trustedHash.String())
+			}
+		}
+		err = certified.Verify()
+		if err != nil {
+			reportErrorf(errVerifyingCompactCert, err)
+		}
+
+		reportInfof(infoCompactCert, response.CertRound, response.FirstRound, response.CertRound, response.ConfirmedRound)
+		reportInfof(infoCompactCertWeight, certified.Cert.SignedWeight, len(certified.Cert.Reveals))
+		hdr := certified.Headers[0]
+		reportInfof(infoCompactCertLinked, hdr.Round, crypto.Digest(hdr.Hash()).String(), response.CertRound)
+		if compactCertVotersHash != "" {
+			reportInfof(infoCompactCertVerified, certified.VotersHeader.Round, votersHash.String())
+		} else {
+			reportWarnf(warnCompactCertVoters, certified.VotersHeader.Round, votersHash.String())
+		}
+
+		if compactCertFilename != "" {
+			err = writeFile(compactCertFilename, response.Cert, 0600)
+			if err != nil {
+				reportErrorf(fileWriteError, compactCertFilename, err)
+			}
+		}
+	},
+}
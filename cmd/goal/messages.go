@@ -197,7 +197,16 @@ const (
 	errGettingToken          = "Couldn't get token for wallet '%s' (ID: %s): %s"
 
 	// Ledger
-	errParsingRoundNumber  = "Error parsing round number: %s"
-	errBadBlockArgs        = "Cannot combine --b32=true or --strict=true with --raw"
-	errEncodingBlockAsJSON = "Error encoding block as json: %s"
+	errParsingRoundNumber   = "Error parsing round number: %s"
+	errBadBlockArgs         = "Cannot combine --b32=true or --strict=true with --raw"
+	errEncodingBlockAsJSON  = "Error encoding block as json: %s"
+	errDecodingCompactCert  = "Error decoding compact certificate: %s"
+	errVerifyingCompactCert = "Compact certificate verification failed: %s"
+	errParsingVotersHash    = "Error parsing voters block header hash: %s"
+	errVotersHashMismatch   = "Voters block header %d reported by the node has hash %s, but the trusted hash is %s"
+	infoCompactCert         = "Compact certificate of round %d, certifying rounds %d through %d, committed in round %d"
+	infoCompactCertWeight   = "Signed weight %d, with %d revealed signatures"
+	infoCompactCertLinked   = "Block header %d (%s) is committed to by the certified block header %d"
+	infoCompactCertVerified = "The compact certificate is verified against the voters of the trusted block header %d (%s)"
+	warnCompactCertVoters   = "The voters were taken from block header %d (%s), as reported by the node; the compact certificate is not verified unless this block header hash is trusted and given with --voters-hash"
 )
@@ -99,7 +99,10 @@ var networkCreateCmd = &cobra.Command{
 		var consensus config.ConsensusProtocols
 		if dataDir != "" {
 			// try to load the consensus from there. If there is none, we can just use the built in one.
-			consensus, _ = config.PreloadConfigurableConsensusProtocols(dataDir)
+			consensus, err = config.PreloadConfigurableConsensusProtocols(dataDir)
+			if err != nil {
+				reportErrorf(errorCreateNetwork, err)
+			}
 		}
 
 		network, err := netdeploy.CreateNetworkFromTemplate(networkName, networkRootDir, networkTemplateFile, binDir, !noImportKeys, nil, consensus, devModeOverride)
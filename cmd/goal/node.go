@@ -33,6 +33,7 @@ import (
 	"github.com/spf13/cobra"
 
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
@@ -59,6 +60,8 @@ var newNodeRelay string
 var newNodeFullConfig bool
 var watchMillisecond uint64
 var abortCatchup bool
+var catchupSource string
+var agreementTraceRound uint64
 
 func init() {
 	nodeCmd.AddCommand(startCmd)
@@ -72,6 +75,7 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(agreementTraceCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -105,6 +109,9 @@ func init() {
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
 
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
+	catchupCmd.Flags().StringVarP(&catchupSource, "source", "s", "", "Load the ledger from this catchpoint file instead of downloading it from the peers; either a local path, a file:// URL or an s3://bucket/key URL")
+
+	agreementTraceCmd.Flags().Uint64VarP(&agreementTraceRound, "round", "r", 0, "Only print the timeline of this round")
 
 }
 
@@ -123,7 +130,7 @@ var catchupCmd = &cobra.Command{
 	Use:     "catchup",
 	Short:   "Catchup the Algorand node to a specific catchpoint",
 	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round.",
-	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
+	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0 --source 6500000.catchpoint\tStart catching up to round 6500000 from a local catchpoint file\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
 		if abortCatchup == false && len(args) == 0 {
@@ -512,6 +519,49 @@ var pendingTxnsCmd = &cobra.Command{
 	},
 }
 
+var agreementTraceCmd = &cobra.Command{
+	Use:   "agreement-trace",
+	Short: "Print the agreement timelines of the recent rounds",
+	Long:  "Print, for each of the recent rounds, when the node accepted and validated proposals, when the votes reached the soft, cert and next thresholds and with which weight, the period changes and the timeouts. The times are relative to the first event of the round.",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, _ []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			response, err := client.AgreementTimeline(agreementTraceRound)
+			if err != nil {
+				reportErrorf(errorNodeStatus, err)
+			}
+			for _, timeline := range response.Rounds {
+				fmt.Print(agreementTimelineString(timeline))
+			}
+		})
+	},
+}
+
+func agreementTimelineString(timeline privateV2.AgreementRoundTimeline) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Round %d\n", timeline.Round)
+	if len(timeline.Events) == 0 {
+		return sb.String()
+	}
+	start := timeline.Events[0].Time
+	for _, e := range timeline.Events {
+		elapsed := time.Duration(e.Time - start)
+		fmt.Fprintf(&sb, "  %+9.3fs  %-16s  period %d step %d", elapsed.Seconds(), e.Type, e.Period, e.Step)
+		if e.Weight != nil && e.Threshold != nil {
+			fmt.Fprintf(&sb, "  weight %d/%d", *e.Weight, *e.Threshold)
+		}
+		if e.Proposal != nil {
+			fmt.Fprintf(&sb, "  proposal %s", *e.Proposal)
+		}
+		if e.Sender != nil {
+			fmt.Fprintf(&sb, " from %s", *e.Sender)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
 var waitCmd = &cobra.Command{
 	Use:   "wait",
 	Short: "Waits for the node to make progress",
@@ -635,7 +685,16 @@ func catchup(dataDir string, args []string) {
 		}
 		return
 	}
-	err := client.Catchup(args[0])
+	source := catchupSource
+	if source != "" && !strings.Contains(source, "://") {
+		// the node resolves relative paths against its own working directory
+		absSource, err := filepath.Abs(source)
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		source = absSource
+	}
+	err := client.Catchup(args[0], source)
 	if err != nil {
 		reportErrorf(errorNodeStatus, err)
 	}
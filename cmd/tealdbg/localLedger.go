@@ -324,6 +324,12 @@ func (l *localLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address
 	return ledgercore.ToAccountData(ad), rnd, nil
 }
 
+// LookupKv always reports that the key does not exist, since the debugger
+// does not load box contents.
+func (l *localLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return nil, nil
+}
+
 func (l *localLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
 	switch ctype {
 	case basics.AssetCreatable:
@@ -0,0 +1,59 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	rootCmd.AddCommand(reportCmd)
+}
+
+var rootCmd = &cobra.Command{
+	Use:   "tracecollector",
+	Short: "Network message trace collector",
+	Long:  "Collects the hops of the messages traced by the nodes with a NetworkMessageTraceServer, and reports how the messages propagated",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		//If no arguments passed, we should fallback to help
+		cmd.HelpFunc()(cmd, args)
+	},
+}
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func reportInfof(format string, args ...interface{}) {
+	fmt.Printf(format+"\n", args...)
+}
+
+func reportErrorf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+	os.Exit(1)
+}
+
+// validateNoPosArgsFn is a reusable cobra positional argument validation function
+// for generating proper error messages when commands see unexpected arguments when they expect no args.
+var validateNoPosArgsFn = cobra.NoArgs
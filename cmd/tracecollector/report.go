@@ -0,0 +1,295 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/network/messagetracer"
+)
+
+var treeHash string
+
+func init() {
+	reportCmd.Flags().StringVarP(&hopsFileName, "input", "i", "", "File of the hops collected by the serve command")
+	reportCmd.Flags().StringVarP(&treeHash, "tree", "t", "", "Print the propagation tree of the message with this hash")
+	reportCmd.MarkFlagRequired("input")
+}
+
+var reportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Report how the traced messages propagated",
+	Long: "Report, for the proposals, votes and transactions, how many times the nodes received the same message, " +
+		"how long it took the messages to reach the nodes, and in how many hops. " +
+		"The delays are counted from the first reception of each message, since the node a message originates from does not trace it.",
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		in, err := os.Open(hopsFileName)
+		if err != nil {
+			reportErrorf("Unable to open %s: %v", hopsFileName, err)
+		}
+		defer in.Close()
+		hops, err := loadHops(in)
+		if err != nil {
+			reportErrorf("Unable to read %s: %v", hopsFileName, err)
+		}
+		messages := groupMessages(hops)
+		if treeHash != "" {
+			for _, msg := range messages {
+				if msg.hash == treeHash {
+					printTree(os.Stdout, msg.tree())
+					return
+				}
+			}
+			reportErrorf("No message with hash %s in %s", treeHash, hopsFileName)
+		}
+		for _, prefix := range []string{messagetracer.Proposal, messagetracer.Vote, messagetracer.Transaction} {
+			stats := makePropagationStats(messages, prefix)
+			if stats.messages > 0 {
+				printStats(os.Stdout, prefixNames[prefix], stats)
+			}
+		}
+	},
+}
+
+var prefixNames = map[string]string{
+	messagetracer.Proposal:    "proposals",
+	messagetracer.Vote:        "votes",
+	messagetracer.Transaction: "transactions",
+}
+
+// loadHops reads the hops written by the collector.
+func loadHops(r io.Reader) (hops []messagetracer.Hop, err error) {
+	dec := json.NewDecoder(bufio.NewReader(r))
+	for {
+		var hop messagetracer.Hop
+		err = dec.Decode(&hop)
+		if err == io.EOF {
+			return hops, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		hops = append(hops, hop)
+	}
+}
+
+// message is a message received by the nodes.
+type message struct {
+	prefix string
+	hash   string
+	// hops are the receptions of the message, by time
+	hops []messagetracer.Hop
+}
+
+// groupMessages groups the hops by message, ordered by their first reception.
+func groupMessages(hops []messagetracer.Hop) []*message {
+	byHash := make(map[string]*message)
+	var messages []*message
+	for _, hop := range hops {
+		key := hop.Prefix + "/" + hop.Hash
+		msg := byHash[key]
+		if msg == nil {
+			msg = &message{prefix: hop.Prefix, hash: hop.Hash}
+			byHash[key] = msg
+			messages = append(messages, msg)
+		}
+		msg.hops = append(msg.hops, hop)
+	}
+	for _, msg := range messages {
+		sort.SliceStable(msg.hops, func(i, j int) bool { return msg.hops[i].Time < msg.hops[j].Time })
+	}
+	sort.SliceStable(messages, func(i, j int) bool { return messages[i].hops[0].Time < messages[j].hops[0].Time })
+	return messages
+}
+
+// propagationTree is how a message spread: the peer each node received it from
+// first, and when.
+type propagationTree struct {
+	// root is where the message came from first, usually its origin
+	root    string
+	parents map[string]string
+	delays  map[string]time.Duration
+	// duplicates counts the receptions past the first one of every node
+	duplicates int
+}
+
+func (msg *message) tree() propagationTree {
+	tree := propagationTree{
+		root:    msg.hops[0].From,
+		parents: make(map[string]string),
+		delays:  make(map[string]time.Duration),
+	}
+	for _, hop := range msg.hops {
+		if _, received := tree.delays[hop.Node]; received {
+			tree.duplicates++
+			continue
+		}
+		tree.parents[hop.Node] = hop.From
+		tree.delays[hop.Node] = time.Duration(hop.Time - msg.hops[0].Time)
+	}
+	return tree
+}
+
+// depth returns the number of hops from the root to the node.
+func (tree propagationTree) depth(node string) int {
+	depth := 0
+	// the parents of a node received the message before it, but the clocks of the nodes may disagree
+	for seen := make(map[string]bool); !seen[node]; depth++ {
+		seen[node] = true
+		parent, traced := tree.parents[node]
+		if !traced {
+			break
+		}
+		node = parent
+	}
+	return depth
+}
+
+// children returns the nodes that received the message from node first, sorted by delay.
+func (tree propagationTree) children(node string) []string {
+	var children []string
+	for child, parent := range tree.parents {
+		if parent == node && child != node {
+			children = append(children, child)
+		}
+	}
+	sort.Slice(children, func(i, j int) bool {
+		if tree.delays[children[i]] != tree.delays[children[j]] {
+			return tree.delays[children[i]] < tree.delays[children[j]]
+		}
+		return children[i] < children[j]
+	})
+	return children
+}
+
+// propagationStats sums up the propagation of the messages of a kind.
+type propagationStats struct {
+	messages   int
+	receptions int
+	duplicates int
+	// delays are how long the messages took to reach each node, sorted
+	delays []time.Duration
+	// completions are how long the messages took to reach all their nodes, sorted
+	completions []time.Duration
+	// depths are the numbers of hops the messages took to reach each node, sorted
+	depths []int
+}
+
+func makePropagationStats(messages []*message, prefix string) (stats propagationStats) {
+	for _, msg := range messages {
+		if msg.prefix != prefix {
+			continue
+		}
+		tree := msg.tree()
+		stats.messages++
+		stats.receptions += len(msg.hops)
+		stats.duplicates += tree.duplicates
+		var completion time.Duration
+		for node, delay := range tree.delays {
+			stats.delays = append(stats.delays, delay)
+			stats.depths = append(stats.depths, tree.depth(node))
+			if delay > completion {
+				completion = delay
+			}
+		}
+		stats.completions = append(stats.completions, completion)
+	}
+	sort.Slice(stats.delays, func(i, j int) bool { return stats.delays[i] < stats.delays[j] })
+	sort.Slice(stats.completions, func(i, j int) bool { return stats.completions[i] < stats.completions[j] })
+	sort.Ints(stats.depths)
+	return stats
+}
+
+// percentile returns the nearest-rank percentile p of n sorted values, as an index.
+func percentile(n int, p int) int {
+	rank := (n*p + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return rank - 1
+}
+
+func durationPercentiles(sorted []time.Duration) string {
+	if len(sorted) == 0 {
+		return "-"
+	}
+	var parts []string
+	for _, p := range []int{50, 90, 99} {
+		parts = append(parts, fmt.Sprintf("p%d %v", p, sorted[percentile(len(sorted), p)]))
+	}
+	parts = append(parts, fmt.Sprintf("max %v", sorted[len(sorted)-1]))
+	return strings.Join(parts, "  ")
+}
+
+func intPercentiles(sorted []int) string {
+	if len(sorted) == 0 {
+		return "-"
+	}
+	var parts []string
+	for _, p := range []int{50, 90, 99} {
+		parts = append(parts, fmt.Sprintf("p%d %d", p, sorted[percentile(len(sorted), p)]))
+	}
+	parts = append(parts, fmt.Sprintf("max %d", sorted[len(sorted)-1]))
+	return strings.Join(parts, "  ")
+}
+
+func printStats(w io.Writer, name string, stats propagationStats) {
+	fmt.Fprintf(w, "%s: %d messages, %d receptions, %d duplicates (%.1f%%)\n",
+		name, stats.messages, stats.receptions, stats.duplicates, 100*float64(stats.duplicates)/float64(stats.receptions))
+	fmt.Fprintf(w, "  delay to reach a node:    %s\n", durationPercentiles(stats.delays))
+	fmt.Fprintf(w, "  delay to reach all nodes: %s\n", durationPercentiles(stats.completions))
+	fmt.Fprintf(w, "  hops to reach a node:     %s\n", intPercentiles(stats.depths))
+}
+
+func printTree(w io.Writer, tree propagationTree) {
+	root := tree.root
+	if root == "" {
+		root = "(unknown)"
+	}
+	fmt.Fprintln(w, root)
+	printed := make(map[string]bool)
+	var printChildren func(node string, indent string)
+	printChildren = func(node string, indent string) {
+		for _, child := range tree.children(node) {
+			if printed[child] {
+				continue
+			}
+			printed[child] = true
+			fmt.Fprintf(w, "%s%s +%v\n", indent, child, tree.delays[child])
+			printChildren(child, indent+"  ")
+		}
+	}
+	printChildren(tree.root, "  ")
+	// the nodes whose parent is not traced, nor a descendant of the root
+	for node := range tree.delays {
+		if !printed[node] && tree.depth(node) == 1 && tree.parents[node] != tree.root {
+			printed[node] = true
+			fmt.Fprintf(w, "%s (from %s) +%v\n", node, tree.parents[node], tree.delays[node])
+			printChildren(node, "  ")
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPropagationStats(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ms := int64(time.Millisecond)
+	hops := []messagetracer.Hop{
+		// a vote from A reaching B, then C and D through B, and C again through D
+		{Node: "B", From: "A", Prefix: messagetracer.Vote, Hash: "v1", Time: 1000 * ms},
+		{Node: "D", From: "B", Prefix: messagetracer.Vote, Hash: "v1", Time: 1030 * ms},
+		{Node: "C", From: "B", Prefix: messagetracer.Vote, Hash: "v1", Time: 1020 * ms},
+		{Node: "C", From: "D", Prefix: messagetracer.Vote, Hash: "v1", Time: 1040 * ms},
+		// a transaction reaching B only
+		{Node: "B", From: "C", Prefix: messagetracer.Transaction, Hash: "t1", Time: 2000 * ms},
+	}
+	messages := groupMessages(hops)
+	require.Len(t, messages, 2)
+	require.Equal(t, "v1", messages[0].hash)
+
+	tree := messages[0].tree()
+	require.Equal(t, "A", tree.root)
+	require.Equal(t, 1, tree.duplicates)
+	require.Equal(t, "B", tree.parents["C"])
+	require.Equal(t, 20*time.Millisecond, tree.delays["C"])
+	require.Equal(t, 1, tree.depth("B"))
+	require.Equal(t, 2, tree.depth("D"))
+	require.Equal(t, []string{"C", "D"}, tree.children("B"))
+
+	stats := makePropagationStats(messages, messagetracer.Vote)
+	require.Equal(t, 1, stats.messages)
+	require.Equal(t, 4, stats.receptions)
+	require.Equal(t, 1, stats.duplicates)
+	require.Equal(t, []time.Duration{0, 20 * time.Millisecond, 30 * time.Millisecond}, stats.delays)
+	require.Equal(t, []time.Duration{30 * time.Millisecond}, stats.completions)
+	require.Equal(t, []int{1, 2, 2}, stats.depths)
+	require.Zero(t, makePropagationStats(messages, messagetracer.Proposal).messages)
+
+	var out bytes.Buffer
+	printTree(&out, tree)
+	require.Equal(t, "A\n  B +0s\n    C +20ms\n    D +30ms\n", out.String())
+}
+
+func TestPercentile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, 0, percentile(1, 50))
+	require.Equal(t, 0, percentile(1, 99))
+	require.Equal(t, 49, percentile(100, 50))
+	require.Equal(t, 98, percentile(100, 99))
+	require.Equal(t, 4, percentile(10, 50))
+	require.Equal(t, 9, percentile(10, 99))
+}
+
+func TestCollector(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	var out bytes.Buffer
+	c := makeCollector(&out)
+	go c.serve(listener)
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetworkMessageTraceServer = listener.Addr().String()
+	tracer := messagetracer.NewCollectorMessageTracer(logging.TestingLog(t)).Init(cfg)
+	require.NotNil(t, tracer)
+	tracer.HopTrace(messagetracer.Proposal, []byte("proposal"), "", "10.0.0.1:4160")
+	tracer.HopTrace(messagetracer.Vote, []byte("vote"), "10.0.0.2:4160", "10.0.0.1:4160")
+
+	require.Eventually(t, func() bool {
+		hops, _, err := c.flush()
+		require.NoError(t, err)
+		return hops == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	_, nodes, _ := c.flush()
+	require.Equal(t, 2, nodes)
+
+	hops, err := loadHops(strings.NewReader(out.String()))
+	require.NoError(t, err)
+	require.Len(t, hops, 2)
+	// the node with no address is named after its connection
+	require.NotEmpty(t, hops[0].Node)
+	require.Equal(t, messagetracer.HashMessage([]byte("proposal")), hops[0].Hash)
+	require.Equal(t, "10.0.0.2:4160", hops[1].Node)
+	require.Equal(t, messagetracer.Vote, hops[1].Prefix)
+}
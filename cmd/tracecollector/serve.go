@@ -0,0 +1,165 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/network/messagetracer"
+)
+
+var listenAddress string
+var hopsFileName string
+
+// collectorStatusInterval is how often the collector flushes the hops to the
+// file and reports how many it collected.
+const collectorStatusInterval = 10 * time.Second
+
+func init() {
+	serveCmd.Flags().StringVarP(&listenAddress, "listen", "l", "127.0.0.1:6525", "Address to listen on, to be set as the NetworkMessageTraceServer of the nodes")
+	serveCmd.Flags().StringVarP(&hopsFileName, "output", "o", "", "File to append the hops to, one JSON object per line")
+	serveCmd.MarkFlagRequired("output")
+}
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Collect the message hops traced by the nodes",
+	Long:  "Collect the message hops traced by the nodes, and append them to the output file until interrupted",
+	Args:  validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		out, err := os.OpenFile(hopsFileName, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			reportErrorf("Unable to open %s: %v", hopsFileName, err)
+		}
+		listener, err := net.Listen("tcp", listenAddress)
+		if err != nil {
+			reportErrorf("Unable to listen on %s: %v", listenAddress, err)
+		}
+		reportInfof("Collecting the message hops on %s into %s", listener.Addr(), hopsFileName)
+
+		c := makeCollector(out)
+		go c.serve(listener)
+
+		interrupted := make(chan os.Signal, 1)
+		signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+		ticker := time.NewTicker(collectorStatusInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				hops, nodes, err := c.flush()
+				if err != nil {
+					reportErrorf("Unable to write %s: %v", hopsFileName, err)
+				}
+				reportInfof("Collected %d hops from %d nodes", hops, nodes)
+			case <-interrupted:
+				listener.Close()
+				_, _, err = c.flush()
+				if err == nil {
+					err = out.Close()
+				}
+				if err != nil {
+					reportErrorf("Unable to write %s: %v", hopsFileName, err)
+				}
+				return
+			}
+		}
+	},
+}
+
+// collector writes the hops received from the nodes to a file.
+type collector struct {
+	mu    sync.Mutex
+	out   *bufio.Writer
+	enc   *json.Encoder
+	hops  uint64
+	nodes map[string]bool
+	err   error
+}
+
+func makeCollector(out io.Writer) *collector {
+	writer := bufio.NewWriter(out)
+	return &collector{
+		out:   writer,
+		enc:   json.NewEncoder(writer),
+		nodes: make(map[string]bool),
+	}
+}
+
+// serve accepts the connections of the nodes until the listener is closed.
+func (c *collector) serve(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go c.handle(conn)
+	}
+}
+
+// handle reads the hops sent over a connection. The hops of a node with no
+// address are recorded under the address of its connection.
+func (c *collector) handle(conn net.Conn) {
+	defer conn.Close()
+	dec := json.NewDecoder(bufio.NewReader(conn))
+	for {
+		var hop messagetracer.Hop
+		err := dec.Decode(&hop)
+		if err != nil {
+			if err != io.EOF {
+				reportInfof("Dropping the connection of %s: %v", conn.RemoteAddr(), err)
+			}
+			return
+		}
+		if hop.Node == "" {
+			hop.Node = conn.RemoteAddr().String()
+		}
+		c.record(hop)
+	}
+}
+
+func (c *collector) record(hop messagetracer.Hop) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return
+	}
+	c.err = c.enc.Encode(&hop)
+	c.hops++
+	c.nodes[hop.Node] = true
+}
+
+// flush writes the hops collected so far, and returns their count and the
+// number of nodes they came from.
+func (c *collector) flush() (hops uint64, nodes int, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.err = c.out.Flush()
+	}
+	return c.hops, len(c.nodes), c.err
+}
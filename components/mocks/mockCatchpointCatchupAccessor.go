@@ -47,6 +47,36 @@ func (m *MockCatchpointCatchupAccessor) SetLabel(ctx context.Context, label stri
 	return nil
 }
 
+// GetSource returns the location of the catchpoint file the catchpoint catchup loads the ledger from
+func (m *MockCatchpointCatchupAccessor) GetSource(ctx context.Context) (source string, err error) {
+	return "", nil
+}
+
+// SetSource set the location of the catchpoint file the catchpoint catchup loads the ledger from
+func (m *MockCatchpointCatchupAccessor) SetSource(ctx context.Context, source string) (err error) {
+	return nil
+}
+
+// GetChunksManifest returns the manifest of the catchpoint file being downloaded in chunks, if any
+func (m *MockCatchpointCatchupAccessor) GetChunksManifest(ctx context.Context) (manifest ledger.CatchpointFileManifest, found bool, err error) {
+	return ledger.CatchpointFileManifest{}, false, nil
+}
+
+// SetChunksManifest sets the manifest of the catchpoint file being downloaded in chunks
+func (m *MockCatchpointCatchupAccessor) SetChunksManifest(ctx context.Context, manifest *ledger.CatchpointFileManifest) (err error) {
+	return nil
+}
+
+// GetChunksProgress returns the persisted progress of the catchpoint file chunks download
+func (m *MockCatchpointCatchupAccessor) GetChunksProgress(ctx context.Context) (progress ledger.CatchpointCatchupChunksProgress, err error) {
+	return ledger.CatchpointCatchupChunksProgress{}, nil
+}
+
+// SetChunksProgress persists the progress of the catchpoint file chunks download
+func (m *MockCatchpointCatchupAccessor) SetChunksProgress(ctx context.Context, progress ledger.CatchpointCatchupChunksProgress) (err error) {
+	return nil
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (m *MockCatchpointCatchupAccessor) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	return nil
@@ -106,6 +106,35 @@ func (network *MockNetwork) GetHTTPRequestConnection(request *http.Request) (con
 	return nil
 }
 
+// ReportPeer - empty implementation
+func (network *MockNetwork) ReportPeer(peer network.Peer, signal network.PeerSignal) {
+}
+
+// GetPeerBans - empty implementation
+func (network *MockNetwork) GetPeerBans() []network.PeerBan {
+	return nil
+}
+
+// ClearPeerBans - empty implementation
+func (network *MockNetwork) ClearPeerBans(address string) int {
+	return 0
+}
+
+// GetPeerInfo - empty implementation
+func (network *MockNetwork) GetPeerInfo() []network.PeerInfo {
+	return nil
+}
+
+// DisconnectAddress - empty implementation
+func (network *MockNetwork) DisconnectAddress(address string) int {
+	return 0
+}
+
+// AddPriorityPeer - empty implementation
+func (network *MockNetwork) AddPriorityPeer(host string) error {
+	return nil
+}
+
 // SubstituteGenesisID - empty implementation
 func (network *MockNetwork) SubstituteGenesisID(rawURL string) string {
 	return rawURL
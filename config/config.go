@@ -69,6 +69,13 @@ const CompactCertFilename = "compactcert.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// P2PIdentityKeyFilename is the name of the file holding the key of the node on the peer-to-peer network.
+// The peer ID of the node is derived from it.
+const P2PIdentityKeyFilename = "p2p.key"
+
+// PeerBansFilename is the name of the file holding the gossip peers banned for misbehaving.
+const PeerBansFilename = "peerbans.json"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus prototocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -18,6 +18,7 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -159,6 +160,12 @@ type ConsensusParams struct {
 	// time for nodes to wait for block proposal headers for period = 0, value should be configured to suit best case
 	// critical path
 	AgreementFilterTimeoutPeriod0 time.Duration
+	// time for nodes to wait for a soft-vote quorum in a period before they start next-voting, value should be set
+	// to BigLambda + SmallLambda
+	AgreementDeadlineTimeout time.Duration
+	// time added to the deadline of the first next-vote step, doubled for every following step, value should be set
+	// to SmallLambda
+	AgreementRecoveryExtraTimeout time.Duration
 
 	FastRecoveryLambda time.Duration // time between fast recovery attempts
 
@@ -290,6 +297,26 @@ type ConsensusParams struct {
 	// maximum sum of the lengths of the key and value of one app state entry
 	MaxAppSumKeyValueLens int
 
+	// EnableBoxes enables the per-application box storage, along with the
+	// Boxes reference array of ApplicationCall transactions
+	EnableBoxes bool
+
+	// maximum number of box references in the ApplicationCall Boxes field.
+	// these are the only boxes which may be accessed in the transaction
+	MaxAppBoxReferences int
+
+	// maximum size of the contents of a single box. The box name is limited
+	// by MaxAppKeyLen
+	MaxBoxSize uint64
+
+	// flat MinBalance requirement for creating a single box, charged to
+	// the account of the application owning the box
+	BoxFlatMinBalance uint64
+
+	// MinBalance requirement (in addition to BoxFlatMinBalance) per byte of
+	// box name and box contents
+	BoxByteMinBalance uint64
+
 	// maximum number of inner transactions that can be created by an app call.
 	// with EnableInnerTransactionPooling, limit is multiplied by MaxTxGroupSize
 	// and enforced over the whole group.
@@ -518,6 +545,10 @@ var MaxAvailableAppProgramLen int
 // to be taken offline, that would be proposed to be taken offline.
 var MaxProposedExpiredOnlineAccounts int
 
+// MaxAppBoxReferences is the maximum number of box references in an ApplicationCall
+// transaction supported by any of the consensus protocols. used for decoding purposes.
+var MaxAppBoxReferences int
+
 func checkSetMax(value int, curMax *int) {
 	if value > *curMax {
 		*curMax = value
@@ -555,6 +586,7 @@ func checkSetAllocBounds(p ConsensusParams) {
 	checkSetMax(p.MaxAppProgramLen, &MaxLogCalls)
 	checkSetMax(p.MaxInnerTransactions*p.MaxTxGroupSize, &MaxInnerTransactionsPerDelta)
 	checkSetMax(p.MaxProposedExpiredOnlineAccounts, &MaxProposedExpiredOnlineAccounts)
+	checkSetMax(p.MaxAppBoxReferences, &MaxAppBoxReferences)
 }
 
 // SaveConfigurableConsensus saves the configurable protocols file to the provided data directory.
@@ -659,9 +691,58 @@ func PreloadConfigurableConsensusProtocols(dataDirectory string) (ConsensusProto
 	if err != nil {
 		return nil, err
 	}
+	for consensusVersion, consensusParams := range configurableConsensus {
+		if consensusParams.ApprovedUpgrades == nil {
+			// deleted protocol
+			continue
+		}
+		if consensusParams.AgreementDeadlineTimeout == 0 && consensusParams.AgreementRecoveryExtraTimeout == 0 {
+			// protocols saved before the agreement deadlines were consensus parameters keep the global ones
+			consensusParams.AgreementDeadlineTimeout = Protocol.BigLambda + Protocol.SmallLambda
+			consensusParams.AgreementRecoveryExtraTimeout = Protocol.SmallLambda
+			configurableConsensus[consensusVersion] = consensusParams
+		}
+		err = consensusParams.validateAgreementTimeouts()
+		if err != nil {
+			return nil, fmt.Errorf("consensus protocol %s in %s: %w", consensusVersion, consensusProtocolPath, err)
+		}
+	}
 	return Consensus.Merge(configurableConsensus), nil
 }
 
+// validateAgreementTimeouts returns an error if the agreement timeouts would
+// not let the agreement protocol make progress.
+func (p ConsensusParams) validateAgreementTimeouts() error {
+	timeouts := []struct {
+		name  string
+		value time.Duration
+	}{
+		{"AgreementFilterTimeout", p.AgreementFilterTimeout},
+		{"AgreementFilterTimeoutPeriod0", p.AgreementFilterTimeoutPeriod0},
+		{"AgreementDeadlineTimeout", p.AgreementDeadlineTimeout},
+		{"AgreementRecoveryExtraTimeout", p.AgreementRecoveryExtraTimeout},
+		{"FastRecoveryLambda", p.FastRecoveryLambda},
+	}
+	for _, timeout := range timeouts {
+		if timeout.value <= 0 {
+			return fmt.Errorf("%s must be positive, not %v", timeout.name, timeout.value)
+		}
+	}
+
+	// the soft votes are sent when the filter timeout expires, and the deadline is counted from the start of
+	// the period too: a deadline before the filter timeout would skip the cert step.
+	if p.AgreementDeadlineTimeout <= p.AgreementFilterTimeout || p.AgreementDeadlineTimeout <= p.AgreementFilterTimeoutPeriod0 {
+		return fmt.Errorf("AgreementDeadlineTimeout %v must be longer than AgreementFilterTimeout %v and AgreementFilterTimeoutPeriod0 %v",
+			p.AgreementDeadlineTimeout, p.AgreementFilterTimeout, p.AgreementFilterTimeoutPeriod0)
+	}
+	// fast recovery is meant for the network partitions the next-vote steps did not resolve.
+	if firstNextVote := p.AgreementDeadlineTimeout + p.AgreementRecoveryExtraTimeout; p.FastRecoveryLambda < firstNextVote {
+		return fmt.Errorf("FastRecoveryLambda %v must not be shorter than the end of the first next-vote step, %v",
+			p.FastRecoveryLambda, firstNextVote)
+	}
+	return nil
+}
+
 func initConsensusProtocols() {
 	// WARNING: copying a ConsensusParams by value into a new variable
 	// does not copy the ApprovedUpgrades map.  Make sure that each new
@@ -704,6 +785,8 @@ func initConsensusProtocols() {
 
 		AgreementFilterTimeout:        4 * time.Second,
 		AgreementFilterTimeoutPeriod0: 4 * time.Second,
+		AgreementDeadlineTimeout:      Protocol.BigLambda + Protocol.SmallLambda,
+		AgreementRecoveryExtraTimeout: Protocol.SmallLambda,
 
 		FastRecoveryLambda: 5 * time.Minute,
 
@@ -1151,6 +1234,13 @@ func initConsensusProtocols() {
 
 	vFuture.EnableSHA256TxnCommitmentHeader = true
 
+	// Enable application boxes
+	vFuture.EnableBoxes = true
+	vFuture.MaxAppBoxReferences = 8
+	vFuture.MaxBoxSize = 32768
+	vFuture.BoxFlatMinBalance = 2500
+	vFuture.BoxByteMinBalance = 400
+
 	Consensus[protocol.ConsensusFuture] = vFuture
 }
 
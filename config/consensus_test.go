@@ -18,7 +18,9 @@ package config
 
 import (
 	"testing"
+	"time"
 
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -69,3 +71,58 @@ func TestConsensusCompactCertParams(t *testing.T) {
 		}
 	}
 }
+
+func TestConsensusAgreementTimeouts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for proto, params := range Consensus {
+		require.NoErrorf(t, params.validateAgreementTimeouts(), "Version :%v", proto)
+	}
+}
+
+func TestPreloadConfigurableConsensusAgreementTimeouts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const labVersion = protocol.ConsensusVersion("lab")
+	fast := Consensus[protocol.ConsensusCurrentVersion]
+	fast.ApprovedUpgrades = map[protocol.ConsensusVersion]uint64{}
+	fast.AgreementFilterTimeoutPeriod0 = 100 * time.Millisecond
+	fast.AgreementFilterTimeout = 200 * time.Millisecond
+	fast.AgreementDeadlineTimeout = 300 * time.Millisecond
+	fast.AgreementRecoveryExtraTimeout = 100 * time.Millisecond
+	fast.FastRecoveryLambda = 10 * time.Second
+
+	dataDir := t.TempDir()
+	require.NoError(t, SaveConfigurableConsensus(dataDir, ConsensusProtocols{labVersion: fast}))
+	loaded, err := PreloadConfigurableConsensusProtocols(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, fast.AgreementDeadlineTimeout, loaded[labVersion].AgreementDeadlineTimeout)
+	require.Equal(t, fast.AgreementRecoveryExtraTimeout, loaded[labVersion].AgreementRecoveryExtraTimeout)
+
+	// protocols saved without the deadlines keep the global ones
+	legacy := fast
+	legacy.AgreementFilterTimeoutPeriod0 = 4 * time.Second
+	legacy.AgreementFilterTimeout = 4 * time.Second
+	legacy.AgreementDeadlineTimeout = 0
+	legacy.AgreementRecoveryExtraTimeout = 0
+	legacy.FastRecoveryLambda = 5 * time.Minute
+	require.NoError(t, SaveConfigurableConsensus(dataDir, ConsensusProtocols{labVersion: legacy}))
+	loaded, err = PreloadConfigurableConsensusProtocols(dataDir)
+	require.NoError(t, err)
+	require.Equal(t, Protocol.BigLambda+Protocol.SmallLambda, loaded[labVersion].AgreementDeadlineTimeout)
+	require.Equal(t, Protocol.SmallLambda, loaded[labVersion].AgreementRecoveryExtraTimeout)
+
+	unsafe := map[string]func(p *ConsensusParams){
+		"zero filter timeout":                 func(p *ConsensusParams) { p.AgreementFilterTimeoutPeriod0 = 0 },
+		"negative recovery timeout":           func(p *ConsensusParams) { p.AgreementRecoveryExtraTimeout = -time.Second },
+		"deadline before the filter timeout":  func(p *ConsensusParams) { p.AgreementDeadlineTimeout = p.AgreementFilterTimeout },
+		"fast recovery before the next votes": func(p *ConsensusParams) { p.FastRecoveryLambda = p.AgreementDeadlineTimeout },
+	}
+	for name, change := range unsafe {
+		params := fast
+		change(&params)
+		require.NoError(t, SaveConfigurableConsensus(dataDir, ConsensusProtocols{labVersion: params}))
+		_, err = PreloadConfigurableConsensusProtocols(dataDir)
+		require.Error(t, err, name)
+	}
+}
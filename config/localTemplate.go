@@ -41,7 +41,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22"`
 
 	// environmental (may be overridden)
 	// When enabled, stores blocks indefinitally, otherwise, only the most recents blocks
@@ -444,6 +444,63 @@ type Local struct {
 
 	// AgreementIncomingBundlesQueueLength sets the size of the buffer holding incoming bundles.
 	AgreementIncomingBundlesQueueLength uint64 `version[21]:"7"`
+
+	// EnableGossipCompression makes the node compress the proposals and transactions it sends to the peers
+	// that support compressed messages. Compressed messages from peers are always accepted.
+	EnableGossipCompression bool `version[22]:"false"`
+
+	// EnableTxnInventory makes the node announce the transaction groups it sends to the peers that enabled it as
+	// well, which then request the groups they have not seen yet, rather than pushing the groups to every peer.
+	// The groups are still pushed to and received from the other peers.
+	EnableTxnInventory bool `version[22]:"false"`
+
+	// TxnInventoryPushFeePerByte is the fee per byte, in microAlgos, from which the transaction groups are still
+	// pushed to every peer when EnableTxnInventory is set, so that high-fee groups propagate without waiting for
+	// a request. Zero announces every transaction group.
+	TxnInventoryPushFeePerByte uint64 `version[22]:"10"`
+
+	// EnableP2P runs the gossip network over a mesh of directly connected peers, identified by the key stored
+	// in the data directory, instead of the relay topology. The mesh is bootstrapped from the phonebook and grows
+	// through the addresses the peers exchange; GossipFanout is the number of outgoing connections kept.
+	EnableP2P bool `version[22]:"false"`
+
+	// PeerBanThreshold is the penalty at which a gossip peer gets banned. Peers are penalized for the empty
+	// transaction groups, invalid votes and proposals, the oversized or repeated messages and the protocol errors
+	// they send, and their penalties halve every ten minutes. Setting it to 0, the default, disables banning; a
+	// threshold of 100 bans a peer after two protocol errors.
+	PeerBanThreshold uint64 `version[22]:"0"`
+
+	// PeerBanDurationSeconds is how long a banned peer is refused connections. Bans are saved in the data directory
+	// and survive restarts.
+	PeerBanDurationSeconds uint64 `version[22]:"3600"`
+
+	// EnableSpeculativeBlockAssembly makes the node assemble its proposal for the next round as soon as it sees a
+	// soft-vote quorum for the current round, on top of the soft-voted block. The proposal is discarded if a
+	// different block gets certified.
+	EnableSpeculativeBlockAssembly bool `version[22]:"false"`
+
+	// CatchupLedgerDownloadParallelism is the number of chunks of the catchpoint file a catchpoint catchup downloads
+	// concurrently, from the peers which serve the same catchpoint file manifest. The download progress is persisted,
+	// so that a restarted node resumes it. Setting it to 0 makes the node stream the whole catchpoint file from a
+	// single peer instead, which is also done when no peer serves a manifest.
+	CatchupLedgerDownloadParallelism int `version[22]:"4"`
+
+	// LightClientUpstream is the REST endpoint of the algod node followed in light client mode. When set, algod
+	// runs as a light client instead of a full node: it tracks only the block headers certified by compact
+	// certificates, starting from LightClientTrustedHeader, and serves verified transaction proofs. The API token
+	// of the followed node, if it requires one, is read from the lightclient_upstream.token file in the data directory.
+	LightClientUpstream string `version[22]:""`
+
+	// LightClientTrustedHeader is the block header the light client starts following the chain from, given as
+	// <round>#<block hash>. Its round must be a multiple of CompactCertRounds, as the voters it commits to sign the
+	// compact certificate of the next certified block header.
+	LightClientTrustedHeader string `version[22]:""`
+
+	// MaxStateDeltasInMemory is the number of most recent rounds whose complete state delta the ledger keeps in
+	// memory, to serve them from /v2/deltas/{round} and to the block stream clients resuming from a past round.
+	// The state deltas hold the transaction ids and leases of their round on top of the account changes. Setting
+	// it to 0 keeps the state delta of every round that the ledger did not flush to disk yet.
+	MaxStateDeltasInMemory uint64 `version[22]:"0"`
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
@@ -1,4 +1,4 @@
-// Copyright (C) 2019-2022 Algorand, Inc.
+// Copyright (C) 2019-2026 Algorand, Inc.
 // This file is part of go-algorand
 //
 // go-algorand is free software: you can redistribute it and/or modify
@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    21,
+	Version:                                    22,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        7,
@@ -40,6 +40,7 @@ var defaultLocal = Local{
 	CatchupFailurePeerRefreshRate:              10,
 	CatchupGossipBlockFetchTimeoutSec:          4,
 	CatchupHTTPBlockFetchTimeoutSec:            4,
+	CatchupLedgerDownloadParallelism:           4,
 	CatchupLedgerDownloadRetryAttempts:         50,
 	CatchupParallelBlocks:                      16,
 	ConnectionsRateLimitingCount:               60,
@@ -60,15 +61,19 @@ var defaultLocal = Local{
 	EnableCatchupFromArchiveServers:            false,
 	EnableDeveloperAPI:                         false,
 	EnableGossipBlockService:                   true,
+	EnableGossipCompression:                    false,
 	EnableIncomingMessageFilter:                false,
 	EnableLedgerService:                        false,
 	EnableMetricReporting:                      false,
 	EnableOutgoingNetworkMessageFiltering:      true,
+	EnableP2P:                                  false,
 	EnablePingHandler:                          true,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
 	EnableRequestLogger:                        false,
+	EnableSpeculativeBlockAssembly:             false,
 	EnableTopAccountsReporting:                 false,
+	EnableTxnInventory:                         false,
 	EnableVerbosedTransactionSyncLogging:       false,
 	EndpointAddress:                            "127.0.0.1:0",
 	FallbackDNSResolverAddress:                 "",
@@ -80,12 +85,15 @@ var defaultLocal = Local{
 	IncomingMessageFilterBucketSize:            512,
 	IsIndexerActive:                            false,
 	LedgerSynchronousMode:                      2,
+	LightClientTrustedHeader:                   "",
+	LightClientUpstream:                        "",
 	LogArchiveMaxAge:                           "",
 	LogArchiveName:                             "node.archive.log",
 	LogSizeLimit:                               1073741824,
 	MaxAPIResourcesPerAccount:                  100000,
 	MaxCatchpointDownloadDuration:              7200000000000,
 	MaxConnectionsPerIP:                        30,
+	MaxStateDeltasInMemory:                     0,
 	MinCatchpointFileDownloadBytesPerSecond:    20480,
 	NetAddress:                                 "",
 	NetworkMessageTraceServer:                  "",
@@ -96,6 +104,8 @@ var defaultLocal = Local{
 	OutgoingMessageFilterBucketCount:           3,
 	OutgoingMessageFilterBucketSize:            128,
 	ParticipationKeysRefreshInterval:           60000000000,
+	PeerBanDurationSeconds:                     3600,
+	PeerBanThreshold:                           0,
 	PeerConnectionsUpdateInterval:              3600,
 	PeerPingPeriodSeconds:                      0,
 	PriorityPeers:                              map[string]bool{},
@@ -120,6 +130,7 @@ var defaultLocal = Local{
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
 	TxSyncTimeoutSeconds:                       30,
+	TxnInventoryPushFeePerByte:                 10,
 	UseXForwardedForAddressField:               "",
 	VerifiedTranscationsCacheSize:              30000,
 }
@@ -59,10 +59,11 @@ const (
 
 // rawRequestPaths is a set of paths where the body should not be urlencoded
 var rawRequestPaths = map[string]bool{
-	"/v1/transactions":  true,
-	"/v2/teal/dryrun":   true,
-	"/v2/teal/compile":  true,
-	"/v2/participation": true,
+	"/v1/transactions":          true,
+	"/v2/teal/dryrun":           true,
+	"/v2/teal/compile":          true,
+	"/v2/participation":         true,
+	"/v2/transactions/simulate": true,
 }
 
 // unauthorizedRequestError is generated when we receive 401 error from the server. This error includes the inner error
@@ -416,6 +417,27 @@ func (client RestClient) ApplicationInformation(index uint64) (response generate
 	return
 }
 
+type applicationBoxesParams struct {
+	Max uint64 `url:"max,omitempty"`
+}
+
+// ApplicationBoxes gets the names of the boxes of an application, at most maxBoxNum of them unless it is zero
+func (client RestClient) ApplicationBoxes(appID uint64, maxBoxNum uint64) (response generatedV2.BoxesResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/boxes", appID), applicationBoxesParams{maxBoxNum})
+	return
+}
+
+type applicationBoxByNameParams struct {
+	Name string `url:"name"`
+}
+
+// GetApplicationBoxByName gets the contents of a box of an application. The name
+// is given in the goal app call arg form "encoding:value".
+func (client RestClient) GetApplicationBoxByName(appID uint64, name string) (response generatedV2.BoxResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/applications/%d/box", appID), applicationBoxByNameParams{name})
+	return
+}
+
 // AccountInformation also gets the AccountInformationResponse associated with the passed address
 func (client RestClient) AccountInformation(address string) (response v1.Account, err error) {
 	err = client.get(&response, fmt.Sprintf("/v1/account/%s", address), nil)
@@ -539,6 +561,17 @@ func (client RestClient) SendRawTransactionGroup(txgroup []transactions.SignedTx
 	return client.post(&response, "/v1/transactions", enc)
 }
 
+// SimulateTransactionGroup evaluates a possibly unsigned transaction group against
+// the latest ledger state of the node, without broadcasting it.
+func (client RestClient) SimulateTransactionGroup(txgroup []transactions.SignedTxn) (response generatedV2.SimulateResponse, err error) {
+	var enc []byte
+	for _, tx := range txgroup {
+		enc = append(enc, protocol.Encode(&tx)...)
+	}
+	err = client.post(&response, "/v2/transactions/simulate", enc)
+	return
+}
+
 // Block gets the block info for the given round
 func (client RestClient) Block(round uint64) (response v1.Block, err error) {
 	err = client.get(&response, fmt.Sprintf("/v1/block/%d", round), nil)
@@ -560,6 +593,14 @@ func (client RestClient) RawBlock(round uint64) (response []byte, err error) {
 	return
 }
 
+// RawLedgerStateDelta gets the encoded, raw msgpack state delta for the given round
+func (client RestClient) RawLedgerStateDelta(round uint64) (response []byte, err error) {
+	var blob Blob
+	err = client.getRaw(&blob, fmt.Sprintf("/v2/deltas/%d", round), rawFormat{Format: "msgpack"})
+	response = blob
+	return
+}
+
 // Shutdown requests the node to shut itself down
 func (client RestClient) Shutdown() (err error) {
 	response := 1
@@ -573,9 +614,23 @@ func (client RestClient) AbortCatchup(catchpointLabel string) (response privateV
 	return
 }
 
-// Catchup start catching up to the give catchpoint label
-func (client RestClient) Catchup(catchpointLabel string) (response privateV2.CatchpointStartResponse, err error) {
-	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), nil, "POST", false, true)
+type catchupParams struct {
+	Source string `url:"source,omitempty"`
+}
+
+// Catchup start catching up to the give catchpoint label, loading the ledger from the catchpoint file at source if it isn't empty
+func (client RestClient) Catchup(catchpointLabel string, source string) (response privateV2.CatchpointStartResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), catchupParams{source}, "POST", false, true)
+	return
+}
+
+type agreementTimelineParams struct {
+	Round uint64 `url:"round,omitempty"`
+}
+
+// AgreementTimeline gets the agreement timelines of the recent rounds, or of the given round if it is not zero
+func (client RestClient) AgreementTimeline(round uint64) (response privateV2.AgreementTimelineResponse, err error) {
+	err = client.get(&response, "/v2/agreement/timeline", agreementTimelineParams{round})
 	return
 }
 
@@ -661,6 +716,13 @@ func (client RestClient) Proof(txid string, round uint64, hashType crypto.HashTy
 	return
 }
 
+// CompactCert gets the compact certificate covering the given round, along with the block headers linking the
+// block header of the round to the certified one.
+func (client RestClient) CompactCert(round uint64) (response generatedV2.CompactCertResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/compactcert/%d", round), nil)
+	return
+}
+
 // PostParticipationKey sends a key file to the node.
 func (client RestClient) PostParticipationKey(file []byte) (response generatedV2.PostParticipationResponse, err error) {
 	err = client.post(&response, "/v2/participation", file)
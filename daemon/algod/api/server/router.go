@@ -74,6 +74,7 @@ import (
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
+	"github.com/algorand/go-algorand/lightclient"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/util/tokens"
@@ -157,3 +158,58 @@ func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-ch
 type apiNode struct{ *node.AlgorandFullNode }
 
 func (n apiNode) LedgerForAPI() v2.LedgerForAPI { return n.Ledger() }
+
+// lightRoutes are the common routes served in light client mode.
+var lightRoutes = lib.Routes{
+	lib.Route{
+		Name:        "healthcheck",
+		Method:      "GET",
+		Path:        "/health",
+		HandlerFunc: common.HealthCheck,
+	},
+
+	lib.Route{
+		Name:        "genesis",
+		Method:      "GET",
+		Path:        "/genesis",
+		HandlerFunc: common.GenesisJSON,
+	},
+}
+
+// NewLightRouter builds and returns a new router serving the light client mode REST API: the status of the
+// light client, and the transaction proofs verified against the block headers it certified.
+func NewLightRouter(logger logging.Logger, follower *lightclient.Follower, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+	if err := tokens.ValidateAPIToken(apiToken); err != nil {
+		logger.Errorf("Invalid apiToken was passed to NewLightRouter ('%s'): %v", apiToken, err)
+	}
+	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
+		logger.Errorf("Invalid adminAPIToken was passed to NewLightRouter ('%s'): %v", adminAPIToken, err)
+	}
+	apiAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken})
+
+	e := echo.New()
+
+	e.Listener = listener
+	e.HideBanner = true
+
+	e.Pre(
+		middlewares.MakeConnectionLimiter(numConnectionsLimit),
+		middleware.RemoveTrailingSlash())
+	e.Use(
+		middlewares.MakeLogger(logger),
+		middlewares.MakeCORS(TokenHeader))
+
+	// Registering common routes (no auth)
+	registerHandlers(e, "", lightRoutes, lib.ReqContext{Log: logger, Shutdown: shutdown})
+
+	// Registering v2 routes
+	lightHandler := v2.LightHandlers{
+		Follower: follower,
+		Log:      logger,
+		Shutdown: shutdown,
+	}
+	e.GET("/v2/status", lightHandler.GetStatus, apiAuthenticator)
+	e.GET("/v2/blocks/:round/transactions/:txid/proof", lightHandler.GetProof, apiAuthenticator)
+
+	return e
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// findCompactCert returns the compact certificate of the given round, along with the round of the block that
+// committed it. The CompactCertNextRound of the block headers never decreases, and moves past the round of a
+// compact certificate at the block committing it, so that block is found by a binary search over the block headers.
+func findCompactCert(ledger LedgerForAPI, certRound basics.Round) (cert compactcert.Cert, confirmedRound basics.Round, err error) {
+	nextCertRound := func(rnd basics.Round) (basics.Round, error) {
+		hdr, err := ledger.BlockHdr(rnd)
+		return hdr.CompactCert[protocol.CompactCertBasic].CompactCertNextRound, err
+	}
+
+	latest := ledger.Latest()
+	next, err := nextCertRound(latest)
+	if err != nil {
+		return compactcert.Cert{}, 0, err
+	}
+	if next <= certRound {
+		return compactcert.Cert{}, 0, fmt.Errorf("the compact certificate of round %d is not committed yet", certRound)
+	}
+	low, high := certRound+1, latest
+	for low < high {
+		mid := low + (high-low)/2
+		next, err = nextCertRound(mid)
+		if err != nil {
+			return compactcert.Cert{}, 0, err
+		}
+		if next > certRound {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+
+	blk, err := ledger.Block(high)
+	if err != nil {
+		return compactcert.Cert{}, 0, err
+	}
+	payset, err := blk.DecodePaysetFlat()
+	if err != nil {
+		return compactcert.Cert{}, 0, err
+	}
+	for _, txn := range payset {
+		if txn.Txn.Type == protocol.CompactCertTx && txn.Txn.CertType == protocol.CompactCertBasic && txn.Txn.CertRound == certRound {
+			return txn.Txn.Cert, high, nil
+		}
+	}
+	return compactcert.Cert{}, 0, fmt.Errorf("block %d does not commit the compact certificate of round %d", high, certRound)
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// encodedStateDelta is the wire representation of a ledgercore.StateDelta.
+// ledgercore keeps the account deltas in unexported fields and uses maps
+// keyed by structs, neither of which can be encoded directly, so everything
+// is flattened into lists here. Lists built from maps are sorted to keep the
+// encoding deterministic.
+type encodedStateDelta struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Round           basics.Round                `codec:"rnd"`
+	Accounts        []encodedAccountDelta       `codec:"accts"`
+	AssetResources  []encodedAssetResourceDelta `codec:"asset-resources"`
+	AppResources    []encodedAppResourceDelta   `codec:"app-resources"`
+	Creatables      []encodedModifiedCreatable  `codec:"creatables"`
+	Txids           []encodedTxid               `codec:"txids"`
+	Txleases        []encodedTxlease            `codec:"txleases"`
+	KvMods          []encodedKvDelta            `codec:"kv-mods"`
+	CompactCertNext basics.Round                `codec:"cc-next"`
+	PrevTimestamp   int64                       `codec:"prev-ts"`
+	Totals          ledgercore.AccountTotals    `codec:"totals"`
+}
+
+type encodedAccountDelta struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Address basics.Address         `codec:"addr"`
+	Data    ledgercore.AccountData `codec:"data"`
+}
+
+// encodedAssetResourceDelta holds the new asset params and holding of an
+// account. A nil value with the matching deleted flag unset means that part of
+// the resource was not modified.
+type encodedAssetResourceDelta struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Address        basics.Address       `codec:"addr"`
+	Asset          basics.AssetIndex    `codec:"aidx"`
+	Params         *basics.AssetParams  `codec:"params"`
+	ParamsDeleted  bool                 `codec:"params-deleted"`
+	Holding        *basics.AssetHolding `codec:"holding"`
+	HoldingDeleted bool                 `codec:"holding-deleted"`
+}
+
+// encodedAppResourceDelta holds the new app params and local state of an
+// account, following the same conventions as encodedAssetResourceDelta.
+type encodedAppResourceDelta struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Address       basics.Address        `codec:"addr"`
+	App           basics.AppIndex       `codec:"aidx"`
+	Params        *basics.AppParams     `codec:"params"`
+	ParamsDeleted bool                  `codec:"params-deleted"`
+	State         *basics.AppLocalState `codec:"state"`
+	StateDeleted  bool                  `codec:"state-deleted"`
+}
+
+type encodedModifiedCreatable struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Index   basics.CreatableIndex `codec:"idx"`
+	Type    basics.CreatableType  `codec:"type"`
+	Created bool                  `codec:"created"`
+	Creator basics.Address        `codec:"creator"`
+}
+
+type encodedTxid struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Txid      transactions.Txid `codec:"txid"`
+	LastValid basics.Round      `codec:"lv"`
+}
+
+type encodedTxlease struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Sender  basics.Address `codec:"sender"`
+	Lease   [32]byte       `codec:"lease"`
+	Expires basics.Round   `codec:"expires"`
+}
+
+// encodedKvDelta is a modified key/value pair; a nil value means the key was
+// deleted.
+type encodedKvDelta struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Key   []byte `codec:"key"`
+	Value []byte `codec:"value"`
+}
+
+// convertStateDelta converts a ledgercore.StateDelta into its wire representation.
+func convertStateDelta(delta ledgercore.StateDelta) encodedStateDelta {
+	res := encodedStateDelta{
+		CompactCertNext: delta.CompactCertNext,
+		PrevTimestamp:   delta.PrevTimestamp,
+		Totals:          delta.Totals,
+	}
+	if delta.Hdr != nil {
+		res.Round = delta.Hdr.Round
+	}
+
+	for i := 0; i < delta.Accts.Len(); i++ {
+		addr, data := delta.Accts.GetByIdx(i)
+		res.Accounts = append(res.Accounts, encodedAccountDelta{Address: addr, Data: data})
+	}
+	for _, rec := range delta.Accts.GetAllAssetResources() {
+		res.AssetResources = append(res.AssetResources, encodedAssetResourceDelta{
+			Address:        rec.Addr,
+			Asset:          rec.Aidx,
+			Params:         rec.Params.Params,
+			ParamsDeleted:  rec.Params.Deleted,
+			Holding:        rec.Holding.Holding,
+			HoldingDeleted: rec.Holding.Deleted,
+		})
+	}
+	for _, rec := range delta.Accts.GetAllAppResources() {
+		res.AppResources = append(res.AppResources, encodedAppResourceDelta{
+			Address:       rec.Addr,
+			App:           rec.Aidx,
+			Params:        rec.Params.Params,
+			ParamsDeleted: rec.Params.Deleted,
+			State:         rec.State.LocalState,
+			StateDeleted:  rec.State.Deleted,
+		})
+	}
+
+	for cidx, mc := range delta.Creatables {
+		res.Creatables = append(res.Creatables, encodedModifiedCreatable{
+			Index:   cidx,
+			Type:    mc.Ctype,
+			Created: mc.Created,
+			Creator: mc.Creator,
+		})
+	}
+	sort.Slice(res.Creatables, func(i, j int) bool { return res.Creatables[i].Index < res.Creatables[j].Index })
+
+	for txid, lv := range delta.Txids {
+		res.Txids = append(res.Txids, encodedTxid{Txid: txid, LastValid: lv})
+	}
+	sort.Slice(res.Txids, func(i, j int) bool { return bytes.Compare(res.Txids[i].Txid[:], res.Txids[j].Txid[:]) < 0 })
+
+	for lease, expires := range delta.Txleases {
+		res.Txleases = append(res.Txleases, encodedTxlease{Sender: lease.Sender, Lease: lease.Lease, Expires: expires})
+	}
+	sort.Slice(res.Txleases, func(i, j int) bool {
+		if c := bytes.Compare(res.Txleases[i].Sender[:], res.Txleases[j].Sender[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(res.Txleases[i].Lease[:], res.Txleases[j].Lease[:]) < 0
+	})
+
+	for key, kv := range delta.KvMods {
+		res.KvMods = append(res.KvMods, encodedKvDelta{Key: []byte(key), Value: kv.Data})
+	}
+	sort.Slice(res.KvMods, func(i, j int) bool { return bytes.Compare(res.KvMods[i].Key, res.KvMods[j].Key) < 0 })
+
+	return res
+}
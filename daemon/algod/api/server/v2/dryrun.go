@@ -326,6 +326,12 @@ func (dl *dryrunLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx
 	return result, nil
 }
 
+// LookupKv always reports that the key does not exist, since dryrun requests
+// carry no box contents.
+func (dl *dryrunLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return nil, nil
+}
+
 func (dl *dryrunLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
 	switch ctype {
 	case basics.AssetCreatable:
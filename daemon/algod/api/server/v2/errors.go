@@ -19,6 +19,8 @@ package v2
 var (
 	errAppDoesNotExist                         = "application does not exist"
 	errAssetDoesNotExist                       = "asset does not exist"
+	errBoxDoesNotExist                         = "box not found"
+	errFailedToParseBoxName                    = "failed to parse the box name"
 	errAccountAppDoesNotExist                  = "account application info not found"
 	errAccountAssetDoesNotExist                = "account asset info not found"
 	errFailedLookingUpLedger                   = "failed to retrieve information from the ledger"
@@ -33,15 +35,32 @@ var (
 	errFailedToParseCert                       = "failed to parse cert"
 	errFailedToParseSourcemap                  = "failed to parse sourcemap"
 	errFailedToEncodeResponse                  = "failed to encode response"
+	errFailedToSimulate                        = "failed to simulate transaction group"
 	errInternalFailure                         = "internal failure"
 	errNoTxnSpecified                          = "no transaction ID was specified"
 	errInvalidHashType                         = "invalid hash type"
 	errTransactionNotFound                     = "could not find the transaction in the transaction pool or in the last 1000 confirmed rounds"
 	errServiceShuttingDown                     = "operation aborted as server is shutting down"
 	errRequestedRoundInUnsupportedRound        = "requested round would reach only after the protocol upgrade which isn't supported"
+	errRoundAfterLatest                        = "requested round %d is after the latest round %d"
+	errRoundNotAvailable                       = "the account state of the requested round is not available on this node"
+	errStateDeltaNotAvailable                  = "the state delta of the requested round is not available on this node"
+	errBlockStreamNotAvailable                 = "block streaming is not available on this node"
+	errBlockNotAvailable                       = "the block of the requested round is not available on this node"
+	errFailedToParseContract                   = "failed to parse the contract description"
+	errContractNotDeployed                     = "the contract description does not declare an application for this network, app-id is required"
 	errFailedToParseCatchpoint                 = "failed to parse catchpoint"
+	errFailedToParseCatchpointSource           = "failed to parse catchpoint source"
 	errFailedToAbortCatchup                    = "failed to abort catchup : %v"
 	errFailedToStartCatchup                    = "failed to start catchup : %v"
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
+	errPeerNotBanned                           = "peer is not banned"
+	errPeerNotConnected                        = "peer is not connected"
+	errRoundNotInTimeline                      = "round is not in the agreement timeline"
+	errRoundNotCertified                       = "the block header of the requested round is not certified yet"
+	errFailedVerifyingProof                    = "failed retrieving a verified transaction proof"
+	errLightClientHashType                     = "the light client only verifies sha512_256 transaction proofs"
+	errCompactCertsNotEnabled                  = "compact certificates are not enabled in the protocol of the requested round"
+	errCompactCertNotAvailable                 = "no compact certificate covering the requested round is available on this node"
 )
@@ -17,12 +17,15 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the agreement timelines of the recent rounds.
+	// (GET /v2/agreement/timeline)
+	GetAgreementTimeline(ctx echo.Context, params GetAgreementTimelineParams) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
-	StartCatchup(ctx echo.Context, catchpoint string) error
+	StartCatchup(ctx echo.Context, catchpoint string, params StartCatchupParams) error
 	// Return a list of participation keys
 	// (GET /v2/participation)
 	GetParticipationKeys(ctx echo.Context) error
@@ -38,6 +41,21 @@ type ServerInterface interface {
 	// Append state proof keys to a participation key
 	// (POST /v2/participation/{participation-id})
 	AppendKeys(ctx echo.Context, participationId string) error
+	// Disconnect a gossip peer.
+	// (DELETE /v2/peers)
+	DisconnectPeer(ctx echo.Context, params DisconnectPeerParams) error
+	// Get the connected gossip peers.
+	// (GET /v2/peers)
+	GetPeers(ctx echo.Context) error
+	// Lift gossip peer bans.
+	// (DELETE /v2/peers/bans)
+	ClearPeerBans(ctx echo.Context, params ClearPeerBansParams) error
+	// Get the banned gossip peers.
+	// (GET /v2/peers/bans)
+	GetPeerBans(ctx echo.Context) error
+	// Add a priority gossip peer.
+	// (POST /v2/peers/priority)
+	AddPriorityPeer(ctx echo.Context, params AddPriorityPeerParams) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -48,6 +66,42 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetAgreementTimeline converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAgreementTimeline(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"round":  true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgreementTimelineParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetAgreementTimeline(ctx, params)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 
@@ -83,6 +137,7 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 
 	validQueryParams := map[string]bool{
 		"pretty": true,
+		"source": true,
 	}
 
 	// Check for unknown query parameters.
@@ -103,8 +158,20 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 
 	ctx.Set("api_key.Scopes", []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartCatchupParams
+	// ------------- Optional query parameter "source" -------------
+	if paramValue := ctx.QueryParam("source"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "source", ctx.QueryParams(), &params.Source)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter source: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.StartCatchup(ctx, catchpoint)
+	err = w.Handler.StartCatchup(ctx, catchpoint, params)
 	return err
 }
 
@@ -244,6 +311,164 @@ func (w *ServerInterfaceWrapper) AppendKeys(ctx echo.Context) error {
 	return err
 }
 
+// DisconnectPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) DisconnectPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"address": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DisconnectPeerParams
+	// ------------- Required query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument address is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.DisconnectPeer(ctx, params)
+	return err
+}
+
+// GetPeers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeers(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeers(ctx)
+	return err
+}
+
+// ClearPeerBans converts echo context to params.
+func (w *ServerInterfaceWrapper) ClearPeerBans(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"address": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ClearPeerBansParams
+	// ------------- Optional query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.ClearPeerBans(ctx, params)
+	return err
+}
+
+// GetPeerBans converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPeerBans(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetPeerBans(ctx)
+	return err
+}
+
+// AddPriorityPeer converts echo context to params.
+func (w *ServerInterfaceWrapper) AddPriorityPeer(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty":  true,
+		"address": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddPriorityPeerParams
+	// ------------- Required query parameter "address" -------------
+	if paramValue := ctx.QueryParam("address"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument address is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.AddPriorityPeer(ctx, params)
+	return err
+}
+
 // ShutdownNode converts echo context to params.
 func (w *ServerInterfaceWrapper) ShutdownNode(ctx echo.Context) error {
 
@@ -297,6 +522,7 @@ func RegisterHandlers(router interface {
 		Handler: si,
 	}
 
+	router.GET("/v2/agreement/timeline", wrapper.GetAgreementTimeline, m...)
 	router.DELETE("/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST("/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
 	router.GET("/v2/participation", wrapper.GetParticipationKeys, m...)
@@ -304,6 +530,11 @@ func RegisterHandlers(router interface {
 	router.DELETE("/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET("/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST("/v2/participation/:participation-id", wrapper.AppendKeys, m...)
+	router.DELETE("/v2/peers", wrapper.DisconnectPeer, m...)
+	router.GET("/v2/peers", wrapper.GetPeers, m...)
+	router.DELETE("/v2/peers/bans", wrapper.ClearPeerBans, m...)
+	router.GET("/v2/peers/bans", wrapper.GetPeerBans, m...)
+	router.POST("/v2/peers/priority", wrapper.AddPriorityPeer, m...)
 	router.POST("/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -311,153 +542,199 @@ func RegisterHandlers(router interface {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PcNrbgX0H13Co/ttkt+ZEZqyp1V7ETjzaO47KUubvX9iZo8nQ3RiTAAKDUHa/+",
-	"+xYOABIkwW7qMcp1XX+y1cTj4ODg4LzxeZKKohQcuFaTo8+TkkpagAaJf9E0FRXXCcvMXxmoVLJSM8En",
-	"R/4bUVoyvppMJ8z8WlK9nkwnnBbQtDH9pxMJv1dMQjY50rKC6USlayioGVhvS9O6HmmTrETihji2Q5y8",
-	"mlzt+ECzTIJSfSh/5vmWMJ7mVQZES8oVTc0nRS6ZXhO9Zoq4zoRxIjgQsSR63WpMlgzyTM38In+vQG6D",
-	"VbrJh5d01YCYSJFDH86XolgwDh4qqIGqN4RoQTJYYqM11cTMYGD1DbUgCqhM12Qp5B5QLRAhvMCrYnL0",
-	"YaKAZyBxt1JgF/jfpQT4AxJN5Qr05NM0trilBploVkSWduKwL0FVuVYE2+IaV+wCODG9ZuSnSmmyAEI5",
-	"ef/DS/L06dMXZiEF1RoyR2SDq2pmD9dku0+OJhnV4D/3aY3mKyEpz5K6/fsfXuL8p26BY1tRpSB+WI7N",
-	"F3LyamgBvmOEhBjXsMJ9aFG/6RE5FM3PC1gKCSP3xDa+000J5/9TdyWlOl2XgnEd2ReCX4n9HOVhQfdd",
-	"PKwGoNW+NJiSZtAPB8mLT58Pp4cHV3/5cJz8p/vz+dOrkct/WY+7BwPRhmklJfB0m6wkUDwta8r7+Hjv",
-	"6EGtRZVnZE0vcPNpgaze9SWmr2WdFzSvDJ2wVIrjfCUUoY6MMljSKtfET0wqnhs2ZUZz1E6YIqUUFyyD",
-	"bGq47+WapWuSUmWHwHbkkuW5ocFKQTZEa/HV7ThMVyFKDFw3wgcu6L8uMpp17cEEbJAbJGkuFCRa7Lme",
-	"/I1DeUbCC6W5q9T1LitytgaCk5sP9rJF3HFD03m+JRr3NSNUEUr81TQlbEm2oiKXuDk5O8f+bjUGawUx",
-	"SMPNad2j5vAOoa+HjAjyFkLkQDkiz5+7Psr4kq0qCYpcrkGv3Z0nQZWCKyBi8U9Itdn2/3X681siJPkJ",
-	"lKIreEfTcwI8FdnwHrtJYzf4P5UwG16oVUnT8/h1nbOCRUD+iW5YURWEV8UCpNkvfz9oQSToSvIhgOyI",
-	"e+isoJv+pGey4ilubjNtS1AzpMRUmdPtjJwsSUE33x5MHTiK0DwnJfCM8RXRGz4opJm594OXSFHxbIQM",
-	"o82GBbemKiFlSwYZqUfZAYmbZh88jF8PnkayCsDxgwyCU8+yBxwOmwjNmKNrvpCSriAgmRn5xXEu/KrF",
-	"OfCawZHFFj+VEi6YqFTdaQBGnHq3eM2FhqSUsGQRGjt16DDcw7Zx7LVwAk4quKaMQ2Y4LwItNFhONAhT",
-	"MOFuZaZ/RS+ogm+eDV3gzdeRu78U3V3fueOjdhsbJfZIRu5F89Ud2LjY1Oo/QvkL51ZsldifexvJVmfm",
-	"KlmyHK+Zf5r982ioFDKBFiL8xaPYilNdSTj6yB+bv0hCTjXlGZWZ+aWwP/1U5ZqdspX5Kbc/vRErlp6y",
-	"1QAya1ij2hR2K+w/Zrw4O9abqNLwRojzqgwXlLa00sWWnLwa2mQ75nUJ87hWZUOt4mzjNY3r9tCbeiMH",
-	"gBzEXUlNw3PYSjDQ0nSJ/2yWSE90Kf8w/5RlHsOpIWB30aJRwBkLjssyZyk12HvvPpuv5vSDVQ9o02KO",
-	"N+nR5wC2UooSpGZ2UFqWSS5SmidKU40j/ZuE5eRo8pd5Y1WZ2+5qHkz+xvQ6xU5GELXCTULL8hpjvDMC",
-	"jdrBJQxnxk/IHyy/Q1GIcbt7hoaY4b05XFCuZ40i0mIE9cn94GZq8G1lGIvvjmI1iHBiGy5AWbnWNnyg",
-	"SIB6gmgliFYUM1e5WNQ/PDwuywaD+P24LC0+UCYEhuIWbJjS6hEunzZHKJzn5NWMvA7HRgFb8HxrbgUr",
-	"Y5hLYemuK3d91RYjt4ZmxAeK4HYKOTNb49FghPe7oDhUFtYiN+LOXloxjf/u2oZkZn4f1fnLILEQt8PE",
-	"heqTw5zVXPCXQGV52KGcPuE4I86MHHf73oxszChxgrkRrezcTzvuDjzWKLyUtLQAui/2EmUcVS/byMJ6",
-	"S246ktFFYQ7OcEBrCNWNz9re8xCFBEmhA8N3uUjP7+C8L8w4/WOHw5M10Awkyaimwbly5yV+WWPHv2M/",
-	"5AggIxL9z/gfmhPz2RC+4Yt2WKOpM6RfEdjVM6PgWrHZzmQaoOItSGF1WmJ00WtB+bKZvMcjLFrG8Ijv",
-	"rRpNsIdfhFl6YyQ7Xgh5M3rpEAInjemPUDNqcFymnZ3FplWZOPxEzAe2QWegxtvSlyJDDHWHj+GqhYVT",
-	"Tf8FWFBm1LvAQnugu8aCKEqWwx2c1zVV6/4ijD739Ak5/fvx88Mnvz55/o1RSEopVpIWZLHVoMhDJ0YT",
-	"pbc5POqvDOXZKtfx0b955g1G7XFj4yhRyRQKWvaHsoYoe2nZZsS062OtjWZcdQ3gmGN5Boa9WLQTa2M1",
-	"oL1iytyJxeJONmMIYVkzS0YcJBnsJabrLq+ZZhsuUW5ldRfKB0gpZMQUgkdMi1TkyQVIxUTEqv3OtSCu",
-	"hRdIyu7vFlpySRUxc6OVruIZyFmMsvSGI2hMQ6H2Xah26LMNb3DjBqRS0m0P/Xa9kdW5ecfsSxv53uij",
-	"SAky0RtOMlhUq5bsupSiIJRk2BEvjrciA6N3VOoOuGUzWAOM2YgQBLoQlSaUcJEBKimVivPRARcX2tbR",
-	"JaBD1qzX9p5egBGIU1qt1ppUJUGDd29rm44JTe2mJHinqgGLYG3Kta3sdNZ9kkugmRGUgROxcGY3ZxDE",
-	"RVK01mvPiRwXj6gOLbhKKVJQyig4VmzdC5pvZ3dZ78ATAo4A17MQJciSyhsCq4Wm+R5AsU0M3FrscrbK",
-	"PtTjpt+1gd3Jw22k0ug4lgqMjGdOdw4ahlA4EicXINFm9y/dPz/JTbevKgc86k5SOWMFqkqccqEgFTxT",
-	"0cFyqnSy79iaRi1xyqwgOCmxk4oDD6jrb6jS1nLLeIaitWU3OI/V480UwwAP3ihm5H/4y6Q/dmr4JFeV",
-	"qm8WVZWlkBqy2Bo4bHbM9RY29VxiGYxdX19akErBvpGHsBSM75BlV2IRRHVt53Cujf7i0Bpg7oFtFJUt",
-	"IBpE7ALk1LcKsBt6FQcAMXpY3RMJh6kO5dSuzOlEaVGW5vzppOJ1vyE0ndrWx/qXpm2fuKhu+HomwMyu",
-	"PUwO8kuLWetPXlMjA+PIpKDn5m5CidaamPswm8OYKMZTSHZRvjmWp6ZVeAT2HNIBZcJFrASzdQ5Hh36j",
-	"RDdIBHt2YWjBA5rNOyo1S1mJksSPsL1zs0h3gqiFhGSgKTPSdvABGTjy3ro/sT6D7pg3E7RGCaF98HtS",
-	"aGQ5OVN4YbSBP4ctmkrfWWf0WeDCvgNJMTKqOd2UEwTUu7jMhRw2gQ1Ndb4115xew5ZcggSiqkXBtLbR",
-	"BW1BUosyCQeIKvg7ZnQmFuvI9TswxuZzikMFy+tvxXRixZbd8J11BJcWOpzAVAqRjzBF95ARhWCUqZqU",
-	"wuw6c8EsPuLBU1ILSCfEoH2tZp4PVAvNuALyf0RFUspRAKs01DeCkMhm8fo1M5gLrJ7TGaUbDEEOBVi5",
-	"Er88ftxd+OPHbs+ZIku49BFgpmEXHY8fo5b0TijdOlx3oPGa43YS4e1o+TAXhZPhujxltle1dyOP2cl3",
-	"ncFrc4k5U0o5wjXLvzUD6JzMzZi1hzSypmq9f+047iijRjB0bN1236UQyzsypMUjAFA5cU5904osK26B",
-	"qpRTR9DP5Q0aYjmtozxsdPcRwRCANfXWOPfnk+ffTKaN677+bu5k+/VTRKJk2SYWoJHBJrYn7oihNvXA",
-	"qB5bBVGvGDJmsYzEaIE8z93KOqyDFGDOtFqz0gzZxJNsNbRiUf/vw38/+nCc/CdN/jhIXvyP+afPz64e",
-	"Pe79+OTq22//X/unp1ffPvr3f4uaFTVbxM2ffze7JJbEsfgNP+HWgbEU0upjWyfmieX9w60lQAalXseC",
-	"P0sJClmjDeIs9brZVICODaWU4gL4lLAZzLosNluB8sakHOgSgxBRpxBjnKL1cbD05okjwHq4kFF8LEY/",
-	"6OJD2sTDbJSOfHsHwosdiMg2Pr2yruxXsQwjZ91BUVuloejbu2zXXwek/fdeVu4dKsFzxiEpBIdtNFmE",
-	"cfgJP8Z62+tuoDMKHkN9u7pEC/4OWO15xmzmbfGLux3w93e1Y/sONr87bsfUGcYMo6kG8pJQkuYMDTmC",
-	"Ky2rVH/kFFXFgFwj7iSvAA8bD176JnFrRcSY4Ib6yKkyOKwVyKgJfAmRK+sHAG9DUNVqBUp3hOYlwEfu",
-	"WjFOKs40zlWY/UrshpUg0aczsy0LuiVLmqOt4w+Qgiwq3RYj8dJTmuW5s7uaaYhYfuRUGx6kNPmJ8bMN",
-	"DucjCD3NcNCXQp7XWIhfUSvgoJhK4nz/tf2K7N8tf+2uAswzsZ89v7lvvu9hjwXeOchPXjkV6+QVytGN",
-	"xbUH+72Z4QrGkyiRGbmoYBzjtzu0RR4abcAT0KPGdut2/SPXG24I6YLmLDOy003IocviemfRno4O1bQ2",
-	"omNV8Wv9FAsbWImkpOk5eo0nK6bX1WKWimLuVcv5StRq5jyjUAiO37I5LdlclZDOLw73yLm34Fckwq6u",
-	"phPHddSdG2LcwLEFdees7Zn+by3Ig9ffn5G52yn1wEbh2qGD8MmINcBFCLUcVmbxNovMhiF/5B/5K1gy",
-	"zsz3o488o5rOF1SxVM0rBfI7mlOewmwlyJEPOnpFNf3Ieyx+MNEzCPciZbXIWUrOw6u4OZo2eac/wseP",
-	"HwyBfPz4qef96F+cbqroGbUTJJdMr0WlE5edkEi4pDKLgK7q6HQc2eYW7Zp1StzYliJd9oMbP86qaVmq",
-	"brBqf/llmZvlB2SoXCim2TKitJCeCRrOaKHB/X0rnMol6aVPbakUKPJbQcsPjOtPJPlYHRw8BdKK3vzN",
-	"8RpDk9sSWnajGwXTdm1GuHArUMFGS5qUdAUqunwNtMTdx4u6QAtlnhPs1ooa9TEWOFSzAI+P4Q2wcFw7",
-	"Ag4Xd2p7+TTT+BLwE24htjHcqTH833S/gjjSG29XJxa1t0uVXifmbEdXpQyJ+52ps89Whid7b4xiK24O",
-	"gUvUWwBJ15CeQ4Y5Q1CUejttdfcOP3fDedbBlM2ts4FumACCJrYFkKrMqJMBKN92I/EVaO3TD97DOWzP",
-	"RJM/cp3Q+3ZAuBo6qEipwWVkiDU8tm6M7uY75zEGwZalj6vGGEJPFkc1Xfg+wwfZ3pB3cIhjRNEKWB5C",
-	"BJURRFjiH0DBDRZqxrsV6ceWZ8Sbhb35ImYez/uJa9JIbc4BHK4G47Dt9wIwUVdcKrKgCjIiXI6pDXoO",
-	"uFil6AoGbE+hlXNkaHHLMoqD7Lv3ojedWHYvtN59EwXZNk7MmqOUAuaLIRU0E3bc/n4ma0jHFcwIlo5w",
-	"CFvkKCbVEQeW6VDZsjbbXPgh0OIEDJI3AocHo42RULJZU+XTXzFL2J/lUTLAvzCIf1fO1kngsQ5SgeuM",
-	"LM9zu+e0Z7d1mVs+XcvnaIVG2xH5VtOJC6KKbYfgKABlkMPKLtw29oTSJBQ0G2Tg+Hm5zBkHksSc31Qp",
-	"kTKbv9xcM24OMPLxY0Ks7YmMHiFGxgHY6CDCgclbEZ5NvroOkNwlRFA/NrqWgr8hHglow5uMyCNKw8IZ",
-	"HwhM8xyAuoiJ+v7qxO3gMITxKTFs7oLmhs05I2ozSC+DCMXWTr6Qc1E+GhJnd5j+7MVyrTXZq+gmqwll",
-	"Jg90XKDbAfFuUSK2BQrx5VTfGldDd+mYqQeu7yFcPQxyj24EQMcS0ZTncZrfXg2tfTf3b7KGpU+bZFof",
-	"mRmj/SH6ie7SAP76huA6W+hd97qOKult12U7USqQn2Ks2JyRvmm0b4BVkANKxElLgkjOYwZzI9gDsttT",
-	"3y3Q3DEdi/Lto8AfLmHFlIbGdGVuJW+LvW93F8X0byGWw6vTpVya9b0XoubRNs3Quu/CZd77Ci6EhmTJ",
-	"pNIJ2v2iSzCNflCoUf5gmsYFhbbH3VZCYVmcN+C057BNMpZXcXp18/74ykz7tjbCqGpxDlsUB4Gma7LA",
-	"yj3ROJwdU9tQrZ0LfmMX/Ibe2XrHnQbT1EwsDbm05/hCzkWH8+5iBxECjBFHf9cGUbqDQeLF/wpyHctY",
-	"CoQGezgz03C2y/TYO0yZH3uXohRAMXxH2ZGiawm05Z2rYBh9YNQ9poPCN/20gYEzQMuSZZuOIdCOOqgu",
-	"0mtp+z6xuIMF3F032B4MBEa/WGSqBNXOIW+kW1vCiIdrm43CzFk70ztkCOFUTPkCfH1EGdLGKlH7cHUG",
-	"NP8Rtv8wbXE5k6vp5HZ2wxiu3Yh7cP2u3t4ontEhZu1ILTfANVFOy1KKC5onzro6RJpSXDjSxObeGHvP",
-	"rC5uwzv7/vjNOwf+1XSS5kBlUosKg6vCduUXsyqbrj5wQHyBL6PweJndipLB5tdpxKFF9nINrphSII32",
-	"ij801vbgKDoL7TLul99rb3WOAbvEHQ4CKGv/QGO7su6BtkuAXlCWe6ORh3bAh46LG1dBJMoVwgFu7VoI",
-	"PETJnbKb3umOn46GuvbwpHCuHeWeClvRTBHBuyFZRoREWxSSakGxdIM1CfSZE6+KxBy/ROUsjRsY+UIZ",
-	"4uDWcWQaE2w8IIyaESs24IfkFQvGMs3UCEW3A2QwRxSZvgzIEO4WwpWirTj7vQLCMuDafJJ4KjsHFWtl",
-	"OFNz/zo1skN/LjewNU83w99GxgjLlnRvPARit4ARuql64L6qVWa/0NocY34I7PHX8HaHM/auxB2eakcf",
-	"jpptyNC67W4KK8f2+Z8hDFtlbH/ZWq+8uvopA3NEy9AylSyl+APieh6qx5GwdV+ohWHU5B/AZ5Hsny6L",
-	"qa07TTXdZvbB7R6SbkIrVNtDP0D1uPOBTwqLYnjzLOV2q21VyFZcSJxgwliuuR2/IRgHcy/+LaeXCxqr",
-	"GGKEDAPTceP9bBmStSC+s8e9s3kzVztnRgJHat2W2YSuEmSTUdJPHr6hwGCnHS0qNJIBUm0oE0yt8ytX",
-	"IjJMxS8pt8VFTT97lFxvBdb4ZXpdConpmCpu884gZQXN45JDhthvp69mbMVsac1KQVC70Q1kaxJbKnL1",
-	"L61/uUHNyZIcTIPqsG43MnbBFFvkgC0ObYsFVcjJa0NU3cUsD7heK2z+ZETzdcUzCZleK4tYJUgt1KF6",
-	"U3tuFqAvATg5wHaHL8hD9FkpdgGPDBbd/Tw5OnyBRlf7x0HsAnA1dHdxkwzZyX84dhKnY3Ta2TEM43aj",
-	"zqLJhbbw+TDj2nGabNcxZwlbOl63/ywVlNMVxMMkij0w2b64m2hI6+CFZ7Zqr9JSbAnT8flBU8OfBmI+",
-	"DfuzYJBUFAXThfNsKFEYemoKM9pJ/XC2BLCrHuTh8h/RQVh6/0hHibxfo6m932KrRjfuW1pAG61TQm0O",
-	"bs4a170v+EVOfCY/llOqqyhZ3Ji5zNJRzEFP/pKUknGNikWll8nfSLqmkqaG/c2GwE0W3zyLlJBqV43h",
-	"1wP83vEuQYG8iKNeDpC9lyFcX/KQC54UhqNkj5oY6+BUDnoy49FinqN3gwV3Dz1WKDOjJIPkVrXIjQac",
-	"+laEx3cMeEtSrNdzLXq89srunTIrGScPWpkd+uX9GydlFELG6ro0x91JHBK0ZHCBgWvxTTJj3nIvZD5q",
-	"F24D/Z/refAiZyCW+bMcUwS+q1ie/aPJGelU4ZOUp+uo3X9hOv7aVEmul2zPcbSMyJpyDnl0OHtn/urv",
-	"1sjt/08xdp6C8ZFtu9X17HI7i2sAb4PpgfITGvQynZsJQqy2g+jrqMt8JTKC8zQ1Kxoq6xcMDCpo/V6B",
-	"0rGkPfxgIz/QvmP0AlvAiQDPUKqekdf2lZM1kFZKPUqzrKhym54N2QqkMzxWZS5oNiVmnLPvj98QO6vt",
-	"Y0t+2gJSKxTm2qvo6PVBgZtxMYS+emc8vnn8OLsDLs2qlcYKF0rTooylrpgWZ74B5seEtk4U80LszMgr",
-	"K2ErL7/ZSQw9LJksjGRaj2Z5PNKE+Y/WNF2j6NriJsMkP77ymadKFRSGr+u81jVq8NwZuF3xM1v7bEqE",
-	"0S8umbKPW8AFtLNl6tQxpzr57Jn28mTFuaWUKI/eldp4E7R74KxD25tDo5B1EH9NwcUWDrxuIbhT7BUt",
-	"+tCtKterCG+ziusSpf7RopRywVmKJReC5zRqkN1DGWN8BSOqU3SNUf6IuxMaOVzRWnZ1OJHD4mB1O88I",
-	"HeL6xsrgq9lUSx32T40vMqypJivQynE2yKa+JKOzlzCuwNUcwjdTAj4pZMv/ghwy6tJLatPvNckIY+cH",
-	"BOAfzLe3Tj3CoNJzxlEQcmhz8avWooF1/LWRnpgmKwHKraedmq8+mD4zTE/PYPNp5uv+4xjWfWGWbX11",
-	"/aGOvefOecpM25emLbFRh/XPrTBFO+lxWbpJhwt2RuUBveGDCI54YBJvAg+QW48fjraD3Ha63PE+NYQG",
-	"F+iwgxLv4R5h1MUrO9V6L2heWYrCFsSGukTzKxmPgPGGcWhepYhcEGn0SsCNwfM60E+lkmorAo7iaWdA",
-	"c/TSxRia0s5Ee9uhOhuMKME1+jmGt7GpuznAOOoGjeBG+bZ+DMNQdyBMvMRXeBwi+1U0UapyQlSGYced",
-	"upoxxmEYt6/c274A+segLxPZ7lpSe3KucxMNZZKlIiZvfr+BtLJOaKF8FDJJMTU7uC+iFs2mQmxkG8Iq",
-	"tR61GCK+2OK/sRJLwyhxXuJrxyl5lzB2vLbA2h6pJ24aYkoUWyXjMYHM/PboaKa+GYU1/e+UxHKxagNy",
-	"z7VQdrGXcI9ijOV7w7HDvOZe3TDL0+u0Y4wKEr7YPOprdcJcmx3gHdIrJIbW6Lpu+G57wHAF8CneOgOx",
-	"gUEFGGovNuveGIoQTAcDWql2eSWakqZ8RZ8n2LLdsRFseIEtF25fGoyadoZCCmxEgfnc6z1OJOsJuDj2",
-	"ToT6WJU+QD/6QDhSUuZ8dw2z6GPWhcz2g5jHBNM1G9xdhAtExUFiK+nVB9xNIb1A5CCY3pZxm41PaD+u",
-	"HaPorsEi3Cvgrgp3O8RwdKDTcgmpZhd7Ar//wwjLTVDx1IvT9omHIA6c1YEz/kHKa0r5DUC74rJ3whNU",
-	"zbg1OENhn+ewfaBIixqideWmnlBvki+JGMCKIokhEaFijger/ztbMFM1ZSAWvKPPdoemmNNgQd8gjeGG",
-	"c3mSJDRMbdgx5YWIKRCj5jJdr5XwgzEgQ7Hh/ZKaw7fXK6xgqupi7PWLk0Ech9ETu/XeLl2+Jobp1yYv",
-	"n7kJyv/mc3LsLPYl06bkMBoYL6nMfIuoxOyF8WQg2qobv2zDxFkc6GU9M2vCMvohvJE6Bxh8k+ZCMb5K",
-	"hiKY2pEQ4WNI6O9B2wTWKkW4liBdqXHtH4pNtPBhHLvg2IUK93DPTZCgBqv2WeAGM37fNynNWNyJ2meC",
-	"nS8rXCCRUFADnQwSj4fn3IXsl/a7j1n1xX06pbQi43p6TfZmDvuAHKZ6SAypfkncbbk/FvYmqgrj3L7k",
-	"oGJZyNygMjRilVJkVWov6PBggFfpRuf472AlUSk/7a+yJ7DlWPHiTZBZcA7buRWa0jXlTemR9rG2xQjt",
-	"GoJMvs5u36kWFxdY85VdwOpO4PwzNaHppBQiTwasVif9ZOruGThn6TlkxNwd3pU9UNSXPERjSe2WuFxv",
-	"ffJwWQKH7NGMEKNLFaXeeg9Fu4xYZ3L+QO+af4OzZpWtb+CUtNlHHo/CsA9v35K/+WF2czUFhvndcio7",
-	"yJ5s5c1AIrekl5ES12NfMYv4DLplhxuislDEpJQbpq6NOt99RS1C+mHSwR7957yl1dlCOR0/gZBwx9pd",
-	"YCC9pnbXT6cYuzxcB3K1SkF/naM3oIXbAdyPQXxjmugjd9iioBdjLArxoh6mO5o0LEKwIg5BUMlvh78R",
-	"CUuskCfI48c4wePHU9f0tyftz0b7evw4ejLvzZjReizNzRujmH8M+ZWt73QghKGzHxXLs32E0QpIaapV",
-	"YsjFry5050+pl/mrVZH7R9WVDryOGbW7CYiYyFpbkwdTBaEmI6JMXLdZ9Dk7BWklmd5iRpHXqNiv0Uzt",
-	"17URxr3AWceguxBo+9a9i4hqTDbN8+SvhX3+rjB3PRqxNdbz/35DizIHd1C+fbD4Kzz927Ps4OnhXxd/",
-	"O3h+kMKz5y8ODuiLZ/TwxdNDePK3588O4HD5zYvFk+zJsyeLZ0+effP8Rfr02eHi2Tcv/vrAvw1uAW3e",
-	"3f7fWFQ2OX53kpwZYBuc0JLVz3gYMvYFKmmKJ9HoJPnkyP/0P/0Jm6WiaIb3v05ceNxkrXWpjubzy8vL",
-	"WdhlvkIdLdGiStdzP0//+YR3J3Xojk25wB21URmGFHBTHSkc47f335+ekeN3J7OGYCZHk4PZwewQ60CX",
-	"wGnJJkeTp/gTnp417vvcEdvk6PPVdDJfA82xOLj5owAtWeo/qUu6WoGcuUqd5qeLJ3Pv+Z9/dvrp1a5v",
-	"87DozfxzS43P9vTEuiDzzz7dZXfrVj6JM18EHUZCMTylfSBs/hn1wcHf22B81huWXc29+cn1cA/tzD83",
-	"L19d2VOYQ8x0ZEO5aPBQ1tTo6/hwqrK/moPnI8iZaj+UVlPRSWaox/R6Wb8CFiTPH33oiV92IOJHwqNm",
-	"6Kg5Ca2ZGmanZQVhPnfNylvtG4b+4SB58enz4fTw4OovhmG7P58/vRppA24eeiWnNTce2fBT5y39JwcH",
-	"/82eyX12zRXvlLlbbrJIud7vaEZ8dCPOfXh/c59wtMAbxknsxXA1nTy/z9WfcEPyNCfYMsj76W/9L/yc",
-	"i0vuW5pbvCoKKrf+GKsWU/Bv++FdQVcKNTDJLqiGySdU8WPu/QHmgu8RX5u54CPLX5nLfTGXL+P16SfX",
-	"POBf/oq/stMvjZ2eWnY3np06Uc4G0M/tCySNhNcrL7uCaCQ/xtTTXe/tdTnsa9C95wMnt2Qxf9pLgv+9",
-	"z8mzg2f3B0G7NuKPsCVvhSY/oNvrCz2z447PLkmooxllWY/ILfsHpb8T2XYHhgq1Kl3Qa0QuWTBuQO7f",
-	"Lv23OXrP+53DllhXsDf5u+dt2/LQ1S15wBf7EuFXHvKVh0g7/dP7m/4U5AVLgZxBUQpJJcu35Bdepyzd",
-	"XK3LsmiYXfvo93ia0UZSkcEKeOIYVrIQ2daXq2kNeA7WNN0TVOaf2zUnrflr0Cz1Cn+vn8LpA73YkpNX",
-	"PQnGduty2u+22LSjMUZ0wi6IOzXDLi8aUMZ2kblZyEpoYrGQuUV9ZTxfGc+thJfRhycmv0S1CW/I6d7J",
-	"U5+7G8tup7o/9Rid4089rv9lH3b/yhK+soSbs4TXEDmMeGodk4gQ3U0svX0GgZFXWbdyO4Yv+OZVTiVR",
-	"MNZMcYwjOuPEfXCJ+1bSoriyOhrlBDZM4UskkQ27W73tK4v7yuK+IK/VfkbTFkSuremcw7agZa3fqHWl",
-	"M3Fpa95EuSKWg6W5qx2H1dzqSAwtiB+gSXAiP7uMvnyLL6KzzIhxmhVgRKqa15nOPmy1iZs1IzRP+K0Y",
-	"xwmQVeAstkgiDVIHFKSC2wevOr42B9lbqxPGmOzvFSBHc7hxME6mLWeL28ZIScJby19938jVDlt6/WpV",
-	"6+/5JWU6WQrpMocQQ/0oDA00n7vqDp1fm7zO3hdMVg1+DGI34r/O6yq90Y/dqJPYVxcU4hs1YWVhmBbu",
-	"YR2g9eGT2Qos8ua2t4k6OprPMdx+LZSeT66mnzsRSeHHTzX2P9c3r9uFq09X/z8AAP//Jlc5MCqxAAA=",
+	"H4sIAAAAAAAC/+x9/XMbubHgv4Lie1Xe9ZGi/LUvVlXqnWzvbnTxblyWNu/u1r4NONMkEQ2BCYCRxPj0",
+	"v191A5jBzGDIkcR4sxf/ZIuDj0Z3o9HoL3yaZGpTKgnSmsnJp0nJNd+ABU1/8SxTlbQzkeNfOZhMi9IK",
+	"JScn4RszVgu5mkwnAn8tuV1PphPJNzA5iftPJxr+VgkN+eTE6gqmE5OtYcNxYLstsXU90s1spWZ+iFM3",
+	"xNmbye2ODzzPNRjTh/JPstgyIbOiyoFZzaXhGX4y7FrYNbNrYZjvzIRkSgJTS2bXrcZsKaDIzVFY5N8q",
+	"0NtolX7y4SXdNiDOtCqgD+drtVkICQEqqIGqCcKsYjksqdGaW4YzIKyhoVXMANfZmi2V3gOqAyKGF2S1",
+	"mZz8PDEgc9BErQzEFf13qQH+DjPL9Qrs5OM0tbilBT2zYpNY2pnHvgZTFdYwaktrXIkrkAx7HbEfKmPZ",
+	"AhiX7P13r9mzZ89e4kI23FrIPZMNrqqZPV6T6z45meTcQvjc5zVerJTmMp/V7d9/95rmP/cLHNuKGwPp",
+	"zXKKX9jZm6EFhI4JFhLSworo0OJ+7JHYFM3PC1gqDSNp4hoflCjx/L8qVTJus3WphLQJujD6ytznpAyL",
+	"uu+SYTUArfYlYkrjoD8fz15+/PRk+uT49t9+Pp39b//ni2e3I5f/uh53DwaSDbNKa5DZdrbSwGm3rLns",
+	"4+O95wezVlWRszW/IuLzDYl635dhXyc6r3hRIZ+ITKvTYqUM456NcljyqrAsTMwqWYAxNJrndiYMK7W6",
+	"EjnkUyYku16LbM0ybtwQ1I5di6JAHqwM5EO8ll7djs10G6ME4boXPmhB/7zIaNa1BxNwQ9JglhXKwMyq",
+	"PcdTOHG4zFl8oDRnlbnbYcUu1sBocvzgDlvCnUSeLoots0TXnHHDOAtH05SJJduqil0TcQpxSf39ahBr",
+	"G4ZII+K0zlHcvEPo6yEjgbyFUgVwScgL+66PMrkUq0qDYddrsGt/5mkwpZIGmFr8FTKLZP8f53/6kSnN",
+	"fgBj+Are8eySgcxUPkxjP2nqBP+rUUjwjVmVPLtMH9eF2IgEyD/wG7GpNkxWmwVopFc4H6xiGmyl5RBA",
+	"bsQ9fLbhN/1JL3QlMyJuM21LUUNWEqYs+PaInS3Zht/8/njqwTGMFwUrQeZCrpi9kYNKGs69H7yZVpXM",
+	"R+gwFgkWnZqmhEwsBeSsHmUHJH6affAIeTd4Gs0qAkfIPeAIOQ4cCTcJnsGti19YyVcQscwR+8lLLvpq",
+	"1SXIWsCxxZY+lRquhKpM3WkARpp6t3otlYVZqWEpEjx27tGB0sO18eJ14xWcTEnLhYScCemAVhacJBqE",
+	"KZpw92Wmf0QvuIFvnk9u930dSf2l6lJ9J8VHUZsazdyWTJyL+NVv2LTa1Oo/4vIXz23EauZ+7hFSrC7w",
+	"KFmKgo6ZvyL9AhoqQ0KghYhw8BixktxWGk4+yMf4F5uxc8tlznWOv2zcTz9UhRXnYoU/Fe6nt2olsnOx",
+	"GkBmDWvyNkXdNu4fHC8tju1N8tLwVqnLqowXlLVupYstO3szRGQ35l0Z87S+ysa3ioubcNO4aw97UxNy",
+	"AMhB3JUcG17CVgNCy7Ml/XOzJH7iS/13/KcsixROkYH9QUtGAW8sOC3LQmQcsffef8avuPvBXQ9402JO",
+	"J+nJpwi2UqsStBVuUF6Ws0JlvJgZyy2N9O8alpOTyb/NG6vK3HU382jyt9jrnDqhIuqUmxkvyzuM8Q4V",
+	"GrNDSqBkpk8kH5y8I1VISEc95CGBsreAKy7t0WSa2ozNzv3Zz9Tg2+kwDt+di9UgwplruADj9FrX8JFh",
+	"EeoZoZURWknNXBVqUf/w1WlZNhik76dl6fBBOiEIUrfgRhhrvqbl82YLxfOcvTli38djk4Kt0Gi0AK9j",
+	"4KGw9MeVP75qi5FfQzPiI8OInGiCuZ3WaDAG7CE4ji4La1WgurOXV7DxH3zbmM3w91GdfxssFuN2mLmw",
+	"FfOYczcX+iW6snzV4Zw+43gjzhE77fa9H9vgKGmGuRev7KSnG3cHHmsUXmteOgD9F3eICklXL9fIwbrS",
+	"ABuQ9kJsoBASDsDhRH76n7CwMXuXFUAghSTAMbmt2YdrzbdpJjOjuAxZnIdZmPUzmGAlxruotMwPiEh5",
+	"4BEzUvonCdl8jjcgQXVvAbRXSCQhwQ9dGF4VKrv8Aexa5a95UZgDcEuG44xmlmbuPoOMEHGe4gtcxghB",
+	"5mCb3kGgXZCliO797PTVGdsQvIwGwtl5mDsg8wAYpBH7q6bh2Rp4Dprl3PKjSXcBaXWQOv6B+tGZAzpx",
+	"Z/wT/YcXDD+jaMWT1w2LtiBBElJFnpscTSjuYuZmwgZk2lFs46wmDK0dd4LydTN5j3gOLWOI9q0z1DjS",
+	"hEUQhdTNwTfcK3WTguGVuultNnUDh9hhC3Xj/jNqh71SN288ZErvlcJu7DFIxgXilcFtAxkrXDhLY/E+",
+	"XSh9PznXEWCSNXZ8xnHU6Oyb9sSQzdZVOfOsmLAFugadgRrXaf9K2JYk7eFTGGth4dzyfwAWjOUR8A/A",
+	"QnugQ2NBbUqe2ddwTwx01pSUXyiovX01GGpZ5uaNRRqiqDHqbC20HEL/56v/PEFHEJ/9/Xj28r/NP356",
+	"fvv1496PT29///v/2/7p2e3vv/7Pf+8jzInb2Z0OsiDj0T7S2OUSi5l69d7rrgU3tj2ckBb0FS+YqPuB",
+	"SZ2TUyTGUugN5HeE1aIrIVObjSD/4wCksY0kPf9SaLMTT9RgYHlhbbuQlZ7WodqM46eYOoYttdp4ffNv",
+	"FRgbTHzMrrWqVmvWUB7nroX152W/rkp1pSxoM3OLuPuyax2bVlqiqp0HC18gxzTwA31Q9M1N67jF8/UO",
+	"On1OFHVFGmg7ae3a/t5oc2vDRF3sjroxJ7cLXYbxkqqWkZYZaCBkC9+d3e0FrigOcfdbc7Pucwlaw589",
+	"Zed/OH3x5OkvT198g0CWWq003zCkmWFfeSMkM3ZbwNcp1nQ24vTo3zyv2a81bmocoyqdwYaX/aGcG8+x",
+	"rGvGsF2fLm0moFXXAI66JwCKIYd25jzUCNobYbgxsFkchBhDCMubWXLmIclhL6vfdXnNNNt4iXqrq0OY",
+	"bkFrpROOJNJprMpUMbsCbYRKxAS88y2YbxGOxLL7u4OWXXPDcG7ycVYyB32UFJ43cryi7Ya+uJENbnaq",
+	"2m69idX5ecfQpY384DIzrAQ9szeS5bCoVi3LH51anOXUkYTFW8hXoMlu+wYKyw+gpOIp4my3OY7oRBhJ",
+	"y2kUTecsWeg29lsTpd4lbOcUtMGyNZcrMGxZoPR3fkCrWCGMNWMvlc2qdt0TW6A2t8UfVQ44QGUOgJJm",
+	"sIZKTog3tOELVVnGmVS5w19l0hr9QOQUot1Fmtj4kkCHrjBsAXggZ7xarS1DJ5ZK6qt1xxnPHLfO6PQZ",
+	"UJKaCAHXyk3nonIKDTxH+ytIphbem+tVNFokpyAQG0S0v0+kFdQGrlKrDIxBu7njob2ghXaN0jaEJwKc",
+	"AK5nYUaxJdf3BNYqy4s9gFKbFLi1rUXIAajHTb+LgN3JYzJy7dRb5AJmFZ1wBVgYQuFInFyBJnX9H0q/",
+	"MMl9yVeVA4Ga/s6Mlm2ki+RSGciUzE1yMLyWzfZtW2wUr8XgCqKdktqpNPDAjektN87+zoTMSafs3RFx",
+	"imGAB49aHPnP4ZTtj50paUCaytRHrqnKUmkLeWoNGEUyPNePcFPPpZbR2PW5bhWrDOwbeQhL0fgeWSa6",
+	"3XBbu898xEx/ceRkwnNgm0RlC4gGEbsAOQ+tIuzGwWoDgAjTINoxjjAdzqkj5KYTY1VZ4v6zs0rW/YbQ",
+	"dO5an9qfmrZ95uK2keu5ApzdBpg85NcOsy5Mcc0N83CwDb/Es4lUfRe50IcZN+PMCJnBbBfn47Y8x1bx",
+	"FtizSQfMWj4QOpqtszk6/JtkukEm2EOFoQUP2NjecW1FJkrSJP4I24PbubsTpC+zOVgu8BoSfXDqXxn3",
+	"Zy4UpTvm/RStUdp5H/yeep5YDiqbdLdtAX8JW/IrvgPQr7g8iE2f3+Gm4efdb8zncrxDdcGlhJytlDGi",
+	"ZCWArtd4iAW6Ae+yQqct712kG3jsKjMlJWQ2uVAKWL2IwlwPoPYnRmXCBZnjakIYHOTt+Fq44ZkttozT",
+	"ebRl16CBmWrhrKx9O79V5SweIOk32DGjv/aYlqFy1AWLhoqWl7I5Oh10N3wXHS20hQ6v/ZZKFSO8vD1k",
+	"JCEYZ5wrFVJd+ID3EBUdxEILSK+RFtsALp6Ej0wLzbQC9r9UxTIuSZuuLNTHu9J0ZmJfmkGYaE4fuNJg",
+	"CAqKf6ix8/hxd+GPH3uaC8OWcB2yRB4/7qPj8WO68r5TxrYk5SF2Ptf2LHFQk30PT32vkHcPiKO9Biw/",
+	"8hhKvusMHialPWWMZ1xc/oMFQGdn3oxZe8wjaHzcv3Z7M3Ll0XqS63Z0R0vzgczF6Shhumn6wF9sxZaV",
+	"dEBVxt8tKRYumO3UclpHgrsM0BNGYcJrHmzO/s+nL76ZTJvw3vr7ZDrxXz8mrgciv0kFcedwk6KJ32J0",
+	"NX6E98itAZv2KBHsiTwO0JcFNCb9ePQN4J42a1F+fveksWKRNvL/AamklsyL+Bt5Jl0ICobg0eV663V2",
+	"tfz8cFsNkENp16kEsVKDIdHoEr1Ku26ICtAxiGEoIMgpE0dw1BWx+Qq8zYGzAvgyuLO0UmMCJ+vt4Pgt",
+	"MEeE9Xgho+RYin8oDLCJQzoXm6rg9hAehyUp87NULtWZQ+hKq6oke7qGv5JmNaXfBW0lNPYKGTVM7C3n",
+	"QeZODKzBj4OSif2oLPO0bKKN6u8soxwzqVw+rLVaLCrrhAlnRshVMcbxzEVRaRgOkdizUA3cIA1s69vR",
+	"XQ02jXtdbDaQC26h2LYcrMIw4yhLuHGB1sFIHnzO1MyNQzpjEK7o9OgOkUQH2cJnZAvfb9ePnf/BC+zw",
+	"1LAkklxYw9S19EmBpVZ5lcGhXAGM6gn02MTTi2Y0VZZBiyijvQbkjJn5dJbR95ewBaPDd8grNJ0QjDMP",
+	"Y/JQSpiBPB27F4frJifWD4h38Uq7CGvGM1vxIjr9p00kNBETrkBvW9tTgzcqccOoE47U5O4wYVimtAa6",
+	"r7ibxVHCftORjC2bSozhLjrGCEWHWmdmiEF3LBBzPErHCq11B7jauYEQP/FpE+zSxn1Vyzj32ItCszUW",
+	"Nn3Xjuv6y4CEeB+w1duzShZCwmyjJGyT5TaEhB/oY6q3uwwMdKZr2VDfrtmsBX8HrPY8Y6j6UPwStaMN",
+	"+K5ODTiEh7MzbserF2ddk1cCipJxlhUCpLPeWl1l9oPkZBXtHFIdtgi23mE7+evQJG2YT9jN/VAfJKf4",
+	"zdpWmjy3lpA4Fr8DCOZyU61WLgCqVaAF4IP0rYRklaRTYMk2SK+ZI1gJmuI6jlzLDd+yJWYPW8X+Dlqx",
+	"RWXb8o2uBMai1d25GHEappYfJLeooRnLfhDohMfhQg5m4BkJ9lrpyxoL6RNwBRKMMLO0Vvy9+0rKsV/+",
+	"2ivK+H/fuYlI/7xacYBd5IOQn73xBqizN3SANs7FHuyfzeOE+c5JJqNwNCEpA77DW+wrqWzNQF83bkpP",
+	"9Q8SAyCswhIQIuf2fuzQFXG9veh2R4drWoToOBDCWj+mdJGVmmHoHemhk5Ww62pxlKnNPOgo85Wq9ZV5",
+	"zmGjJH3L57wUc1NCNr96sscK8AB5xRLi6nY68VLHHNzn4AdOLag7Z+26C39bxR59/+0Fm3tKmUdETT90",
+	"lICasJW6D+3YDFy8q8PjQjo/yA/yDSyFFPj95IPMueXzBTciM/PKoIW+4DKDo5ViJyFt6w23/IPsifjB",
+	"UlmRcszKalGIDLXi1NZ05U/6I3z48DMyyIcPH3uO/v7B6adK7lE3wQx1dlXZma/vMNNwzXWeAN3U+f00",
+	"MvXeOau7D6jKGUD9+MyPnxbVvCxNN923v/yyLHD5ERsan8yKJGPGKh2EoDABGqLvj8obpDS/DsVBKgOG",
+	"/WXDy5+FtB/Z7EN1fPwMWCv/9S9e1iBPbktoWdXvlY7cvTLQwp1CBTdW8xlWejDJ5VvgJVGfDuoNaclF",
+	"wahbjJM6zpKGahYQ8DFMAAfHndPlaHHnrlco1JVeAn0iElIblE6Nj/u+9Ioyce9Nrk42b49KlV3PcG8n",
+	"V2WQxQNl6vo9K5TJIfAAr1O4CXypowXehCG7hJyqrsCmtNtpq7tatk64IDqEcdWJXCIXldAgBwRWLSpz",
+	"7nUALrfdWgYGmov9e7iE7YVqKnDcpXhBO6XeDG1U4tToMEJmjbetH6NLfB8nRVfTsgyZ6ZQjF9jipOaL",
+	"0Gd4I7sT8gCbOMUUrZTvIURwnUAEdRhCwT0WiuM9iPVTy0P1ZuFOvoQRPMh+5ps0WpuPdYpXc7Guv1Oi",
+	"70qra8MwOjxnylfpcpHykRSr0Ig3YJmPfUAjk7NbfiMaZN+5lzzpMISgfaD1zpskyK7xDNec5BTAL8gq",
+	"ZOfrRLiFmZyb0ZsNyVjmEbYoSE2qg+uc0OG65YuTq12gpRkYtGwUjgBGGyOxZrPmJhQQy6fRXh6lA/wD",
+	"yyDsqnoTW+WiYmotu1hlAmM3dO55tXztm1DwJlS5iV1aIyrWOMNtlSaHkqQA5VDAyi3cNQ6M0pRkaAiE",
+	"cPxpuSyEBDZLxXlxY1QmXAW45pjxcwDqx48Zc7YnNnqEFBtHYJP7nAZG18C7mEnvAqT0JSV4GJsc79Hf",
+	"kM4GcJG8qPKoEkW4kAMx2EECcB8cWJ9fnRBVGoYJOWUo5q54AdIGF1MzSK8GC6mtnYorPoDj6yF1dofp",
+	"zx0sd1oT9bjXamKdKQCdVuh2QLxQNzOXDpTUeBc3C+T3ZHAz9kpuTFft5pFhC3VDEV50tJD/weyBZRiO",
+	"AEYDAJUxwbVTv6HT3AGza9rd2lSKCw37qtZtGnYZUifGTD2gwQyxy1dRAZt7AdAxxjQ1nv3ld+8lta2e",
+	"9A/z5lSbNhXZQh5GavsPbaEklQbw17eF1yVn3nU1lqSdotWqU20nUiFTTM+ETFiH+zZoA4Xzuc5aStTs",
+	"Erbpuw3QiXMeukXGC6rpw+X26yhgSsNKGAuN9S44MX+NeAhONQSVWg6vzpZ6iet7r1R9TFFHH98RL/Oz",
+	"rwBzUGcuQZVMn8klYKPvDF2qv4sSqzu6UovYzJXTFXlaNtC0l7Cd5aKo0vzq5/3jG5z2x1okmmpB8lZI",
+	"BjxbswWVf05G3e6Y2gVm71zwW7fgt/xg6x23G7ApTqyRXdpz/Eb2RUfy7hIHCQZMMUefaoMo3SEgI099",
+	"XzpGelMUtHC0y/ra20x1IMROb3+cZTh0RrmRkmtJF8xKh9D5r7XqHvo2rj1XFCxwSXupcBUelbhbKa8A",
+	"1LfYf2elpj1ntgdguATTdDIwaSqimUbbjQrbQdogakrQQg1cJN23MJFdazBYto7qDVcyL5xv6SL8/h7F",
+	"GF45ZM5e0ffTLIOyDhlCH4ofEvu9o/++Vj7Xb0r9bG9aSqxReHG5FgYGgw9LZXiRXoaLx8kFOmvDqKEH",
+	"/eHwKYzLRE1egvxjFMnxlRYrIXnhB22qRYRJ0iNaKIdCjaA8CNIDQuMBx6CznnRgM9YwhRIXhmkHxsB4",
+	"yXzC/wohSw79a16WIH3F+ShviVEqkGtYqmw9MMXe6Nt6phNGAocqE03ZO0+jBmsU7fln7z0Nf7920V5o",
+	"O5r2kD/tYb7D20i8cwuUOznEYdcgVuuBNEn3LazCYdyd3zqQv6HKiCuE3/SeCT2BfK+kbGrOlN0Hjgt/",
+	"RL4TNip038/nHlBXeFmK/KbjtnKjDho3+Z1s06GQaAcjdBD7wfZgIHJRpVIGNZh2zdjGFuOeLGjVDDsa",
+	"hZmLdmXXWHeLpxImPLjTRxRqIXSr34crrHnxR9j+GdvScia308nDvFwpXPsR9+D6XU3eJJ4pfMN5PVpO",
+	"6zuinJcYDciLmfcFDrGmVleeNal5cB1+Zq007XG6+Pb07TsPPrpbCuB6Vt/qBldF7crfzKpcedqBDRIe",
+	"9KDoXG9ecbf+iPh1UcfYf3i9Bv94QmQ46BV7bnzDzXjBn7hMR5Ht9Q56N7Zb4g53NpS1N7vxtFDnjgOb",
+	"X3FRBBdHgHYg4osWN65ieFIqxAM82BEexTPMDipuers7vTsa7tojk+K5djzvsHEvmBimZDe9Am/7OINj",
+	"VYz+W4A3YPeFk6w2ZPSdmUJkaXeYXBhkDunCHLAxo8YDdgMcsRIDUTOyEtFY2MyMUCg6QEZzJJEZyn4P",
+	"4W6hfIR8JcXfKmAiB0l1vnRzSWg2Ku7L8HxR/zhF3aE/lx+Y+kTDP0THiMuUd088AmK3ghEHVfTAfVNb",
+	"N8NCa+cB/hB5j+8QmxXP2DsSd8RVef7w3OwCXNeQVDzT8g8Zw70qsv+ZuqD0+nrpA3Mkn50TZrbU6u+Q",
+	"NsmRJTORguonImWKeo8I628M8c3rec3sg+Qe0m6ij6wdTzbA9UT5KIKCskGCM5FLR2r3ClQrijHNMFEL",
+	"M3fjNwzjYe5Faxf8esGzy7SSgTCdNrE6LbenVSx0Drj3Hlrha+UfsSjsp24rXKWNEnSTHd6v6nRPhcFN",
+	"O1pVaDQD7NjSCdwtnBdGJYap5DWXLskE+7mt5HtTWo+35FwrTXVyTNpDm0MmNrxIaw551vfG5WIl3FNa",
+	"lYHorSY/kHuD0HGRf++qzsXyqDlbsuNp9Bqcp0YuroQRiwKoxRPXAoM1aG21ySp0weWBtGtDzZ+OaL6u",
+	"ZK4ht2vjEGsUq5U6lzQV4gwWYK8BJDumdk9esq8owsKIK/gasejP58nJk5fkH3N/HKcOAP9m3i5pkpM4",
+	"+S8vTtJ8TCEmbgwU3H7Uo2TVF/fQ6bDg2rGbXNcxe4laelm3fy9tuOQrSAf1bfbA5PoSNcnn0cGLpEY5",
+	"GKvVlom0gWQDlqN8GshQQPHnwPCpeRvvhzdqg/zUPMTkJg3DuSf/3NlUwxU+UjhLGbz5nUvk5/VvufMt",
+	"tWoKOvqRb6CN1injrjhSEVcI9g98sLPaNIWRUXX6oMMNzuUy3DalQhJSnVMhyf7FKruc/Q6TEzXPUPwd",
+	"DYE7W3zzPFHQv13nVN4N8M+Odw0G9FUa9XqA7YMO4ftizoacbQSK+q+bjKBoVw7G3SSntUNhHruHHquU",
+	"4SizQXarWuzGI0n9IMaTOwZ8ICvW67kTP955ZZ+dMyudZg9eIYV+ev/WaxkbpVMFN5vt7jUODVYLuIJ8",
+	"kEg45gNpoYtRVHgI9L9yJW2vckZqWdjLqYsAvqNx8mngkYnaku7TihLWgaFtih+QDRZ+qClr15f+/PEZ",
+	"wfjcjxPALwFW+qML7K9MUkJyWMEAEaPHRpLkzOvvUagSZ6/UzViidnZIIOw/AWqSKKlEkf+5ydxtr3Ch",
+	"uczWydCDBXb8pXnts16cO5+SdUvXXEooksM5XfCXoDMmtNq/qrHzbIQc2bZbkc4tt7O4BvA2mAGoMCGi",
+	"V9gCJ4ix2k5lrHNfMC2S0TxNkcxGevZrQUS1zOk9h1RhGfrg4m8tvXmKXEydGMicbotH7Hv3Wv8aWKvs",
+	"G93S6qoNBVXd9gb1qiwUz6dUpQMt/czN6vq4p+tcKe8VXVLaq+jYq6KKuuNiPFyHoSyz8ePsTnvBVRtL",
+	"JTWN5ZsylUCMLS5CAyY6Nny6vsTYOWJv3M3RhHuJm4TV7zWwejqvuxBP4H+sde5iq1oidZjlx9egD1xp",
+	"ogeO/f+zmhPdvkO4fRl6V4V+2kQkUF4BluNocXUAo45i8DnM7eXpSkrHKUndY1eBifugPQBH49Zm/iRk",
+	"HcTfUSF3xWHuWpL/nHqlmLJX37/3srGrfFU/hPZDeJuaSyVFRmUBU0ezf/B9jA9sRAXFrpE1bHG/QxOb",
+	"K/mqQB3R7LE4+M7AdNJCXN8IH31FojrucH9aelkcDYkrsMZLNsin4XEMbwcU0oAvcoxMFMtJpVt+RZKQ",
+	"SVf1rHZp3JGNKINx4GL3HX770V/7cQuyS+FeHPJocwwtnKWO3qO2eCsQlq0UGL+edokr8zP2OaISajnc",
+	"fDwK71fTGM4th8t2Puj+UKfBI+09wNj2Nbb1RaDqn1vJIm7S07L0kw4/nZLUB7AozxCCE57FWXDtRMit",
+	"x49H28FuO0NJ6DxFRoMrckRDSedwjzHqZ0Q6bz2g0uo4ilowF22bQko6+vKtkNC8rp44ILLkkUCEof06",
+	"0M9kGuOdR8s0dECT9zkl0Iz1roeHDtUhMKGE1hjmGCZj8wLKgOCoGzSKG5fb+lF35O5ImcC3QWvXfv89",
+	"E9KqvBKVc9uU6wsvnKQEBwruUPCtfQDsfT+r7m41z6DVd8RJNJTPn6mUvvntDWSVr3RnQi4YvT0aS5ck",
+	"V0Vv9STIEL8XFFCLJEZLDv6bKgM8jBIf/XDnUOkQ6kAd76ywtkfqqZvITDNM3xyPCRLmD0dHM/X9OKzp",
+	"f1AWK9SqDciv+Q5dR7zENEoJlm9RYsfVZfqR4NiiKf5C0W4qPGlL97W6bEFbHOC3frFr8rLU9SB33/yH",
+	"H7+c0qkzkJ4QVSnl7mBzbruhJIVsMKeGW5/daznbVelyOGPShc3QdwdF2mQ5FCrjImXwc6/3OJWsp+DS",
+	"2DsRGmKw+gD9MQR4spIL75NuhEUfsz5rZ9hOt2vTNQTuLsLnwgyayqKXr1MM3Q6ta0v8+kVqjrzjvioK",
+	"b3j/evbcv1E9oDIPBNXiIJB3HXt9HuJ6NZDxGWByszOuVxWVJx9dUqJByKlOCkY3w2xAwbtoarF2K8y2",
+	"6sS2cBc95z3kal0PJYU0qf71i0KEwwb9CRcamnLSw9FThd264I6PXbfG0eJmmKIKK6z/apiSMPCI3RDR",
+	"m6CiXdy2vxa5Zyw/1W5mR9r2+b2himebEK8TMXRNpTZXU5HPmVouk6F03yndYoWaLaedWMRSGRFy9KEB",
+	"wxXgBZPG0qKu9d8rO5rePuk750Xk9otnPxo+SFND4Pv0cZLHzmEGvBCDnFhjxEl49h6WoEFmQPUx6s/G",
+	"PxlmVHHlIh5a5Yk08hy9aKGxP1qBqMizAetih1Kk2s+Ag/kavVdfdh/DvYTTKGnaZQwdja/ddlpHVVGs",
+	"B5WHXoH0j0628xNGR0kvl5BZcbUnwZcyiprk0WmwWRAs8UPKoo66pfpQd7fINQAV/J7wFPxw4AxJukvY",
+	"PjKsxQ3JByamQRu4T2kgwgDl2M2G8/CckdU7koWpOYOwEKKEXHdoqroPPtMWpavfc67AkozHKew7psSs",
+	"q3vOhV3vVNiBTpWhHODwDFLiOIke9wkPG6F42QizgDW/8vGzIzfyRb/AGg48RXFG2V04C56lsv42s2pG",
+	"P3sreJLV4KYUGszAdnERgJSFXoil9fmAd8oFdOrQUFVVjwqlvblW2Rp8j7MRJ39NqbCYetYhip0P1Ck6",
+	"DW8zCSVdBf+IiP8E1CLeL6laCLL6zGox9EBkU3sKG5ETKoBC26EMJfVoUi7NNeh+vmeapqqyK5UMjf+v",
+	"+JFASm6lNNJQAdqjNh1lqVUxuI4CYjyeMA0Flmn2xXTpL9NM2jywZdU0FJBVmpVaKC1s0xFbh5DjqI8v",
+	"coCjOk/BBsy67oQQtMg6inRW8+VSZANJ0XTrhRv3xkDePErguGaxZf6Czyxfjb7MIKdf8NWFnzlxm6nK",
+	"NPP8QV2zQnkGibbEmptQZglkLAsGylMMuSkvIt9c73lstexMexcRULOm56cG8fVqG7iGxEOEtPHk4m4n",
+	"CRloZfxjADwh52kEX+dqwEgyU5VNf7Z8td8iVM8QD+f6ppfdfSNu2NT1ht5XNPVT0aFEXnxfY2f9R1au",
+	"fYk9KitS+8dDsT0w4bdQQ8jNUohLiB9EpWgELJAUWiSNDOGONBtIOeom8VIzJtJAL+uZRZOb0M9j7VPK",
+	"ZaBkhTIos4fSeNrpAHUs3SPjgh5JPF2D9nAtQevmVoNjwwyPKrcFdsGxCxWGIjvvhQQz+AyVA26wSOP7",
+	"pgol1ePnVJSR+4DOeIFMw4YjdDqqFTk85y5kv3bfQ+JmEOp7n+ip+XX/4zkhK0WYHhJjrl+G92L2J4Te",
+	"x68hpHQP8JtU4UgJuu3x9g/iuCy2aGNA8P/c4dgZFCVJl0DWX2XPultQkeK3UXp94mEeT8oYeve6lltD",
+	"VHmsQ+2DunzS1u1i5RawOgicv6bbZDoplSqGLKBn/fqX3T1wKbB6NMOzI8RzD7xSyb4iz2odw3S93oZ6",
+	"j648yddHjJ1Kl0ETwpnaLz90JpeP7K75b2jWvAJvy6ZFHn2QaTsqFYvVD5RvYZjdUs1VvHngVG6Q3RPZ",
+	"mwF1DYs5999sHf2aVT/AqFsFpWEqB0VKSxl+0ioRNxVeXGLuWaeQo4n8cSVyfIKq7cBu6xD+malZbWFP",
+	"3Rb97SXwHLJf8z5WR/yL5umqesyBR8brp6geImp7b3XWgyYxe78iZqMkZ99flhAqcU2DPRbSy5ZzzVWN",
+	"74RrKQ0HdrJFcSp3dLL1qzWMXR6tg/i2MtBf52gCtHA7gPsxiG88xAM3moEStGMcu2mXAnYnz7JDCDY6",
+	"YgQq+8uTvzgDPoWAPn5MEzx+PPVN//K0/bkS0j5+nJR5n82n7HDkx/Dzpjjmz0P3ZhfCOhBJ3qEHBp3v",
+	"Y4xWXkDzdBNFvv/iM4N+lcejfhF5eqs6WO8UzdIlAiEmsdbW5NFUUcT/iGB/3y0R2k/HeFahAYoKloS7",
+	"qvglWbPz+9pNswaO53ad4u4zrK26hLrkTePUqUyw7H2veEHpt6hFUSyRpae/v73hm7IIjt3fP1r8Bzz7",
+	"3fP8+NmT/1j87vjFcQbPX7w8PuYvn/MnL589gae/e/H8GJ4sv3m5eJo/ff508fzp829evMyePX+yeP7N",
+	"y/94NJlOBILsAJ0EF+Pkf9ILa7PTd2ezCwS2wQkvBXrC6E0lZOPwWhPPaCfiba+YnISf/nvYYfgOVTN8",
+	"+HXis+8ma2tLczKfX19fH8Vd5iu6/c6sqrL1PMzTf2n93VmdQeG0BaKoC45HVjiaNKxwSt/ef3t+wU7f",
+	"nR1NImvX5Pjo+OgJjq9KkLwUk5PJM/qJds+a6D73zDY5+XQ7nczXwAu79n9swGqRhU/mmq9WoI/8s1X4",
+	"09XTeQjAnn/yN//bXd/m0bGBPzd/zUS+p6cxQD/4ahq7W7fKVXjDUNQhlMec26jC6CrlNP8ebKtuptlf",
+	"YtPZltwl3Jz4uhveCWacBYX7ooChrnooK0htr9zLPb5yn++ho5KOZOdz5d5cEcBpKI8Z7qDYyrq6gsQt",
+	"Ne+c5W5NvcKioRCNq8x38nPi5cxtSL+xveKrcS1e2oJ/q0Bvmy1Sh9vXFZJ6bq+PlFVNSiMx5tPj4wc8",
+	"1uyQf/firu2ys/tkuJ9l1NPzLZbpcVOLZ3De58dPDvZ0XDtkMAHcmSQeRAnCnIQkCJ5/PgiiJ59d2YnU",
+	"XorKbvQ36k/yUqpryWgqd8pVmw3X22gTjyXAkbNQG7oQanHFLUw+3gbhMVKE7Wo2X6ibOzQFEzUeloPk",
+	"IDfzT7SIwd/nLoSIIrfNYKOWAP2EwUy38+Ba9z0yjNWvyvkn+g8dV7eOLgWk3OIuF5CzpvmUCYvme00V",
+	"bmy2RpUhlNYQJmrZk2Gn2Ou1g2Cf7Dp1A7EwEkkoPAEbAdWaqdniVlcQS61aCW21b1TRn49nLz9+ejJ9",
+	"cnz7b6hq+j9fPLsdGd/yuh6Xndd65MiGD5WgvbjLZpGOSHVMceJhXkeJ4ZfjPak6A7EaGXvy5zvDpyQu",
+	"iazjzyeyXvGchfTYfwqB/eJzrv5MIsvzIsjbe0rmU7f5Y6HAPLHTQng6KZP5IQPCxVh+D+FCZaC/CJdW",
+	"w14OmOIunsJnb1OqkQv9rTsvRQGMzO++KLH3cRoLnPIIc3UtMSkSSSNsMwbFNhyxM+vjlRfA6neqELd1",
+	"Yq0zmK+VsVPGab6T+ZwKhTgrq3l2Mp8vquwS7BzDsH56/3ZIR63z83pKai2U/pESljj1EBK2PdCBJezT",
+	"O0q53/6Kv5wpv7Uz5dzJ/PFnStBn1abkmc1A274O7RLz+r876Ten+k/b5ufe+5pJ48J7d5/m9Ph8+pWf",
+	"1OW9G8ntIg0fIJfG+dM7syZux31T1q6V/ctvrs96w26Rj/0RtpRs8B2y8291o4/bPrt0yM6dMs97TO7O",
+	"DDD2lcq3OzC0MavS55snNLqFkFwnEuzThpDeMpgLrAoOdKly6GmStwe1nyEIZ4noom4qTBvUZB5B18Hu",
+	"Rh5jM3vXGTxMaqoFubCV/CJDvsiQWoa8OH72+aY/B30lMmAXsCmV5loUW/aTrKsF3f9CnOfJ5Kv21u/J",
+	"NLzHZSqHFciZF1izhcq3oQJ6a8BLcKbsnqIy/9T601sXBw16b+h3zE6gy3cf6MWWnb3paTCuW1fSvtqe",
+	"venftRO36S6IO+/UI29wu9gcF7JSljks5H5RXwTPF8HzIOVl9OZJ6S9pV6U3gXXP5Gnw26UKpnLbn3rM",
+	"neNX3a4HIXT/PpO6v7jgfshZ9MElVHTR/EUkfBEJDxMJ6KbsywHctV5IJJjuPjbyvoCgOOa8+26zcbmH",
+	"rnlVcE256ePMFKc0ojdOfA4p8bkvaUlc5XmI2A5P2ycIdth72xcR90XE/Yb8ffsFTVsRufNN5xK2G142",
+	"9xsgqTN8iXlNiV3tTM+6mmucO18nw3pZ7BPd2EVIXsZyDX4MxlfcFZztXH2E8S0wv3Ofa3EwfZsbMnm5",
+	"ywgqZ+BL66f8Wk1S6oGvSfXC6XZUrwzyf9H4JcKFD19q4eJ+94Maof30//FXAh/4tJu1zbRmbkHpeJhy",
+	"3Mn2Dm/sCd1N50/GFxJHTg5rkgw7eXTSuS+vsC+Kzw08NoivpmwLg/8cLP+g4Lj0wnZ6zKjFfMHlTgn7",
+	"VixtXcLDy7H9gpWKVFD5LdfTMLFksk4wxo1G7fvsR+WAfUEUc1AZiwPuF7MHEKu0XhSrrtzJF4Hqq7Dc",
+	"m8mJBzuVcMy9RGm8OULORbEdqKwzZabK1shLxhcnEB6z3TLf9H77oCj1nHxAaRo27Whh+orvr8ZOg44V",
+	"pB5j/59J0cSqRojQUBGG6DR4d28Kx/gzXMhMbZCr/HTxMV/HUjmpevauEax1KYt3frx3cSkZqg2wqhwT",
+	"TlklrSiamjYaXEhdn1XRexqNN0bwNkDFsvfXVWRrUvzL33Uf4r8KjLpXcfXbwKwri8GAwxuAHoLmhX81",
+	"koL46yQpq1gYoKlOyP7kax4XW7xpXokcGA9ZMU0WG3YOufpNsQDidbP2wYcrIWkCsujQLO55VB7VS4kq",
+	"EHWCST1kPzrXXcoW1mF0D2NLlaiJkXiM9MFm8n7c2+0uWoWqZa2/59dcWAwh9WX/CEP9eCljNfCNzzZo",
+	"frbAi7l/FqPza1MQu/eFqnxHP0ZnavrXef1sd/JjN08s9dUnQww0Co8ahc9Nnmicd0mUrzMuf/6IBKRH",
+	"IT1TNGmEJ/M5VSZZK2PnFIXbTjGMP36safapNqt62t1+vP1/AwDaBQhXSu0AAA==",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
@@ -87,6 +87,12 @@ type Account struct {
 	// The count of all assets that have been opted in, equivalent to the count of AssetHolding objects held by this account.
 	TotalAssetsOptedIn uint64 `json:"total-assets-opted-in"`
 
+	// \[tbxb\] The total number of bytes used by this account's app's box keys and values.
+	TotalBoxBytes *uint64 `json:"total-box-bytes,omitempty"`
+
+	// \[tbx\] The number of existing boxes created by this account's app.
+	TotalBoxes *uint64 `json:"total-boxes,omitempty"`
+
 	// The count of all apps (AppParams objects) created by this account.
 	TotalCreatedApps uint64 `json:"total-created-apps"`
 
@@ -124,6 +130,40 @@ type AccountStateDelta struct {
 	Delta StateDelta `json:"delta"`
 }
 
+// AgreementRoundTimeline defines model for AgreementRoundTimeline.
+type AgreementRoundTimeline struct {
+	Events []AgreementTimelineEvent `json:"events"`
+	Round  uint64                   `json:"round"`
+}
+
+// AgreementTimelineEvent defines model for AgreementTimelineEvent.
+type AgreementTimelineEvent struct {
+
+	// The period of the threshold or bundle for ThresholdReached and BundleAccepted, the new period for PeriodConcluded, and the period of the node otherwise.
+	Period uint64 `json:"period"`
+
+	// The block digest of the proposal the event is about.
+	Proposal *string `json:"proposal,omitempty"`
+
+	// The original proposer of the proposal.
+	Sender *string `json:"sender,omitempty"`
+
+	// The step of the threshold or bundle for ThresholdReached and BundleAccepted, and the step of the node otherwise.
+	Step uint64 `json:"step"`
+
+	// The threshold the votes reached.
+	Threshold *uint64 `json:"threshold,omitempty"`
+
+	// When the event happened, in nanoseconds since the epoch.
+	Time uint64 `json:"time"`
+
+	// The type of the event: RoundStart, ProposalAccepted, BlockValidated, BlockCommittable, ThresholdReached, BundleAccepted, PeriodConcluded or StepTimeout.
+	Type string `json:"type"`
+
+	// The weight of the votes which reached a threshold.
+	Weight *uint64 `json:"weight,omitempty"`
+}
+
 // Application defines model for Application.
 type Application struct {
 
@@ -259,6 +299,23 @@ type AssetParams struct {
 	UrlB64 *[]byte `json:"url-b64,omitempty"`
 }
 
+// Box defines model for Box.
+type Box struct {
+
+	// \[name\] box name, base64 encoded
+	Name []byte `json:"name"`
+
+	// \[value\] box value, base64 encoded.
+	Value []byte `json:"value"`
+}
+
+// BoxDescriptor defines model for BoxDescriptor.
+type BoxDescriptor struct {
+
+	// Base64 encoded box name
+	Name []byte `json:"name"`
+}
+
 // BuildVersion defines model for BuildVersion.
 type BuildVersion struct {
 	Branch      string `json:"branch"`
@@ -360,6 +417,44 @@ type EvalDeltaKeyValue struct {
 	Value EvalDelta `json:"value"`
 }
 
+// MethodCall defines model for MethodCall.
+type MethodCall struct {
+
+	// The called application.
+	AppId uint64 `json:"app-id"`
+
+	// The decoded method arguments.
+	Args *[]MethodCallArg `json:"args,omitempty"`
+
+	// The reason the transaction could not be decoded as a method call.
+	DecodeError *string `json:"decode-error,omitempty"`
+
+	// The signature of the called method.
+	Method *string `json:"method,omitempty"`
+
+	// The JSON encoding of the value returned by the method, if it returns one.
+	Return *string `json:"return,omitempty"`
+
+	// The ID of the application call transaction.
+	Txid string `json:"txid"`
+}
+
+// MethodCallArg defines model for MethodCallArg.
+type MethodCallArg struct {
+
+	// For transaction arguments, the number of positions the argument precedes the application call by in the transaction group.
+	GroupOffset *uint64 `json:"group-offset,omitempty"`
+
+	// The name of the argument.
+	Name *string `json:"name,omitempty"`
+
+	// The ABI type of the argument.
+	Type string `json:"type"`
+
+	// The JSON encoding of the argument value. Reference-type arguments are resolved to the address or ID they refer to. Not set for transaction arguments.
+	Value *string `json:"value,omitempty"`
+}
+
 // ParticipationKey defines model for ParticipationKey.
 type ParticipationKey struct {
 
@@ -388,6 +483,51 @@ type ParticipationKey struct {
 	LastVote *uint64 `json:"last-vote,omitempty"`
 }
 
+// PeerBan defines model for PeerBan.
+type PeerBan struct {
+
+	// The address of the peer, or its peer ID on the peer-to-peer network.
+	Address string `json:"address"`
+
+	// When the ban is lifted, in seconds since the epoch.
+	Expires uint64 `json:"expires"`
+
+	// The misbehavior that got the peer banned.
+	Reason string `json:"reason"`
+}
+
+// PeerStatus defines model for PeerStatus.
+type PeerStatus struct {
+
+	// The address of the peer, or its peer ID on the peer-to-peer network.
+	Address string `json:"address"`
+
+	// The round trip time of the last ping the peer answered, in nanoseconds.
+	LastPingRoundTripTime *uint64 `json:"last-ping-round-trip-time,omitempty"`
+
+	// Whether the node opened the connection.
+	Outgoing bool `json:"outgoing"`
+
+	// The role of the peer: relay for the relays the node connected to, client or priority for the nodes that connected to this relay, and mesh for the peers of the peer-to-peer network.
+	Role string `json:"role"`
+
+	// The bytes exchanged with the peer, by message tag.
+	Traffic []PeerTagTraffic `json:"traffic"`
+
+	// How long the connection has been open, in seconds.
+	Uptime uint64 `json:"uptime"`
+
+	// The network protocol version of the connection.
+	Version string `json:"version"`
+}
+
+// PeerTagTraffic defines model for PeerTagTraffic.
+type PeerTagTraffic struct {
+	BytesIn  uint64 `json:"bytes-in"`
+	BytesOut uint64 `json:"bytes-out"`
+	Tag      string `json:"tag"`
+}
+
 // PendingTransactionResponse defines model for PendingTransactionResponse.
 type PendingTransactionResponse struct {
 
@@ -434,6 +574,16 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// SimulateTransactionResult defines model for SimulateTransactionResult.
+type SimulateTransactionResult struct {
+
+	// A boolean indicating whether this transaction is missing signatures
+	MissingSignature *bool `json:"missing-signature,omitempty"`
+
+	// Details about a pending transaction. If the transaction was recently confirmed, includes confirmation details like the round and reward details.
+	TxnResult PendingTransactionResponse `json:"txn-result"`
+}
+
 // StateDelta defines model for StateDelta.
 type StateDelta []EvalDeltaKeyValue
 
@@ -572,12 +722,25 @@ type AccountAssetResponse struct {
 // AccountResponse defines model for AccountResponse.
 type AccountResponse Account
 
+// AgreementTimelineResponse defines model for AgreementTimelineResponse.
+type AgreementTimelineResponse struct {
+	Rounds []AgreementRoundTimeline `json:"rounds"`
+}
+
 // ApplicationResponse defines model for ApplicationResponse.
 type ApplicationResponse Application
 
 // AssetResponse defines model for AssetResponse.
 type AssetResponse Asset
 
+// BlockMethodCallsResponse defines model for BlockMethodCallsResponse.
+type BlockMethodCallsResponse struct {
+	Calls []MethodCall `json:"calls"`
+
+	// The round of the block.
+	Round uint64 `json:"round"`
+}
+
 // BlockResponse defines model for BlockResponse.
 type BlockResponse struct {
 
@@ -588,6 +751,14 @@ type BlockResponse struct {
 	Cert *map[string]interface{} `json:"cert,omitempty"`
 }
 
+// BoxResponse defines model for BoxResponse.
+type BoxResponse Box
+
+// BoxesResponse defines model for BoxesResponse.
+type BoxesResponse struct {
+	Boxes []BoxDescriptor `json:"boxes"`
+}
+
 // CatchpointAbortResponse defines model for CatchpointAbortResponse.
 type CatchpointAbortResponse struct {
 
@@ -602,6 +773,28 @@ type CatchpointStartResponse struct {
 	CatchupMessage string `json:"catchup-message"`
 }
 
+// CompactCertResponse defines model for CompactCertResponse.
+type CompactCertResponse struct {
+
+	// The msgpack encoded compact certificate.
+	Cert []byte `json:"cert"`
+
+	// The round of the block header signed by the compact certificate, which is the last round of the interval it certifies.
+	CertRound uint64 `json:"cert-round"`
+
+	// The round of the block that committed the compact certificate transaction.
+	ConfirmedRound uint64 `json:"confirmed-round"`
+
+	// The first round of the interval certified by the compact certificate.
+	FirstRound uint64 `json:"first-round"`
+
+	// The msgpack encoded block headers from the requested round through cert-round.
+	Headers [][]byte `json:"headers"`
+
+	// The msgpack encoded block header of the round preceding the interval, committing to the voters that signed the compact certificate.
+	VotersHeader []byte `json:"voters-header"`
+}
+
 // CompileResponse defines model for CompileResponse.
 type CompileResponse struct {
 
@@ -631,6 +824,9 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// LedgerStateDeltaResponse defines model for LedgerStateDeltaResponse.
+type LedgerStateDeltaResponse map[string]interface{}
+
 // NodeStatusResponse defines model for NodeStatusResponse.
 type NodeStatusResponse struct {
 
@@ -686,6 +882,16 @@ type ParticipationKeyResponse ParticipationKey
 // ParticipationKeysResponse defines model for ParticipationKeysResponse.
 type ParticipationKeysResponse []ParticipationKey
 
+// PeerBansResponse defines model for PeerBansResponse.
+type PeerBansResponse struct {
+	Bans []PeerBan `json:"bans"`
+}
+
+// PeersResponse defines model for PeersResponse.
+type PeersResponse struct {
+	Peers []PeerStatus `json:"peers"`
+}
+
 // PendingTransactionsResponse defines model for PendingTransactionsResponse.
 type PendingTransactionsResponse struct {
 
@@ -731,6 +937,26 @@ type ProofResponse struct {
 	Treedepth uint64 `json:"treedepth"`
 }
 
+// SimulateResponse defines model for SimulateResponse.
+type SimulateResponse struct {
+
+	// If the group was rejected, the index within the group of the transaction that caused the rejection. Not present when the rejection could not be attributed to a single transaction.
+	FailedAt *uint64 `json:"failed-at,omitempty"`
+
+	// If the group was rejected, the reason it was rejected.
+	FailureMessage *string `json:"failure-message,omitempty"`
+
+	// The round immediately preceding this simulation. State changes through this round were used to run this simulation.
+	LastRound uint64 `json:"last-round"`
+
+	// The state delta that committing the group in a block of its own would produce, with the account, resource and key/value changes flattened into lists. Only present when the group would succeed.
+	StateDelta *map[string]interface{}     `json:"state-delta,omitempty"`
+	TxnResults []SimulateTransactionResult `json:"txn-results"`
+
+	// Indicates whether the simulated transactions would have succeeded during an actual submission, provided that every transaction reported as missing a signature is correctly signed.
+	WouldSucceed bool `json:"would-succeed"`
+}
+
 // SupplyResponse defines model for SupplyResponse.
 type SupplyResponse struct {
 
@@ -774,6 +1000,41 @@ type TransactionParametersResponse struct {
 // VersionsResponse defines model for VersionsResponse.
 type VersionsResponse Version
 
+// GetAgreementTimelineParams defines parameters for GetAgreementTimeline.
+type GetAgreementTimelineParams struct {
+
+	// Only return the timeline of this round.
+	Round *uint64 `json:"round,omitempty"`
+}
+
+// StartCatchupParams defines parameters for StartCatchup.
+type StartCatchupParams struct {
+
+	// Load the ledger from the catchpoint file at this location instead of downloading it from the peers. It could be either a path on the node's host, a file:// URL or an s3://bucket/key URL.
+	Source *string `json:"source,omitempty"`
+}
+
+// DisconnectPeerParams defines parameters for DisconnectPeer.
+type DisconnectPeerParams struct {
+
+	// The address of the peer, as listed by GetPeers.
+	Address string `json:"address"`
+}
+
+// ClearPeerBansParams defines parameters for ClearPeerBans.
+type ClearPeerBansParams struct {
+
+	// The address of the peer, as listed by GetPeerBans.
+	Address *string `json:"address,omitempty"`
+}
+
+// AddPriorityPeerParams defines parameters for AddPriorityPeer.
+type AddPriorityPeerParams struct {
+
+	// The IP address of the peer.
+	Address string `json:"address"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `json:"timeout,omitempty"`
@@ -32,15 +32,30 @@ type ServerInterface interface {
 	// Get application information.
 	// (GET /v2/applications/{application-id})
 	GetApplicationByID(ctx echo.Context, applicationId uint64) error
+	// Get box information for a given application.
+	// (GET /v2/applications/{application-id}/box)
+	GetApplicationBoxByName(ctx echo.Context, applicationId uint64, params GetApplicationBoxByNameParams) error
+	// Get all box names for a given application.
+	// (GET /v2/applications/{application-id}/boxes)
+	GetApplicationBoxes(ctx echo.Context, applicationId uint64, params GetApplicationBoxesParams) error
 	// Get asset information.
 	// (GET /v2/assets/{asset-id})
 	GetAssetByID(ctx echo.Context, assetId uint64) error
 	// Get the block for the given round.
 	// (GET /v2/blocks/{round})
 	GetBlock(ctx echo.Context, round uint64, params GetBlockParams) error
+	// Decode the ABI method calls of a block.
+	// (POST /v2/blocks/{round}/method-calls)
+	GetBlockMethodCalls(ctx echo.Context, round uint64, params GetBlockMethodCallsParams) error
 	// Get a Merkle proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetProof(ctx echo.Context, round uint64, txid string, params GetProofParams) error
+	// Get the compact certificate covering the given round, and a proof of the block header of the given round.
+	// (GET /v2/compactcert/{round})
+	GetCompactCert(ctx echo.Context, round uint64) error
+	// Get the state delta of the given round.
+	// (GET /v2/deltas/{round})
+	GetLedgerStateDelta(ctx echo.Context, round uint64, params GetLedgerStateDeltaParams) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
@@ -50,6 +65,9 @@ type ServerInterface interface {
 	// Gets the node status after waiting for the given round.
 	// (GET /v2/status/wait-for-block-after/{round})
 	WaitForBlock(ctx echo.Context, round uint64) error
+	// Streams blocks and their state deltas over a websocket.
+	// (GET /v2/stream/blocks)
+	StreamBlocks(ctx echo.Context, params StreamBlocksParams) error
 	// Compile TEAL source code to binary, produce its hash
 	// (POST /v2/teal/compile)
 	TealCompile(ctx echo.Context, params TealCompileParams) error
@@ -71,6 +89,9 @@ type ServerInterface interface {
 	// Get a specific pending transaction.
 	// (GET /v2/transactions/pending/{txid})
 	PendingTransactionInformation(ctx echo.Context, txid string, params PendingTransactionInformationParams) error
+	// Simulates a raw transaction or transaction group as it would be evaluated on the network.
+	// (POST /v2/transactions/simulate)
+	SimulateTransaction(ctx echo.Context, params SimulateTransactionParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -85,6 +106,7 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		"pretty":  true,
 		"format":  true,
 		"exclude": true,
+		"round":   true,
 	}
 
 	// Check for unknown query parameters.
@@ -127,6 +149,16 @@ func (w *ServerInterfaceWrapper) AccountInformation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter exclude: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountInformation(ctx, address, params)
 	return err
@@ -138,6 +170,7 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 	validQueryParams := map[string]bool{
 		"pretty": true,
 		"format": true,
+		"round":  true,
 	}
 
 	// Check for unknown query parameters.
@@ -178,6 +211,16 @@ func (w *ServerInterfaceWrapper) AccountApplicationInformation(ctx echo.Context)
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountApplicationInformation(ctx, address, applicationId, params)
 	return err
@@ -189,6 +232,7 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 	validQueryParams := map[string]bool{
 		"pretty": true,
 		"format": true,
+		"round":  true,
 	}
 
 	// Check for unknown query parameters.
@@ -229,6 +273,16 @@ func (w *ServerInterfaceWrapper) AccountAssetInformation(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
 	}
 
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.AccountAssetInformation(ctx, address, assetId, params)
 	return err
@@ -318,6 +372,94 @@ func (w *ServerInterfaceWrapper) GetApplicationByID(ctx echo.Context) error {
 	return err
 }
 
+// GetApplicationBoxByName converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxByName(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"name":   true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxByNameParams
+	// ------------- Required query parameter "name" -------------
+	if paramValue := ctx.QueryParam("name"); paramValue != "" {
+
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Query argument name is required, but not found"))
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxByName(ctx, applicationId, params)
+	return err
+}
+
+// GetApplicationBoxes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApplicationBoxes(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"max":    true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameter("simple", false, "application-id", ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApplicationBoxesParams
+	// ------------- Optional query parameter "max" -------------
+	if paramValue := ctx.QueryParam("max"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "max", ctx.QueryParams(), &params.Max)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetApplicationBoxes(ctx, applicationId, params)
+	return err
+}
+
 // GetAssetByID converts echo context to params.
 func (w *ServerInterfaceWrapper) GetAssetByID(ctx echo.Context) error {
 
@@ -391,6 +533,49 @@ func (w *ServerInterfaceWrapper) GetBlock(ctx echo.Context) error {
 	return err
 }
 
+// GetBlockMethodCalls converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockMethodCalls(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"app-id": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBlockMethodCallsParams
+	// ------------- Optional query parameter "app-id" -------------
+	if paramValue := ctx.QueryParam("app-id"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "app-id", ctx.QueryParams(), &params.AppId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter app-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockMethodCalls(ctx, round, params)
+	return err
+}
+
 // GetProof converts echo context to params.
 func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 
@@ -453,6 +638,79 @@ func (w *ServerInterfaceWrapper) GetProof(ctx echo.Context) error {
 	return err
 }
 
+// GetCompactCert converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCompactCert(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCompactCert(ctx, round)
+	return err
+}
+
+// GetLedgerStateDelta converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLedgerStateDelta(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameter("simple", false, "round", ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLedgerStateDeltaParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetLedgerStateDelta(ctx, round, params)
+	return err
+}
+
 // GetSupply converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 
@@ -529,6 +787,53 @@ func (w *ServerInterfaceWrapper) WaitForBlock(ctx echo.Context) error {
 	return err
 }
 
+// StreamBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) StreamBlocks(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"round":  true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamBlocksParams
+	// ------------- Optional query parameter "round" -------------
+	if paramValue := ctx.QueryParam("round"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StreamBlocks(ctx, params)
+	return err
+}
+
 // TealCompile converts echo context to params.
 func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 
@@ -747,6 +1052,42 @@ func (w *ServerInterfaceWrapper) PendingTransactionInformation(ctx echo.Context)
 	return err
 }
 
+// SimulateTransaction converts echo context to params.
+func (w *ServerInterfaceWrapper) SimulateTransaction(ctx echo.Context) error {
+
+	validQueryParams := map[string]bool{
+		"pretty": true,
+		"format": true,
+	}
+
+	// Check for unknown query parameters.
+	for name, _ := range ctx.QueryParams() {
+		if _, ok := validQueryParams[name]; !ok {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Unknown parameter detected: %s", name))
+		}
+	}
+
+	var err error
+
+	ctx.Set("api_key.Scopes", []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SimulateTransactionParams
+	// ------------- Optional query parameter "format" -------------
+	if paramValue := ctx.QueryParam("format"); paramValue != "" {
+
+	}
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SimulateTransaction(ctx, params)
+	return err
+}
+
 // RegisterHandlers adds each server route to the EchoRouter.
 func RegisterHandlers(router interface {
 	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
@@ -769,12 +1110,18 @@ func RegisterHandlers(router interface {
 	router.GET("/v2/accounts/:address/assets/:asset-id", wrapper.AccountAssetInformation, m...)
 	router.GET("/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
 	router.GET("/v2/applications/:application-id", wrapper.GetApplicationByID, m...)
+	router.GET("/v2/applications/:application-id/box", wrapper.GetApplicationBoxByName, m...)
+	router.GET("/v2/applications/:application-id/boxes", wrapper.GetApplicationBoxes, m...)
 	router.GET("/v2/assets/:asset-id", wrapper.GetAssetByID, m...)
 	router.GET("/v2/blocks/:round", wrapper.GetBlock, m...)
+	router.POST("/v2/blocks/:round/method-calls", wrapper.GetBlockMethodCalls, m...)
 	router.GET("/v2/blocks/:round/transactions/:txid/proof", wrapper.GetProof, m...)
+	router.GET("/v2/compactcert/:round", wrapper.GetCompactCert, m...)
+	router.GET("/v2/deltas/:round", wrapper.GetLedgerStateDelta, m...)
 	router.GET("/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET("/v2/status", wrapper.GetStatus, m...)
 	router.GET("/v2/status/wait-for-block-after/:round", wrapper.WaitForBlock, m...)
+	router.GET("/v2/stream/blocks", wrapper.StreamBlocks, m...)
 	router.POST("/v2/teal/compile", wrapper.TealCompile, m...)
 	router.POST("/v2/teal/disassemble", wrapper.TealDisassemble, m...)
 	router.POST("/v2/teal/dryrun", wrapper.TealDryrun, m...)
@@ -782,210 +1129,285 @@ func RegisterHandlers(router interface {
 	router.GET("/v2/transactions/params", wrapper.TransactionParams, m...)
 	router.GET("/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
 	router.GET("/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
+	router.POST("/v2/transactions/simulate", wrapper.SimulateTransaction, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3fbOJLoX8HV7jlJvKLkPGfic/rsdSf98J0knRO7Z2e3ndsNkSUJYxLgAKAtda7/",
-	"+z0oACRIgpL8SNJJ+1NiEY9CoVAo1PPDKBVFKThwrUYHH0YllbQADRL/omkqKq4Tlpm/MlCpZKVmgo8O",
-	"/DeitGR8MRqPmPm1pHo5Go84LaBpY/qPRxL+VTEJ2ehAywrGI5UuoaBmYL0uTet6pFWyEIkb4tAOcfRy",
-	"dLnhA80yCUr1ofyJ52vCeJpXGRAtKVc0NZ8UuWB6SfSSKeI6E8aJ4EDEnOhlqzGZM8gzNfGL/FcFch2s",
-	"0k0+vKTLBsREihz6cL4QxYxx8FBBDVS9IUQLksEcGy2pJmYGA6tvqAVRQGW6JHMht4BqgQjhBV4Vo4Nf",
-	"Rgp4BhJ3KwV2jv+dS4DfIdFULkCP3o9ji5trkIlmRWRpRw77ElSVa0WwLa5xwc6BE9NrQl5XSpMZEMrJ",
-	"u+9fkMePHz83Cymo1pA5IhtcVTN7uCbbfXQwyqgG/7lPazRfCEl5ltTt333/Auc/dgvctRVVCuKH5dB8",
-	"IUcvhxbgO0ZIiHENC9yHFvWbHpFD0fw8g7mQsOOe2Ma3uinh/J91V1Kq02UpGNeRfSH4ldjPUR4WdN/E",
-	"w2oAWu1LgylpBv1lP3n+/sPD8cP9y3/75TD5H/fn08eXOy7/RT3uFgxEG6aVlMDTdbKQQPG0LCnv4+Od",
-	"owe1FFWekSU9x82nBbJ615eYvpZ1ntO8MnTCUikO84VQhDoyymBOq1wTPzGpeG7YlBnNUTthipRSnLMM",
-	"srHhvhdLli5JSpUdAtuRC5bnhgYrBdkQrcVXt+EwXYYoMXBdCx+4oD8uMpp1bcEErJAbJGkuFCRabLme",
-	"/I1DeUbCC6W5q9TVLitysgSCk5sP9rJF3HFD03m+Jhr3NSNUEUr81TQmbE7WoiIXuDk5O8P+bjUGawUx",
-	"SMPNad2j5vAOoa+HjAjyZkLkQDkiz5+7Psr4nC0qCYpcLEEv3Z0nQZWCKyBi9k9Itdn2/3P80xsiJHkN",
-	"StEFvKXpGQGeimx4j92ksRv8n0qYDS/UoqTpWfy6zlnBIiC/pitWVAXhVTEDafbL3w9aEAm6knwIIDvi",
-	"Fjor6Ko/6YmseIqb20zbEtQMKTFV5nQ9IUdzUtDVN/tjB44iNM9JCTxjfEH0ig8KaWbu7eAlUlQ820GG",
-	"0WbDgltTlZCyOYOM1KNsgMRNsw0exq8GTyNZBeD4QQbBqWfZAg6HVYRmzNE1X0hJFxCQzIT87DgXftXi",
-	"DHjN4MhsjZ9KCedMVKruNAAjTr1ZvOZCQ1JKmLMIjR07dBjuYds49lo4AScVXFPGITOcF4EWGiwnGoQp",
-	"mHDzY6Z/Rc+ogmdPhi7w5uuOuz8X3V3fuOM77TY2SuyRjNyL5qs7sHGxqdV/h8dfOLdii8T+3NtItjgx",
-	"V8mc5XjN/NPsn0dDpZAJtBDhLx7FFpzqSsLBKd8zf5GEHGvKMyoz80thf3pd5Zods4X5Kbc/vRILlh6z",
-	"xQAya1ijrynsVth/zHhxdqxX0UfDKyHOqjJcUNp6lc7W5Ojl0CbbMa9KmIf1UzZ8VZys/Evjqj30qt7I",
-	"ASAHcVdS0/AM1hIMtDSd4z+rOdITncvfzT9lmcdwagjYXbSoFHDKgsOyzFlKDfbeuc/mqzn9YJ8HtGkx",
-	"xZv04EMAWylFCVIzOygtyyQXKc0TpanGkf5dwnx0MPq3aaNVmdruahpM/sr0OsZORhC1wk1Cy/IKY7w1",
-	"Ao3awCUMZ8ZPyB8sv0NRiHG7e4aGmOG9OZxTrifNQ6TFCOqT+4ubqcG3lWEsvjsPq0GEE9twBsrKtbbh",
-	"PUUC1BNEK0G0opi5yMWs/uH+YVk2GMTvh2Vp8YEyITAUt2DFlFYPcPm0OULhPEcvJ+SHcGwUsAXP1+ZW",
-	"sDKGuRTm7rpy11etMXJraEa8pwhup5ATszUeDUZ4vw2Kw8fCUuRG3NlKK6bxj65tSGbm9506fxkkFuJ2",
-	"mLjw+eQwZ18u+EvwZLnfoZw+4TglzoQcdvtej2zMKHGCuRatbNxPO+4GPNYovJC0tAC6L/YSZRyfXraR",
-	"hfWG3HRHRheFOTjDAa0hVNc+a1vPQxQSJIUODN/mIj27hfM+M+P0jx0OT5ZAM5Ako5oG58qdl/hljR1/",
-	"xH7IEUBGJPqf8D80J+azIXzDF+2w5qXOkH5FoFfPzAPXis12JtMAH96CFPZNS8xb9EpQvmgm7/EIi5Zd",
-	"eMR39hlNsIdfhFl6oyQ7nAl5PXrpEAInjeqPUDNqcFzGnZ3FplWZOPxE1Ae2QWegxtrSlyJDDHWHj+Gq",
-	"hYVjTT8CFpQZ9Taw0B7otrEgipLlcAvndUnVsr8I8557/Igc/3j49OGjXx89fWYeJKUUC0kLMltrUOS+",
-	"E6OJ0uscHvRXhvJslev46M+eeIVRe9zYOEpUMoWClv2hrCLKXlq2GTHt+lhroxlXXQO4y7E8AcNeLNqJ",
-	"1bEa0F4yZe7EYnYrmzGEsKyZJSMOkgy2EtNVl9dMsw6XKNeyuo3HB0gpZEQVgkdMi1TkyTlIxUREq/3W",
-	"tSCuhRdIyu7vFlpyQRUxc6OWruIZyEmMsvSKI2hMQ6G2Xah26JMVb3DjBqRS0nUP/Xa9kdW5eXfZlzby",
-	"vdJHkRJkolecZDCrFi3ZdS5FQSjJsCNeHG9EBubdUalb4JbNYA0wZiNCEOhMVJpQwkUG+EipVJyPDpi4",
-	"ULeOJgEdsma9tPf0DIxAnNJqsdSkKgkqvHtb23RMaGo3JcE7VQ1oBGtVrm1lp7Pmk1wCzYygDJyImVO7",
-	"OYUgLpKitl57TuS4eOTp0IKrlCIFpcwDx4qtW0Hz7ewu6w14QsAR4HoWogSZU3lNYLXQNN8CKLaJgVuL",
-	"XU5X2Yd6t+k3bWB38nAbqTRvHEsFRsYzpzsHDUMo3BEn5yBRZ/dR989Pct3tq8oBi7qTVE5YgU8lTrlQ",
-	"kAqeqehgOVU62XZsTaOWOGVWEJyU2EnFgQee66+o0lZzy3iGorVlNziPfcebKYYBHrxRzMh/95dJf+zU",
-	"8EmuKlXfLKoqSyE1ZLE1cFhtmOsNrOq5xDwYu76+tCCVgm0jD2EpGN8hy67EIojqWs/hTBv9xaE2wNwD",
-	"6ygqW0A0iNgEyLFvFWA3tCoOAGLeYXVPJBymOpRTmzLHI6VFWZrzp5OK1/2G0HRsWx/qn5u2feKiuuHr",
-	"mQAzu/YwOcgvLGatPXlJjQyMI5OCnpm7CSVaq2Luw2wOY6IYTyHZRPnmWB6bVuER2HJIBx4TzmMlmK1z",
-	"ODr0GyW6QSLYsgtDCx542bylUrOUlShJ/A3Wt64W6U4Q1ZCQDDRlRtoOPiADR95b9yfWZtAd83qC1k5C",
-	"aB/8nhQaWU7OFF4YbeDPYI2q0rfWGH0SmLBvQVKMjGpON+UEAfUmLnMhh01gRVOdr801p5ewJhcggahq",
-	"VjCtrXdBW5DUokzCAaIP/A0zOhWLNeT6HdhF53OMQwXL62/FeGTFls3wnXQElxY6nMBUCpHvoIruISMK",
-	"wU6qalIKs+vMObN4jwdPSS0gnRCD+rWaed5TLTTjCsh/i4qklKMAVmmobwQhkc3i9WtmMBdYPadTSjcY",
-	"ghwKsHIlftnb6y58b8/tOVNkDhfeA8w07KJjbw9fSW+F0q3DdQsvXnPcjiK8HTUf5qJwMlyXp0y2Pu3d",
-	"yLvs5NvO4LW6xJwppRzhmuXfmAF0TuZql7WHNLKkarl97TjuTkqNYOjYuu2+SyHmt6RIi3sA4OPEGfVN",
-	"KzKvuAWqUu45gnYur9AQ83Ht5WG9uw8IugAsqdfGuT8fPX02Gjem+/q7uZPt1/cRiZJlq5iDRgar2J64",
-	"I4avqXvm6bFWELWKIWMW84iPFsiz3K2swzpIAeZMqyUrzZCNP8laQ8sX9f/e/8+DXw6T/6HJ7/vJ8/+Y",
-	"vv/w5PLBXu/HR5fffPP/2j89vvzmwX/+e1StqNksrv780eySmBPH4lf8iFsDxlxI+x5bOzFPzD893FoC",
-	"ZFDqZcz5s5SgkDVaJ85SL5tNBejoUEopzoGPCZvApMtiswUor0zKgc7RCRHfFGIXo2h9HCy9eeIIsB4u",
-	"ZCc+FqMfNPEhbeJhNo+OfH0LwosdiMg2Pv1jXdmvYh56zrqDotZKQ9HXd9muvw5I+++8rNw7VILnjENS",
-	"CA7raLAI4/AaP8Z62+tuoDMKHkN9u2+JFvwdsNrz7LKZN8Uv7nbA39/Whu1b2PzuuB1VZ+gzjKoayEtC",
-	"SZozVOQIrrSsUn3KKT4VA3KNmJP8A3hYefDCN4lrKyLKBDfUKafK4LB+QEZV4HOIXFnfA3gdgqoWC1C6",
-	"IzTPAU65a8U4qTjTOFdh9iuxG1aCRJvOxLYs6JrMaY66jt9BCjKrdFuMxEtPaZbnTu9qpiFifsqpNjxI",
-	"afKa8ZMVDuc9CD3NcNAXQp7VWIhfUQvgoJhK4nz/B/sV2b9b/tJdBRhnYj97fvOp+b6HPeZ45yA/eume",
-	"WEcvUY5uNK492D+ZGq5gPIkSmZGLCsbRf7tDW+S+eQ14AnrQ6G7drp9yveKGkM5pzjIjO12HHLosrncW",
-	"7enoUE1rIzpaFb/W9zG3gYVISpqeodV4tGB6Wc0mqSim/mk5XYj6mTnNKBSC47dsSks2VSWk0/OHW+Tc",
-	"G/ArEmFXl+OR4zrq1hUxbuDYgrpz1vpM/7cW5N4P352Qqdspdc964dqhA/fJiDbAeQi1DFZm8TaKzLoh",
-	"n/JT/hLmjDPz/eCUZ1TT6YwqlqpppUB+S3PKU5gsBDnwTkcvqaanvMfiBwM9A3cvUlaznKXkLLyKm6Np",
-	"g3f6I5ye/mII5PT0fc/60b843VTRM2onSC6YXopKJy46IZFwQWUWAV3V3uk4so0t2jTrmLixLUW66Ac3",
-	"fpxV07JUXWfV/vLLMjfLD8hQOVdMs2VEaSE9EzSc0UKD+/tGuCeXpBc+tKVSoMhvBS1/YVy/J8lptb//",
-	"GEjLe/M3x2sMTa5LaOmNruVM29UZ4cKtQAUrLWlS0gWo6PI10BJ3Hy/qAjWUeU6wW8tr1PtY4FDNAjw+",
-	"hjfAwnFlDzhc3LHt5cNM40vAT7iF2MZwp0bxf939CvxIr71dHV/U3i5VepmYsx1dlTIk7nemjj5bGJ7s",
-	"rTGKLbg5BC5QbwYkXUJ6BhnGDEFR6vW41d0b/NwN51kHUza2zjq6YQAIqthmQKoyo04GoHzd9cRXoLUP",
-	"P3gHZ7A+EU38yFVc79sO4WrooCKlBpeRIdbw2LoxupvvjMfoBFuW3q8afQg9WRzUdOH7DB9ke0PewiGO",
-	"EUXLYXkIEVRGEGGJfwAF11ioGe9GpB9bnhFvZvbmi6h5PO8nrkkjtTkDcLga9MO23wvAQF1xociMKsiI",
-	"cDGm1uk54GKVogsY0D2FWs4dXYtbmlEcZNu9F73pxLx7ofXumyjItnFi1hylFDBfDKmgmrBj9vczWUU6",
-	"rmBCMHWEQ9gsRzGp9jiwTIfKlrbZxsIPgRYnYJC8ETg8GG2MhJLNkiof/opRwv4s7yQDfEQn/k0xW0eB",
-	"xToIBa4jsjzP7Z7Tnt7WRW75cC0foxUqbXeItxqPnBNVbDsERwEogxwWduG2sSeUJqCg2SADx0/zec44",
-	"kCRm/KZKiZTZ+OXmmnFzgJGP9wixuiey8wgxMg7ARgMRDkzeiPBs8sVVgOQuIIL6sdG0FPwNcU9A695k",
-	"RB5RGhbO+IBjmucA1HlM1PdXx28HhyGMj4lhc+c0N2zOKVGbQXoRRCi2duKFnInywZA4u0H1Zy+WK63J",
-	"XkXXWU0oM3mg4wLdBog3ixKxLVCIL/f0rXE1dJfuMvXA9T2Eq/tB7NG1AOhoIpr0PO7lt/WF1r6b+zdZ",
-	"w9LHTTCt98yM0f4Q/UR3aQB/fUVwHS30tntdRx/pbdNlO1AqkJ9irNickb5qtK+AVZADSsRJS4JIzmIK",
-	"cyPYA7LbY98teLljOBbl6weBPVzCgikNjerK3EpeF/upzV0Uw7+FmA+vTpdybtb3ToiaR9swQ2u+C5f5",
-	"yVdwLjQkcyaVTlDvF12CafS9whfl96ZpXFBoW9xtJhSWxXkDTnsG6yRjeRWnVzfv316aad/UShhVzc5g",
-	"jeIg0HRJZpi5J+qHs2Fq66q1ccGv7IJf0Vtb726nwTQ1E0tDLu05vpBz0eG8m9hBhABjxNHftUGUbmCQ",
-	"ePG/hFzHIpYCocEezsw0nGxSPfYOU+bH3vRQCqAYvqPsSNG1BK/ljatg6H1gnntMB4lv+mEDA2eAliXL",
-	"Vh1FoB118LlIr/Ta94HFHSzg7rrBtmAgUPrFPFMlqHYMeSPd2hRGPFzbZCfMnLQjvUOGEE7FlE/A10eU",
-	"IW3MErUNVydA87/B+u+mLS5ndDke3UxvGMO1G3ELrt/W2xvFMxrErB6pZQa4IsppWUpxTvPEaVeHSFOK",
-	"c0ea2NwrYz8xq4vr8E6+O3z11oF/OR6lOVCZ1KLC4KqwXfnFrMqGqw8cEJ/gyzx4vMxuRclg8+sw4lAj",
-	"e7EEl0wpkEZ7yR8abXtwFJ2Gdh63y2/VtzrDgF3iBgMBlLV9oNFdWfNA2yRAzynLvdLIQztgQ8fF7ZZB",
-	"JMoVwgFubFoILETJrbKb3umOn46GurbwpHCuDemeCpvRTBHBuy5ZRoREXRSSakExdYNVCfSZE6+KxBy/",
-	"ROUsjSsY+UwZ4uDWcGQaE2w8IIyaESs2YIfkFQvGMs3UDg/dDpDBHFFk+jQgQ7ibCZeKtuLsXxUQlgHX",
-	"5pPEU9k5qJgrw6ma+9epkR36c7mBrXq6Gf4mMkaYtqR74yEQmwWM0EzVA/dl/WT2C63VMeaHQB9/BWt3",
-	"OGPvStxgqXb04ajZugwt2+amMHNsn/8ZwrBZxranrfWPV5c/ZWCOaBpappK5FL9D/J2Hz+OI27pP1MLQ",
-	"a/J34JNI9E+XxdTanSabbjP74HYPSTehFqptoR+getz5wCaFSTG8epZyu9U2K2TLLyROMKEv19SO3xCM",
-	"g7nn/5bTixmNZQwxQoaB6bCxfrYUyVoQ39nj3um8mcudMyGBIbVuy2xAVwmyiSjpBw9fU2Cw0+4sKjSS",
-	"AVJtKBOMrfErVyIyTMUvKLfJRU0/e5RcbwVW+WV6XQiJ4ZgqrvPOIGUFzeOSQ4bYb4evZmzBbGrNSkGQ",
-	"u9ENZHMSWypy+S+tfblBzdGc7I+D7LBuNzJ2zhSb5YAtHtoWM6qQk9eKqLqLWR5wvVTY/NEOzZcVzyRk",
-	"eqksYpUgtVCHz5vacjMDfQHAyT62e/ic3EeblWLn8MBg0d3Po4OHz1Hpav/Yj10ALofuJm6SITv5L8dO",
-	"4nSMRjs7hmHcbtRJNLjQJj4fZlwbTpPtustZwpaO120/SwXldAFxN4liC0y2L+4mKtI6eOGZzdqrtBRr",
-	"wnR8ftDU8KcBn0/D/iwYJBVFwXThLBtKFIaemsSMdlI/nE0B7LIHebj8RzQQlt4+0nlEflqlqb3fYqtG",
-	"M+4bWkAbrWNCbQxuzhrTvU/4RY58JD+mU6qzKFncmLnM0lHMQUv+nJSScY0Pi0rPk7+SdEklTQ37mwyB",
-	"m8yePYmkkGpnjeFXA/yT412CAnkeR70cIHsvQ7i+5D4XPCkMR8keND7WwakctGTGvcU8R+86C24eeleh",
-	"zIySDJJb1SI3GnDqGxEe3zDgDUmxXs+V6PHKK/vklFnJOHnQyuzQz+9eOSmjEDKW16U57k7ikKAlg3N0",
-	"XItvkhnzhnsh85124SbQf17Lgxc5A7HMn+XYQ+DbiuXZ35uYkU4WPkl5uozq/Wem469NluR6yfYcR9OI",
-	"LCnnkEeHs3fmr/5ujdz+/xS7zlMwvmPbbnY9u9zO4hrA22B6oPyEBr1M52aCEKttJ/ra6zJfiIzgPE3O",
-	"iobK+gkDgwxa/6pA6VjQHn6wnh+o3zHvApvAiQDPUKqekB9slZMlkFZIPUqzrKhyG54N2QKkUzxWZS5o",
-	"NiZmnJPvDl8RO6vtY1N+2gRSCxTm2qvovOuDBDe7+RD67J1x/+bdx9nscGlWrTRmuFCaFmUsdMW0OPEN",
-	"MD4m1HWimBdiZ0JeWglbefnNTmLoYc5kYSTTejTL45EmzH+0pukSRdcWNxkm+d0zn3mqVEFi+DrPa52j",
-	"Bs+dgdslP7O5z8ZEmPfFBVO2uAWcQztapg4dc08nHz3TXp6sOLeUEuXRm0Ibr4N2D5w1aHt1aBSyDuKv",
-	"KLjYxIFXTQR3jL2iSR+6WeV6GeFtVHGdotQXLUopF5ylmHIhKKdRg+wKZexiK9ghO0VXGeWPuDuhkcMV",
-	"zWVXuxM5LA5mt/OM0CGur6wMvppNtdRh/9RYkWFJNVmAVo6zQTb2KRmdvoRxBS7nENZMCfikkC37C3LI",
-	"qEkvqVW/VyQj9J0fEIC/N9/euOcROpWeMY6CkEOb81+1Gg3M46+N9MQ0WQhQbj3t0Hz1i+kzwfD0DFbv",
-	"Jz7vP45hzRdm2dZW1x/q0FvunKXMtH1h2hLrdVj/3HJTtJMelqWbdDhhZ1Qe0Cs+iOCIBSbxKvAAufX4",
-	"4WgbyG2jyR3vU0NocI4GOyjxHu4RRp28spOt95zmlaUobEGsq0s0vpLxCBivGIemKkXkgkijVwJuDJ7X",
-	"gX4qlVRbEXAnnnYCNEcrXYyhKe1UtDcdqrPBiBJco59jeBubvJsDjKNu0AhulK/rYhiGugNh4gVW4XGI",
-	"7GfRRKnKCVEZuh138mrGGIdh3D5zb/sC6B+Dvkxku2tJ7cm5yk00FEmWipi8+d0K0soaoYXyXsgkxdDs",
-	"4L6IajSbDLGRbQiz1HrUoov4bI3/xlIsDaPEWYmv7KfkTcLY8coCa3uknrhpiClRbJHsjglk5jdHRzP1",
-	"9Sis6X+rJJaLRRuQT5wLZRN7Cfcoxli+Mxw7jGvu5Q2zPL0OO0avIOGTzeN7rQ6Ya7MDvEN6icRQG13n",
-	"Dd+sDxjOAD7GW2fANzDIAEPtxWbNG0MegumgQyvVLq5EU9Kkr+jzBJu2OzaCdS+w6cJtpcGoamfIpcB6",
-	"FJjPvd67iWQ9ARfH3ohQ76vSB+hv3hGOlJQ5213DLPqYdS6zfSfmXZzpmg3uLsI5ouIgsZX08gNuppCe",
-	"I3LgTG/TuE12D2g/rA2jaK7BJNwL4C4Ld9vFcGdHp/kcUs3Otzh+/5cRlhun4rEXp22Jh8APnNWOM74g",
-	"5RWl/AagTX7ZG+EJsmbcGJwht88zWN9TpEUN0bxyY0+o14mXRAxgRpHEkIhQMcODff87XTBTNWUgFryh",
-	"z3aHJpnTYELfIIzhmnN5kiQ0DG3YMOW5iD0gdprLdL1SwA/6gAz5hvdTag7fXi8xg6mqk7HXFScDPw7z",
-	"Tuzme7tw8Zropl+rvHzkJij/m4/JsbPYSqZNymFUMF5QmfkWUYnZC+PJgLdV13/ZuomzONDzembWuGX0",
-	"XXgjeQ7Q+SbNhWJ8kQx5MLU9IcJiSGjvQd0E5ipFuOYgXapx7QvFJlp4N45NcGxChSvccx0kqMGsfRa4",
-	"wYjfd01IMyZ3orZMsLNlhQskEgpqoJNB4PHwnJuQ/cJ+9z6rPrlPJ5VWZFxPr8nWyGHvkMNUD4kh1c+J",
-	"uy23+8Je56nCOLeVHFQsCpkbVIZKrFKKrErtBR0eDPBPup1j/DewkqiUn/ZX2RPYcsx48SqILDiD9dQK",
-	"TemS8ib1SPtY22SEdg1BJF9nt2/1FRcXWPOFXcDiVuD8nC+h8agUIk8GtFZH/WDq7hk4Y+kZZMTcHd6U",
-	"PZDUl9xHZUltlrhYrn3wcFkCh+zBhBDzlipKvfYWinYasc7k/J7eNP8KZ80qm9/APdImpzzuhWELb9+Q",
-	"v/lhNnM1BYb53XAqO8iWaOXVQCC3pBeRFNe7VjGL2Ay6aYcborJQxKSUa4au7XS++w+1COmHQQdb3j9n",
-	"rVedTZTTsRMICbf8ugsUpFd83fXDKXZdHq4DuVqloL/OnTeghdsB3O+C+EY10UfusEZBz3bRKMSTepju",
-	"qNKwCMGMOARBJb89/I1ImGOGPEH29nCCvb2xa/rbo/Zn8/ra24uezE+mzGgVS3Pzxijm70N2ZWs7HXBh",
-	"6OxHxfJsG2G0HFKabJXocvGrc935LPkyf7VP5P5RdakDr6JG7W4CIiay1tbkwVSBq8kOXiau2yRazk5B",
-	"Wkmm1xhR5F9U7NdopPYPtRLGVeCsfdCdC7Stde88ohqVTVOe/Adhy98V5q5HJbbGfP7frWhR5uAOyjf3",
-	"Zn+Bx399ku0/fviX2V/3n+6n8OTp8/19+vwJffj88UN49NenT/bh4fzZ89mj7NGTR7Mnj548e/o8ffzk",
-	"4ezJs+d/uedrg1tAm7rb/8Ckssnh26PkxADb4ISWrC7jYcjYJ6ikKZ5E8ybJRwf+p//tT9gkFUUzvP91",
-	"5NzjRkutS3UwnV5cXEzCLtMFvtESLap0OfXz9MsnvD2qXXdsyAXuqPXKMKSAm+pI4RC/vfvu+IQcvj2a",
-	"NAQzOhjtT/YnDzEPdAmclmx0MHqMP+HpWeK+Tx2xjQ4+XI5H0yXQHJODmz8K0JKl/pO6oIsFyInL1Gl+",
-	"On809Zb/6Qf3Pr00oy5isVbWCSmsMNxLYOl0XWhPsk5GrYRQyuUnGtdpwpz4yDP0DbFPPsPaamQdZU1K",
-	"kKOgbK0LjLKR4ge/RBInz9mikp3CQ7U23+UQZIrYKpKSvLY697c0PQv9L2J14B0ri5WBd14ahVqUbZNm",
-	"o+mPlSiJZQLFmc0+B5Raq4oaTqRlBSEkDV81vHI/ef7+w9O/Xo52AAT1lq4Q7m80z3+zlaFghcqfdk1q",
-	"NR4qgD5uVA+dstNjtMnWX8MMlXWbtifQb1xw+G1oGxxg0X2geW4aCg6xPXjfqbf/aH//I5SuHrdG8STx",
-	"WWtgP7nFhbYtaDdebne43qK/pRlmDQSl7VIefrFLOeJoOjAcn9gb7XI8evoF780RNzyH5gRbBvFP/Vvk",
-	"Z37GxQX3LY00UxUFlWuUVYLUpqFUejl4W03DNGzTDy3Fcnaju6yXgfLo5Zbr7Z4aYor9xACdLG/me53H",
-	"DFWPYW1/9WBCfgh7X6+AfwNbWMZ/4LINXut39+5HvXcP21qHVmR5DJgWiW+EqWd5uunF1/d76iTpvlYS",
-	"7CCf3DWy8nzUTKHdqtpD5Qt3YLB3uBsq/Tgg3gTw1pJOOw/gx+e79v0WXBOt++AjcuUvXFh7TXNDJ8Fy",
-	"Oz7YNt3CnRD3pxHiamcEW2sDMwxtEuswjej0g8+OcQuinMsOsoMQF750g75B9ob7HU7xYGJTXYRtrscO",
-	"nGPBVvEMc5bcCWYfWzDrJ/uJgdGkcPl8whjCsGyyAV2lwEUree+VshZ9odLXnxhZg+KWgXS7oHUN3tgT",
-	"ohwn/mg886sUnhzS7sSmP7XYZH35NghOrUxczvFzWHaCoO52UMuk5Xg2W3s6HBOF5f3NT6VkQjK9HhPG",
-	"SQbm7KHFUEgMOm4qeDsnI+D439eH/0DX09eH/yDfkP1xLYJhTFZkeuvc05aBfgAdqTD/7fqwFgc2ykJ/",
-	"GAHjpEbSQAV4LXwyLURaQVffDKFsZe2KMfGsoKvRRklk/OVIizcVmjrRlH0qckU0bQV5V/il7VKlCKxo",
-	"qvM1oXj/rK3vL9YW95mwOtXQu6X5Y/FGG2b0dSViUWNX9eqKhKz3yuIPlAseolKXcQ6LuGwXTHrIiEJw",
-	"PSnvbne/2N3ti6WkFOZMM0yJ0Nwn/q5qAdlUF3DgDjisTsh/iwqdXWzxLIil88QZ0LnXz+kE0CAfb46l",
-	"y2rs7O11F7635/acKTKHC+SglGPDLjr29r4CkXVVZ1GkhAuecKztdA4k8JC7k1v/0HLr0/3HX+xqjkGe",
-	"sxTICRSlkFSyfE1+5nXamZuJ5TXPqXiQCGgj/+l5yjdSdCC+38h23bVNM91Ihq3AqUCFUJfgc2/lcZPD",
-	"37zlMV2ID1hXY286Qcc/a1Wx+zHuGVYmMSE9sOB8uz56uYtc/oUYQndOWxW51+J787FvgKg/zbtP40+z",
-	"GzN9sv/k00EQ7sIbocn3qC77yCz9o+oO4mQVMJsrW1Qai0nIWlwg4kamYk7o2KUaxdyXa1IHChl+Yhmh",
-	"LTbQ5xpmhl35xR9YP79Dmd0IXXbRe8cX7vjCjfhCl6AajoDh9mr6AU0FITvoHclvTcuvyMQY2FukKLzB",
-	"RZA56HRp0xB0w2IibMVnvxvmKZtyxN+y/Q+BjuTIxbW40A/MXb5jQCB2/NFGYlyORynICPH95PPhmM9s",
-	"jmGddWZDXwoBzTnMZweuEwO79OlMeZ9zl/WGmF28EpQvmsn7YTqIltuwGd4h+GoI7jG171yeZnu83CK+",
-	"Bq90n8Q3IW9QHMID7hP7fY1qj495I3/sBb0RHKxd2kislhbvTJC1uIDVVBApPguCNTy6Aq1x0aFtdPyg",
-	"Vyy7nNZpeoaEirfYYItQ0dzUrKlh2Vav0LIEKtW1L+nt5rCTzoxHL0M/jVZWoTqfUAQUg5crWhL/Y7Sj",
-	"NIMBP2JOllQtybziFtC6WhK6rHgnCjEf18pacxrE/ICc8j2ilvTpw0e/Pnr6zP/56OmzAXnMzOPij/sS",
-	"WTOQ+WyH2UUs+3rNjm1Rokbewafeyqvt0HjEslU0hQisfCak8Fw43Scyh3uKlHQ9mHloIInXa5BnuS84",
-	"3jbykALMhaqWrPwcBdTZLF5D6EezS2JO6szeR/zbmn+eg2RzLIRV84VPnBlGAmRQ6uXGlAy2jlepl82m",
-	"gqs0yZRLfVNKcQ58TNgEJl1jWLZokuTmQOd16hQhdnFVC3iJoTdPHAHWw4XsImq+jdEPhkO6FHOfWqnS",
-	"uHTZy8wjT3bulc+qcdGfRePyRvAE5THg2r8NWmj5fNoXzHYzDhScda0ELjQqNoVEMTJkW2qykwAGg8am",
-	"Fg+0rpODZOzEsZTqdFmV0w/4H8w8cNnE+NvCIFOriN0kkR3bFrfqYmPHJLLNbXyyC6ccFnPymqVSHGJW",
-	"JHeNqLXSUPTLRtquv24qORG9cgTPGYekEDyWJ+Mn/PoaP0bzLqHZfqAzOlAM9e0W+2nB3wGrPc8urO6m",
-	"+J38MZS8N3qwdFYroazdFNGfA+m/OS2txLfNMWn9PP3Q+tPZS1xLtax0Ji6CvjavxcazZVvc6tl6IzKw",
-	"47ZTycT8R7nIwKXf6B+pmmvEJVKP36ZdRzhIabVYalv2MFpTte6Y0NQeBZs7Vm1Ltmlb+aRy50BoLoFm",
-	"azID4ETMzKLbSYsJVXUdWyQOyxvjOSMbuEopUlAKsiSsd7QJtDqpCUo+egOeEHAEuJ6FKEHmVF4TWMsk",
-	"NgPaLfRXg1trCh0f6EO92/SbNrA7ebiNVALxDBFfNKIoc3BvmggKd8QJytrsI++fn+S621eVWFInkvXU",
-	"fj1hBebt4JQLBangmRrOTbzt2GI24mAtCmwVWX9SopVKzMADV+srqrSr6NRK4RjktDZTbEimPJSQzIz8",
-	"9zodWW/s1PBLrirVFLuyshdk0TqisNow1xtY1XOJeTB2LdzZGsfbRh7CUjB+Xf4qyI6sAy2WGS6yOAyC",
-	"oU4Ui9TiD4FoELEJkGPfKsBuqGEZAISpBtF1ytM25QT1h5UWZWnOn04qXvcbQtOxbX2of27a9onLBQ8g",
-	"X88EqFDwdpBfWMzaynZLqoiDgxT0zMnsC+fD34fZHMZEMZ66lO5D8VmsgGPTKjwCWw5pV+wLj3/rnHUO",
-	"R4d+o0Q3SARbdmFowTFB8w8hFl713dfV231EVXlb0A7Eq0bQtH9PLyjTyVxIly4fa6dHrO6dbFyUaVex",
-	"372KtXCqbld93TIUN05Q11GFDtAWBB+EY3a/73NjpvpeyJ2M/I0+XgtiFkYqrpmPpDbnrZYx/3gW8zvp",
-	"+U56vpOe76TnO+n5Tnq+k57vpOePLT1/Hq9dkiSeT3vTcCwgi4y+SAn/C4p5+pRBSo3QX4v8+EgwIro5",
-	"xxu9eTTQfOqqKaO3QrR2qA0LCCszp2Y6xkmZUyMNwUr74HQyowqePfE+GXUNTJu+3/Aa0+DxI3L846F3",
-	"VFg6S3q77X1fWU7pdQ4PnNdjnV/buz8Cp1hxE70fqX/9pM6hxArzc5YDUQZX32Hrl3AOuZHkrfGTmLdI",
-	"/3V0AjR/4XCz5XHUyqBsRvtt3HqTObQVtPQij18rVYSiU0snAfKc5mo4A7Idr6BlLLy/5tP22YSs4VuR",
-	"rTvkbnZtihvYJvTGT4FxKiNVjfvk3SMNLbCyuSvT3Xv3Xd66U02faPtkto3C4qVk4tWHN1H5cHFss2G9",
-	"oaxH07xDJ9H0/13fiVEN4C4GQ0PPfk+IK6v8WW8rghC5I9Zw5j9M4Em3tp5jGtjWCFSO9XypQSIe8dHT",
-	"i2d/7GuPEaYVcRS3SkyjBfDE8ZZkJrJ10uJM7QumKcm79ZIJWSMepvpeqWu2D15Bn+eGCMo+jzax25Ae",
-	"VonjrQOM1zqI7cZ2a2zhiI7zBhj/2Nx3iEOGIBDHemJv5272sivys6Dc8x1Pu+NpwWnsXPaMO9/ELhOZ",
-	"XI+nYYX0YXZm6+2DIuEhva8eGJaFGF3pluY+g1m1WNgqeV0tNGbRqos9fh4uZ5e7K4O7GnHYwevQ05tG",
-	"TXSH6zOOwKnuvpBkIUVVPrA5DfkaFZxFSfnaGzXMy7+oclf8FiO9bpeH1hUbe3KjV64N6+XeevVboH1y",
-	"t2j7d4sWrPNo9xcyUvEMZLyc2qpTJGs7xk9WvOHAG0to+WKCvdW5eXfh/n6XXYRAbcgpbWlVe6Bah8n5",
-	"KduTO7kLr/5z3Ahvbe7QAQbb97JtGML2i0EGLAtvhk6yLX81tPnpO3oRpu66LaFx99f6EvBOrF+vkcxk",
-	"RoyUgmYpVajU4KAvhDz7yLKkXh1FtMgIJmaY7AeemDfJZKtQiePuJFK2Y738q7yaFUzZqnyfV7hsogkO",
-	"XcBuCxt3it2vRbH7rT98ilAs8Ns5nNaGg2dyBzZFL/SKR7nUtLQZqof8l4MD4XJZ36onRm/4tkNGkB/a",
-	"GpQhLwklac7Q3Cy40rJK9SmnaNDqVD/uOGt4M92wKPXCN4nbVCMmTzfUKTdC1ZzUZq6oSDWHiAH7ewAv",
-	"salqsQClO5x4DnDKXSvGScWZxrmwmHRi/frNdW04+sS2LOiazGmOFtnfQQoyM4+IMGsZmoeUZnnuvEPM",
-	"NETMTznVJAfD9F8zI9CZ4bwFofZ4snRXY2GgSL4tT5nEtbM/2K8YQ+eW760AaKywn320y/jzFJFNWDYI",
-	"+dFLl1H06CUmiWv8QnqwfzJngYLxJEpk5sZ3/lVd2iL3jYznCehB42Hidv2UG2FaC4KMnurrkUPXqNs7",
-	"i/Z0dKimtREd269f6/tYNouFSMyTkS7M7wuml9UMy7j6LBfThagzXkwzCoXg+C2b0pJNVQnp9PzhFvng",
-	"BvyKRNjV3c399ZhkQzowp6XeeKyc0N37gXv5FhK4/7Gztm91OL3LkX6XI/0ui/ZdjvS73b3LkX6XQfwu",
-	"g/ifNYP4ZKOE6LJubc3pq3uqTUokpHbmmoGHzVrZf/tWSaYnhJwsDf+n5g6Ac5A0JylVVjDi1u+5YIul",
-	"JqpKU4Ds4JQnLUhSUbiJ7zf/tc/c02p//zGQ/QfdPlZvEXDefl8UVfETmprIN+R0dDrqjSShEOfgcoFi",
-	"86xC9xfba+uw/6se9yfZ27qCrq1yZUnLEsy1pqr5nKXMojwX5jGwEB1vbS7wC0gDnM17RJi2adcRn+jl",
-	"7nxiqMsmEhO6+/f7FYpGHnaz03zStGZfr4C9iU/1N+z2eODGsXsM8Y5lfAqW8dmZxleUgfUu2eofbEGh",
-	"IbWVTf0GklRdRjSid/IyklUnG96MI0BaSabXeMPRkv16Bub/7w0fVyDP/eVXyXx0MFpqXR5Mp1jvZCmU",
-	"no7M1dR8U52P5n6gCzuCu1xKyc4xV/L7y/8fAAD//xxN9dmyGAEA",
+	"H4sIAAAAAAAC/+y9eZfbNrI4+lXw9LvnOPaV1N6SO+l7cu5r20mm38SOj92z3TgvgUhIwpgCOADYLU2e",
+	"v/s7VQWQIAlK7MVbpv+yW8RSKBQKhVp/m2R6U2ollLOT498mJTd8I5ww+BfPMl0pN5M5/JULmxlZOqnV",
+	"5Dh8Y9YZqVaT6UTCryV368l0ovhGTI7j/tOJEf+spBH55NiZSkwnNluLDYeB3a6E1vVI29lKz/wQJzTE",
+	"6bPJuz0feJ4bYW0fyh9VsWNSZUWVC+YMV5Zn8MmyC+nWzK2lZb4zk4ppJZheMrduNWZLKYrczsMi/1kJ",
+	"s4tW6ScfXtK7BsSZ0YXow/lUbxZSiQCVqIGqN4Q5zXKxxEZr7hjMALCGhk4zK7jJ1mypzQFQCYgYXqGq",
+	"zeT4p4kVKhcGdysT8hz/uzRC/EvMHDcr4SY/T1OLWzphZk5uEks79dg3wlaFswzb4hpX8lwoBr3m7Hll",
+	"HVsIxhV79d1T9ujRo69hIRvunMg9kQ2uqpk9XhN1nxxPcu5E+NynNV6stOEqn9XtX333FOd/7Rc4thW3",
+	"VqQPywl8YafPhhYQOiZISConVrgPLeqHHolD0fy8EEttxMg9ocY3uinx/B91VzLusnWppXKJfWH4ldHn",
+	"JA+Luu/jYTUArfYlYMrAoD/dn339828Ppg/uv/s/P53M/tf/+eWjdyOX/7Qe9wAGkg2zyhihst1sZQTH",
+	"07Lmqo+PV54e7FpXRc7W/Bw3n2+Q1fu+DPoS6zznRQV0IjOjT4qVtox7MsrFkleFY2FiVqlCWIujeWpn",
+	"0rLS6HOZi3zKpGIXa5mtWcYtDYHt2IUsCqDByop8iNbSq9tzmN7FKAG4roQPXNCni4xmXQcwIbbIDWZZ",
+	"oa2YOX3gego3Dlc5iy+U5q6yl7us2NlaMJwcPtBli7hTQNNFsWMO9zVn3DLOwtU0ZXLJdrpiF7g5hXyL",
+	"/f1qAGsbBkjDzWndo3B4h9DXQ0YCeQutC8EVIi+cuz7K1FKuKiMsu1gLt/Z3nhG21MoKphf/EJmDbf9/",
+	"Xv/4gmnDngtr+Uq85NlbJlSm8+E99pOmbvB/WA0bvrGrkmdv09d1ITcyAfJzvpWbasNUtVkIA/sV7gen",
+	"mRGuMmoIIBrxAJ1t+LY/6ZmpVIab20zbEtSAlKQtC76bs9Ml2/DtN/enHhzLeFGwUqhcqhVzWzUopMHc",
+	"h8GbGV2pfIQM42DDolvTliKTSylyVo+yBxI/zSF4pLocPI1kFYEj1QFwpBoHjhLbBM3A0YUvrOQrEZHM",
+	"nP3Zcy786vRboWoGxxY7/FQacS51ZetOAzDi1PvFa6WdmJVGLGWCxl57dAD3oDaevW68gJNp5bhUImdS",
+	"EdDaCeJEgzBFE+5/zPSv6AW34qvHk3eHvo7c/aXu7vreHR+129hoRkcycS/CV39g02JTq/+Ix188t5Wr",
+	"Gf3c20i5OoOrZCkLvGb+AfsX0FBZZAItRISLx8qV4q4y4viNugd/sRl77bjKucnhlw399LwqnHwtV/BT",
+	"QT/9oFcyey1XA8isYU2+prDbhv6B8dLs2G2Tj4YftH5blfGCstardLFjp8+GNpnGvCxhntRP2fhVcbYN",
+	"L43L9nDbeiMHgBzEXcmh4VuxMwKg5dkS/9kukZ740vwL/inLIoVTIGB/0aJSwCsLTsqykBkH7L3yn+Er",
+	"nH5BzwPetDjCm/T4twi20uhSGCdpUF6Ws0JnvJhZxx2O9B9GLCfHk/9z1GhVjqi7PYom/wF6vcZOIIiS",
+	"cDPjZXmJMV6CQGP3cAngzPgJ+QPxOxSFpKLdAxqSwHsLcc6Vm0+mqcPYnNyf/EwNvkmGIXx3HlaDCGfU",
+	"cCEsybXU8I5lEeoZopUhWlHMXBV6Uf/wxUlZNhjE7ydlSfhAmVBIFLfEVlpn7+LyeXOE4nlOn83Z9/HY",
+	"KGBrUBothJcx4FJY+uvKX1+1xsivoRnxjmW4naCCeTet0WCtcDdBcfhYWOsCxJ2DtAKN/+jbxmQGv4/q",
+	"/HmQWIzbYeKCVsxjjl4u+Ev0ZPmiQzl9wvFKnDk76fa9GtnAKGmCuRKt7N1PGncPHmsUXhheEoD+C12i",
+	"UuHTixoRrCsjxEYodyY3opBK3ACF4/bj/6QTG3twWQEEFEgCHJN3NflwY/guTWR2FJUBifMwC3N+Bhu0",
+	"xPAWVY75AQEp17xiRnL/5EY2n+MDiFBdmQEdZBJJSOBDF4Ynhc7ePhdurfOnvCjsDVBLBuOMJpZm7j6B",
+	"jGBxfscXsIwRjIxgm16CoZ2hpgjf/ezkySnbILwMB4LZeZg7IPMGMIgj9leNw7O14LkwLOeOzyfdBaTF",
+	"Qez4R+yHd44wiTfjj/gfXjD4DKwVbl4aFnRBEjmkjiw3OahQ6GFGM0EDVO1otiGtCQNtx6WgfNpM3ts8",
+	"QsuYTfuWFDW0NWERuEN6e+MH7onepmB4ore9w6a34iZO2EJv6T+jTtgTvX3mIdPmIBemsccgGRYITwY6",
+	"BioWuGCWRuN9stDmanyuw8AUa/T4jMOo0d037bEhl62rcuZJMaELpAadgRrTaf9J2OYk7eFTGGth4bXj",
+	"7wEL1vEI+GtgoT3QTWNBb0qeuafiihjorCnJv4BRe/1qUNSyjOaNWRqgqFHq7JxoGYT+3y/+5xgMQXz2",
+	"r/uzr//z6OffHr+7e6/348N333zz/7V/evTum7v/8x99hBG7nV3qIgs8HvQjjV4usZipF++97Fpw69rD",
+	"SeWEOecFk3U/YVP35BQ2YynNRuSXhNWBKSHTm41E++MApLGOJD3/Uhq7F0/YYGB5YW37kJWellBtx9FT",
+	"vDuWLY3eeHnzn5WwLqj4mFsbXa3WrNl5mLtm1h+W/Loi1bl2wtgZLeLyy65lbFxpCaJ2HjR8YTumgR7w",
+	"g8ZvNC1Ri6frPfv0IVHUZWnCuEnr1PbPRptaGyLqYnfUizl5XPAxDI9UvYykzLAHUrXw3TndnuHK4ibe",
+	"fmtu130qAW34o4fs9R9Pvnzw8JeHX34FQJZGrwzfMNgzy77wSkhm3a4Qd1OkSTri9OhfPa7JrzVuahyr",
+	"K5OJDS/7Q5EZj0iWmjFo19+XNhHgqmsAR70TBLAhQjsjCzWA9kxabq3YLG5kM4YQljez5MxDkouDpH7Z",
+	"5TXT7OIlmp2pbkJ1K4zRJmFIQpnG6UwXs3NhrNQJn4CXvgXzLcKVWHZ/J2jZBbcM5kYbZ6VyYeZJ5rlV",
+	"4wVtGvpsqxrc7BW1ab2J1fl5x+xLG/nBZGZZKczMbRXLxaJatTR/eGtxlmNHZBY/iHwlDOptn4nC8RsQ",
+	"UuEWId1tDiMSC0NuOY286UiTBWZjfzSB670VuyN02mDZmquVsGxZAPcnO6DTrJDW2bGPymZV+96JLVCb",
+	"1+ILnQsYoLI3gJJmsGaXiIk3e8MXunKMM6Vzwl9l0xL9gOcUoJ08TVz8SMBLV1q2EHAhZ7xarR0DI5ZO",
+	"yqt1xxnPiFpnePsMCEmNhwC1ounIK6cwguegfxWK6YW35noRDRfJ0QnEBRbt3xNpAbWBqzQ6E9aC3pxo",
+	"6CBooV0jtA3hCQFHgOtZmNVsyc0VgXXa8eIAoNgmBW6ta5FqAOpx0+/bwO7k8TZyQ+ItUAFzGm+4Qjgx",
+	"hMKRODkXBsX197p/YZKrbl9VDjhq+jczaLZhXxRX2opMq9wmB4Nn2ezQsYVG8VosrCA6KamTigMPvJh+",
+	"4Jb070yqHGXK3hsRphgGePCqhZH/Em7Z/tiZVlYoW9n6yrVVWWrjRJ5aA3iRDM/1QmzrufQyGru+151m",
+	"lRWHRh7CUjS+R5aNXjfc1eYz7zHTXxwameAe2CVR2QKiQcQ+QF6HVhF2Y2e1AUCkbRBNhCNth3JqD7np",
+	"xDpdlnD+3KxSdb8hNL2m1ifuz03bPnFx1/D1XAuY3QWYPOQXhFlyU1xzyzwcbMPfwt2Eoj55LvRhhsM4",
+	"s1JlYraP8uFYvoZW8RE4cEgH1FreETqarXM4OvSbJLpBIjiwC0MLHtCxveTGyUyWKEn8SexuXM/dnSD9",
+	"mM2F4xKeIdEHEv/KuD8jV5TumFcTtEZJ533we+J5YjkgbOLbtgX8W7FDu+JLIcwTrm5Ep88v8dLw8x5W",
+	"5nM13qC64EqJnK20tbJkpRCmXuNNLJAGvMwKSVo+uEgaeOwqM62UyFxyoeiweha5ud6A2J8YlUlyMofV",
+	"BDc4kbf9a8WWZ67YMY730Y5dCCOYrRakZe3r+Z0uZ/EASbvBnhn9s8e2FJWjHlg4VLS8lM6RZND98J11",
+	"pNAWOrz0W2pdjLDy9pCRhGCccq7UsOvSO7wHr+jAFlpAeom02AVw4Sa8Y1toxhWwv+uKZVyhNF05UV/v",
+	"2uCdCX1xBmmjOb3jSoMhUaD/Q42de/e6C793z++5tGwpLkKUyL17fXTcu4dP3pfauhanvImTz407TVzU",
+	"qN+DW98L5N0LYn5QgeVHHrOTLzuDh0nxTFnrCReWf20G0DmZ2zFrj2kElI+H1+62I1cerSe5btp30DTf",
+	"kLo47SWML03v+Aut2LJSBFRl/dsSfeGC2k4vp7UnOEWAHjN0E17zoHP2fz788qvJtHHvrb9PphP/9efE",
+	"80Dm25QTdy62qT3xRwyfxnfgHbmzwqUtSgh7Io5DmLeFaFT68egbAWfarmX54c2T1slFWsn/R9glvWSe",
+	"xW/VqSIXFHDBw8f1zsvsevnh4XZGiFyUbp0KECuNsMgaKdCrdOtmU4XoKMTAFVCoKZNzMe+y2HwlvM6B",
+	"s0LwZTBnGa3HOE7Wx4HoLRBHhPV4IaP4WIp+0A2w8UN6LTdVwd1NWByWKMzPUrFUp4TQldFVifp0I/6B",
+	"ktUUf5d4lEDZK1XUMHG2yILMiQ2shR8HOBN7oR3ze9l4G9XfWYYxZkpTPKxzRi4qR8yEMyvVqhhjeOay",
+	"qIwYdpE4sFAjuIU9cK1v88sqbBrzutxsRC65E8WuZWCVllnaWcQNOVoHJXmwOWMzGgdlxsBcwejRHSKJ",
+	"DtSFz1AXflivHxv/gxWY8NSQJGy5dJbpC+WDAkuj8yoTN2UKYJhPoEcmfr9wRltlmWhtymirARpjZj6c",
+	"ZfT7JRzB6PIdsgpNJwjjzMOYvJQSaiC/j92Hw0UTE+sHhLd4ZcjDmvHMVbyIbv9p4wmNmynOhdm1jqcR",
+	"XqnELcNOMFITu8OkZZk2RuB7hV4W84T+psMZWzqVGMNddIxhioRaUjPEoBMJxBQP3LECbd0NPO1oIMBP",
+	"fNsEvbSlr3oZxx57Vmh31olN37RDXX8Z4BCvArZ6Z1arQiox22gldsl0G1KJ5/gx1ZseAwOd8Vk21Ler",
+	"NmvB3wGrPc+YXb0ufnG3owP4sg4NuAkLZ2fcjlUvjrpGq4QoSsZZVkihSHvrTJW5N4qjVrRzSXXIIuh6",
+	"h/XkT0OTtGI+oTf3Q71RHP03a11p8t5aisS1+J0QQV1uq9WKHKBaCVqEeKN8K6lYpfAWWLIN7NeMNqwU",
+	"Bv065tRyw3dsCdHDTrN/CaPZonJt/oZPAutA604mRpiG6eUbxR1IaNax5xKM8DBciMEMNKOEu9DmbY2F",
+	"9A24EkpYaWdpqfh7+orCsV/+2gvK8H/fufFI/7BScYBd5oOQnz7zCqjTZ3iBNsbFHuwfzOIE8c5JIkN3",
+	"NKkwAr5DW+wLpV1NQHcbM6Xf9TcKHCCchhQQMufuauTQZXG9s0ino0M1rY3oGBDCWn9OySIrPQPXO5RD",
+	"Jyvp1tVinunNUZBRjla6lleOci42WuG3/IiX8siWIjs6f3BAC3ANfsUS7OrddOK5jr1xm4MfOLWg7py1",
+	"6S787TS78/23Z+zI75S9g7vph44CUBO6UvrQ9s2AxVMeHnLpfKPeqGdiKZWE78dvVM4dP1pwKzN7VFnQ",
+	"0BdcZWK+0uw4hG09446/UT0WP5gqKxKOWVktCpmBVJw6mpT+pD/Cmzc/AYG8efNzz9Dfvzj9VMkzShPM",
+	"QGbXlZv5/A4zIy64yROg2zq+H0fG3ntnpfeArkgB6sdnfvw0q+Zlabvhvv3ll2UBy4/I0PpgVtgyZp02",
+	"gQlKG6DB/X2hvULK8IuQHKSywrJfN7z8SSr3M5u9qe7ffyRYK/71V89rgCZ3pWhp1a8Ujtx9MuDCSaAS",
+	"W2f4DDI92OTyneAl7j5e1BuUkouCYbcYJ7WfJQ7VLCDgY3gDCI5Lh8vh4l5Tr5CoK70E/IRbiG2AOzU2",
+	"7qvuVxSJe+Xt6kTz9napcusZnO3kqiyQeNiZOn/PCnhycDyA5xQcAp/qaAEvYZG9FTlmXRGb0u2mre56",
+	"2brhAuuQlrITUSAXptBAAwRkLSpz7mUArnbdXAZWNA/7V+Kt2J3pJgPHZZIXtEPq7dBBRUqNLiMg1vjY",
+	"+jG6m+/9pPBpWpYhMh1j5AJZHNd0EfoMH2S6IW/gEKeIohXyPYQIbhKIwA5DKLjCQmG8a5F+ankg3izo",
+	"5ksowQPvZ75JI7V5X6d4NWfr+jsG+q6MvrAMvMNzpn2WLvKUj7hYBUq8Ac18bAMaGZzdshvhIIfuveRN",
+	"By4E7Qutd98kQabGM1hzklIEfAFSQT1fx8MtzERmRq82RGWZR9iiQDGpdq4jpsNNyxanVvtASxOwMKoR",
+	"OAIYbYzEks2a25BALJ9GZ3mUDPAe0yDsy3oTa+WiZGotvVhlA2E3+9yzavncNyHhTchyE5u0RmSsIcVt",
+	"ld4OrVAAykUhVrRwahwIpUnJ0GwQwPHjcllIJdgs5efFrdWZpAxwzTXj5xAgH99jjHRPbPQIKTKOwEbz",
+	"OQ4MpoGXMZFeBkjlU0rwMDYa3qO/RToagDx5QeTRJbBwqQZ8sAMH4N45sL6/Oi6qOAyTasqAzZ3zQigX",
+	"TEzNIL0cLCi2djKueAeOu0Pi7B7VH10sl1oT9rjSamKZKQCdFuj2QLzQ2xmFAyUl3sV2AfSedG6GXsmD",
+	"Sdlu7li20Fv08MKrBe0P9gAsw3AEMBoAMI0JrB37Dd3mBMy+afdLUykqtOyLWrZpyGVInBgz9YAEM0Qu",
+	"X0QJbK4EQEcZ0+R49o/fg4/UtnjSv8ybW23aZGQLcRip4z90hJK7NIC/vi68TjnzsiuxJPUUrVadbDuR",
+	"CJkieiZVQjvc10FbUZDNddYSomZvxS79thF447wO3SLlBeb04Wp3N3KYMmIlrRON9i4YMT+GPwTHHIJa",
+	"L4dX50qzhPW90rq+prCj9++Il/nBVwAxqDMKUEXVZ3IJ0Og7i4/q76LA6o6s1NpsRul0ZZ7mDTjtW7Gb",
+	"5bKo0vTq5/3TM5j2Rc0SbbVAfisVEzxbswWmf0563e6Zmhyz9y74B1rwD/zG1jvuNEBTmNgAubTn+EzO",
+	"RYfz7mMHCQJMEUd/1wZRuodBRpb6PneM5KbIaWG+T/vaO0y1I8Rea38cZTh0R9FIybWkE2alXej811p0",
+	"D30b0x4lBQtU0l6qOA9FJS6XyisA9S3035up6cCd7QEYTsE0nQxMmvJoxtH2o8J1kDaImlIYqQcekvQt",
+	"TOTWRlhIW4f5hiuVF2RbOgu/vwI2Bk8OlbMn+P0ky0RZuwyBDcUPCf1e4n+fah/rN8V+rjctBtZoeLhc",
+	"SCsGnQ9LbXmRXgb54+QSjLVh1NAD/yB8SkuRqMlHkC9GkRxfG7mSihd+0CZbRJgkPaIT5ZCrkShvBOkB",
+	"ofGAY9BZTzpwGGuYQooLywyBMTBeMp7wr8FlidC/5mUplM84H8UtMQwFooalztYDUxz0vq1nOmbIcDAz",
+	"0ZS99HvUYA29Pf/irafh76fk7QW6o2kP+dMe5ju0DZv32gmMnRyisAshV+uBMEn6FlZBGKf724Ttb3Zl",
+	"xBPCH3pPhH6DfK8kb2rulP0XDrk/At1JFyW678dzD4grvCxlvu2YrWjUQeUmv5RuOiQS7WAEL2I/2AEM",
+	"RCaqVMigEbadM7bRxVDJglbOsPkozJy1M7vGsls8lbSh4E4fUSCF4Kv+EK4g58WfxO4v0BaXM3k3nVzP",
+	"ypXCtR/xAK5f1tubxDO6b5DVo2W0viTKeQnegLyYeVvgEGkafe5JE5sH0+EHlkrTFqezb09+eOnBB3NL",
+	"IbiZ1a+6wVVhu/KzWRWlpx04IKGgB3rnevUKvfqjza+TOsb2w4u18MUTIsVBL9lzYxtuxgv2xGXai+yg",
+	"ddCbsWmJe8zZoqyt2Y2lBTt3DNj8nMsimDgCtAMeX7i4cRnDk1whHuDahvDIn2F2o+ymd7rTp6OhrgM8",
+	"KZ5rT3mHDVUwsUyrbngFvPZhBiJV8P5bCK/A7jMnVW1Q6TuzhczS5jC1sEAcitwcoDHDxgN6AxixkgNe",
+	"M6qS0VjQzI4QKDpARnMkkRnSfg/hbqG9h3yl5D8rwWQuFOb5Ms0joTmocC5D+aL+dQqyQ38uPzD2iYa/",
+	"jowRpynv3ngIxH4BI3aq6IH7rNZuhoXWxgP4IbIeX8I3K56xdyXu8avy9OGpmRxc1yIpeKb5HxAGVRU5",
+	"XKYuCL0+X/rAHMmyc9LOlkb/S6RVcqjJTISg+olQmMLeI9z6G0V8Uz2vmX1wu4ekm+gja/uTDVA97nzk",
+	"QYHRIMGYyBVtNVWBankxpgkmamGPaPyGYDzMPW/tgl8sePY2LWQATCeNr07L7Ok0C50D7r2FVvpc+XMW",
+	"uf3UbSVl2iiFaaLD+1mdrigw0LSjRYVGMoCOLZmAXuG8sDoxTKUuuKIgE+hHR8n3xrAer8m50Abz5Ni0",
+	"hTYXmdzwIi055FnfGpfLlaRSWpUVUa0mPxDVICQq8vWu6lgsj5rTJbs/jarB+d3I5bm0clEIbPGAWoCz",
+	"Bq6tVlmFLrA8odzaYvOHI5qvK5Ubkbu1JcRazWqhjoKmgp/BQrgLIRS7j+0efM2+QA8LK8/FXcCiv58n",
+	"xw++RvsY/XE/dQH4mnn7uEmO7OSvnp2k6RhdTGgMYNx+1Hky6wsVOh1mXHtOE3Udc5awped1h8/Shiu+",
+	"Emmnvs0BmKgv7ibaPDp4UdgoF9YZvWMyrSDZCMeBPw1EKAD7IzB8aN7G2+Gt3gA9NYWYaNIwHJX8o7up",
+	"hit8RHeWMljzO4/ID2vfovsttWp0OnrBN6KN1injlBypiDME+wIf7LRWTYFnVB0+SLiBuSjCbVNq2ELM",
+	"cyoV6r9Y5ZazP0BwouEZsL/5ELizxVePEwn923lO1eUA/+B4N8IKc55GvRkg+yBD+L4Qs6FmGwms/m4T",
+	"ERSdykG/m+S0bsjNY//QY4UyGGU2SG5Vi9x4xKmvRXhqz4DXJMV6PZeix0uv7INTZmXS5MEr2KE/v/rB",
+	"SxkbbVIJN5vj7iUOI5yR4lzkg5sEY15zL0wxaheuA/1HzqTtRc5ILAtnOfUQgDoax78NFJmoNek+rCih",
+	"HRg6pvAByGDhh5qydn7pD++fEZTPfT8B+BJgxT+6wH7kLUUkhxUMbGJUbCS5nXn9PXJV4uyJ3o7d1M4J",
+	"CRv7CaAmiZJKFvlfmsjd9goXhqtsnXQ9WEDHX5pqn/Xi6H5K5i1dc6VEkRyOZMFfgsyYkGr/ocfOs5Fq",
+	"ZNtuRjpabmdxDeBtMANQYUJAr3QFTBBjtR3KWMe+QFgkw3maJJkN9+zngohymWM9h1RiGfxA/rcOa54C",
+	"FWMnJlSOr8U5+56q9a8Fa6V9w1danbWhwKzbXqFelYXm+RSzdICmn9Gs1IdK11Eq7xU+Utqr6Oirooy6",
+	"43w8qMNQlNn4cfaHvcCqrcOUmtbxTZkKIIYWZ6EBkx0dPj5fYuzM2TN6OdrwLqFJWF2vgdXTedkFaQL+",
+	"4xyZi51usdRhkh+fgz5QpY0KHPv/ZzUl0rkDuH0aespCP208EjCuANJxtKg6gFF7MfgY5vbyTKUUUUpS",
+	"9tiXYOIqaA/A4bi1mj8JWQfxlxTIKTnMZVPyv8ZeKaLs5ffvVTamzFd1IbTnoTY1V1rJDNMCpq5mX/B9",
+	"jA1sRAbFrpI1HHF/QhOHK1lVoPZo9lgcrDMwnbQQ11fCR19hU4k66E+HlcVBkbgSznrOJvJpKI7h9YBS",
+	"WeGTHAMRxXxSm5ZdETlk0lQ9q00alyQjjGAceNh9B99e+Gc/HEH2VlLFIY82ImhJmjqsR+3gVSAdW2lh",
+	"/XraKa7sT9BnjinUcrH9eR7qV+MYZJaDZZMNuj/USbBIewswtH0KbX0SqPrnVrAITXpSln7S4dIpSXkA",
+	"kvIMIThhWZwF006E3Hr8eLQ95LbXlQTvUyA0cY6GaFHiPdwjjLqMSKfWAwitRFHYgpG3bQopae/LH6QS",
+	"TXX1xAWRJa8E3Bg8rwP9bGbA33k0TwMDNFqfUwzNOm96uO5QnQ1GlOAawxzD29hUQBlgHHWDRnDjalcX",
+	"dQfqjoQJqA1am/b79UxQqvJCVM5dk64vVDhJMQ5g3CHhW/sCOFg/q+7uDM9Eq++Im2gonj/TKXnz263I",
+	"Kp/pzoZYMKw9GnOXJFVFtXoS2xDXCwqohS0GTQ78m0oDPIwS7/1waVfp4OqAHS8tsLZH6ombQEwzCN8c",
+	"jwlk5tdHRzP11Sis6X+jJFboVRuQj1mHrsNe4j1KMZZvgWPH2WX6nuDQokn+gt5uOpS0xfdanbagzQ7g",
+	"Wz/ZNVpZ6nyQ+1/+w8Uvp3jrDIQnRFlKOV1sZLYbClLIBmNquPPRvY6zfZkuhyMmyW0GvxMUaZXlkKsM",
+	"ecrA517vcSJZT8DFsfciNPhg9QH6U3DwZCWX3ibdMIs+Zn3UzrCebt+haza4uwgfCzOoKosqX6cIuu1a",
+	"1+b4dUVqDrRDXzW6N7x6Onvsa1QPiMwDTrUwiMi7hr0+DXGzGoj4DDDR7IybVYXpyUenlGgQcmKSjJFm",
+	"mA0IeGdNLtZuhtlWntgW7qJy3kOm1vVQUEgT6l9XFEIcNuhPmNBAlZMeDksVdvOCEx1Tt8bQQjNMQYSV",
+	"zn+1TCsxUMRuaNMbp6J91HY4F7knLD/VfmKHve3Te7MrnmyCv05E0PUutakak3zO9HKZdKX7TpsWKdRk",
+	"Oe34IpbayhCjLxowKAGvsGksLepc/720o+njk35znkVmv3j2+fBFmhoC6tPHQR57hxmwQgxSYo0R4vDs",
+	"lVgKI1QmMD9G/dn6kmFWF+fk8dBKT2SA5rCihYH+oAXCJM9WOPIdSm3VYQIcjNfoVX3Zfw33Ak6joGmK",
+	"GJqPz912UntVoa8HpodeCeWLTrbjE0Z7SS+XInPy/ECAL0YUNcGj06CzQFjiQsqy9rrF/FCX18g1ABX8",
+	"ivAU/ObAGeJ0b8XujmUtakgWmJgGaeAqqYEQAxhjNxuOwyMlqzckS1tTBmIheAlRd9FkdR8s0xaFq19x",
+	"rkCSjMch7HumhKirK84FXS+V2AFvlaEY4FAGKXGdRMV9QmEjYC8baRdizc+9/+zIg3zWT7AGA0+BnWF0",
+	"F8wCd6mqv82cnuHPXgueJDWxLaURduC4kAcgRqEXcul8POClYgFJHBrKqupRoY1X12pXg+9xNuLmr3cq",
+	"LKaedWjHXg/kKToJtZmkVpTBP9rET2C3kPZLzBYCpD5zRg4ViGxyT0EjNEIFUPA4lCGlHk7Klb0Qph/v",
+	"md5TXbmVTrrG/zUuEojBrRhGGjJAe9SmvSyNLgbXUYgYj8fMiALSNPtkuviXbSZtCmw5PQ0JZLVhpZHa",
+	"SNd0hNbB5Tjq45McwKhkKdgIu647AQStbR21dc7w5VJmA0HR+OoVW6oxkDdFCYhqFjvmH/jM8dXoxwxQ",
+	"+hlfnfmZE6+ZqkwTzx/1BSu0J5DoSKy5DWmWhIp5wUB6iiEz5Vlkm+uVx9bLzrSXYQE1aXp6ahBfr7aB",
+	"a4g9REgbv12cTpJUYa+sLwbAE3weR/B5rgaUJDNdufRnx1eHNUL1DPFw1De97G6NuGFV1zOsr2jrUtEh",
+	"RV78XmOn/SIrFz7FHqYVqe3jIdmesOG3kEOIZinkWxEXREVvBEiQFFoklQzhjTQbCDnqBvFiMybTQC/r",
+	"mWUTm9CPY+3vFEWgZIW2wLOHwnja4QC1L90dS06PyJ4uhPFwLYUxzasGxhYzuKroCOyDYx8qLHp2XgkJ",
+	"drAMFQE3mKTxVZOFEvPxc0zKyL1DZ7xAZsSGA3QmyhU5POc+ZD+l7yFwMzD1gyV6ano9XDwnRKVI20Ni",
+	"TPXLUC/mcEDoVewaUikqwG9TiSOVMG2Lty+IQ1Fs0cEQwf5ziWtnkJUkTQJZf5U97W6BSYp/iMLrE4V5",
+	"/FbG0FN1LVpDlHmss9s3avJJa7eLFS1gdSNwfkyzyXRSal0MaUBP+/kvu2fgrYTs0QzujuDPPVClkn2B",
+	"ltXah+livQv5Hik9yd05YyeKImiCO1O78kNncnXH7Zt/i7PmlfC6bFzk/I1K61ExWay5Jn8Lw+znapTx",
+	"5ppT0SD7J3LbAXENkjn3a7aOrmbVdzDqZkFpiIqgSEkpwyWtEn5ToeISo7JOIUYT6ONc5lCCqm3AbssQ",
+	"vszUrNawp16L/vUSaA7Ir6mP1WH/sildVY85UGS8LkV1HVbbq9VZD5rE7NWSmI3inH17WYKpxDkNDmhI",
+	"37aMa5Q1vuOupY24YSNb5KdySSNbP1vD2OXhOpBuKyv66xy9AS3cDuB+DOIbC/HAi2YgBe0Yw27apADd",
+	"0bJMCIFGc4agsl8f/EoKfHQBvXcPJ7h3b+qb/vqw/bmSyt27l+R5H8ymTDjyY/h5UxTzl6F3M7mwDniS",
+	"d/YDnM4PEUYrLqAp3YSe77/4yKCPUjzqF5mnjyrBeilvlu4mIGISa21NHk0VefyPcPb33RKu/XiNZxUo",
+	"oDBhSXiryl+SOTu/r800a8Hh3q5D3H2EtdNvRZ3ypjHqVDZo9r7XvMDwW5Ci0JfIYenvb7d8UxbBsPvN",
+	"ncV/iUd/eJzff/TgvxZ/uP/l/Uw8/vLr+/f514/5g68fPRAP//Dl4/viwfKrrxcP84ePHy4eP3z81Zdf",
+	"Z48eP1g8/urr/7ozmU4kgEyAToKJcfI3rLA2O3l5OjsDYBuc8FKCJQxrKgEZh2pNPMOTCK+9YnIcfvq/",
+	"wwmDOlTN8OHXiY++m6ydK+3x0dHFxcU87nK0wtfvzOkqWx+FefqV1l+e1hEUJC3gjpJzPJDCfNKQwgl+",
+	"e/Xt6zN28vJ0Pom0XZP78/vzBzC+LoXipZwcTx7hT3h61rjvR57YJse/vZtOjtaCF27t/9gIZ2QWPtkL",
+	"vloJM/dlq+Cn84dHwQH76Df/8n+HhuiU/ZliQaIAgH41J28NQ4NysOVHWfmtT9Y/rWtmeMFc5eiiT49p",
+	"1L8FZEHF8ZAh9bRhVCHvCiWiO/4pUUVwKVcgGrUqjdZOVXSYmLRkIdaGPSdt20tIQxG5wSNB/rMSZtcQ",
+	"DEExiTOohfoG3ll+Y1dl27O0Ua+lHGRSZbFwZtjniFJr/WTDiZypRAxJw1eBV96fff3zb1/+4d1kBCBo",
+	"qrEC4+t/5UXxK7uQWF0J1WohQ43PQDBN5PJHoW7aKHWwQ7NNU3SNrb9G3Zs27YCMX5VW4tehbfCAJfcB",
+	"XJGmE+g+ag9eIa1Gua95rZmP6qQxqawTPG8MIBiiA84qlH7Sv1a5EUxp1HwLw96K0pE6d6PNDj9iAG4T",
+	"mUJeviZbQ6EBMiYMrbmOe6hX3LM//jydBDJHDvHw/v0bK2JXB1i9m7ZGCfR+hYH67JM+1cXwLgwviYv4",
+	"L+QYQqmBfSMs3ff4Bhfa9tK89nK7w/UW/YTnzPhYPVzKg892KacKPSfgOmN0Xb+bTr78jPfmVAFD5QXD",
+	"llHumP4V+Wf1VukLFVqCqFZtNtzsUBCLipjFIve7wav4KFoY/Nz8NZP5tS7qXq2p02cH7u47dojj95Mq",
+	"duq5wPe6XAdqrH3RGiwgYu/O2fdxb7x1kEUuIn9BWadfphLfHkd1KqcGtjs2Tt+QlCQiVcStUPFehYqO",
+	"+28rK18KmBaJ74Wp73hze6sPM9S++3Sn1uiVanlGNWGukK75vRY86zzXh3L0j7k9bnE3gLsh2S2Ctxbj",
+	"2rV83v+lQi/v6A5sXXbv8cr5zCXR57wAOomW2wliPn12K6H+W0motYMOXZqYenqfzGqtwB982tQbkFN9",
+	"2tgREmqso4j6NmIdliaKOcXdOTvptrkaO/DONgdlT0xmeyt1vm+ps58FOgVGk9v3VtJ8L5ImInjd5MC+",
+	"TBHyVnXBS+Xq/kxFy39jZA3KkgDpYSnyCoy/JyH6a+a9XQi/S8nQI+1WJvy3lgnJeXePVNjKP+89vYcF",
+	"Q0Euf4WkVCEJz3CLDqY0+pRZbby/Y4jNwKiCXMDZQ0O2NpiSzJlKZWR/oimEwv8+P/kb+po/P/kb+way",
+	"oAf5EjO2JKYnb762gPe9cH1PKvtkd1LLOnsFvU9GejqrkRS5k8eodzqkkEekbfj2myGUbdWgLLLh28nl",
+	"xKxPVxS+rtDUybXUpyJYFFcMfVFCcf62DyVEkvAMYiK4pbhkdPa31aLJ/94WN5wuZ/EA6eQNwzN6fNtU",
+	"TpnLunEmEtphgen98J11cmW30OHD2bHQ/mHBpIeMJARXk/Jud/ez3d2+WMpKDWdaYsLE5j4Jd1ULyKb8",
+	"sQd3wEN9zv6uK/TBgqu+cqLmb1ERG5xB2mhOL4A2GBKFoMwFfrp797oLv3fP77m0bCkukINyhQ276Lh3",
+	"73cgsm7r2iGcKa1mSqw4xPizyHHzVm79pOXWL+8/+mxX81qYc5kJdiY2pTbcyGLH/qxq1c31xPKa51Qq",
+	"ShO8l//0QmMaKToS30P16SPXFPAO367lkdD1OIhS/sSfWuoFjOoEWdK/o6dNVUt452Oi0ZDqzk6DzQg+",
+	"eXMS7dW0Z1GapwT4yHT1ZHf6bIzM/oHM2+/Vr6vpmbzz0nvzvm+HpJfUqw/jJTWO0T6+//jDQRDvAiQa",
+	"+g5Vae+Z3b9XvUKarCJGtI/ZHC309hDDUR2OgzygqZ4RsR9MURJX6CCv9i+wrme7cMXdOQu1PGwtXXj+",
+	"utK8aDKbcrNqkk/B+tid8Ocxjn9nziCrl8QkXpWlAHVqKJU7fvDw0WPfBOLmMO6j227x1ePjk2++8c2a",
+	"yi30NO01t84cr0VRaN/BM//+uPDh+G9//9/5fH7nIKfU2ye7F5Qm+VNhl9NUmF298UO79ZlvUkqvoWhf",
+	"DqLuxnQZeyOF9DbJ2PX29mL5aBcLYP93caEs2mTkDTy120IT8Dj6ghH2sldM0IPWBdDQEiydZTgckyjS",
+	"ttTSQYnKpGVUkMyxjbYOf1vUVw1lIyTb0wiOLOynzI3hZd7oLZpFOu3XGCNFUVpFzHsFP33TU9Ev9Bbz",
+	"9F9d2XyzZu+adEYF1rZLTR2MPMSxx+iqGjGFUpDydl2bWxZ7K7tfWXanQ+fJ6zCjvbRTWOP0FSsJ8McD",
+	"6gES8aiMJtZ13LE6/wcvGmEqzUNhhrEv//foYvReX/sAUZJKu+i95RK3XOJaXKJLUA1HwGyw9ug3dAiK",
+	"2UHvSD6Blr8jL8nIq8roTXCr0mwpHOgaYLXdnAwJthI8+YZ5yr765zct7uAW9atb4lp83gGsyz0yzw92",
+	"/CP2Q9c2YRLE92OoiQGfwYOLO1FXNwtl/tFpS4bKt3XRW5rJC9yA/JAYE3bxUlA+bSbvS2qFbtHE1T0D",
+	"bxF8OQT3mNq3ocIqYswv4vcQNexvSzZjL1AcwgPun1a/S+Pm+7yR3/eCXmglyPsUJFaixVtHw1pcQCU8",
+	"IiUkN4y854dEhyMqqYGVzJBXlskCZM+wKofP5qfLWSHORbG3WEjtwk8gUXpnbNPJuhickuvqCgQ1FfyA",
+	"PTU8cywCZ46F/gluYpEF5Yxe7KKyBvEUoYDElEEZ1V39N/Su641kmTa5L8Uqna2LaEyZGVXhgq+4VNYF",
+	"/i3kyjsI1ZiIsEP2VJKxUN9FBpMIHpRu6kThhV7NGVRNqUutxutD5Obaa3oAG01FmyaRMyESYC6kdU3G",
+	"ZJrRp1lMverwImkKtxzUjDUiWpsEtJ/q/chk00PZhev56xo9sDP9IrZtzIokDcJIBTdRwtOQSTvOZjkk",
+	"Eddlcg49YfFyeqLz3aX4W5MsTCpuEpUt+2wIq8bgiUMBP7nkfoWgeW+33t2oYFwzpUuWjUq5zB0M0ohp",
+	"dYRPXOZPwviQjLg2FjCXqJiRz1Lu5/6IagM2Y02gQpoKTJ1B88PGtX6CGocXWs1QHhHK0eZ9ruqGZw1j",
+	"3Eua8NKAliuhZp49zRY63/kaypNAMkPSRiuQ4TeoEvbuqK5ZM6TCeIkNRl86UtXBVm2XLV6Wght75etn",
+	"3JUTz3j6LI79apXYqYvrJEABvFwyOuE/JyN1J6Eu2JpDNY1KEaChLg+FwYXALL2c1i4aWkG3Y/ZG3WN2",
+	"zb988PCXh19+Ff58+OVXA3cdzONTbfb1P81A8JmGGaME+v2GMrQvwBp5xx96Ky+3Q9OJzLfJPORim5B+",
+	"gwcJMoc7lpV8N1i+YKCi1XNh3hZ+ZR3HcYj1XQhj17JsleL/IKlTrZML2I9EORfYJb1k3sEfSsA/qV9r",
+	"58LI5Q4u1ZovfFi4nREiF6Vb780+7FCEKd262VQh6Pkhrc+fD96lWJdmLuZdB/t81ZTlLgRf1vnXtR4T",
+	"/hrxEqC3QBwR1uOFjJHJXqboB5PjfSRZrJG+6DILyDOde+WjSlvuE5G2Omj5eMIXvp2nkTm1rqeET/Kq",
+	"LLVBpVXMtux8lLgmBh3Y48G8EXmQjL04lnGXravy6Df8DybZfde4pwMOeOYyYVzCtJSOMvV9Wlpu+v8u",
+	"pECG42zA41wv2VNqDmppn7TBRLkbWimdI+3VlHGshFWX5FrEqnvIriyd87ob+HyuMfAcx/SxWdLN2UkS",
+	"Wmhg+6PGddrqF6J0tl7Of/f62EZrEwHP3NroarUmcOin2BvJx6T5fawrdqSAiUeVNiyc7nuv/fIrEznT",
+	"SkzD2Gt/+ZBDbjyyD7dfGK6ymq0rsfWZNV5jecH2IinDhutNX1Mk3grS138MR8Hnn0FIsjWXPveBx4FU",
+	"rCx4Jui10bvdO/iY+hJIlhVCrdyarYy+sA155NI6zJCc3g9dBy/7scOWJtVfEcmOf4RcrLUVPUzT3fg5",
+	"2CTTNi1UvJLwG6To1JH68OILTD67lIon7IpnD+H09Bcz9c+3fpHaDvEwWfcT9tr1qxLa8+a4DfDdg/Wz",
+	"qFbwnrnjksDd5TWcZRhZ6Wk94xhHTwMM1WsbRN5hqs3Of0LFmej+mdEiLr/sgHpaKVUe716m03HX3p59",
+	"+pAo6ipPiZdGp7Z/NtrU2hBRF7vjwo5TxwXuEN5cMrzDrtW+033r2vVhRf+0nKnh3ewPRi0V1fGhsUBe",
+	"u1bUzdoSzE64z9lVbFASjzHUFqjbxD9C4mxeEVh5yo5+IkTlqupqaItdNGV/rmP8YaNzunBCSpyp94nr",
+	"5t82wurKZMJG9RNUDlMK+D/+BhRhp1HJQirsVJU5x5ij9vvaTlkhuBWW5gq587XjhZ2zH8Ftxz9cSNKj",
+	"1UnLrINsV2tR5J20cxFZkkABP+IdVuwayeLRw/t+4KQs+gOoUExUJ2y0QBrnIGtvSSSGfyDL7L9LJpqz",
+	"DqbxkvHnr36reNqa1kSMFJeorFlw5wS9lZxGG74dXfmvIZgrpFz5NJcx6KTWAvV356p265X2iS0ocTo6",
+	"ckbIRdpKXHGbZeTfIcuISxNHUqoq8G4/oqCbffbw19TiRq8qGpOZtq0nVNUjmAD65zIz+gQL2/oHkt1Z",
+	"Jza9BFq+6y8DyoZXXqToKwu0KqQSs41WqYJ8P+LX5/gx1ZsSMQ10xpRYQ327T9QW/B2w2vOMeYFeF7/z",
+	"T+PVd70HSnu1RpR14kn4TPTfnIeSGyczWXKa4rfUz0e/tf70sXGhpSClU/zn0YKr3m8h7WXzu11XLtcX",
+	"0bRUe2/vsaQWN3osX+hc0Lht20gqmajSufAlAvunsTb3pMW7sDVNu45VN+PVau1YVTKnkwrYuuOMZ3SK",
+	"ZuT7k54wil7GVqGk+LlgvDCC55AsWCimF7Dohkhwkdwy2Pbat5WMWmmNawNXaXQmrIUkz/45eQi00K7R",
+	"Qg7hCQFHgOtZmNVsyc0VgSX+sh9Q18nwV4NbB5RINQD1uOn3bWB38ngbybpFVICuKBpKnToxAMxYnKCT",
+	"hHzP+xcmuer2VeXMyU3CY+cpfT2TG7SNKa60FZlWuU0OVnDrZoeOLTSK12JhBdFJSZ1UHHjgVv6BWzLL",
+	"dgr4R0YPmGIY4POhoskw8l/qksm9sTOtrFC2snVdZW80F3lqDWCfHJ7rhdjWc+llNHZtlXeaVVYcGnkI",
+	"S9H4r4KU34j83EV6FhgusTjMiM69FNdHZQuIBhH7AHkdWkXYjXUrA4BI2yCaCMe7ridr4lunyxLOn5tV",
+	"qu43hKbX1PrE/blp2ycub+2GOVmuhY09JjzkF0HVxlXO1twyDwfb8Lfe2WLlEzr3YYbDOLMSojf2UT4c",
+	"S7R1x0fgwCHteYRHx791zjqHo0O/SaIbJIIDuzC04JSM+klIlJd9Bx8MZbg5rXpbRo/Eq0ZGpb+PLrh0",
+	"oKaiG3PGl06Yg+rxv3LpQvKHOkKGfJQZjuAZih8HqT9OuOaz4RIIISM77H5fcwxTfafNqFjwRmXsNIOF",
+	"sUo5GWoGwXmrZcxPz4nhVnq+lZ5vpedb6flWer6Vnm+l51vp+X1Lzx8rSnMW+HSI6Ull52eTz1LC/4xM",
+	"Ux/SltQI/bXIj48EENF9huRh25J1RvDNUSOSJF8kfy5Xhoc0D5lWSmQhcp6zC7GwOnvrvW9oQOsTKjR+",
+	"VOEhsyLmWbsHtBxOXHADmsLvFBnBXXjozNm3PFvXKW8ybowUlnEGQceFvyj+m1xDgAML5dDNHXi070TM",
+	"LrhYcssoEr7+jKEAhRRBgAGR2TKnNbOFvih2NK45b+VhQPOEfwxJLzgIy6qySeYQ4UxalhXaoh9+JmKT",
+	"sGNKiNy2bcNth6H+C+414vsJ7d+IF1zsyOu0365+rgNqQITULarZd3LqeA0lXZ0yrqjMn602Hn3XKKf5",
+	"GbsOPSDG2+EPF9Jla6D3l16assDM+7Sj9EV85m4dWD71mjG3/g/dO+u1vyL829bzSGliNmIZOKjGlN7c",
+	"WU7wAhckCzGcmYgynp59e/ID835ncMR9PJFUeC3UWaXbpQhC3RPKOo8AQoNHD9nrP56EqOgQOdVu+4Wv",
+	"Ssis2xXirk/oJlROL8mQ2U0owKBP7MaDxi7zPMyn2ZaFYFY4y77F1s8gtRIwf4q0ZM5UCY3emeDFU4+b",
+	"A9fBX2Fyn0fuVxjt12lLj+jRtuFleKaHtXLLOPLR9sXx65IXVvw6xEVpvA0vU6y9flvsT3ADu3aEG3j9",
+	"1DZd0nAaLihPWPl7TmGTjgXvk9khCku9tuGOLVx69CEqT43TbFhvKLpElx06maRyMnYDtSc1gKOS4whe",
+	"hD1hr6jfx82DgxD5I9a8Jj6ZwItufjbPNLCt0i6wns81qCEgPnl68exPgbDzKhMYB+opbn92GhqnfcHk",
+	"0nJrxWZx+JKJWaMvVBJVK9h/BX2cG+JZtLix+cS2M89bBxgvBYuNY7s1tnBEz3kjjL9v7jvEIWMQmGc9",
+	"KX1vh61dlp810+xuedotT4tOY+eyx1CIFBOZX42nmZ2p1DA7+3YrsgrmjQ/pF/YusCzE6Na1rM25WFSr",
+	"Fbxa+5ZTgFrgeD4150fgcrTcqydM3EccNHj9dr3ua6w7XJ9xRGFoX2jDVkZX5V3cDq52aJTblFztgiEe",
+	"tNWbqiAcUhLrm+WhlH+k73mBRnJUYQzbkoKSI7aY+Fu0/TuhhV1wy2h/Rc4qBdV9kuHWWzU+LSQNfbZV",
+	"DQfeWyCG1ptYnZ93DPcPu0yb0DgflMLM3FbRgWoXW6KkSHRyb8OL/01uhJdGn8tcED30GGw/pU/DEA5f",
+	"DCZiWXgzdKqFh6uhzU9f8YuIA92Y0HipRLRwJ9av10RpdRAjjeZ5xi0qNXzq3fcsS7rtacLyWReoTGS5",
+	"gzfJ/KBQieOOEinbiSX9hFjD3lqqDvKJJI498ZGbLWzcGiN/L8bIJ+HwWcaxamfncEbpsEewKX7htirJ",
+	"pY5QrTkccxMdiJfU8majt7vDt50IG52rd4ISRcl4MCtmWllnqsy9URydMDq5eToOhsG1ZFiUehqapP2A",
+	"Em46fqg3imMO39o1IylSLUXC6eo7IYLEZqvVitLvxJu9FOKN8q2kYpWSDufayMzoGYWxwXUNHH1OLTd8",
+	"x5ZYB0Czfwmj2aJy8Zi+KLC3LaJHI0zD9PKN4g6TMjj2XIJAB8MFq3ftpUt0V2MhnZBoJZSw0s7S2tnv",
+	"6Ssm7JTt/Gnwf9+5SYn8YXMNBdhlPgj56TOAm+M14XP9h5q9Xdg/mIPbRqpZksgwGRL5BHdpi32htKsJ",
+	"6G7jFel3/Y0CYdpphoyeu6uRQ9cRqXcW6XR0qKa1ER1/pbDWn1NpDVZ6Bk9GvoLfV9Ktq8U805ujkO7g",
+	"aKXr1AdHORcbrfBbfsRLeWRLkR2dPzggH1yDX7EEu7q9uX8/bkQxHcBpqTcenXC6ez9wLwsFMu/BxD/A",
+	"e9CyRe3jge2U2dpvMsTfYmnxXLTr+06ZM5XKKKmPqzM3cseen/wN69w+P/kb69W0Tc05f6NSSXVeUtPo",
+	"/Bx2salBavyl45mw2Ii0ZcF3oRTvN0MAbtXV6+7+26bWSeyZv/NQqwP70X85Wia2PHNQ0hR54o5dCOOf",
+	"UM6JvC+YOV3Oug/nfiXT4Rk9vm0rN+GoVDc41Fk7uXE3zSDFGeyH76wTadBCh5cKSj3qZuwhIwnB1Qr2",
+	"3e7uZ7u7iWyLpYYzLXkBqc1qVhmugxaQXlIrdgFcbxyI0YwrYH/XFXoXwoVaOVHzN21QFVhfONJGc8pl",
+	"Nwd9Iah4l5/u3r3uwu/d83suLVuKC+SgXGHDLjru3Zv/Tt3ZbpMT/f6TE/H6RFaqzr564HT2juVeCdGX",
+	"+DlYrtz1VJucGZHRzDUDbxfQiwqb962SkO0dygQageF0FtzUwcrPLQlGPjf5RkJYpq2yTIj8+I2atSBp",
+	"vNq/aP5Lz9w31f37jwS7f7fbh/QWEeft90VRFT+hqYl9w95M3kx6Ixmx0bUDOjbPK3R/oV4Hh/2/6nF/",
+	"NL2tAy0MKlfWvCwxG5+tlkuZSUI5JtvnK92JMGrc1Y3wRVaYdMH9XVqKzKJdYdyXLkgJ3f37/bTZwoO1",
+	"4zvk8mFrKP1+Bex9fKq/YTfHA/eO/W56yzI+Asv46EzjNuDhNmPne8vYGdH0C+3Yd3AYrilJ2VJkkHQ7",
+	"pXcakJG8384et9NvIXUuatnb/A5dAFoFh33clo9RQ78grP0il8D08B0MzdAZi3qH1xb4eqE1gHL5Aies",
+	"jeuwDmCLrXc4cIGFYIVYOlYpehRPm6KLwC/p9c1h0pCN2/qXmH+UZ9oYgW91GuDDO4699thvezvsFTw+",
+	"8av/4/pptNQiJkGuTrNA8WmKCsT0vqsKLznEfcx44sCdegsfAgzOaEb8A2uLT/F3ifUM4ZhIFTVMuH74",
+	"cudUi3Et/DjoIQkcxycBjaoShO8s01WRo0JjIRh3zshF5Uss1RGwh+u8cFlURsx8vOulF2oEt1oh64i+",
+	"XdpeGNVk3WxELrkTxa5Vz0Ra1rgvzhkm+K5ThTd1s6QvE0bMI1S4NJXqDZFEh9uqGfluj3cbTPCHIQfC",
+	"6eQCNm3mhcJkHcxEUolwGjpPbhyL5Cw/oMhZXgG+UQGauYoXkdsPxkGAF1lOVEcR2W2x0Keo4Lap3YyM",
+	"l7vKCKok1uHHiWwQHX1hy+IZY7iLjqsphG/P7O2ZvT2zn9CZ7YkAhFp6efev+3h/fz9vuY/v5vgxX3uf",
+	"iDf2rQXhkwjv96ww5QSafADAG9B5VrkQTPjHbc60uobLKPloYUp3AE9kFWVy/wn2SP7yVsD/f4YXEiZ0",
+	"8Q+7yhST48naufL46KjQGS/W2rqjybtp/M12PgJX5CsawcNSGnnOnZi8+/nd/z8A3rVvxK6DAQA=",
 }
 
 // GetSwagger returns the Swagger specification corresponding to the generated code
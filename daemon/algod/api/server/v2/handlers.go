@@ -30,20 +30,26 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/catchup"
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklearray"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	model "github.com/algorand/go-algorand/daemon/algod/api/spec/v2"
+	"github.com/algorand/go-algorand/data/abi"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/blockstream"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
 	"github.com/algorand/go-codec/codec"
@@ -51,6 +57,7 @@ import (
 
 const maxTealSourceBytes = 1e5
 const maxTealDryrunBytes = 1e5
+const maxContractDescriptionBytes = 1e6
 
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
@@ -63,6 +70,7 @@ type Handlers struct {
 type LedgerForAPI interface {
 	LookupAccount(round basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, basics.MicroAlgos, error)
 	LookupLatest(addr basics.Address) (basics.AccountData, basics.Round, basics.MicroAlgos, error)
+	LookupAccountWithResources(rnd basics.Round, addr basics.Address) (basics.AccountData, basics.MicroAlgos, error)
 	ConsensusParams(r basics.Round) (config.ConsensusParams, error)
 	Latest() basics.Round
 	LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error)
@@ -74,6 +82,9 @@ type LedgerForAPI interface {
 	GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error)
 	EncodedBlockCert(rnd basics.Round) (blk []byte, cert []byte, err error)
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
+	LookupKv(rnd basics.Round, key string) ([]byte, error)
+	LookupKeysByPrefix(rnd basics.Round, prefix string, maxKeyNum uint64) ([]string, error)
+	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -83,10 +94,11 @@ type NodeInterface interface {
 	GenesisID() string
 	GenesisHash() crypto.Digest
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
+	Simulate(txgroup []transactions.SignedTxn) (simulation.Result, error)
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
 	SuggestedFee() basics.MicroAlgos
-	StartCatchup(catchpoint string) error
+	StartCatchup(catchpoint string, source string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
@@ -94,6 +106,13 @@ type NodeInterface interface {
 	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
 	RemoveParticipationKey(account.ParticipationID) error
 	AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error
+	BlockStream() *blockstream.Hub
+	GetPeerBans() []network.PeerBan
+	ClearPeerBans(address string) int
+	GetPeerInfo() []network.PeerInfo
+	DisconnectPeer(address string) int
+	AddPriorityPeer(host string) error
+	AgreementTimeline() []agreement.RoundTimeline
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -271,6 +290,114 @@ func (v2 *Handlers) ShutdownNode(ctx echo.Context, params private.ShutdownNodePa
 	return ctx.String(http.StatusNotImplemented, "Endpoint not implemented.")
 }
 
+// GetPeers lists the connections to the gossip peers.
+// (GET /v2/peers)
+func (v2 *Handlers) GetPeers(ctx echo.Context) error {
+	now := time.Now()
+	response := private.PeersResponse{Peers: []private.PeerStatus{}}
+	for _, info := range v2.Node.GetPeerInfo() {
+		status := private.PeerStatus{
+			Address:  info.Address,
+			Role:     info.Role,
+			Outgoing: info.Outgoing,
+			Version:  info.Version,
+			Uptime:   uint64(now.Sub(info.ConnectedSince).Seconds()),
+			Traffic:  make([]private.PeerTagTraffic, 0, len(info.Traffic)),
+		}
+		if info.LastPingRoundTripTime > 0 {
+			roundTripTime := uint64(info.LastPingRoundTripTime.Nanoseconds())
+			status.LastPingRoundTripTime = &roundTripTime
+		}
+		for _, traffic := range info.Traffic {
+			status.Traffic = append(status.Traffic, private.PeerTagTraffic{
+				Tag:      string(traffic.Tag),
+				BytesIn:  traffic.Received,
+				BytesOut: traffic.Sent,
+			})
+		}
+		response.Peers = append(response.Peers, status)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// DisconnectPeer closes the connections to a gossip peer.
+// (DELETE /v2/peers)
+func (v2 *Handlers) DisconnectPeer(ctx echo.Context, params private.DisconnectPeerParams) error {
+	if v2.Node.DisconnectPeer(params.Address) == 0 {
+		return notFound(ctx, errors.New(errPeerNotConnected), errPeerNotConnected, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// AddPriorityPeer gives priority to the incoming connections from a host.
+// (POST /v2/peers/priority)
+func (v2 *Handlers) AddPriorityPeer(ctx echo.Context, params private.AddPriorityPeerParams) error {
+	err := v2.Node.AddPriorityPeer(params.Address)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetPeerBans lists the gossip peers banned for misbehaving.
+// (GET /v2/peers/bans)
+func (v2 *Handlers) GetPeerBans(ctx echo.Context) error {
+	response := private.PeerBansResponse{Bans: []private.PeerBan{}}
+	for _, ban := range v2.Node.GetPeerBans() {
+		response.Bans = append(response.Bans, private.PeerBan{
+			Address: ban.Address,
+			Reason:  ban.Reason,
+			Expires: uint64(ban.Expires.Unix()),
+		})
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// ClearPeerBans lifts the ban of a gossip peer, or all the bans if no address is given.
+// (DELETE /v2/peers/bans)
+func (v2 *Handlers) ClearPeerBans(ctx echo.Context, params private.ClearPeerBansParams) error {
+	address := ""
+	if params.Address != nil {
+		address = *params.Address
+	}
+	if v2.Node.ClearPeerBans(address) == 0 && address != "" {
+		return notFound(ctx, errors.New(errPeerNotBanned), errPeerNotBanned, v2.Log)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// GetAgreementTimeline returns the agreement timelines of the recent rounds.
+// (GET /v2/agreement/timeline)
+func (v2 *Handlers) GetAgreementTimeline(ctx echo.Context, params private.GetAgreementTimelineParams) error {
+	response := private.AgreementTimelineResponse{Rounds: []private.AgreementRoundTimeline{}}
+	for _, rt := range v2.Node.AgreementTimeline() {
+		if params.Round != nil && uint64(rt.Round) != *params.Round {
+			continue
+		}
+		timeline := private.AgreementRoundTimeline{
+			Round:  uint64(rt.Round),
+			Events: make([]private.AgreementTimelineEvent, 0, len(rt.Events)),
+		}
+		for _, e := range rt.Events {
+			timeline.Events = append(timeline.Events, private.AgreementTimelineEvent{
+				Time:      uint64(e.Time.UnixNano()),
+				Type:      e.Type.String(),
+				Period:    e.Period,
+				Step:      e.Step,
+				Proposal:  strOrNil(e.Proposal),
+				Sender:    strOrNil(e.Sender),
+				Weight:    numOrNil(e.Weight),
+				Threshold: numOrNil(e.Threshold),
+			})
+		}
+		response.Rounds = append(response.Rounds, timeline)
+	}
+	if params.Round != nil && len(response.Rounds) == 0 {
+		return notFound(ctx, errors.New(errRoundNotInTimeline), errRoundNotInTimeline, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // AccountInformation gets account information for a given account.
 // (GET /v2/accounts/{address})
 func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params generated.AccountInformationParams) error {
@@ -284,24 +411,28 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
 	}
 
+	myLedger := v2.Node.LedgerForAPI()
+	rnd, err := lookupRound(myLedger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
 	// should we skip fetching apps and assets?
 	if params.Exclude != nil {
 		switch *params.Exclude {
 		case "all":
-			return v2.basicAccountInformation(ctx, addr, handle, contentType)
+			return v2.basicAccountInformation(ctx, addr, rnd, handle, contentType)
 		case "none", "":
 		default:
 			return badRequest(ctx, err, errFailedToParseExclude, v2.Log)
 		}
 	}
 
-	myLedger := v2.Node.LedgerForAPI()
-
 	// count total # of resources, if max limit is set
 	if maxResults := v2.Node.Config().MaxAPIResourcesPerAccount; maxResults != 0 {
-		record, _, _, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+		record, _, _, err := myLedger.LookupAccount(rnd, addr)
 		if err != nil {
-			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+			return v2.lookupError(ctx, err)
 		}
 		totalResults := record.TotalAssets + record.TotalAssetParams + record.TotalAppLocalStates + record.TotalAppParams
 		if totalResults > maxResults {
@@ -320,9 +451,17 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 		}
 	}
 
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupLatest(addr)
+	var record basics.AccountData
+	var lastRound basics.Round
+	var amountWithoutPendingRewards basics.MicroAlgos
+	if params.Round == nil {
+		record, lastRound, amountWithoutPendingRewards, err = myLedger.LookupLatest(addr)
+	} else {
+		lastRound = rnd
+		record, amountWithoutPendingRewards, err = myLedger.LookupAccountWithResources(rnd, addr)
+	}
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
 	}
 
 	// check against configured total limit on assets/apps
@@ -349,11 +488,15 @@ func (v2 *Handlers) AccountInformation(ctx echo.Context, address string, params
 }
 
 // basicAccountInformation handles the case when no resources (assets or apps) are requested.
-func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, handle codec.Handle, contentType string) error {
+func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Address, rnd basics.Round, handle codec.Handle, contentType string) error {
 	myLedger := v2.Node.LedgerForAPI()
-	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(myLedger.Latest(), addr)
+	record, lastRound, amountWithoutPendingRewards, err := myLedger.LookupAccount(rnd, addr)
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
+	}
+	if rnd < lastRound {
+		// report the state as of the requested round, even if it is also valid for later rounds.
+		lastRound = rnd
 	}
 
 	if handle == protocol.CodecHandle {
@@ -410,12 +553,48 @@ func (v2 *Handlers) basicAccountInformation(ctx echo.Context, addr basics.Addres
 			NumUint:      record.TotalAppSchema.NumUint,
 		},
 		AppsTotalExtraPages: numOrNil(uint64(record.TotalExtraAppPages)),
+		TotalBoxes:          numOrNil(record.TotalBoxes),
+		TotalBoxBytes:       numOrNil(record.TotalBoxBytes),
 		MinBalance:          record.MinBalance(&consensus).Raw,
 	}
 	response := generated.AccountResponse(account)
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// lookupRound returns the round whose state a request should be answered with:
+// the requested round if there is one, or else the latest round of the ledger.
+func lookupRound(ledger LedgerForAPI, requested *uint64) (basics.Round, error) {
+	latest := ledger.Latest()
+	if requested == nil {
+		return latest, nil
+	}
+	if basics.Round(*requested) > latest {
+		return 0, fmt.Errorf(errRoundAfterLatest, *requested, latest)
+	}
+	return basics.Round(*requested), nil
+}
+
+// lookupError reports a failed account lookup, telling apart the rounds for which
+// the ledger no longer has the account states from other failures.
+func (v2 *Handlers) lookupError(ctx echo.Context, err error) error {
+	var roundErr *ledger.RoundOffsetError
+	if errors.As(err, &roundErr) {
+		return badRequest(ctx, err, errRoundNotAvailable, v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
+// blockError reports a failed block lookup, telling apart the rounds for which
+// the ledger does not have the block, such as those a non-archival node pruned,
+// from other failures.
+func (v2 *Handlers) blockError(ctx echo.Context, err error) error {
+	var noEntry ledgercore.ErrNoEntry
+	if errors.As(err, &noEntry) {
+		return notFound(ctx, err, errBlockNotAvailable, v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
 // AccountAssetInformation gets account information about a given asset.
 // (GET /v2/accounts/{address}/assets/{asset-id})
 func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, assetID uint64, params generated.AccountAssetInformationParams) error {
@@ -431,10 +610,13 @@ func (v2 *Handlers) AccountAssetInformation(ctx echo.Context, address string, as
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := lookupRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 	record, err := ledger.LookupAsset(lastRound, addr, basics.AssetIndex(assetID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
 	}
 
 	if record.AssetParams == nil && record.AssetHolding == nil {
@@ -484,10 +666,13 @@ func (v2 *Handlers) AccountApplicationInformation(ctx echo.Context, address stri
 
 	ledger := v2.Node.LedgerForAPI()
 
-	lastRound := ledger.Latest()
+	lastRound, err := lookupRound(ledger, params.Round)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
 	record, err := ledger.LookupApplication(lastRound, addr, basics.AppIndex(applicationID))
 	if err != nil {
-		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		return v2.lookupError(ctx, err)
 	}
 
 	if record.AppParams == nil && record.AppLocalState == nil {
@@ -566,6 +751,146 @@ func (v2 *Handlers) GetBlock(ctx echo.Context, round uint64, params generated.Ge
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
+// GetBlockMethodCalls decodes the application calls of a block as calls to the
+// methods of an ARC-4 contract.
+// (POST /v2/blocks/{round}/method-calls)
+func (v2 *Handlers) GetBlockMethodCalls(ctx echo.Context, round uint64, params generated.GetBlockMethodCallsParams) error {
+	req := ctx.Request()
+	buf := new(bytes.Buffer)
+	req.Body = http.MaxBytesReader(nil, req.Body, maxContractDescriptionBytes)
+	_, err := buf.ReadFrom(req.Body)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	contract, err := abi.ParseContract(buf.Bytes())
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseContract, v2.Log)
+	}
+
+	var appID basics.AppIndex
+	if params.AppId != nil {
+		appID = basics.AppIndex(*params.AppId)
+	} else {
+		genesisHash := v2.Node.GenesisHash()
+		contractAppID, ok := contract.AppID(base64.StdEncoding.EncodeToString(genesisHash[:]))
+		if !ok {
+			return badRequest(ctx, nil, errContractNotDeployed, v2.Log)
+		}
+		appID = basics.AppIndex(contractAppID)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	if latest := ledger.Latest(); basics.Round(round) > latest {
+		return notFound(ctx, nil, fmt.Sprintf(errRoundAfterLatest, round, latest), v2.Log)
+	}
+	block, err := ledger.Block(basics.Round(round))
+	if err != nil {
+		return v2.blockError(ctx, err)
+	}
+	payset, err := block.DecodePaysetFlat()
+	if err != nil {
+		return internalError(ctx, err, errFailedToParseBlock, v2.Log)
+	}
+
+	response := generated.BlockMethodCallsResponse{
+		Round: round,
+		Calls: make([]generated.MethodCall, 0),
+	}
+	for _, stxn := range payset {
+		if stxn.Txn.Type != protocol.ApplicationCallTx {
+			continue
+		}
+		calledApp := stxn.Txn.ApplicationID
+		if calledApp == 0 {
+			calledApp = stxn.ApplyData.ApplicationID
+		}
+		if calledApp != appID {
+			continue
+		}
+		response.Calls = append(response.Calls, methodCallToGenerated(contract, stxn, appID))
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetLedgerStateDelta returns the state delta applied by the block of the given round.
+// (GET /v2/deltas/{round})
+func (v2 *Handlers) GetLedgerStateDelta(ctx echo.Context, round uint64, params generated.GetLedgerStateDeltaParams) error {
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	ledger := v2.Node.LedgerForAPI()
+	if latest := ledger.Latest(); basics.Round(round) > latest {
+		return badRequest(ctx, nil, fmt.Sprintf(errRoundAfterLatest, round, latest), v2.Log)
+	}
+	delta, err := ledger.GetStateDeltaForRound(basics.Round(round))
+	if err != nil {
+		return notFound(ctx, err, errStateDeltaNotAvailable, v2.Log)
+	}
+
+	data, err := encode(handle, convertStateDelta(delta))
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
+// GetCompactCert returns the compact certificate covering the given round, along with the block headers
+// linking the block header of the round to the certified one.
+// (GET /v2/compactcert/{round})
+func (v2 *Handlers) GetCompactCert(ctx echo.Context, round uint64) error {
+	ledger := v2.Node.LedgerForAPI()
+	if latest := ledger.Latest(); basics.Round(round) > latest {
+		// the round is not committed yet, so neither is a compact certificate covering it.
+		return notFound(ctx, nil, fmt.Sprintf(errRoundAfterLatest, round, latest), v2.Log)
+	}
+	hdr, err := ledger.BlockHdr(basics.Round(round))
+	if err != nil {
+		return notFound(ctx, err, errCompactCertNotAvailable, v2.Log)
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+	if proto.CompactCertRounds == 0 {
+		return notFound(ctx, nil, errCompactCertsNotEnabled, v2.Log)
+	}
+
+	// the compact certificate signs the block header of the last round of its interval.
+	interval := basics.Round(proto.CompactCertRounds)
+	certRound := (hdr.Round + interval - 1) / interval * interval
+	if certRound == 0 {
+		return notFound(ctx, nil, errCompactCertNotAvailable, v2.Log)
+	}
+	cert, confirmedRound, err := findCompactCert(ledger, certRound)
+	if err != nil {
+		return notFound(ctx, err, errCompactCertNotAvailable, v2.Log)
+	}
+	votersHdr, err := ledger.BlockHdr(certRound - interval)
+	if err != nil {
+		return notFound(ctx, err, errCompactCertNotAvailable, v2.Log)
+	}
+	headers := make([][]byte, 0, certRound-hdr.Round+1)
+	for rnd := hdr.Round; rnd <= certRound; rnd++ {
+		if rnd != hdr.Round {
+			hdr, err = ledger.BlockHdr(rnd)
+			if err != nil {
+				return notFound(ctx, err, errCompactCertNotAvailable, v2.Log)
+			}
+		}
+		headers = append(headers, protocol.Encode(&hdr))
+	}
+
+	response := generated.CompactCertResponse{
+		CertRound:      uint64(certRound),
+		FirstRound:     uint64(certRound - interval + 1),
+		ConfirmedRound: uint64(confirmedRound),
+		Cert:           protocol.Encode(&cert),
+		VotersHeader:   protocol.Encode(&votersHdr),
+		Headers:        headers,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetProof generates a Merkle proof for a transaction in a block.
 // (GET /v2/blocks/{round}/transactions/{txid}/proof)
 func (v2 *Handlers) GetProof(ctx echo.Context, round uint64, txid string, params generated.GetProofParams) error {
@@ -784,6 +1109,110 @@ func (v2 *Handlers) RawTransaction(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, generated.PostTransactionsResponse{TxId: txid.String()})
 }
 
+type preEncodedSimulateTxnResult struct {
+	Txn              preEncodedTxInfo `codec:"txn-result"`
+	MissingSignature *bool            `codec:"missing-signature,omitempty"`
+}
+
+type preEncodedSimulateResponse struct {
+	LastRound    uint64                        `codec:"last-round"`
+	TxnResults   []preEncodedSimulateTxnResult `codec:"txn-results"`
+	WouldSucceed bool                          `codec:"would-succeed"`
+	// failed-at is not omitempty, since the codec would also omit a pointer to index 0
+	FailedAt       *uint64            `codec:"failed-at"`
+	FailureMessage *string            `codec:"failure-message,omitempty"`
+	StateDelta     *encodedStateDelta `codec:"state-delta,omitempty"`
+}
+
+func convertSimulationResult(result simulation.Result) preEncodedSimulateResponse {
+	response := preEncodedSimulateResponse{
+		LastRound:    uint64(result.Round - 1),
+		TxnResults:   make([]preEncodedSimulateTxnResult, len(result.TxnResults)),
+		WouldSucceed: result.WouldSucceed,
+	}
+	for i := range result.TxnResults {
+		txnResult := &result.TxnResults[i]
+		if result.WouldSucceed {
+			response.TxnResults[i].Txn = convertInnerTxn(&txnResult.Txn)
+		} else {
+			// the group was not applied, so there is no ApplyData to report
+			response.TxnResults[i].Txn = preEncodedTxInfo{Txn: txnResult.Txn.SignedTxn}
+		}
+		if txnResult.MissingSignature {
+			missing := true
+			response.TxnResults[i].MissingSignature = &missing
+		}
+	}
+	if result.FailedAt >= 0 {
+		failedAt := uint64(result.FailedAt)
+		response.FailedAt = &failedAt
+	}
+	response.FailureMessage = strOrNil(result.FailureMessage)
+	if result.WouldSucceed {
+		delta := convertStateDelta(result.Delta)
+		response.StateDelta = &delta
+	}
+	return response
+}
+
+// SimulateTransaction simulates broadcasting a raw transaction group to the network,
+// returning the effects it would have on the ledger if it were committed in the next round.
+// (POST /v2/transactions/simulate)
+func (v2 *Handlers) SimulateTransaction(ctx echo.Context, params generated.SimulateTransactionParams) error {
+	if !v2.Node.Config().EnableDeveloperAPI {
+		return ctx.String(http.StatusNotFound, "/transactions/simulate was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
+	}
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("SimulateTransaction failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+	proto := config.Consensus[stat.LastVersion]
+
+	var txgroup []transactions.SignedTxn
+	dec := protocol.NewDecoder(ctx.Request().Body)
+	for {
+		var st transactions.SignedTxn
+		err := dec.Decode(&st)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		txgroup = append(txgroup, st)
+
+		if len(txgroup) > proto.MaxTxGroupSize {
+			err := fmt.Errorf("max group size is %d", proto.MaxTxGroupSize)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	if len(txgroup) == 0 {
+		err := errors.New("empty txgroup")
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	result, err := v2.Node.Simulate(txgroup)
+	if err != nil {
+		return internalError(ctx, err, errFailedToSimulate, v2.Log)
+	}
+
+	handle, contentType, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	data, err := encode(handle, convertSimulationResult(result))
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
 // TealDryrun takes transactions and additional simulated ledger state and returns debugging information.
 // (POST /v2/teal/dryrun)
 func (v2 *Handlers) TealDryrun(ctx echo.Context) error {
@@ -1034,15 +1463,21 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 }
 
 // startCatchup Given a catchpoint, it starts catching up to this catchpoint
-func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
+func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, source string) error {
 	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
 	}
+	if source != "" {
+		err = catchup.ValidateCatchpointSource(source)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseCatchpointSource, v2.Log)
+		}
+	}
 
 	// Select 200/201, or return an error
 	var code int
-	err = v2.Node.StartCatchup(catchpoint)
+	err = v2.Node.StartCatchup(catchpoint, source)
 	switch err.(type) {
 	case nil:
 		code = http.StatusCreated
@@ -1111,6 +1546,62 @@ func (v2 *Handlers) GetApplicationByID(ctx echo.Context, applicationID uint64) e
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetApplicationBoxes returns the box names of an application
+// (GET /v2/applications/{application-id}/boxes)
+func (v2 *Handlers) GetApplicationBoxes(ctx echo.Context, applicationID uint64, params generated.GetApplicationBoxesParams) error {
+	appIdx := basics.AppIndex(applicationID)
+	ledger := v2.Node.LedgerForAPI()
+	lastRound := ledger.Latest()
+
+	var maxBoxes uint64
+	if params.Max != nil {
+		maxBoxes = *params.Max
+	}
+
+	keys, err := ledger.LookupKeysByPrefix(lastRound, ledgercore.MakeBoxKeyPrefix(appIdx), maxBoxes)
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+
+	boxes := make([]generated.BoxDescriptor, 0, len(keys))
+	for _, key := range keys {
+		_, name, err := ledgercore.SplitBoxKey(key)
+		if err != nil {
+			return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+		}
+		boxes = append(boxes, generated.BoxDescriptor{Name: []byte(name)})
+	}
+	response := generated.BoxesResponse{Boxes: boxes}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetApplicationBoxByName returns the value of an application's box
+// (GET /v2/applications/{application-id}/box)
+func (v2 *Handlers) GetApplicationBoxByName(ctx echo.Context, applicationID uint64, params generated.GetApplicationBoxByNameParams) error {
+	appIdx := basics.AppIndex(applicationID)
+	ledger := v2.Node.LedgerForAPI()
+	lastRound := ledger.Latest()
+
+	name, err := parseBoxName(params.Name)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseBoxName, v2.Log)
+	}
+
+	value, err := ledger.LookupKv(lastRound, ledgercore.MakeBoxKey(appIdx, string(name)))
+	if err != nil {
+		return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+	}
+	if value == nil {
+		return notFound(ctx, errors.New(errBoxDoesNotExist), errBoxDoesNotExist, v2.Log)
+	}
+
+	response := generated.BoxResponse{
+		Name:  name,
+		Value: value,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetAssetByID returns application information by app idx.
 // (GET /v2/assets/{asset-id})
 func (v2 *Handlers) GetAssetByID(ctx echo.Context, assetID uint64) error {
@@ -1147,8 +1638,12 @@ func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, addr strin
 
 // StartCatchup Given a catchpoint, it starts catching up to this catchpoint
 // (POST /v2/catchup/{catchpoint})
-func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string) error {
-	return v2.startCatchup(ctx, catchpoint)
+func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string, params private.StartCatchupParams) error {
+	var source string
+	if params.Source != nil {
+		source = *params.Source
+	}
+	return v2.startCatchup(ctx, catchpoint, source)
 }
 
 // AbortCatchup Given a catchpoint, it aborts catching up to this catchpoint
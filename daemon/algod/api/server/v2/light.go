@@ -0,0 +1,90 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/lightclient"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// LightHandlers is an implementation of the subset of the V2 API served in light client mode.
+type LightHandlers struct {
+	Follower *lightclient.Follower
+	Log      logging.Logger
+	Shutdown <-chan struct{}
+}
+
+// GetStatus gets the current status of the light client, reporting the latest certified block header as the last round.
+// (GET /v2/status)
+func (v2 *LightHandlers) GetStatus(ctx echo.Context) error {
+	latest := v2.Follower.Latest()
+	response := generated.NodeStatusResponse{
+		LastRound:            uint64(latest.Round),
+		LastVersion:          string(latest.CurrentProtocol),
+		NextVersion:          string(latest.NextProtocol),
+		NextVersionRound:     uint64(latest.NextProtocolSwitchOn),
+		NextVersionSupported: true,
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetProof gets a proof of a transaction in a block, verified against the certified block header.
+// (GET /v2/blocks/{round}/transactions/{txid}/proof)
+func (v2 *LightHandlers) GetProof(ctx echo.Context) error {
+	round, err := strconv.ParseUint(ctx.Param("round"), 10, 64)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+	var txID transactions.Txid
+	err = txID.UnmarshalText([]byte(ctx.Param("txid")))
+	if err != nil {
+		return badRequest(ctx, err, errNoTxnSpecified, v2.Log)
+	}
+	hashtype := ctx.QueryParam("hashtype")
+	if hashtype != "" && hashtype != "sha512_256" {
+		return badRequest(ctx, nil, errLightClientHashType, v2.Log)
+	}
+
+	proof, err := v2.Follower.TransactionProof(ctx.Request().Context(), basics.Round(round), txID)
+	if errors.Is(err, lightclient.ErrRoundNotCertified) {
+		return notFound(ctx, err, errRoundNotCertified, v2.Log)
+	}
+	if err != nil {
+		return internalError(ctx, err, errFailedVerifyingProof, v2.Log)
+	}
+
+	singleLeafProof := merklearray.SingleLeafProof{Proof: proof.Proof}
+	response := generated.ProofResponse{
+		Proof:     singleLeafProof.GetConcatenatedProof(),
+		Stibhash:  proof.StibHash[:],
+		Idx:       proof.Index,
+		Treedepth: uint64(proof.Proof.TreeDepth),
+		Hashtype:  "sha512_256",
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
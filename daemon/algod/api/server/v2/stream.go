@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package v2
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/labstack/echo/v4"
+
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/node/blockstream"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// streamCloseTimeout bounds the time it may take to send the close message to
+// a block stream client.
+const streamCloseTimeout = 5 * time.Second
+
+// streamWriteTimeout bounds the time it may take to send a single block to a
+// block stream client. Clients that do not keep up are disconnected.
+const streamWriteTimeout = 30 * time.Second
+
+// streamReadLimit is the largest message accepted from a block stream client.
+// Clients are not expected to send anything but control messages.
+const streamReadLimit = 1024
+
+// streamUpgrader upgrades StreamBlocks requests to websockets. Requests are
+// already authenticated by their API token, so any origin is accepted.
+var streamUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 64 * 1024,
+	CheckOrigin:     func(r *http.Request) bool { return true },
+}
+
+// streamMessage is a single message of the block stream.
+type streamMessage struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Block bookkeeping.Block `codec:"block"`
+	Delta encodedStateDelta `codec:"delta"`
+}
+
+// StreamBlocks streams committed blocks and their state deltas over a websocket.
+// (GET /v2/stream/blocks)
+func (v2 *Handlers) StreamBlocks(ctx echo.Context, params generated.StreamBlocksParams) error {
+	handle, _, err := getCodecHandle(params.Format)
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+	messageType := websocket.TextMessage
+	if handle == protocol.CodecHandle {
+		messageType = websocket.BinaryMessage
+	}
+
+	hub := v2.Node.BlockStream()
+	if hub == nil {
+		return serviceUnavailable(ctx, nil, errBlockStreamNotAvailable, v2.Log)
+	}
+
+	var from basics.Round
+	if params.Round != nil {
+		from = basics.Round(*params.Round)
+		// reject rounds that can no longer be served before upgrading the
+		// connection, so that the client gets a meaningful status code.
+		ledger := v2.Node.LedgerForAPI()
+		if from <= ledger.Latest() {
+			if _, err := ledger.GetStateDeltaForRound(from); err != nil {
+				return badRequest(ctx, err, errStateDeltaNotAvailable, v2.Log)
+			}
+		}
+	}
+
+	conn, err := streamUpgrader.Upgrade(ctx.Response(), ctx.Request(), nil)
+	if err != nil {
+		// the upgrader already replied to the client.
+		v2.Log.Debugf("StreamBlocks: failed to upgrade connection: %v", err)
+		return nil
+	}
+	defer conn.Close()
+	conn.SetReadLimit(streamReadLimit)
+
+	sub := hub.Subscribe(from)
+	defer sub.Close()
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		// clients are not expected to send anything, but reading is required
+		// to process control messages and to notice the client going away.
+		defer cancel()
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+	go func() {
+		select {
+		case <-v2.Shutdown:
+			cancel()
+		case <-streamCtx.Done():
+		}
+	}()
+
+	for {
+		entry, err := sub.Next(streamCtx)
+		if err != nil {
+			var notAvailable *blockstream.RoundNotAvailableError
+			switch {
+			case errors.As(err, &notAvailable):
+				closeStream(conn, websocket.ClosePolicyViolation, errStateDeltaNotAvailable)
+			case streamCtx.Err() != nil:
+				closeStream(conn, websocket.CloseGoingAway, "")
+			default:
+				closeStream(conn, websocket.CloseInternalServerErr, err.Error())
+			}
+			return nil
+		}
+
+		data, err := encode(handle, streamMessage{Block: entry.Block, Delta: convertStateDelta(entry.Delta)})
+		if err != nil {
+			v2.Log.Warnf("StreamBlocks: failed to encode round %d: %v", entry.Block.Round(), err)
+			closeStream(conn, websocket.CloseInternalServerErr, errFailedToEncodeResponse)
+			return nil
+		}
+
+		// a slow client blocks the write; in the meantime its subscription
+		// stops queueing blocks and catches up from the ledger afterwards. A
+		// client that does not accept the block in time is dropped.
+		conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+		if err := conn.WriteMessage(messageType, data); err != nil {
+			var netErr net.Error
+			if errors.As(err, &netErr) && netErr.Timeout() {
+				v2.Log.Infof("StreamBlocks: dropping client that did not accept round %d within %v", entry.Block.Round(), streamWriteTimeout)
+				return nil
+			}
+			v2.Log.Debugf("StreamBlocks: failed to write round %d: %v", entry.Block.Round(), err)
+			return nil
+		}
+	}
+}
+
+// closeStream sends a close message to a block stream client. Errors are
+// ignored since the connection is closed right after anyway.
+func closeStream(conn *websocket.Conn, code int, text string) {
+	conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(streamCloseTimeout))
+}
@@ -20,6 +20,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/algorand/go-algorand/agreement"
@@ -40,6 +42,7 @@ import (
 
 type mockLedger struct {
 	accounts map[basics.Address]basics.AccountData
+	kvstore  map[string][]byte
 	latest   basics.Round
 }
 
@@ -57,6 +60,9 @@ func (l *mockLedger) LookupLatest(addr basics.Address) (basics.AccountData, basi
 	}
 	return ad, l.latest, basics.MicroAlgos{Raw: 0}, nil
 }
+func (l *mockLedger) LookupAccountWithResources(rnd basics.Round, addr basics.Address) (basics.AccountData, basics.MicroAlgos, error) {
+	return l.accounts[addr], basics.MicroAlgos{Raw: 0}, nil
+}
 
 func (l *mockLedger) ConsensusParams(r basics.Round) (config.ConsensusParams, error) {
 	return config.Consensus[protocol.ConsensusFuture], nil
@@ -112,6 +118,26 @@ func (l *mockLedger) Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	panic("not implemented")
 }
 
+func (l *mockLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return l.kvstore[key], nil
+}
+func (l *mockLedger) LookupKeysByPrefix(rnd basics.Round, prefix string, maxKeyNum uint64) ([]string, error) {
+	var keys []string
+	for key := range l.kvstore {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if maxKeyNum > 0 && uint64(len(keys)) > maxKeyNum {
+		keys = keys[:maxKeyNum]
+	}
+	return keys, nil
+}
+func (l *mockLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
+	panic("not implemented")
+}
+
 func randomAccountWithResources(N int) basics.AccountData {
 	a := ledgertesting.RandomAccountData(0)
 	a.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
@@ -342,3 +368,150 @@ func TestAccountInformationResourceLimits(t *testing.T) {
 		})
 	}
 }
+
+func setupTestForBoxes(t *testing.T, appIdx basics.AppIndex, boxes map[string][]byte) v2.Handlers {
+	ml := mockLedger{
+		accounts: make(map[basics.Address]basics.AccountData),
+		kvstore:  make(map[string][]byte),
+		latest:   basics.Round(10),
+	}
+	for name, value := range boxes {
+		ml.kvstore[ledgercore.MakeBoxKey(appIdx, name)] = value
+	}
+	// a box of another app, which should never show up
+	ml.kvstore[ledgercore.MakeBoxKey(appIdx+1, "other")] = []byte("other")
+
+	return v2.Handlers{
+		Node:     makeMockNode(&ml, t.Name(), nil),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+}
+
+func TestGetApplicationBoxes(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	appIdx := basics.AppIndex(7)
+	handlers := setupTestForBoxes(t, appIdx, map[string][]byte{
+		"b":     []byte("value b"),
+		"a":     []byte("value a"),
+		"c\x00": []byte("value c"),
+	})
+
+	getNames := func(max *uint64) []string {
+		ctx, rec := newReq(t)
+		err := handlers.GetApplicationBoxes(ctx, uint64(appIdx), generatedV2.GetApplicationBoxesParams{Max: max})
+		require.NoError(t, err)
+		require.Equal(t, 200, rec.Code)
+		var resp generatedV2.BoxesResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		names := make([]string, len(resp.Boxes))
+		for i, box := range resp.Boxes {
+			names[i] = string(box.Name)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"a", "b", "c\x00"}, getNames(nil))
+	two := uint64(2)
+	require.Equal(t, []string{"a", "b"}, getNames(&two))
+
+	ctx, rec := newReq(t)
+	err := handlers.GetApplicationBoxes(ctx, uint64(appIdx+2), generatedV2.GetApplicationBoxesParams{})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var resp generatedV2.BoxesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Empty(t, resp.Boxes)
+}
+
+func TestGetApplicationBoxByName(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	appIdx := basics.AppIndex(7)
+	addr := ledgertesting.RandomAddress()
+	intName := []byte{0, 0, 0, 0, 0, 0, 0x04, 0xd2}
+	handlers := setupTestForBoxes(t, appIdx, map[string][]byte{
+		"hello":         []byte("world"),
+		string(intName): []byte("int"),
+		string(addr[:]): []byte("addr"),
+	})
+
+	testCases := []struct {
+		name         string
+		expectedCode int
+		expected     string
+	}{
+		{"str:hello", 200, "world"},
+		{"b64:aGVsbG8=", 200, "world"},
+		{"int:1234", 200, "int"},
+		{"addr:" + addr.String(), 200, "addr"},
+		{"str:nope", 404, ""},
+		{"str:other", 404, ""},
+		{"hello", 400, ""},
+		{"b64:!!", 400, ""},
+		{"xyz:hello", 400, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, rec := newReq(t)
+			err := handlers.GetApplicationBoxByName(ctx, uint64(appIdx), generatedV2.GetApplicationBoxByNameParams{Name: tc.name})
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedCode, rec.Code)
+			if tc.expectedCode != 200 {
+				return
+			}
+			var resp generatedV2.BoxResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			require.Equal(t, tc.expected, string(resp.Value))
+		})
+	}
+}
+
+func TestAccountInformationAtRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handlers, addr, acctData := setupTestForLargeResources(t, 4, 0, randomAccountWithResources)
+
+	check := func(rnd uint64, exclude string, expectedCode int) {
+		params := generatedV2.AccountInformationParams{Round: &rnd}
+		if exclude != "" {
+			params.Exclude = &exclude
+		}
+		ctx, rec := newReq(t)
+		err := handlers.AccountInformation(ctx, addr.String(), params)
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, rec.Code, rec.Body.String())
+		if expectedCode != http.StatusOK {
+			return
+		}
+
+		var ret generatedV2.Account
+		err = json.Unmarshal(rec.Body.Bytes(), &ret)
+		require.NoError(t, err)
+		require.Equal(t, rnd, ret.Round)
+		require.Equal(t, acctData.MicroAlgos.Raw, ret.Amount)
+	}
+
+	check(5, "", http.StatusOK)
+	check(5, "all", http.StatusOK)
+	check(10, "", http.StatusOK)
+	check(11, "", http.StatusBadRequest)
+	check(11, "all", http.StatusBadRequest)
+
+	rnd := uint64(11)
+	ctx, rec := newReq(t)
+	err := handlers.AccountAssetInformation(ctx, addr.String(), 0, generatedV2.AccountAssetInformationParams{Round: &rnd})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rnd = 5
+	ctx, rec = newReq(t)
+	err = handlers.AccountApplicationInformation(ctx, addr.String(), 2, generatedV2.AccountApplicationInformationParams{Round: &rnd})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	var ret generatedV2.AccountApplicationResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &ret)
+	require.NoError(t, err)
+	require.Equal(t, rnd, ret.Round)
+}
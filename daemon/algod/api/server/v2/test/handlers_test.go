@@ -18,33 +18,44 @@ package test
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/algorand/websocket"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/agreement"
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
 	"github.com/algorand/go-algorand/crypto/merklearray"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/logspec"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/blockstream"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/execpool"
@@ -223,6 +234,167 @@ func TestGetBlockJsonEncoding(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetLedgerStateDelta(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, stx, releasefunc := addBlockHelper(t)
+	defer releasefunc()
+
+	type stateDelta struct {
+		Round    basics.Round `codec:"rnd"`
+		Accounts []struct {
+			Address basics.Address `codec:"addr"`
+		} `codec:"accts"`
+		Txids []struct {
+			Txid      transactions.Txid `codec:"txid"`
+			LastValid basics.Round      `codec:"lv"`
+		} `codec:"txids"`
+	}
+
+	for _, format := range []string{"json", "msgpack"} {
+		e := echo.New()
+		rec = httptest.NewRecorder()
+		c = e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		err := handler.GetLedgerStateDelta(c, 1, generatedV2.GetLedgerStateDeltaParams{Format: &format})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var delta stateDelta
+		var handle codec.Handle = new(codec.JsonHandle)
+		if format == "msgpack" {
+			handle = new(codec.MsgpackHandle)
+		}
+		require.NoError(t, codec.NewDecoderBytes(rec.Body.Bytes(), handle).Decode(&delta))
+		require.Equal(t, basics.Round(1), delta.Round)
+		require.Len(t, delta.Txids, 1)
+		require.Equal(t, stx.ID(), delta.Txids[0].Txid)
+		require.Equal(t, stx.Txn.LastValid, delta.Txids[0].LastValid)
+		require.NotEmpty(t, delta.Accounts)
+	}
+
+	// the genesis round has no delta, and round 2 was not added yet
+	rec = httptest.NewRecorder()
+	c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, handler.GetLedgerStateDelta(c, 0, generatedV2.GetLedgerStateDeltaParams{}))
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = httptest.NewRecorder()
+	c = echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+	require.NoError(t, handler.GetLedgerStateDelta(c, 2, generatedV2.GetLedgerStateDeltaParams{}))
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestGetBlockMethodCalls(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, _, _, stx, releasefunc := addBlockHelper(t)
+	defer releasefunc()
+
+	genesisHash := handler.Node.GenesisHash()
+	contract := fmt.Sprintf(`{"name": "Test", "networks": {"%s": {"appID": 1}}, "methods": [{"name": "reset", "args": [], "returns": {"type": "void"}}]}`,
+		base64.StdEncoding.EncodeToString(genesisHash[:]))
+
+	methodCalls := func(round uint64, body string, params generatedV2.GetBlockMethodCallsParams) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)), rec)
+		require.NoError(t, handler.GetBlockMethodCalls(c, round, params))
+		return rec
+	}
+
+	// the app call of the block has no app args, so it does not select a method
+	rec := methodCalls(1, contract, generatedV2.GetBlockMethodCallsParams{})
+	require.Equal(t, http.StatusOK, rec.Code)
+	var response generatedV2.BlockMethodCallsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(1), response.Round)
+	require.Len(t, response.Calls, 1)
+	require.Equal(t, stx.ID().String(), response.Calls[0].Txid)
+	require.Equal(t, uint64(1), response.Calls[0].AppId)
+	require.Nil(t, response.Calls[0].Method)
+	require.NotNil(t, response.Calls[0].DecodeError)
+
+	// calls to other apps are skipped
+	otherApp := uint64(2)
+	rec = methodCalls(1, contract, generatedV2.GetBlockMethodCallsParams{AppId: &otherApp})
+	require.Equal(t, http.StatusOK, rec.Code)
+	response = generatedV2.BlockMethodCallsResponse{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Empty(t, response.Calls)
+
+	undeployed := `{"name": "Test", "methods": [{"name": "reset", "args": [], "returns": {"type": "void"}}]}`
+	rec = methodCalls(1, undeployed, generatedV2.GetBlockMethodCallsParams{})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = methodCalls(1, `{"name": "Test", "methods": [`, generatedV2.GetBlockMethodCallsParams{})
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = methodCalls(2, contract, generatedV2.GetBlockMethodCallsParams{})
+	require.Equal(t, http.StatusNotFound, rec.Code)
+
+	// the blocks a non-archival node no longer has are not found either
+	handler.Node = makeMockNode(blocksLedger{blocks: make([]bookkeeping.Block, 3), pruned: 2}, t.Name(), nil)
+	rec = methodCalls(1, contract, generatedV2.GetBlockMethodCallsParams{AppId: &otherApp})
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestStreamBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, stx, releasefunc := addBlockHelper(t)
+	defer releasefunc()
+
+	l := handler.Node.LedgerForAPI().(*data.Ledger)
+	hub := blockstream.MakeHub(l)
+	l.RegisterBlockListeners([]ledger.BlockListener{hub})
+
+	// the stream is not available on a node without a hub
+	round := uint64(1)
+	err := handler.StreamBlocks(c, generatedV2.StreamBlocksParams{Round: &round})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+	handler.Node.(*mockNode).blockStream = hub
+
+	// the genesis round has no state delta to stream
+	e := echo.New()
+	rec = httptest.NewRecorder()
+	genesis := uint64(0)
+	err = handler.StreamBlocks(e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec), generatedV2.StreamBlocksParams{Round: &genesis})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+
+	e.GET("/v2/stream/blocks", func(ctx echo.Context) error {
+		return handler.StreamBlocks(ctx, generatedV2.StreamBlocksParams{Round: &round})
+	})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/v2/stream/blocks", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadLimit(1 << 20)
+
+	messageType, data, err := conn.ReadMessage()
+	require.NoError(t, err)
+	require.Equal(t, websocket.TextMessage, messageType)
+
+	var message struct {
+		Block bookkeeping.Block `codec:"block"`
+		Delta struct {
+			Round basics.Round `codec:"rnd"`
+			Txids []struct {
+				Txid transactions.Txid `codec:"txid"`
+			} `codec:"txids"`
+		} `codec:"delta"`
+	}
+	require.NoError(t, codec.NewDecoderBytes(data, new(codec.JsonHandle)).Decode(&message))
+	require.Equal(t, basics.Round(1), message.Block.Round())
+	require.Equal(t, basics.Round(1), message.Delta.Round)
+	require.Len(t, message.Delta.Txids, 1)
+	require.Equal(t, stx.ID(), message.Delta.Txids[0].Txid)
+}
+
 func TestGetSupply(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -450,7 +622,85 @@ func TestPostTransaction(t *testing.T) {
 	postTransactionTest(t, 0, 200)
 }
 
-func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expectedCode int) {
+func simulateTransactionTest(t *testing.T, handler v2.Handlers, stxn *transactions.SignedTxn, expectedCode int) (response generatedV2.SimulateResponse) {
+	e := echo.New()
+	var body io.Reader
+	if stxn != nil {
+		bodyBytes := protocol.Encode(stxn)
+		body = bytes.NewReader(bodyBytes)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/", body)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := handler.SimulateTransaction(c, generatedV2.SimulateTransactionParams{})
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+	if rec.Code == 200 {
+		err = protocol.DecodeJSON(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+	}
+	return
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 5
+	numTransactions := 5
+	offlineAccounts := true
+	mockLedger, _, _, stxns, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	dummyShutdownChan := make(chan struct{})
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	simulateTransactionTest(t, handler, &stxns[0], 404)
+
+	mockNode.config.EnableDeveloperAPI = true
+	simulateTransactionTest(t, handler, nil, 400)
+
+	response := simulateTransactionTest(t, handler, &stxns[0], 200)
+	require.True(t, response.WouldSucceed)
+	require.Equal(t, uint64(mockLedger.Latest()), response.LastRound)
+	require.Nil(t, response.FailedAt)
+	require.Nil(t, response.FailureMessage)
+	require.Len(t, response.TxnResults, 1)
+	require.Nil(t, response.TxnResults[0].MissingSignature)
+	require.NotNil(t, response.StateDelta)
+	delta := *response.StateDelta
+	require.EqualValues(t, mockLedger.Latest()+1, delta["rnd"])
+	// the payment touches the sender, the receiver and the fee sink
+	require.Len(t, delta["accts"], 3)
+	require.Len(t, delta["txids"], 1)
+
+	unsigned := transactions.SignedTxn{Txn: stxns[0].Txn}
+	response = simulateTransactionTest(t, handler, &unsigned, 200)
+	require.True(t, response.WouldSucceed)
+	require.Len(t, response.TxnResults, 1)
+	require.NotNil(t, response.TxnResults[0].MissingSignature)
+	require.True(t, *response.TxnResults[0].MissingSignature)
+
+	// nothing was committed by the previous simulations
+	require.Equal(t, basics.Round(0), mockLedger.Latest())
+
+	overspend := stxns[0].Txn
+	overspend.Amount.Raw = math.MaxUint64 / 2
+	badTxn := transactions.SignedTxn{Txn: overspend}
+	response = simulateTransactionTest(t, handler, &badTxn, 200)
+	require.False(t, response.WouldSucceed)
+	require.NotNil(t, response.FailedAt)
+	require.Equal(t, uint64(0), *response.FailedAt)
+	require.NotNil(t, response.FailureMessage)
+	require.Contains(t, *response.FailureMessage, "overspend")
+	require.Nil(t, response.StateDelta)
+}
+
+func startCatchupTest(t *testing.T, catchpoint string, source string, nodeError error, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
 	offlineAccounts := true
@@ -467,7 +717,11 @@ func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expected
 	req := httptest.NewRequest(http.MethodPost, "/", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	err := handler.StartCatchup(c, catchpoint)
+	var params private.StartCatchupParams
+	if source != "" {
+		params.Source = &source
+	}
+	err := handler.StartCatchup(c, catchpoint, params)
 	require.NoError(t, err)
 	require.Equal(t, expectedCode, rec.Code)
 }
@@ -477,18 +731,22 @@ func TestStartCatchup(t *testing.T) {
 	t.Parallel()
 
 	goodCatchPoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
-	startCatchupTest(t, goodCatchPoint, nil, 201)
+	startCatchupTest(t, goodCatchPoint, "", nil, 201)
 
 	inProgressError := node.MakeCatchpointAlreadyInProgressError("catchpoint")
-	startCatchupTest(t, goodCatchPoint, inProgressError, 200)
+	startCatchupTest(t, goodCatchPoint, "", inProgressError, 200)
 
 	unableToStartError := node.MakeCatchpointUnableToStartError("running", "requested")
-	startCatchupTest(t, goodCatchPoint, unableToStartError, 400)
+	startCatchupTest(t, goodCatchPoint, "", unableToStartError, 400)
 
-	startCatchupTest(t, goodCatchPoint, errors.New("anothing else is internal"), 500)
+	startCatchupTest(t, goodCatchPoint, "", errors.New("anothing else is internal"), 500)
 
 	badCatchPoint := "bad catchpoint"
-	startCatchupTest(t, badCatchPoint, nil, 400)
+	startCatchupTest(t, badCatchPoint, "", nil, 400)
+
+	startCatchupTest(t, goodCatchPoint, "/var/catchpoints/5894690.catchpoint", nil, 201)
+	startCatchupTest(t, goodCatchPoint, "s3://catchpoints/mainnet/5894690.catchpoint", nil, 201)
+	startCatchupTest(t, goodCatchPoint, "ftp://catchpoints/5894690.catchpoint", nil, 400)
 }
 
 func abortCatchupTest(t *testing.T, catchpoint string, expectedCode int) {
@@ -523,6 +781,185 @@ func TestAbortCatchup(t *testing.T) {
 	abortCatchupTest(t, badCatchPoint, 400)
 }
 
+func TestPeerBans(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	expires := time.Now().Add(time.Hour)
+	mockNode.peerBans = []network.PeerBan{
+		{Address: "1.2.3.4", Reason: network.PeerSignalInvalidTxn.String(), Expires: expires},
+		{Address: "r1.algorand.network:4160", Reason: network.PeerSignalInvalidVote.String(), Expires: expires},
+	}
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+
+	getBans := func() []private.PeerBan {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.GetPeerBans(e.NewContext(req, rec)))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response private.PeerBansResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response.Bans
+	}
+	clearBans := func(address *string) int {
+		req := httptest.NewRequest(http.MethodDelete, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.ClearPeerBans(e.NewContext(req, rec), private.ClearPeerBansParams{Address: address}))
+		return rec.Code
+	}
+
+	bans := getBans()
+	require.Len(t, bans, 2)
+	require.Equal(t, private.PeerBan{Address: "1.2.3.4", Reason: "InvalidTxn", Expires: uint64(expires.Unix())}, bans[0])
+
+	address := "1.2.3.4"
+	require.Equal(t, http.StatusOK, clearBans(&address))
+	require.Equal(t, http.StatusNotFound, clearBans(&address))
+	bans = getBans()
+	require.Len(t, bans, 1)
+	require.Equal(t, "r1.algorand.network:4160", bans[0].Address)
+
+	require.Equal(t, http.StatusOK, clearBans(nil))
+	require.Empty(t, getBans())
+	require.Equal(t, http.StatusOK, clearBans(nil))
+}
+
+func TestPeers(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	mockNode.peers = []network.PeerInfo{
+		{
+			Address:               "r1.algorand.network:4160",
+			Role:                  network.PeerRoleRelay,
+			Outgoing:              true,
+			Version:               "2.1",
+			ConnectedSince:        time.Now().Add(-time.Minute),
+			LastPingRoundTripTime: 5 * time.Millisecond,
+			Traffic:               []network.TagTraffic{{Tag: protocol.AgreementVoteTag, Received: 100, Sent: 200}},
+		},
+		{Address: "1.2.3.4", Role: network.PeerRoleClient, Version: "2.1", ConnectedSince: time.Now()},
+	}
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+
+	getPeers := func() []private.PeerStatus {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.GetPeers(e.NewContext(req, rec)))
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response private.PeersResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return response.Peers
+	}
+
+	peers := getPeers()
+	require.Len(t, peers, 2)
+	require.Equal(t, "r1.algorand.network:4160", peers[0].Address)
+	require.Equal(t, "relay", peers[0].Role)
+	require.True(t, peers[0].Outgoing)
+	require.InDelta(t, 60, peers[0].Uptime, 1)
+	require.NotNil(t, peers[0].LastPingRoundTripTime)
+	require.Equal(t, uint64(5*time.Millisecond), *peers[0].LastPingRoundTripTime)
+	require.Equal(t, []private.PeerTagTraffic{{Tag: "AV", BytesIn: 100, BytesOut: 200}}, peers[0].Traffic)
+	require.Nil(t, peers[1].LastPingRoundTripTime)
+	require.Empty(t, peers[1].Traffic)
+
+	disconnect := func(address string) int {
+		req := httptest.NewRequest(http.MethodDelete, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.DisconnectPeer(e.NewContext(req, rec), private.DisconnectPeerParams{Address: address}))
+		return rec.Code
+	}
+	require.Equal(t, http.StatusOK, disconnect("1.2.3.4"))
+	require.Equal(t, http.StatusNotFound, disconnect("1.2.3.4"))
+	require.Len(t, getPeers(), 1)
+
+	addPriorityPeer := func(address string) int {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.AddPriorityPeer(e.NewContext(req, rec), private.AddPriorityPeerParams{Address: address}))
+		return rec.Code
+	}
+	require.Equal(t, http.StatusOK, addPriorityPeer("1.2.3.4"))
+	require.Equal(t, http.StatusBadRequest, addPriorityPeer(""))
+	require.Equal(t, []string{"1.2.3.4"}, mockNode.prioPeers)
+}
+
+func TestAgreementTimeline(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	start := time.Now()
+	mockNode.timeline = []agreement.RoundTimeline{
+		{Round: 9, Events: []agreement.TimelineEvent{{Time: start, Type: logspec.RoundStart}}},
+		{Round: 10, Events: []agreement.TimelineEvent{
+			{Time: start, Type: logspec.RoundStart},
+			{Time: start.Add(time.Second), Type: logspec.ThresholdReached, Step: 1, Proposal: "digest", Sender: "proposer", Weight: 2500, Threshold: 2267},
+		}},
+	}
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	e := echo.New()
+
+	getTimeline := func(round *uint64) (int, []private.AgreementRoundTimeline) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		rec := httptest.NewRecorder()
+		require.NoError(t, handler.GetAgreementTimeline(e.NewContext(req, rec), private.GetAgreementTimelineParams{Round: round}))
+		if rec.Code != http.StatusOK {
+			return rec.Code, nil
+		}
+		var response private.AgreementTimelineResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		return rec.Code, response.Rounds
+	}
+
+	code, rounds := getTimeline(nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, rounds, 2)
+
+	round := uint64(10)
+	code, rounds = getTimeline(&round)
+	require.Equal(t, http.StatusOK, code)
+	require.Len(t, rounds, 1)
+	require.Equal(t, round, rounds[0].Round)
+	require.Len(t, rounds[0].Events, 2)
+	require.Equal(t, "RoundStart", rounds[0].Events[0].Type)
+	require.Nil(t, rounds[0].Events[0].Weight)
+	threshold := rounds[0].Events[1]
+	require.Equal(t, "ThresholdReached", threshold.Type)
+	require.Equal(t, uint64(start.Add(time.Second).UnixNano()), threshold.Time)
+	require.Equal(t, uint64(1), threshold.Step)
+	require.Equal(t, "digest", *threshold.Proposal)
+	require.Equal(t, uint64(2500), *threshold.Weight)
+	require.Equal(t, uint64(2267), *threshold.Threshold)
+
+	round = 11
+	code, _ = getTimeline(&round)
+	require.Equal(t, http.StatusNotFound, code)
+}
+
 func tealCompileTest(t *testing.T, bytesToUse []byte, expectedCode int,
 	enableDeveloperAPI bool, params generated.TealCompileParams,
 	expectedSourcemap *logic.SourceMap,
@@ -963,3 +1400,90 @@ func TestGetProofDefault(t *testing.T) {
 	err = merklearray.Verify(blkHdr.TxnCommitments.NativeSha512_256Commitment.ToSlice(), elems, &proof)
 	a.NoError(err)
 }
+
+func TestGetCompactCert(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	const certRounds = 256
+	const confirmedRound = 2*certRounds + 8
+	var blocks []bookkeeping.Block
+	for rnd := basics.Round(0); rnd <= 3*certRounds+10; rnd++ {
+		var blk bookkeeping.Block
+		blk.CurrentProtocol = protocol.ConsensusFuture
+		blk.BlockHeader.Round = rnd
+		blk.BlockHeader.GenesisHash = genesisHash
+		if rnd > 0 {
+			blk.Branch = blocks[rnd-1].Hash()
+		}
+		state := bookkeeping.CompactCertState{CompactCertNextRound: 2 * certRounds}
+		if rnd >= confirmedRound {
+			state.CompactCertNextRound = 3 * certRounds
+		}
+		if rnd%certRounds == 0 {
+			state.CompactCertVoters = crypto.GenericDigest(crypto.Hash([]byte{byte(rnd / certRounds)}).ToSlice())
+			state.CompactCertVotersTotal = basics.MicroAlgos{Raw: 1000}
+		}
+		blk.CompactCert = map[protocol.CompactCertType]bookkeeping.CompactCertState{protocol.CompactCertBasic: state}
+		if rnd == confirmedRound {
+			txn := transactions.Transaction{
+				Type:   protocol.CompactCertTx,
+				Header: transactions.Header{GenesisHash: genesisHash},
+				CompactCertTxnFields: transactions.CompactCertTxnFields{
+					CertRound: 2 * certRounds,
+					CertType:  protocol.CompactCertBasic,
+				},
+			}
+			txn.Cert.SignedWeight = 900
+			stib, err := blk.BlockHeader.EncodeSignedTxn(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{})
+			require.NoError(t, err)
+			blk.Payset = append(blk.Payset, stib)
+		}
+		blocks = append(blocks, blk)
+	}
+
+	handler := v2.Handlers{
+		Node:     makeMockNode(blocksLedger{blocks: blocks}, t.Name(), nil),
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	getCompactCert := func(round uint64) (*httptest.ResponseRecorder, generatedV2.CompactCertResponse) {
+		rec := httptest.NewRecorder()
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		require.NoError(t, handler.GetCompactCert(c, round))
+		var response generatedV2.CompactCertResponse
+		if rec.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		}
+		return rec, response
+	}
+
+	for _, round := range []uint64{certRounds + 1, 2*certRounds - 100, 2 * certRounds} {
+		rec, response := getCompactCert(round)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, uint64(2*certRounds), response.CertRound)
+		require.Equal(t, uint64(certRounds+1), response.FirstRound)
+		require.Equal(t, uint64(confirmedRound), response.ConfirmedRound)
+
+		var cert compactcert.Cert
+		require.NoError(t, protocol.Decode(response.Cert, &cert))
+		require.Equal(t, uint64(900), cert.SignedWeight)
+		var votersHdr bookkeeping.BlockHeader
+		require.NoError(t, protocol.Decode(response.VotersHeader, &votersHdr))
+		require.Equal(t, blocks[certRounds].BlockHeader, votersHdr)
+		require.Len(t, response.Headers, int(2*certRounds-round+1))
+		for i, encoded := range response.Headers {
+			var hdr bookkeeping.BlockHeader
+			require.NoError(t, protocol.Decode(encoded, &hdr))
+			require.Equal(t, blocks[round+uint64(i)].BlockHeader, hdr)
+		}
+	}
+
+	// the compact certificate of the next interval is not committed yet, nor is the round after the latest one.
+	rec, _ := getCompactCert(2*certRounds + 1)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	rec, _ = getCompactCert(0)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+	rec, _ = getCompactCert(3*certRounds + 11)
+	require.Equal(t, http.StatusNotFound, rec.Code)
+}
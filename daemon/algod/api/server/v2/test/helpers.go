@@ -34,8 +34,11 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/node"
+	"github.com/algorand/go-algorand/node/blockstream"
 	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/db"
@@ -82,12 +85,17 @@ var txnPoolGolden = make([]transactions.SignedTxn, 2)
 // but doing this would create an import cycle, as mockNode needs
 // package `data` and package `node`, which themselves import `mocks`
 type mockNode struct {
-	ledger    v2.LedgerForAPI
-	genesisID string
-	config    config.Local
-	err       error
-	id        account.ParticipationID
-	keys      account.StateProofKeys
+	ledger      v2.LedgerForAPI
+	genesisID   string
+	config      config.Local
+	err         error
+	id          account.ParticipationID
+	keys        account.StateProofKeys
+	blockStream *blockstream.Hub
+	peerBans    []network.PeerBan
+	peers       []network.PeerInfo
+	prioPeers   []string
+	timeline    []agreement.RoundTimeline
 }
 
 func (m mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -124,6 +132,54 @@ func (m mockNode) LedgerForAPI() v2.LedgerForAPI {
 	return m.ledger
 }
 
+func (m mockNode) BlockStream() *blockstream.Hub {
+	return m.blockStream
+}
+
+func (m mockNode) GetPeerBans() []network.PeerBan {
+	return m.peerBans
+}
+
+func (m mockNode) GetPeerInfo() []network.PeerInfo {
+	return m.peers
+}
+
+func (m *mockNode) DisconnectPeer(address string) int {
+	remaining := m.peers[:0]
+	for _, peer := range m.peers {
+		if peer.Address != address {
+			remaining = append(remaining, peer)
+		}
+	}
+	disconnected := len(m.peers) - len(remaining)
+	m.peers = remaining
+	return disconnected
+}
+
+func (m mockNode) AgreementTimeline() []agreement.RoundTimeline {
+	return m.timeline
+}
+
+func (m *mockNode) AddPriorityPeer(host string) error {
+	if host == "" {
+		return fmt.Errorf("no host")
+	}
+	m.prioPeers = append(m.prioPeers, host)
+	return nil
+}
+
+func (m *mockNode) ClearPeerBans(address string) int {
+	remaining := m.peerBans[:0]
+	for _, ban := range m.peerBans {
+		if address != "" && ban.Address != address {
+			remaining = append(remaining, ban)
+		}
+	}
+	cleared := len(m.peerBans) - len(remaining)
+	m.peerBans = remaining
+	return cleared
+}
+
 func (m mockNode) Status() (s node.StatusReport, err error) {
 	s = cannedStatusReportGolden
 	return
@@ -140,6 +196,13 @@ func (m mockNode) BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	return m.err
 }
 
+func (m mockNode) Simulate(txgroup []transactions.SignedTxn) (simulation.Result, error) {
+	if m.err != nil {
+		return simulation.Result{}, m.err
+	}
+	return simulation.MakeSimulator(m.ledger.(*data.Ledger).Ledger).SimulateTransactionGroup(txgroup)
+}
+
 func (m mockNode) GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool) {
 	res = node.TxnWithStatus{}
 	found = true
@@ -200,7 +263,7 @@ func (m mockNode) AssembleBlock(round basics.Round) (agreement.ValidatedBlock, e
 	return nil, fmt.Errorf("assemble block not implemented")
 }
 
-func (m mockNode) StartCatchup(catchpoint string) error {
+func (m mockNode) StartCatchup(catchpoint string, source string) error {
 	return m.err
 }
 
@@ -208,6 +271,30 @@ func (m mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
 
+// blocksLedger is a ledger holding only the given blocks, for the handlers that look up nothing but blocks.
+// The blocks before the pruned round are not available, as on a non-archival node.
+type blocksLedger struct {
+	v2.LedgerForAPI
+	blocks []bookkeeping.Block
+	pruned basics.Round
+}
+
+func (l blocksLedger) Latest() basics.Round {
+	return basics.Round(len(l.blocks) - 1)
+}
+
+func (l blocksLedger) Block(rnd basics.Round) (bookkeeping.Block, error) {
+	if rnd > l.Latest() || rnd < l.pruned {
+		return bookkeeping.Block{}, ledgercore.ErrNoEntry{Round: rnd, Latest: l.Latest(), Committed: l.Latest()}
+	}
+	return l.blocks[rnd], nil
+}
+
+func (l blocksLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	blk, err := l.Block(rnd)
+	return blk.BlockHeader, err
+}
+
 ////// mock ledger testing environment follows
 
 var sinkAddr = basics.Address{0x7, 0xda, 0xcb, 0x4b, 0x6d, 0x9e, 0xd1, 0x41, 0xb1, 0x75, 0x76, 0xbd, 0x45, 0x9a, 0xe6, 0x42, 0x1d, 0x48, 0x6d, 0xa3, 0xd4, 0xef, 0x22, 0x47, 0xc4, 0x9, 0xa3, 0x96, 0xb8, 0x2e, 0xa2, 0x21}
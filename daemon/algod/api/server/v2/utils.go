@@ -17,9 +17,12 @@
 package v2
 
 import (
+	"encoding/base32"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -28,6 +31,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/abi"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
@@ -194,6 +198,55 @@ func computeAppIndexFromTxn(tx node.TxnWithStatus, l LedgerForAPI) *uint64 {
 	return computeCreatableIndexInPayset(tx, blk.BlockHeader.TxnCounter, payset)
 }
 
+// methodCallToGenerated decodes an application call transaction of a block as
+// a call to a method of the contract. Decoding failures are reported in the
+// DecodeError field.
+func methodCallToGenerated(contract abi.Contract, stxn transactions.SignedTxnWithAD, appID basics.AppIndex) generated.MethodCall {
+	txn := stxn.Txn
+	res := generated.MethodCall{
+		Txid:  txn.ID().String(),
+		AppId: uint64(appID),
+	}
+
+	refs := abi.CallReferences{
+		Sender:        txn.Sender[:],
+		ApplicationID: uint64(appID),
+	}
+	for i := range txn.Accounts {
+		refs.Accounts = append(refs.Accounts, txn.Accounts[i][:])
+	}
+	for _, app := range txn.ForeignApps {
+		refs.ForeignApps = append(refs.ForeignApps, uint64(app))
+	}
+	for _, asset := range txn.ForeignAssets {
+		refs.ForeignAssets = append(refs.ForeignAssets, uint64(asset))
+	}
+	logs := make([][]byte, len(stxn.EvalDelta.Logs))
+	for i, log := range stxn.EvalDelta.Logs {
+		logs[i] = []byte(log)
+	}
+
+	call, err := contract.DecodeCall(txn.ApplicationArgs, refs, logs)
+	if err != nil {
+		res.DecodeError = strOrNil(err.Error())
+		return res
+	}
+
+	res.Method = strOrNil(call.Method.Signature())
+	args := make([]generated.MethodCallArg, len(call.Args))
+	for i, arg := range call.Args {
+		args[i] = generated.MethodCallArg{
+			Name:        strOrNil(arg.Name),
+			Type:        arg.Type,
+			Value:       strOrNil(string(arg.Value)),
+			GroupOffset: numOrNil(uint64(arg.GroupOffset)),
+		}
+	}
+	res.Args = &args
+	res.Return = strOrNil(string(call.Return))
+	return res
+}
+
 // getCodecHandle converts a format string into the encoder + content type
 func getCodecHandle(formatPtr *string) (codec.Handle, string, error) {
 	format := "json"
@@ -335,6 +388,40 @@ func convertInnerTxn(txn *transactions.SignedTxnWithAD) preEncodedTxInfo {
 
 // printableUTF8OrEmpty checks to see if the entire string is a UTF8 printable string.
 // If this is the case, the string is returned as is. Otherwise, the empty string is returned.
+// parseBoxName decodes a box name given in the goal app call arg form
+// "encoding:value", where encoding is one of str, int, addr, b32 or b64.
+func parseBoxName(arg string) ([]byte, error) {
+	parts := strings.SplitN(arg, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("box name %q is not of the form encoding:value", arg)
+	}
+	encoding, value := parts[0], parts[1]
+	switch encoding {
+	case "str", "string":
+		return []byte(value), nil
+	case "int", "integer":
+		num, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse uint64 from %q: %v", value, err)
+		}
+		ibytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(ibytes, num)
+		return ibytes, nil
+	case "addr", "address":
+		addr, err := basics.UnmarshalChecksumAddress(value)
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal checksummed address from %q: %v", value, err)
+		}
+		return addr[:], nil
+	case "b32", "base32", "byte base32":
+		return base32.StdEncoding.DecodeString(value)
+	case "b64", "base64", "byte base64":
+		return base64.StdEncoding.DecodeString(value)
+	default:
+		return nil, fmt.Errorf("unknown encoding: %s", encoding)
+	}
+}
+
 func printableUTF8OrEmpty(in string) string {
 	// iterate throughout all the characters in the string to see if they are all printable.
 	// when range iterating on go strings, go decode each element as a utf8 rune.
@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package algod
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/lightclient"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// lightClientUpstreamTokenFilename is the file in the data directory holding the API token of the algod node
+// followed in light client mode.
+const lightClientUpstreamTokenFilename = "lightclient_upstream.token"
+
+// lightClient runs a lightclient.Follower in place of a full node.
+type lightClient struct {
+	cfg      config.Local
+	follower *lightclient.Follower
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// makeLightClient creates a light client following the algod node at cfg.LightClientUpstream, starting from the
+// block header given by cfg.LightClientTrustedHeader, which must belong to the given genesis.
+func makeLightClient(log logging.Logger, rootPath string, cfg config.Local, genesis bookkeeping.Genesis) (*lightClient, error) {
+	upstream, err := url.Parse(cfg.LightClientUpstream)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LightClientUpstream '%s' : %v", cfg.LightClientUpstream, err)
+	}
+	if cfg.LightClientTrustedHeader == "" {
+		return nil, fmt.Errorf("LightClientTrustedHeader is required to follow %s", cfg.LightClientUpstream)
+	}
+	trustedRound, trustedHash, err := lightclient.ParseTrustedHeader(cfg.LightClientTrustedHeader)
+	if err != nil {
+		return nil, err
+	}
+	apiToken, err := ioutil.ReadFile(filepath.Join(rootPath, lightClientUpstreamTokenFilename))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	source := lightclient.MakeRESTSource(*upstream, strings.TrimSpace(string(apiToken)))
+	follower, err := lightclient.MakeFollower(context.Background(), source, trustedRound, trustedHash, log)
+	if err != nil {
+		return nil, err
+	}
+	if genesisHash := follower.Latest().GenesisHash; genesisHash != crypto.HashObj(genesis) {
+		return nil, fmt.Errorf("trusted block header %d belongs to genesis %s, not to %s", trustedRound, genesisHash.String(), genesis.ID())
+	}
+	return &lightClient{cfg: cfg, follower: follower}, nil
+}
+
+// start starts following the chain.
+func (lc *lightClient) start() {
+	var ctx context.Context
+	ctx, lc.cancel = context.WithCancel(context.Background())
+	lc.wg.Add(1)
+	go func() {
+		defer lc.wg.Done()
+		lc.follower.Run(ctx)
+	}()
+}
+
+// stop stops following the chain, and waits for the follower to exit.
+func (lc *lightClient) stop() {
+	lc.cancel()
+	lc.wg.Wait()
+}
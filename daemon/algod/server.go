@@ -33,6 +33,7 @@ import (
 	"time"
 
 	"github.com/algorand/go-deadlock"
+	"github.com/labstack/echo/v4"
 
 	"github.com/algorand/go-algorand/config"
 	apiServer "github.com/algorand/go-algorand/daemon/algod/api/server"
@@ -59,6 +60,7 @@ type Server struct {
 	netListenFile        string
 	log                  logging.Logger
 	node                 *node.AlgorandFullNode
+	lightClient          *lightClient
 	metricCollector      *metrics.MetricService
 	metricServiceStarted bool
 	stopping             chan struct{}
@@ -166,6 +168,14 @@ func (s *Server) Initialize(cfg config.Local, phonebookAddresses []string, genes
 			NodeExporterPath:          cfg.NodeExporterPath,
 		})
 
+	if cfg.LightClientUpstream != "" {
+		s.lightClient, err = makeLightClient(s.log, s.RootPath, cfg, s.Genesis)
+		if err != nil {
+			return fmt.Errorf("couldn't initialize the light client: %s", err)
+		}
+		return nil
+	}
+
 	s.node, err = node.MakeFull(s.log, s.RootPath, cfg, phonebookAddresses, s.Genesis)
 	if os.IsNotExist(err) {
 		return fmt.Errorf("node has not been installed: %s", err)
@@ -195,13 +205,22 @@ func makeListener(addr string) (net.Listener, error) {
 
 // Start starts a Node instance and its network services
 func (s *Server) Start() {
-	s.log.Info("Trying to start an Algorand node")
-	fmt.Print("Initializing the Algorand node... ")
-	s.node.Start()
-	s.log.Info("Successfully started an Algorand node.")
-	fmt.Println("Success!")
-
-	cfg := s.node.Config()
+	var cfg config.Local
+	if s.lightClient != nil {
+		s.log.Info("Trying to start an Algorand light client")
+		fmt.Print("Initializing the Algorand light client... ")
+		s.lightClient.start()
+		s.log.Info("Successfully started an Algorand light client.")
+		fmt.Println("Success!")
+		cfg = s.lightClient.cfg
+	} else {
+		s.log.Info("Trying to start an Algorand node")
+		fmt.Print("Initializing the Algorand node... ")
+		s.node.Start()
+		s.log.Info("Successfully started an Algorand node.")
+		fmt.Println("Success!")
+		cfg = s.node.Config()
+	}
 
 	if cfg.EnableMetricReporting {
 		if err := s.metricCollector.Start(context.Background()); err != nil {
@@ -245,9 +264,16 @@ func (s *Server) Start() {
 		WriteTimeout: time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
 	}
 
-	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
-		cfg.RestConnectionsSoftLimit)
+	var e *echo.Echo
+	if s.lightClient != nil {
+		e = apiServer.NewLightRouter(
+			s.log, s.lightClient.follower, s.stopping, apiToken, adminAPIToken, listener,
+			cfg.RestConnectionsSoftLimit)
+	} else {
+		e = apiServer.NewRouter(
+			s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
+			cfg.RestConnectionsSoftLimit)
+	}
 
 	// Set up files for our PID and our listening address
 	// before beginning to listen to prevent 'goal node start'
@@ -257,10 +283,12 @@ func (s *Server) Start() {
 	ioutil.WriteFile(s.pidFile, []byte(fmt.Sprintf("%d\n", os.Getpid())), 0644)
 	ioutil.WriteFile(s.netFile, []byte(fmt.Sprintf("%s\n", addr)), 0644)
 
-	listenAddr, listening := s.node.ListeningAddress()
-	if listening {
-		s.netListenFile = filepath.Join(s.RootPath, "algod-listen.net")
-		ioutil.WriteFile(s.netListenFile, []byte(fmt.Sprintf("%s\n", listenAddr)), 0644)
+	if s.node != nil {
+		listenAddr, listening := s.node.ListeningAddress()
+		if listening {
+			s.netListenFile = filepath.Join(s.RootPath, "algod-listen.net")
+			ioutil.WriteFile(s.netListenFile, []byte(fmt.Sprintf("%s\n", listenAddr)), 0644)
+		}
 	}
 
 	errChan := make(chan error, 1)
@@ -299,7 +327,11 @@ func (s *Server) Stop() {
 	// Attempt to log a shutdown event before we exit...
 	s.log.Event(telemetryspec.ApplicationState, telemetryspec.ShutdownEvent)
 
-	s.node.Stop()
+	if s.lightClient != nil {
+		s.lightClient.stop()
+	} else {
+		s.node.Stop()
+	}
 
 	err := server.Shutdown(context.Background())
 	if err != nil {
@@ -0,0 +1,216 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// CallReferences holds the arrays of an application call transaction that the
+// reference-type arguments of a method call index into.
+type CallReferences struct {
+	// Sender is the address of the transaction sender, account index 0.
+	Sender []byte
+	// Accounts are the addresses at account indices 1 and above.
+	Accounts [][]byte
+	// ApplicationID is the called application, application index 0.
+	ApplicationID uint64
+	// ForeignApps are the applications at application indices 1 and above.
+	ForeignApps []uint64
+	// ForeignAssets are the assets, starting at asset index 0.
+	ForeignAssets []uint64
+}
+
+// DecodedArg is a decoded argument of a method call.
+type DecodedArg struct {
+	Name string `json:"name,omitempty"`
+	Type string `json:"type"`
+	// Value is the JSON representation of the argument. Reference-type
+	// arguments are resolved to the address or ID they refer to. It is
+	// empty for transaction arguments, which are not part of the app args.
+	Value json.RawMessage `json:"value,omitempty"`
+	// GroupOffset is set for transaction arguments: the argument is the
+	// transaction that many positions before the application call in its group.
+	GroupOffset int `json:"group-offset,omitempty"`
+}
+
+// DecodedCall is a decoded method call.
+type DecodedCall struct {
+	Method Method       `json:"-"`
+	Args   []DecodedArg `json:"args"`
+	// Return is the JSON representation of the value returned by the call,
+	// if the method returns one and the logs of the call were provided.
+	Return json.RawMessage `json:"return,omitempty"`
+}
+
+// DecodeCall finds the contract method selected by the first application
+// argument and decodes the remaining application arguments as its arguments.
+// If logs is not nil, the return value is decoded from the last log as well.
+func (c Contract) DecodeCall(appArgs [][]byte, refs CallReferences, logs [][]byte) (DecodedCall, error) {
+	if len(appArgs) == 0 {
+		return DecodedCall{}, fmt.Errorf("no application arguments, the call does not select a method")
+	}
+	for _, method := range c.Methods {
+		if bytes.Equal(method.Selector(), appArgs[0]) {
+			return method.DecodeCall(appArgs, refs, logs)
+		}
+	}
+	return DecodedCall{}, fmt.Errorf("no method of contract %s has the selector %x", c.Name, appArgs[0])
+}
+
+// DecodeCall decodes the application arguments of a call to the method, as
+// described in DecodeCall of Contract.
+func (m Method) DecodeCall(appArgs [][]byte, refs CallReferences, logs [][]byte) (DecodedCall, error) {
+	if len(appArgs) == 0 || !bytes.Equal(appArgs[0], m.Selector()) {
+		return DecodedCall{}, fmt.Errorf("application arguments do not start with the selector of %s", m.Signature())
+	}
+
+	// transaction arguments are not encoded in the app args, and reference
+	// arguments are encoded as uint8 indices.
+	var encodedTypes []Type
+	var encodedArgs []int
+	txnArgs := 0
+	for _, arg := range m.Args {
+		if IsTransactionType(arg.Type) {
+			txnArgs++
+		}
+	}
+
+	call := DecodedCall{Method: m, Args: make([]DecodedArg, len(m.Args))}
+	for i, arg := range m.Args {
+		call.Args[i] = DecodedArg{Name: arg.Name, Type: arg.Type}
+		switch {
+		case IsTransactionType(arg.Type):
+			call.Args[i].GroupOffset = txnArgs
+			txnArgs--
+		case IsReferenceType(arg.Type):
+			encodedTypes = append(encodedTypes, Type{abiTypeID: Uint, bitSize: 8})
+			encodedArgs = append(encodedArgs, i)
+		default:
+			argType, err := TypeOf(arg.Type)
+			if err != nil {
+				return DecodedCall{}, err
+			}
+			encodedTypes = append(encodedTypes, argType)
+			encodedArgs = append(encodedArgs, i)
+		}
+	}
+
+	values, err := decodeAppArgs(encodedTypes, appArgs[1:])
+	if err != nil {
+		return DecodedCall{}, err
+	}
+	for j, i := range encodedArgs {
+		arg := m.Args[i]
+		if IsReferenceType(arg.Type) {
+			call.Args[i].Value, err = resolveReference(arg.Type, values[j].(uint8), refs)
+		} else {
+			call.Args[i].Value, err = encodedTypes[j].MarshalToJSON(values[j])
+		}
+		if err != nil {
+			return DecodedCall{}, fmt.Errorf("argument %d of %s: %v", i, m.Signature(), err)
+		}
+	}
+
+	if logs != nil && m.Returns.Type != VoidReturnType {
+		if len(logs) == 0 {
+			return DecodedCall{}, fmt.Errorf("call to %s did not log a return value", m.Signature())
+		}
+		decoded, err := m.DecodeReturnLog(logs[len(logs)-1])
+		if err != nil {
+			return DecodedCall{}, fmt.Errorf("cannot decode the return value of %s: %v", m.Signature(), err)
+		}
+		retType, err := m.ReturnType()
+		if err != nil {
+			return DecodedCall{}, err
+		}
+		call.Return, err = retType.MarshalToJSON(decoded)
+		if err != nil {
+			return DecodedCall{}, err
+		}
+	}
+	return call, nil
+}
+
+// decodeAppArgs decodes the method arguments held by the app args, undoing the
+// packing of the arguments past methodArgsTupleThreshold done by
+// ParseArgJSONtoByteSlice.
+func decodeAppArgs(types []Type, appArgs [][]byte) ([]interface{}, error) {
+	packed := len(types) > maxAppArgs-1
+	expected := len(types)
+	if packed {
+		expected = methodArgsTupleThreshold + 1
+	}
+	if len(appArgs) != expected {
+		return nil, fmt.Errorf("expected %d application arguments after the selector but got %d", expected, len(appArgs))
+	}
+
+	values := make([]interface{}, 0, len(types))
+	for i := 0; i < len(appArgs); i++ {
+		if packed && i == methodArgsTupleThreshold {
+			tupleType, err := MakeTupleType(types[methodArgsTupleThreshold:])
+			if err != nil {
+				return nil, err
+			}
+			decoded, err := tupleType.Decode(appArgs[i])
+			if err != nil {
+				return nil, fmt.Errorf("cannot decode application argument %d: %v", i+1, err)
+			}
+			values = append(values, decoded.([]interface{})...)
+			break
+		}
+		decoded, err := types[i].Decode(appArgs[i])
+		if err != nil {
+			return nil, fmt.Errorf("cannot decode application argument %d: %v", i+1, err)
+		}
+		values = append(values, decoded)
+	}
+	return values, nil
+}
+
+// resolveReference returns the JSON representation of the address or ID that
+// a reference argument refers to.
+func resolveReference(refType string, index uint8, refs CallReferences) (json.RawMessage, error) {
+	switch refType {
+	case AccountReferenceType:
+		if index == 0 {
+			return addressType.MarshalToJSON(refs.Sender)
+		}
+		if int(index) > len(refs.Accounts) {
+			return nil, fmt.Errorf("account index %d is out of range", index)
+		}
+		return addressType.MarshalToJSON(refs.Accounts[index-1])
+	case ApplicationReferenceType:
+		if index == 0 {
+			return []byte(strconv.FormatUint(refs.ApplicationID, 10)), nil
+		}
+		if int(index) > len(refs.ForeignApps) {
+			return nil, fmt.Errorf("application index %d is out of range", index)
+		}
+		return []byte(strconv.FormatUint(refs.ForeignApps[index-1], 10)), nil
+	case AssetReferenceType:
+		if int(index) >= len(refs.ForeignAssets) {
+			return nil, fmt.Errorf("asset index %d is out of range", index)
+		}
+		return []byte(strconv.FormatUint(refs.ForeignAssets[index], 10)), nil
+	default:
+		return nil, fmt.Errorf("unknown reference type %s", refType)
+	}
+}
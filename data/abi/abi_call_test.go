@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCall(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	method, err := MethodFromSignature("transfer(pay,account,asset,application,uint64,string)bool")
+	require.NoError(t, err)
+	method.Args[4].Name = "amount"
+	contract := Contract{Name: "Bank", Methods: []Method{method}}
+
+	sender := make([]byte, 32)
+	other := make([]byte, 32)
+	other[0] = 1
+	refs := CallReferences{
+		Sender:        sender,
+		Accounts:      [][]byte{other},
+		ApplicationID: 7,
+		ForeignApps:   []uint64{8},
+		ForeignAssets: []uint64{100, 200},
+	}
+
+	appArgs := [][]byte{method.Selector()}
+	err = ParseArgJSONtoByteSlice([]string{"uint8", "uint8", "uint8", "uint64", "string"}, []string{"1", "1", "0", "5", `"hi"`}, &appArgs)
+	require.NoError(t, err)
+
+	boolType, err := TypeOf("bool")
+	require.NoError(t, err)
+	encodedTrue, err := boolType.Encode(true)
+	require.NoError(t, err)
+	logs := [][]byte{[]byte("unrelated"), append(append([]byte{}, MethodReturnPrefix...), encodedTrue...)}
+
+	call, err := contract.DecodeCall(appArgs, refs, logs)
+	require.NoError(t, err)
+	require.Equal(t, method.Signature(), call.Method.Signature())
+	require.Len(t, call.Args, 6)
+
+	require.Equal(t, 1, call.Args[0].GroupOffset)
+	require.Empty(t, call.Args[0].Value)
+
+	otherJSON, err := addressType.MarshalToJSON(other)
+	require.NoError(t, err)
+	require.Equal(t, string(otherJSON), string(call.Args[1].Value))
+	require.Equal(t, "200", string(call.Args[2].Value))
+	require.Equal(t, "7", string(call.Args[3].Value))
+	require.Equal(t, "amount", call.Args[4].Name)
+	require.Equal(t, "5", string(call.Args[4].Value))
+	require.Equal(t, `"hi"`, string(call.Args[5].Value))
+	require.Equal(t, "true", string(call.Return))
+
+	// without logs, the return value is not decoded
+	call, err = contract.DecodeCall(appArgs, refs, nil)
+	require.NoError(t, err)
+	require.Empty(t, call.Return)
+	_, err = contract.DecodeCall(appArgs, refs, [][]byte{})
+	require.Error(t, err)
+
+	// references out of range
+	_, err = contract.DecodeCall(appArgs, CallReferences{ForeignAssets: []uint64{100}}, nil)
+	require.Error(t, err)
+
+	// unknown selector and wrong number of arguments
+	_, err = contract.DecodeCall([][]byte{{1, 2, 3, 4}}, refs, nil)
+	require.Error(t, err)
+	_, err = contract.DecodeCall(appArgs[:3], refs, nil)
+	require.Error(t, err)
+}
+
+func TestDecodeCallManyArgs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	argTypes := make([]string, 18)
+	values := make([]string, len(argTypes))
+	for i := range argTypes {
+		argTypes[i] = "uint16"
+		values[i] = strconv.Itoa(i * 100)
+	}
+	method, err := MethodFromSignature("many(" + strings.Join(argTypes, ",") + ")void")
+	require.NoError(t, err)
+
+	appArgs := [][]byte{method.Selector()}
+	err = ParseArgJSONtoByteSlice(argTypes, values, &appArgs)
+	require.NoError(t, err)
+	require.Len(t, appArgs, maxAppArgs)
+
+	call, err := method.DecodeCall(appArgs, CallReferences{}, [][]byte{})
+	require.NoError(t, err)
+	require.Len(t, call.Args, len(values))
+	for i, arg := range call.Args {
+		var value int
+		require.NoError(t, json.Unmarshal(arg.Value, &value))
+		require.Equal(t, i*100, value)
+	}
+	require.Empty(t, call.Return)
+}
@@ -0,0 +1,281 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MethodReturnPrefix is the 4-byte prefix of the log holding the return value
+// of a method call, from https://github.com/algorandfoundation/ARCs/blob/main/ARCs/arc-0004.md#standard-format
+var MethodReturnPrefix = []byte{0x15, 0x1f, 0x7c, 0x75}
+
+// methodSelectorLength is the number of bytes of the method signature hash
+// used to identify a method.
+const methodSelectorLength = 4
+
+// Arg is an argument of an ARC-4 method description.
+type Arg struct {
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// Return is the return value of an ARC-4 method description.
+type Return struct {
+	Type string `json:"type"`
+	Desc string `json:"desc,omitempty"`
+}
+
+// Method is an ARC-4 method description.
+type Method struct {
+	Name    string `json:"name"`
+	Desc    string `json:"desc,omitempty"`
+	Args    []Arg  `json:"args"`
+	Returns Return `json:"returns"`
+}
+
+// MethodFromSignature creates a Method, without names or descriptions, from a
+// method signature such as "add(uint64,uint64)uint128".
+func MethodFromSignature(methodSig string) (Method, error) {
+	name, argTypes, retType, err := ParseMethodSignature(methodSig)
+	if err != nil {
+		return Method{}, err
+	}
+
+	method := Method{
+		Name:    name,
+		Args:    make([]Arg, len(argTypes)),
+		Returns: Return{Type: retType},
+	}
+	for i, argType := range argTypes {
+		method.Args[i].Type = argType
+	}
+
+	err = method.Verify()
+	if err != nil {
+		return Method{}, err
+	}
+	return method, nil
+}
+
+// Signature returns the method signature, such as "add(uint64,uint64)uint128".
+func (m Method) Signature() string {
+	argTypes := make([]string, len(m.Args))
+	for i, arg := range m.Args {
+		argTypes[i] = arg.Type
+	}
+	return m.Name + "(" + strings.Join(argTypes, ",") + ")" + m.Returns.Type
+}
+
+// Selector returns the 4-byte method selector, the first application argument
+// of a call to the method.
+func (m Method) Selector() []byte {
+	hash := sha512.Sum512_256([]byte(m.Signature()))
+	return hash[:methodSelectorLength]
+}
+
+// Verify checks that the method has a name and that its argument and return
+// types can be parsed.
+func (m Method) Verify() error {
+	if m.Name == "" {
+		return fmt.Errorf("Method has no name")
+	}
+	if strings.ContainsAny(m.Name, "(),") {
+		return fmt.Errorf(`Method name contains a reserved character: "%s"`, m.Name)
+	}
+
+	for i, arg := range m.Args {
+		if IsReferenceType(arg.Type) || IsTransactionType(arg.Type) {
+			continue
+		}
+		_, err := TypeOf(arg.Type)
+		if err != nil {
+			return fmt.Errorf("Error parsing argument type at index %d: %s", i, err.Error())
+		}
+	}
+
+	if m.Returns.Type != VoidReturnType {
+		_, err := TypeOf(m.Returns.Type)
+		if err != nil {
+			return fmt.Errorf("Error parsing return type: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// GetArgIndex returns the position of the argument with the given name, or -1
+// if the method has no such argument.
+func (m Method) GetArgIndex(name string) int {
+	if name == "" {
+		return -1
+	}
+	for i, arg := range m.Args {
+		if arg.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// ReturnType returns the ABI type of the method return value. It fails for
+// methods that return void.
+func (m Method) ReturnType() (Type, error) {
+	if m.Returns.Type == VoidReturnType {
+		return Type{}, fmt.Errorf("method %s does not return a value", m.Name)
+	}
+	return TypeOf(m.Returns.Type)
+}
+
+// DecodeReturnLog decodes the return value of the method from the last log of
+// the application call that invoked it.
+func (m Method) DecodeReturnLog(lastLog []byte) (interface{}, error) {
+	retType, err := m.ReturnType()
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(lastLog, MethodReturnPrefix) {
+		return nil, fmt.Errorf("log does not start with the return value prefix %x", MethodReturnPrefix)
+	}
+	return retType.Decode(lastLog[len(MethodReturnPrefix):])
+}
+
+// Interface is an ARC-4 interface description, a named set of methods.
+type Interface struct {
+	Name    string   `json:"name"`
+	Desc    string   `json:"desc,omitempty"`
+	Methods []Method `json:"methods"`
+}
+
+// ParseInterface parses and verifies an ARC-4 JSON interface description.
+func ParseInterface(jsonDesc []byte) (Interface, error) {
+	var iface Interface
+	err := json.Unmarshal(jsonDesc, &iface)
+	if err != nil {
+		return Interface{}, fmt.Errorf("cannot parse interface description: %v", err)
+	}
+	err = verifyMethods(iface.Methods)
+	if err != nil {
+		return Interface{}, err
+	}
+	return iface, nil
+}
+
+// GetMethodByName returns the interface method with the given name or signature.
+func (i Interface) GetMethodByName(name string) (Method, error) {
+	return getMethodByName(i.Methods, name)
+}
+
+// ContractNetworkInfo holds the deployment of a contract on a network.
+type ContractNetworkInfo struct {
+	AppID uint64 `json:"appID"`
+}
+
+// Contract is an ARC-4 contract description, the methods implemented by an
+// application along with the application IDs it is deployed at.
+type Contract struct {
+	Name string `json:"name"`
+	Desc string `json:"desc,omitempty"`
+	// Networks maps base64 encoded genesis hashes to the deployment of the
+	// contract on that network.
+	Networks map[string]ContractNetworkInfo `json:"networks,omitempty"`
+	Methods  []Method                       `json:"methods"`
+}
+
+// ParseContract parses and verifies an ARC-4 JSON contract description.
+func ParseContract(jsonDesc []byte) (Contract, error) {
+	var contract Contract
+	err := json.Unmarshal(jsonDesc, &contract)
+	if err != nil {
+		return Contract{}, fmt.Errorf("cannot parse contract description: %v", err)
+	}
+	err = verifyMethods(contract.Methods)
+	if err != nil {
+		return Contract{}, err
+	}
+	return contract, nil
+}
+
+// GetMethodByName returns the contract method with the given name or signature.
+func (c Contract) GetMethodByName(name string) (Method, error) {
+	return getMethodByName(c.Methods, name)
+}
+
+// AppID returns the application ID the contract is deployed at on the network
+// with the given base64 encoded genesis hash.
+func (c Contract) AppID(genesisHash string) (uint64, bool) {
+	info, ok := c.Networks[genesisHash]
+	if !ok || info.AppID == 0 {
+		return 0, false
+	}
+	return info.AppID, true
+}
+
+// verifyMethods checks every method and makes sure that no two methods share
+// a selector.
+func verifyMethods(methods []Method) error {
+	selectors := make(map[string]string, len(methods))
+	for _, method := range methods {
+		err := method.Verify()
+		if err != nil {
+			return fmt.Errorf("invalid method %s: %v", method.Name, err)
+		}
+		signature := method.Signature()
+		selector := string(method.Selector())
+		if other, ok := selectors[selector]; ok {
+			return fmt.Errorf("methods %s and %s have the same selector", other, signature)
+		}
+		selectors[selector] = signature
+	}
+	return nil
+}
+
+// getMethodByName looks up a method by its full signature or, if there is only
+// one method with that name, by its name alone.
+func getMethodByName(methods []Method, name string) (Method, error) {
+	if strings.Contains(name, "(") {
+		for _, method := range methods {
+			if method.Signature() == name {
+				return method, nil
+			}
+		}
+		return Method{}, fmt.Errorf("no method with signature %s", name)
+	}
+
+	var found []Method
+	for _, method := range methods {
+		if method.Name == name {
+			found = append(found, method)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Method{}, fmt.Errorf("no method named %s", name)
+	case 1:
+		return found[0], nil
+	default:
+		signatures := make([]string, len(found))
+		for i, method := range found {
+			signatures[i] = method.Signature()
+		}
+		return Method{}, fmt.Errorf("there are %d methods named %s, use one of the signatures %s", len(found), name, strings.Join(signatures, ", "))
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package abi
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const testContract = `{
+  "name": "Calculator",
+  "desc": "A simple calculator",
+  "networks": {
+    "wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=": {"appID": 1234}
+  },
+  "methods": [
+    {
+      "name": "add",
+      "desc": "Add two numbers",
+      "args": [
+        {"type": "uint64", "name": "a", "desc": "The first term"},
+        {"type": "uint64", "name": "b", "desc": "The second term"}
+      ],
+      "returns": {"type": "uint128", "desc": "The sum"}
+    },
+    {
+      "name": "multiply",
+      "args": [{"type": "uint64", "name": "a"}, {"type": "uint64", "name": "b"}],
+      "returns": {"type": "uint128"}
+    },
+    {
+      "name": "multiply",
+      "args": [{"type": "uint64[]", "name": "terms"}],
+      "returns": {"type": "uint128"}
+    },
+    {
+      "name": "reset",
+      "args": [],
+      "returns": {"type": "void"}
+    }
+  ]
+}`
+
+func TestMethodSignatureAndSelector(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tests := []struct {
+		signature string
+		selector  []byte
+	}{
+		// selectors from the ARC-4 specification
+		{"add(uint64,uint64)uint128", []byte{0x8a, 0xa3, 0xb6, 0x1f}},
+		{"optIn()void", []byte{0x29, 0x31, 0x4d, 0x95}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.signature, func(t *testing.T) {
+			method, err := MethodFromSignature(test.signature)
+			require.NoError(t, err)
+			require.Equal(t, test.signature, method.Signature())
+			require.Equal(t, test.selector, method.Selector())
+		})
+	}
+
+	_, err := MethodFromSignature("add(uint64,uint65)uint128")
+	require.Error(t, err)
+	_, err = MethodFromSignature("add(uint64,uint64)")
+	require.Error(t, err)
+}
+
+func TestParseContract(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	contract, err := ParseContract([]byte(testContract))
+	require.NoError(t, err)
+	require.Equal(t, "Calculator", contract.Name)
+	require.Len(t, contract.Methods, 4)
+
+	appID, ok := contract.AppID("wGHE2Pwdvd7S12BL5FaOP20EGYesN73ktiC1qzkkit8=")
+	require.True(t, ok)
+	require.Equal(t, uint64(1234), appID)
+	_, ok = contract.AppID("SGO1GKSzyE7IEPItTxCByw9x8FmnrCDexi9/cOUJOiI=")
+	require.False(t, ok)
+
+	add, err := contract.GetMethodByName("add")
+	require.NoError(t, err)
+	require.Equal(t, "add(uint64,uint64)uint128", add.Signature())
+	require.Equal(t, "The sum", add.Returns.Desc)
+	require.Equal(t, 1, add.GetArgIndex("b"))
+	require.Equal(t, -1, add.GetArgIndex("c"))
+	require.Equal(t, -1, add.GetArgIndex(""))
+
+	// overloaded methods can only be looked up by their signature
+	_, err = contract.GetMethodByName("multiply")
+	require.ErrorContains(t, err, "multiply(uint64[])uint128")
+	multiply, err := contract.GetMethodByName("multiply(uint64[])uint128")
+	require.NoError(t, err)
+	require.Equal(t, "terms", multiply.Args[0].Name)
+
+	_, err = contract.GetMethodByName("subtract")
+	require.Error(t, err)
+	_, err = contract.GetMethodByName("add(uint32,uint32)uint64")
+	require.Error(t, err)
+
+	_, err = ParseContract([]byte(`{"name": "Broken", "methods": [{"name": "f", "args": [{"type": "uint7"}], "returns": {"type": "void"}}]}`))
+	require.Error(t, err)
+	_, err = ParseContract([]byte(`{"name": "Duplicate", "methods": [{"name": "f", "args": [], "returns": {"type": "void"}}, {"name": "f", "args": [], "returns": {"type": "void"}}]}`))
+	require.ErrorContains(t, err, "same selector")
+}
+
+func TestParseInterface(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	iface, err := ParseInterface([]byte(`{"name": "Resettable", "methods": [{"name": "reset", "args": [], "returns": {"type": "void"}}]}`))
+	require.NoError(t, err)
+	require.Equal(t, "Resettable", iface.Name)
+
+	reset, err := iface.GetMethodByName("reset")
+	require.NoError(t, err)
+	require.Equal(t, "reset()void", reset.Signature())
+
+	_, err = ParseInterface([]byte(`{"name": "Resettable", "methods": [`))
+	require.Error(t, err)
+}
+
+func TestMethodDecodeReturnLog(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	method, err := MethodFromSignature("add(uint64,uint64)uint128")
+	require.NoError(t, err)
+
+	retType, err := method.ReturnType()
+	require.NoError(t, err)
+	encoded, err := retType.Encode(big.NewInt(5))
+	require.NoError(t, err)
+
+	decoded, err := method.DecodeReturnLog(append(append([]byte{}, MethodReturnPrefix...), encoded...))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(5), decoded)
+
+	_, err = method.DecodeReturnLog(encoded)
+	require.Error(t, err)
+	_, err = method.DecodeReturnLog(MethodReturnPrefix)
+	require.Error(t, err)
+
+	void, err := MethodFromSignature("reset()void")
+	require.NoError(t, err)
+	_, err = void.DecodeReturnLog(MethodReturnPrefix)
+	require.Error(t, err)
+}
@@ -223,6 +223,14 @@ type AccountData struct {
 	// TotalExtraAppPages stores the extra length in pages (MaxAppProgramLen bytes per page)
 	// requested for app program by this account
 	TotalExtraAppPages uint32 `codec:"teap"`
+
+	// TotalBoxes is the number of boxes created by the application whose
+	// account this is, so that we don't have to look them up to compute MinBalance.
+	TotalBoxes uint64 `codec:"tbx"`
+
+	// TotalBoxBytes is the sum of the lengths of the names and contents of
+	// the boxes counted by TotalBoxes.
+	TotalBoxBytes uint64 `codec:"tbxb"`
 }
 
 // AppLocalState stores the LocalState associated with an application. It also
@@ -468,7 +476,7 @@ func (u AccountData) MinBalance(proto *config.ConsensusParams) (res MicroAlgos)
 		uint64(len(u.Assets)),
 		u.TotalAppSchema,
 		uint64(len(u.AppParams)), uint64(len(u.AppLocalStates)),
-		uint64(u.TotalExtraAppPages),
+		uint64(u.TotalExtraAppPages), u.TotalBoxes, u.TotalBoxBytes,
 	)
 }
 
@@ -481,6 +489,7 @@ func MinBalance(
 	totalAppSchema StateSchema,
 	totalAppParams uint64, totalAppLocalStates uint64,
 	totalExtraAppPages uint64,
+	totalBoxes uint64, totalBoxBytes uint64,
 ) (res MicroAlgos) {
 	var min uint64
 
@@ -508,6 +517,12 @@ func MinBalance(
 	extraAppProgramLenCost := MulSaturate(proto.AppFlatParamsMinBalance, totalExtraAppPages)
 	min = AddSaturate(min, extraAppProgramLenCost)
 
+	// MinBalance for each box, and for the bytes of box names and contents
+	boxBaseCost := MulSaturate(proto.BoxFlatMinBalance, totalBoxes)
+	min = AddSaturate(min, boxBaseCost)
+	boxByteCost := MulSaturate(proto.BoxByteMinBalance, totalBoxBytes)
+	min = AddSaturate(min, boxByteCost)
+
 	res.Raw = min
 	return res
 }
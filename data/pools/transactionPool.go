@@ -17,6 +17,7 @@
 package pools
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -74,6 +75,9 @@ type TransactionPool struct {
 	// assemblyRound indicates which round number we're currently waiting for or waited for last.
 	assemblyRound   basics.Round
 	assemblyResults poolAsmResults
+	// speculativeBlock is the block assembled for the round following a block
+	// that was not yet committed, by AssembleSpeculativeBlock.
+	speculativeBlock *ledgercore.ValidatedBlock
 
 	// pendingMu protects pendingTxGroups and pendingTxids
 	pendingMu       deadlock.RWMutex
@@ -500,6 +504,12 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 		pool.pendingMu.RUnlock()
 	}
 
+	pool.assemblyMu.Lock()
+	if pool.speculativeBlock != nil && pool.speculativeBlock.Block().Round() <= block.Round() {
+		pool.speculativeBlock = nil
+	}
+	pool.assemblyMu.Unlock()
+
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
 	defer pool.cond.Broadcast()
@@ -829,6 +839,11 @@ func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Tim
 		}()
 	}
 
+	if speculative := pool.speculativeBlockFor(round); speculative != nil {
+		stats.StopReason = telemetryspec.AssembleBlockSpeculative
+		return speculative, nil
+	}
+
 	pool.assemblyMu.Lock()
 
 	// if the transaction pool is more than two rounds behind, we don't want to wait.
@@ -912,6 +927,106 @@ func (pool *TransactionPool) AssembleBlock(round basics.Round, deadline time.Tim
 	return pool.assemblyResults.blk, nil
 }
 
+// AssembleSpeculativeBlock assembles the block of the round following prev,
+// before prev is committed to the ledger, trying not to take longer than the
+// ProposalAssemblyTime. The block is kept until the ledger moves past its round,
+// and AssembleBlock returns it if prev is the block committed before it.
+//
+// The assembly is abandoned if ctx is canceled.
+func (pool *TransactionPool) AssembleSpeculativeBlock(ctx context.Context, prev *ledgercore.ValidatedBlock) {
+	deadline := time.Now().Add(pool.proposalAssemblyTime)
+	prevHdr := prev.Block().BlockHeader
+
+	// Ensure we know about the next protocol version (MakeBlock will panic
+	// if we don't)
+	_, upgradeState, err := bookkeeping.ProcessUpgradeParams(prevHdr)
+	if err != nil {
+		pool.log.Warnf("TransactionPool.AssembleSpeculativeBlock: error processing upgrade params for round %d: %v", prevHdr.Round+1, err)
+		return
+	}
+	if _, ok := config.Consensus[upgradeState.CurrentProtocol]; !ok {
+		pool.log.Warnf("TransactionPool.AssembleSpeculativeBlock: next protocol version %v is not supported", upgradeState.CurrentProtocol)
+		return
+	}
+
+	pool.pendingMu.RLock()
+	txgroups := pool.pendingTxGroups
+	pendingCount := pool.pendingCountNoLock()
+	pool.pendingMu.RUnlock()
+
+	next := bookkeeping.MakeBlock(prevHdr)
+	eval, err := pool.ledger.StartSpeculativeEvaluator(*prev, next.BlockHeader, pendingCount, 0)
+	if err != nil {
+		pool.log.Infof("TransactionPool.AssembleSpeculativeBlock: cannot start evaluator for round %d: %v", next.Round(), err)
+		return
+	}
+
+	committed := prev.Delta().Txids
+	for _, txgroup := range txgroups {
+		if ctx.Err() != nil {
+			return
+		}
+		if time.Now().After(deadline) {
+			break
+		}
+		if len(txgroup) == 0 {
+			continue
+		}
+		if _, alreadyCommitted := committed[txgroup[0].ID()]; alreadyCommitted {
+			continue
+		}
+		// the groups that are not valid on top of prev are left to the pending block evaluator
+		err = eval.TransactionGroup(transactions.WrapSignedTxnsWithAD(txgroup))
+		if err == ledgercore.ErrNoSpace {
+			break
+		}
+	}
+
+	blk, err := eval.GenerateBlock()
+	if err != nil {
+		pool.log.Warnf("TransactionPool.AssembleSpeculativeBlock: could not generate block for round %d: %v", next.Round(), err)
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	pool.assemblyMu.Lock()
+	defer pool.assemblyMu.Unlock()
+	if pool.assemblyResults.roundStartedEvaluating > next.Round() {
+		// the ledger has already moved past that round
+		return
+	}
+	pool.speculativeBlock = blk
+}
+
+// speculativeBlockFor returns the block assembled by AssembleSpeculativeBlock
+// for round, if it follows the block the ledger committed for the previous round.
+func (pool *TransactionPool) speculativeBlockFor(round basics.Round) *ledgercore.ValidatedBlock {
+	pool.assemblyMu.Lock()
+	blk := pool.speculativeBlock
+	pool.assemblyMu.Unlock()
+	if blk == nil || blk.Block().Round() != round {
+		return nil
+	}
+
+	prev, err := pool.ledger.BlockHdr(round - 1)
+	if err != nil {
+		return nil
+	}
+	if blk.Block().Branch != bookkeeping.BlockHash(prev.Hash()) {
+		// a different block was committed
+		pool.log.Infof("TransactionPool.speculativeBlockFor: discarding the block speculatively assembled for round %d", round)
+		pool.assemblyMu.Lock()
+		if pool.speculativeBlock == blk {
+			pool.speculativeBlock = nil
+		}
+		pool.assemblyMu.Unlock()
+		return nil
+	}
+	return blk
+}
+
 // assembleEmptyBlock construct a new block for the given round. Internally it's using the ledger database calls, so callers
 // need to be aware that it might take a while before it would return.
 func (pool *TransactionPool) assembleEmptyBlock(round basics.Round) (assembled *ledgercore.ValidatedBlock, err error) {
@@ -17,10 +17,12 @@
 package pools
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -573,6 +575,94 @@ func TestRememberForget(t *testing.T) {
 	require.Len(t, pending, 0)
 }
 
+func TestAssembleSpeculativeBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	numOfAccounts := 3
+	// Generate accounts
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+
+	for i := 0; i < numOfAccounts; i++ {
+		secret := keypair()
+		addr := basics.Address(secret.SignatureVerifier)
+		secrets[i] = secret
+		addresses[i] = addr
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	pay := func(sender int, amount uint64) transactions.SignedTxn {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[sender],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[numOfAccounts-1],
+				Amount:   basics.MicroAlgos{Raw: amount},
+			},
+		}
+		return tx.Sign(secrets[sender])
+	}
+	committed := pay(0, 1)
+	pending := pay(1, 1)
+	require.NoError(t, transactionPool.RememberOne(committed))
+	require.NoError(t, transactionPool.RememberOne(pending))
+
+	eval := newBlockEvaluator(t, mockLedger)
+	require.NoError(t, eval.Transaction(committed, transactions.ApplyData{}))
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+
+	// the block of the next round only holds the transactions which are not in blk
+	transactionPool.AssembleSpeculativeBlock(context.Background(), blk)
+	speculative := transactionPool.speculativeBlock
+	require.NotNil(t, speculative)
+	require.Equal(t, blk.Block().Round()+1, speculative.Block().Round())
+	require.Len(t, speculative.Block().Payset, 1)
+	require.Equal(t, pending.Txn.Sender, speculative.Block().Payset[0].Txn.Sender)
+
+	// it is proposed once blk is committed
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), blk.Delta())
+	assembled, err := transactionPool.AssembleBlock(speculative.Block().Round(), time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Equal(t, speculative, assembled)
+
+	// and discarded once a block of its round is committed
+	require.NoError(t, mockLedger.AddValidatedBlock(*assembled, agreement.Certificate{}))
+	transactionPool.OnNewBlock(assembled.Block(), assembled.Delta())
+	require.Nil(t, transactionPool.speculativeBlock)
+
+	// a block assembled on top of a block which does not get committed is not proposed
+	eval = newBlockEvaluator(t, mockLedger)
+	abandoned, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	transactionPool.AssembleSpeculativeBlock(context.Background(), abandoned)
+	require.NotNil(t, transactionPool.speculativeBlock)
+
+	eval = newBlockEvaluator(t, mockLedger)
+	require.NoError(t, eval.Transaction(pay(0, 2), transactions.ApplyData{}))
+	blk, err = eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), blk.Delta())
+	require.NotNil(t, transactionPool.speculativeBlock)
+	assembled, err = transactionPool.AssembleBlock(blk.Block().Round()+1, time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.NotEqual(t, transactionPool.speculativeBlock, assembled)
+	require.Nil(t, transactionPool.speculativeBlock)
+}
+
 //	Test that clean up works
 func TestCleanUp(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -46,6 +46,12 @@ const (
 	// can contain. Its value is verified against consensus parameters in
 	// TestEncodedAppTxnAllocationBounds
 	encodedMaxForeignAssets = 32
+
+	// encodedMaxBoxes sets the allocation bound for the maximum
+	// number of Boxes that a transaction decoded off of the wire
+	// can contain. Its value is verified against consensus parameters in
+	// TestEncodedAppTxnAllocationBounds
+	encodedMaxBoxes = 32
 )
 
 // OnCompletion is an enum representing some layer 1 side effect that an
@@ -120,6 +126,10 @@ type ApplicationCallTxnFields struct {
 	// ApprovalProgram or ClearStateProgram.
 	ForeignAssets []basics.AssetIndex `codec:"apas,allocbound=encodedMaxForeignAssets"`
 
+	// Boxes are the boxes that may be accessed by the executing
+	// ApprovalProgram or ClearStateProgram.
+	Boxes []BoxRef `codec:"apbx,allocbound=encodedMaxBoxes"`
+
 	// LocalStateSchema specifies the maximum number of each type that may
 	// appear in the local key/value store of users who opt in to this
 	// application. This field is only used during application creation
@@ -155,6 +165,16 @@ type ApplicationCallTxnFields struct {
 	// method below!
 }
 
+// BoxRef names a box by its name and the application that owns it.
+type BoxRef struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Index is 0 for the application being called, or the 1-based index
+	// of the owning application in the ForeignApps array.
+	Index uint64 `codec:"i"`
+	Name  []byte `codec:"n,allocbound=config.MaxBytesKeyValueLen"`
+}
+
 // Empty indicates whether or not all the fields in the
 // ApplicationCallTxnFields are zeroed out
 func (ac *ApplicationCallTxnFields) Empty() bool {
@@ -176,6 +196,9 @@ func (ac *ApplicationCallTxnFields) Empty() bool {
 	if ac.ForeignAssets != nil {
 		return false
 	}
+	if ac.Boxes != nil {
+		return false
+	}
 	if ac.LocalStateSchema != (basics.StateSchema{}) {
 		return false
 	}
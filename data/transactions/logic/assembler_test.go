@@ -383,11 +383,50 @@ dup
 bn256_scalar_mul
 dup
 bn256_pairing
+pushbytes 0x626f78
+pushint 8
+box_create
+pushbytes 0x626f78
+pushint 1
+pushint 2
+box_extract
+pushbytes 0x626f78
+pushint 1
+pushbytes 0x01
+box_replace
+pushbytes 0x626f78
+box_del
+pushbytes 0x626f78
+box_len
+pushbytes 0x626f78
+box_get
+pushbytes 0x626f78
+pushbytes 0x01
+box_put
+pushbytes 0x0123
+dup
+ec_add BLS12_381g1
+dup
+ec_scalar_mul BLS12_381g2
+dup
+ec_multi_scalar_mul BLS12_381g1
+ec_map_to BLS12_381g2
+dup
+ec_pairing_check BLS12_381g1
+pushbytes 0x0123
+dup
+dup
+vrf_verify VrfAlgorand
+pushint 1
+block BlkSeed
 `
 
 const v6Compiled = "2004010002b7a60c26050242420c68656c6c6f20776f726c6421070123456789abcd208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d047465737400320032013202320380021234292929292b0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e01022581f8acd19181cf959a1281f8acd19181cf951a81f8acd19181cf1581f8acd191810f082209240a220b230c240d250e230f23102311231223132314181b1c28171615400003290349483403350222231d4a484848482b50512a632223524100034200004322602261222704634848222862482864286548482228246628226723286828692322700048482371004848361c0037001a0031183119311b311d311e311f312023221e312131223123312431253126312731283129312a312b312c312d312e312f447825225314225427042455220824564c4d4b0222382124391c0081e80780046a6f686e2281d00f23241f880003420001892224902291922494249593a0a1a2a3a4a5a6a7a8a9aaabacadae24af3a00003b003c003d816472064e014f012a57000823810858235b235a2359b03139330039b1b200b322c01a23c1001a2323c21a23c3233e233f8120af06002a494905002a49490700b53a03b6b7043cb8033a0c2349c42a9631007300810881088120978101c53a8101c6003a"
 
-const v7Compiled = v6Compiled + "5c005d018120af060180070123456789abcd4949050198800301234549498480030123454999499a499b"
+const v7Compiled = v6Compiled + "5c005d018120af060180070123456789abcd4949050198800301234549498480030123454999499a499b" +
+	"8003626f788108b98003626f7881018102ba8003626f788101800101bb8003626f78bc8003626f78bd8003626f78be8003626f78800101bf" +
+	"8002012349e00049e10149e300e40149e200" +
+	"800201234949d0008101d100"
 
 var nonsense = map[uint64]string{
 	1: v1Nonsense,
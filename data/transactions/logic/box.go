@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// topLevel returns the outermost EvalContext of the current evaluation, which
+// is evaluating one of the transactions of the top-level group.
+func (cx *EvalContext) topLevel() *EvalContext {
+	top := cx
+	for top.caller != nil {
+		top = top.caller
+	}
+	return top
+}
+
+// availableBox returns an error unless the box called name, belonging to the
+// currently executing app, is referenced by an app call of the top-level group.
+func (cx *EvalContext) availableBox(name string) error {
+	if !cx.Proto.EnableBoxes {
+		return errors.New("boxes are not enabled")
+	}
+	if len(name) == 0 {
+		return errors.New("box names may not be zero length")
+	}
+	if len(name) > cx.Proto.MaxAppKeyLen {
+		return fmt.Errorf("name too long: length was %d, maximum is %d", len(name), cx.Proto.MaxAppKeyLen)
+	}
+
+	top := cx.topLevel()
+	for gi := range top.TxnGroup {
+		txn := &top.TxnGroup[gi].Txn
+		if txn.Type != protocol.ApplicationCallTx {
+			continue
+		}
+		for _, br := range txn.Boxes {
+			var app basics.AppIndex
+			if br.Index == 0 {
+				app = txn.ApplicationID
+				// The app being created by the current top-level txn can
+				// only be known once it is executing.
+				if app == 0 && gi == top.groupIndex {
+					app = top.appID
+				}
+			} else if br.Index <= uint64(len(txn.ForeignApps)) {
+				app = txn.ForeignApps[br.Index-1]
+			}
+			if app == cx.appID && string(br.Name) == name {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("invalid Box reference %#x", name)
+}
+
+func (cx *EvalContext) checkBoxSize(size uint64) error {
+	if size == 0 {
+		return errors.New("box size may not be zero")
+	}
+	if size > cx.Proto.MaxBoxSize {
+		return fmt.Errorf("box size too large: %d, maximum is %d", size, cx.Proto.MaxBoxSize)
+	}
+	return nil
+}
+
+// createBox creates the box called name, with the given contents, for the
+// currently executing app. The min balance of the boxes is charged to the
+// account of the app.
+func (cx *EvalContext) createBox(name string, value []byte) error {
+	err := cx.checkBoxSize(uint64(len(value)))
+	if err != nil {
+		return err
+	}
+	return cx.Ledger.NewBox(cx.appID, name, value, cx.getApplicationAddress(cx.appID))
+}
+
+func opBoxCreate(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // size
+	prev := last - 1          // name
+
+	name := string(cx.stack[prev].Bytes)
+	size := cx.stack[last].Uint
+
+	err := cx.availableBox(name)
+	if err != nil {
+		return err
+	}
+	err = cx.checkBoxSize(size)
+	if err != nil {
+		return err
+	}
+
+	value, exists, err := cx.Ledger.GetBox(cx.appID, name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if uint64(len(value)) != size {
+			return fmt.Errorf("box size mismatch %d %d", len(value), size)
+		}
+		cx.stack[prev] = stackValue{Uint: boolToUint(false)}
+	} else {
+		err = cx.createBox(name, make([]byte, size))
+		if err != nil {
+			return err
+		}
+		cx.stack[prev] = stackValue{Uint: boolToUint(true)}
+	}
+	cx.stack = cx.stack[:last]
+	return nil
+}
+
+// getBox fetches the contents of the box called name, failing if it does not exist.
+func (cx *EvalContext) getBox(name string) ([]byte, error) {
+	err := cx.availableBox(name)
+	if err != nil {
+		return nil, err
+	}
+	value, exists, err := cx.Ledger.GetBox(cx.appID, name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("no such box %#x", name)
+	}
+	return value, nil
+}
+
+func opBoxExtract(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // length
+	prev := last - 1          // start
+	pprev := prev - 1         // name
+
+	name := string(cx.stack[pprev].Bytes)
+	start := cx.stack[prev].Uint
+	length := cx.stack[last].Uint
+
+	if length > maxStringSize {
+		return fmt.Errorf("box_extract length %d exceeds maximum %d", length, maxStringSize)
+	}
+
+	contents, err := cx.getBox(name)
+	if err != nil {
+		return err
+	}
+	end, overflow := basics.OAdd(start, length)
+	if overflow || end > uint64(len(contents)) {
+		return fmt.Errorf("extraction end %d is beyond length: %d", end, len(contents))
+	}
+
+	cx.stack[pprev].Bytes = nilToEmpty(append([]byte(nil), contents[start:end]...))
+	cx.stack = cx.stack[:prev]
+	return nil
+}
+
+func opBoxReplace(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // replacement
+	prev := last - 1          // start
+	pprev := prev - 1         // name
+
+	name := string(cx.stack[pprev].Bytes)
+	start := cx.stack[prev].Uint
+	replacement := cx.stack[last].Bytes
+
+	contents, err := cx.getBox(name)
+	if err != nil {
+		return err
+	}
+	end, overflow := basics.OAdd(start, uint64(len(replacement)))
+	if overflow || end > uint64(len(contents)) {
+		return fmt.Errorf("replacement end %d beyond length: %d", end, len(contents))
+	}
+
+	// Copy, so that the value stored in the ledger is never modified in place
+	value := append([]byte(nil), contents...)
+	copy(value[start:], replacement)
+	err = cx.Ledger.SetBox(cx.appID, name, value)
+	if err != nil {
+		return err
+	}
+	cx.stack = cx.stack[:pprev]
+	return nil
+}
+
+func opBoxDel(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // name
+
+	name := string(cx.stack[last].Bytes)
+	err := cx.availableBox(name)
+	if err != nil {
+		return err
+	}
+	existed, err := cx.Ledger.DelBox(cx.appID, name, cx.getApplicationAddress(cx.appID))
+	if err != nil {
+		return err
+	}
+	cx.stack[last] = stackValue{Uint: boolToUint(existed)}
+	return nil
+}
+
+func opBoxLen(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // name
+
+	name := string(cx.stack[last].Bytes)
+	err := cx.availableBox(name)
+	if err != nil {
+		return err
+	}
+	contents, exists, err := cx.Ledger.GetBox(cx.appID, name)
+	if err != nil {
+		return err
+	}
+	cx.stack[last] = stackValue{Uint: uint64(len(contents))}
+	cx.stack = append(cx.stack, stackValue{Uint: boolToUint(exists)})
+	return nil
+}
+
+func opBoxGet(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // name
+
+	name := string(cx.stack[last].Bytes)
+	err := cx.availableBox(name)
+	if err != nil {
+		return err
+	}
+	contents, exists, err := cx.Ledger.GetBox(cx.appID, name)
+	if err != nil {
+		return err
+	}
+	if len(contents) > maxStringSize {
+		return fmt.Errorf("box_get produced a too big (%d) byte-array", len(contents))
+	}
+	cx.stack[last].Bytes = nilToEmpty(append([]byte(nil), contents...))
+	cx.stack = append(cx.stack, stackValue{Uint: boolToUint(exists)})
+	return nil
+}
+
+func opBoxPut(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // value
+	prev := last - 1          // name
+
+	value := cx.stack[last].Bytes
+	name := string(cx.stack[prev].Bytes)
+
+	err := cx.availableBox(name)
+	if err != nil {
+		return err
+	}
+
+	// This boils down to replacing the box contents, if it exists.
+	contents, exists, err := cx.Ledger.GetBox(cx.appID, name)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if len(contents) != len(value) {
+			return fmt.Errorf("attempt to box_put wrong size %d != %d", len(contents), len(value))
+		}
+		err = cx.Ledger.SetBox(cx.appID, name, append([]byte(nil), value...))
+	} else {
+		err = cx.createBox(name, append([]byte(nil), value...))
+	}
+	if err != nil {
+		return err
+	}
+	cx.stack = cx.stack[:prev]
+	return nil
+}
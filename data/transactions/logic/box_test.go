@@ -0,0 +1,187 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeBoxEnv returns an environment in which app 888 is being called, with
+// references to the boxes named by names.
+func makeBoxEnv(names ...string) (*EvalParams, *transactions.Transaction, *Ledger) {
+	ep, txn, ledger := makeSampleEnv()
+	txn.Type = protocol.ApplicationCallTx
+	txn.ApplicationID = 888
+	txn.Boxes = nil
+	for _, name := range names {
+		txn.Boxes = append(txn.Boxes, transactions.BoxRef{Index: 0, Name: []byte(name)})
+	}
+	ledger.NewApp(txn.Sender, 888, basics.AppParams{})
+	return ep, txn, ledger
+}
+
+func TestBoxCreate(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, _, ledger := makeBoxEnv("self", "other")
+
+	// create a box, then find it exists with the right size
+	testApp(t, `byte "self"; int 24; box_create; assert; int 1`, ep)
+	testApp(t, `byte "self"; box_len; assert; int 24; ==`, ep)
+	testApp(t, `byte "self"; box_get; assert; len; int 24; ==`, ep)
+
+	// creating it again, with the same size, is a noop that returns 0
+	testApp(t, `byte "self"; int 24; box_create; !`, ep)
+	// but not with a different size
+	testApp(t, `byte "self"; int 25; box_create`, ep, "box size mismatch")
+
+	testApp(t, `byte "other"; int 0; box_create`, ep, "box size may not be zero")
+	testApp(t, `byte "other"; int 1001; box_create`, ep, "box size too large")
+	testApp(t, `byte "other"; int 1000; box_create`, ep)
+
+	appAddr := basics.AppIndex(888).Address()
+	acct, err := ledger.AccountData(appAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), acct.TotalBoxes)
+	require.Equal(t, uint64(len("self")+24+len("other")+1000), acct.TotalBoxBytes)
+}
+
+func TestBoxAvailability(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, txn, _ := makeBoxEnv("self")
+
+	testApp(t, `byte "unreferenced"; int 8; box_create`, ep, "invalid Box reference 0x756e7265666572656e636564")
+	testApp(t, `byte ""; box_len`, ep, "box names may not be zero length")
+	testApp(t, `byte "self"; int 8; box_create`, ep)
+
+	// A reference through the foreign apps array works too
+	txn.ForeignApps = []basics.AppIndex{111, 888}
+	txn.Boxes = []transactions.BoxRef{{Index: 2, Name: []byte("foreign")}}
+	testApp(t, `byte "foreign"; int 8; box_create`, ep)
+	// but only for the app it names
+	txn.Boxes = []transactions.BoxRef{{Index: 1, Name: []byte("foreign")}}
+	testApp(t, `byte "foreign"; box_len`, ep, "invalid Box reference")
+
+	// Boxes referenced in another app call of the group are available
+	ep, _, _ = makeBoxEnv()
+	other := ep.TxnGroup[1].Txn
+	other.Type = protocol.ApplicationCallTx
+	other.ApplicationID = 888
+	other.Boxes = []transactions.BoxRef{{Index: 0, Name: []byte("elsewhere")}}
+	ep.TxnGroup[1].Txn = other
+	testApp(t, `byte "elsewhere"; int 8; box_create`, ep)
+
+	// Boxes can not be used before they are enabled
+	ep, _, _ = makeBoxEnv("self")
+	ep.Proto.EnableBoxes = false
+	testApp(t, `byte "self"; box_len`, ep, "boxes are not enabled")
+
+	testProg(t, `byte "self"; box_len`, boxVersion-1, Expect{2, "box_len opcode was introduced in TEAL v7"})
+	testLogic(t, `byte "self"; box_len`, boxVersion, defaultEvalParams(nil),
+		"not allowed in current mode", "not allowed in current mode")
+}
+
+func TestBoxReadWrite(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, _, _ := makeBoxEnv("self")
+
+	testApp(t, `byte "self"; box_get; !; assert; len; !`, ep)
+	testApp(t, `byte "self"; box_len; !; assert; !`, ep)
+	testApp(t, `byte "self"; int 1; int 2; box_extract; len`, ep, "no such box")
+	testApp(t, `byte "self"; int 1; byte 0x11; box_replace; int 1`, ep, "no such box")
+
+	// box_put creates a box of the size of its value
+	testApp(t, `byte "self"; byte 0x00010203040506; box_put; int 1`, ep)
+	testApp(t, `byte "self"; box_len; assert; int 7; ==`, ep)
+	testApp(t, `byte "self"; int 1; int 3; box_extract; byte 0x010203; ==`, ep)
+	testApp(t, `byte "self"; int 5; int 3; box_extract`, ep, "extraction end 8")
+	testApp(t, `byte "self"; int 0; int 7; box_extract; byte 0x00010203040506; ==`, ep)
+
+	testApp(t, `byte "self"; int 2; byte 0xaabb; box_replace;
+                byte "self"; box_get; assert; byte 0x0001aabb040506; ==`, ep)
+	testApp(t, `byte "self"; int 6; byte 0xaabb; box_replace; int 1`, ep, "replacement end 8")
+
+	// box_put must not change the size of an existing box
+	testApp(t, `byte "self"; byte 0x0102; box_put; int 1`, ep, "attempt to box_put wrong size 7 != 2")
+	testApp(t, `byte "self"; byte 0x01020304050607; box_put;
+                byte "self"; box_get; assert; byte 0x01020304050607; ==`, ep)
+
+	// extractions beyond the maximum stack size are refused
+	testApp(t, `byte "self"; int 0; int 4097; box_extract`, ep, "exceeds maximum")
+}
+
+func TestBoxDelete(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, _, ledger := makeBoxEnv("self")
+	appAddr := basics.AppIndex(888).Address()
+
+	testApp(t, `byte "self"; box_del; !`, ep)
+	testApp(t, `byte "self"; int 10; box_create; assert; int 1`, ep)
+	acct, err := ledger.AccountData(appAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), acct.TotalBoxes)
+
+	testApp(t, `byte "self"; box_del; assert; byte "self"; box_len; !; assert; !`, ep)
+	acct, err = ledger.AccountData(appAddr)
+	require.NoError(t, err)
+	require.Zero(t, acct.TotalBoxes)
+	require.Zero(t, acct.TotalBoxBytes)
+}
+
+func TestBoxNameLength(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	longest := strings.Repeat("x", 64)
+	ep, _, _ := makeBoxEnv(longest, longest+"x")
+
+	testApp(t, fmt.Sprintf(`byte "%s"; int 1; box_create`, longest), ep)
+	testApp(t, fmt.Sprintf(`byte "%s"; int 1; box_create`, longest+"x"), ep, "name too long")
+}
+
+func TestBoxMinBalance(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, _, ledger := makeBoxEnv("self")
+	appAddr := basics.AppIndex(888).Address()
+
+	testApp(t, `global CurrentApplicationAddress; min_balance; int 1001; ==`, ep)
+	// 1001 + 1007 for the box + 11 for each of the 4+10 bytes of name and value
+	testApp(t, `byte "self"; int 10; box_create; assert;
+                global CurrentApplicationAddress; min_balance; int 2162; ==`, ep)
+	acct, err := ledger.AccountData(appAddr)
+	require.NoError(t, err)
+	require.Equal(t, ep.Proto.MinBalance+ep.Proto.BoxFlatMinBalance+ep.Proto.BoxByteMinBalance*14,
+		acct.MinBalance(ep.Proto).Raw)
+}
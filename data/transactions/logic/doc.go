@@ -36,6 +36,15 @@ var opDocByName = map[string]string{
 	"bn256_scalar_mul":    "for (curve point A, scalar K) return the curve point KA",
 	"bn256_pairing":       "for (points in G1 group G1s, points in G2 group G2s), return whether they are paired => {0 or 1}",
 
+	"ec_add":              "for curve points A and B, return the curve point A + B",
+	"ec_scalar_mul":       "for curve point A and scalar B, return the curve point BA",
+	"ec_pairing_check":    "1 if the product of the pairing of each point in A with its respective point in B is equal to the identity element of the target group Gt, else 0",
+	"ec_multi_scalar_mul": "for curve points A and scalars B, return curve point B0A0 + B1A1 + B2A2 + ... + BnAn",
+	"ec_map_to":           "maps field element A to group G",
+
+	"vrf_verify": "Verify the proof B of message A against pubkey C. Returns vrf output and verification flag.",
+	"block":      "field F of block A. Fail unless txn.LastValid-MaxTxnLife <= A < txn.FirstValid",
+
 	"+":       "A plus B. Fail on overflow.",
 	"-":       "A minus B. Fail if B > A.",
 	"/":       "A divided by B (truncated division). Fail if B == 0.",
@@ -161,9 +170,17 @@ var opDocByName = map[string]string{
 	"asset_params_get":  "X is field F from asset A. Y is 1 if A exists, else 0",
 	"app_params_get":    "X is field F from app A. Y is 1 if A exists, else 0",
 	"acct_params_get":   "X is field F from account A. Y is 1 if A owns positive algos, else 0",
-	"assert":            "immediately fail unless A is a non-zero number",
-	"callsub":           "branch unconditionally to TARGET, saving the next instruction on the call stack",
-	"retsub":            "pop the top instruction from the call stack and branch to it",
+
+	"box_create":  "create a box named A, of length B. Fail if A is empty or B exceeds 32,768. Returns 0 if A already existed, else 1",
+	"box_extract": "read C bytes from box A, starting at offset B. Fail if A does not exist, or the byte range is outside A's size.",
+	"box_replace": "write byte-array C into box A, starting at offset B. Fail if A does not exist, or the byte range is outside A's size.",
+	"box_del":     "delete box named A if it exists. Return 1 if A existed, 0 otherwise",
+	"box_len":     "X is the length of box A if A exists, else 0. Y is 1 if A exists, else 0.",
+	"box_get":     "X is the contents of box A if A exists, else ''. Y is 1 if A exists, else 0.",
+	"box_put":     "replaces the contents of box A with byte-array B. Fails if A exists and len(B) != len(box A). Creates A if it does not exist",
+	"assert":      "immediately fail unless A is a non-zero number",
+	"callsub":     "branch unconditionally to TARGET, saving the next instruction on the call stack",
+	"retsub":      "pop the top instruction from the call stack and branch to it",
 
 	"b+":  "A plus B. A and B are interpreted as big-endian unsigned integers",
 	"b-":  "A minus B. A and B are interpreted as big-endian unsigned integers. Fail on underflow.",
@@ -250,6 +267,15 @@ var opcodeImmediateNotes = map[string]string{
 	"ecdsa_pk_decompress": "{uint8 curve index}",
 	"ecdsa_pk_recover":    "{uint8 curve index}",
 
+	"ec_add":              "{uint8 curve}",
+	"ec_scalar_mul":       "{uint8 curve}",
+	"ec_pairing_check":    "{uint8 curve}",
+	"ec_multi_scalar_mul": "{uint8 curve}",
+	"ec_map_to":           "{uint8 curve}",
+
+	"vrf_verify": "{uint8 parameters index}",
+	"block":      "{uint8 block field}",
+
 	"base64_decode": "{uint8 encoding index}",
 	"json_ref":      "{string return type}",
 }
@@ -268,6 +294,14 @@ var opDocExtras = map[string]string{
 	"bn256_add":           "A, B are curve points in G1 group. Each point consists of (X, Y) where X and Y are 256 bit integers, big-endian encoded. The encoded point is 64 bytes from concatenation of 32 byte X and 32 byte Y.",
 	"bn256_scalar_mul":    "A is a curve point in G1 Group and encoded as described in `bn256_add`. Scalar K is a big-endian encoded big integer that has no padding zeros.",
 	"bn256_pairing":       "G1s are encoded by the concatenation of encoded G1 points, as described in `bn256_add`. G2s are encoded by the concatenation of encoded G2 points. Each G2 is in form (XA0+i*XA1, YA0+i*YA1) and encoded by big-endian field element XA0, XA1, YA0 and YA1 in sequence.",
+
+	"ec_add":              "A and B are curve points of the group G, encoded as the concatenation of their X and Y coordinates. BLS12-381 field elements are 48 byte, big-endian values, and a coordinate of a G2 point, an element of Fp2 of the form A0+i*A1, is encoded as A0 followed by A1. The point at infinity is encoded as zeros. A and B must be on the curve, but need not be in the subgroup G.",
+	"ec_scalar_mul":       "A is a point of the group G, encoded as described in `ec_add`. B is a big-endian scalar of at most 32 bytes. A must be in the subgroup G.",
+	"ec_pairing_check":    "A is the concatenation of points of the group G and B the concatenation of as many points of the other group, G2 for BLS12_381g1 and G1 for BLS12_381g2, encoded as described in `ec_add`. All points must be in their subgroup. The cost is charged per point of B.",
+	"ec_multi_scalar_mul": "A is the concatenation of points of the group G, encoded as described in `ec_add`, and B the concatenation of as many 32 byte big-endian scalars. All points must be in the subgroup G. The cost is charged per scalar of B.",
+	"ec_map_to":           "For BLS12_381g1, A is a 48 byte big-endian field element. For BLS12_381g2, A is an element of Fp2, 96 bytes encoded as described in `ec_add`. The point is computed with the Shallue-van de Woestijne method of RFC 9380 and its cofactor is cleared, so that it is in the subgroup G.",
+	"vrf_verify":          "`VrfAlgorand` is the VRF used in Algorand. It is ECVRF-ED25519-SHA512-Elligator2, specified in the IETF internet draft [draft-irtf-cfrg-vrf-03](https://datatracker.ietf.org/doc/draft-irtf-cfrg-vrf/03/).",
+	"block":               "The available rounds are those whose headers the ledger is guaranteed to retain for as long as the transaction can be in a block. Round 0 is never available, and a transaction with the longest allowed lifetime can read no block at all.",
 	"bnz":                 "The `bnz` instruction opcode 0x40 is followed by two immediate data bytes which are a high byte first and low byte second which together form a 16 bit offset which the instruction may branch to. For a bnz instruction at `pc`, if the last element of the stack is not zero then branch to instruction at `pc + 3 + N`, else proceed to next instruction at `pc + 3`. Branch targets must be aligned instructions. (e.g. Branching to the second byte of a 2 byte op will be rejected.) Starting at v4, the offset is treated as a signed 16 bit integer allowing for backward branches and looping. In prior version (v1 to v3), branch offsets are limited to forward branches only, 0-0x7fff.\n\nAt v2 it became allowed to branch to the end of the program exactly after the last instruction: bnz to byte N (with 0-indexing) was illegal for a TEAL program with N bytes before v2, and is legal after it. This change eliminates the need for a last instruction of no-op as a branch target at the end. (Branching beyond the end--in other words, to a byte larger than N--is still illegal and will cause the program to fail.)",
 	"bz":                  "See `bnz` for details on how branches work. `bz` inverts the behavior of `bnz`.",
 	"b":                   "See `bnz` for details on how branches work. `b` always jumps to the offset.",
@@ -308,6 +342,9 @@ var opDocExtras = map[string]string{
 	"asset_params_get":    "params: Txn.ForeignAssets offset (or, since v4, an _available_ asset id. Return: did_exist flag (1 if the asset existed and 0 otherwise), value.",
 	"app_params_get":      "params: Txn.ForeignApps offset or an _available_ app id. Return: did_exist flag (1 if the application existed and 0 otherwise), value.",
 	"log":                 "`log` fails if called more than MaxLogCalls times in a program, or if the sum of logged bytes exceeds 1024 bytes.",
+	"box_create":          "Newly created boxes are filled with 0 bytes. `box_create` will fail if the referenced box already exists with a different size. Otherwise, existing boxes are unchanged by `box_create`.",
+	"box_get":             "For boxes that exceed 4,096 bytes, consider `box_create`, `box_extract`, and `box_replace`",
+	"box_put":             "For boxes that exceed 4,096 bytes, consider `box_create`, `box_extract`, and `box_replace`",
 	"itxn_begin":          "`itxn_begin` initializes Sender to the application address; Fee to the minimum allowable, taking into account MinTxnFee and credit from overpaying in earlier transactions; FirstValid/LastValid to the values in the invoking transaction, and all other fields to zero or empty values.",
 	"itxn_next":           "`itxn_next` initializes the transaction exactly as `itxn_begin` does",
 	"itxn_field":          "`itxn_field` fails if A is of the wrong type for F, including a byte array of the wrong size for use as an address when F is an address field. `itxn_field` also fails if A is an account, asset, or app that is not _available_, or an attempt is made extend an array field beyond the limit imposed by consensus parameters. (Addresses set into asset params of acfg transactions need not be _available_.)",
@@ -325,13 +362,13 @@ func OpDocExtra(opName string) string {
 // here is the order args opcodes are presented, so place related
 // opcodes consecutively, even if their opcode values are not.
 var OpGroups = map[string][]string{
-	"Arithmetic":              {"sha256", "keccak256", "sha512_256", "sha3_256", "ed25519verify", "ed25519verify_bare", "ecdsa_verify", "ecdsa_pk_recover", "ecdsa_pk_decompress", "bn256_add", "bn256_scalar_mul", "bn256_pairing", "+", "-", "/", "*", "<", ">", "<=", ">=", "&&", "||", "shl", "shr", "sqrt", "bitlen", "exp", "==", "!=", "!", "len", "itob", "btoi", "%", "|", "&", "^", "~", "mulw", "addw", "divw", "divmodw", "expw", "getbit", "setbit", "getbyte", "setbyte", "concat"},
+	"Arithmetic":              {"sha256", "keccak256", "sha512_256", "sha3_256", "ed25519verify", "ed25519verify_bare", "ecdsa_verify", "ecdsa_pk_recover", "ecdsa_pk_decompress", "bn256_add", "bn256_scalar_mul", "bn256_pairing", "ec_add", "ec_scalar_mul", "ec_pairing_check", "ec_multi_scalar_mul", "ec_map_to", "vrf_verify", "+", "-", "/", "*", "<", ">", "<=", ">=", "&&", "||", "shl", "shr", "sqrt", "bitlen", "exp", "==", "!=", "!", "len", "itob", "btoi", "%", "|", "&", "^", "~", "mulw", "addw", "divw", "divmodw", "expw", "getbit", "setbit", "getbyte", "setbyte", "concat"},
 	"Byte Array Manipulation": {"substring", "substring3", "extract", "extract3", "extract_uint16", "extract_uint32", "extract_uint64", "base64_decode", "json_ref"},
 	"Byte Array Arithmetic":   {"b+", "b-", "b/", "b*", "b<", "b>", "b<=", "b>=", "b==", "b!=", "b%", "bsqrt"},
 	"Byte Array Logic":        {"b|", "b&", "b^", "b~"},
 	"Loading Values":          {"intcblock", "intc", "intc_0", "intc_1", "intc_2", "intc_3", "pushint", "bytecblock", "bytec", "bytec_0", "bytec_1", "bytec_2", "bytec_3", "pushbytes", "bzero", "arg", "arg_0", "arg_1", "arg_2", "arg_3", "args", "txn", "gtxn", "txna", "txnas", "gtxna", "gtxnas", "gtxns", "gtxnsa", "gtxnsas", "global", "load", "loads", "store", "stores", "gload", "gloads", "gloadss", "gaid", "gaids"},
 	"Flow Control":            {"err", "bnz", "bz", "b", "return", "pop", "dup", "dup2", "dig", "cover", "uncover", "swap", "select", "assert", "callsub", "retsub"},
-	"State Access":            {"balance", "min_balance", "app_opted_in", "app_local_get", "app_local_get_ex", "app_global_get", "app_global_get_ex", "app_local_put", "app_global_put", "app_local_del", "app_global_del", "asset_holding_get", "asset_params_get", "app_params_get", "acct_params_get", "log"},
+	"State Access":            {"balance", "min_balance", "app_opted_in", "app_local_get", "app_local_get_ex", "app_global_get", "app_global_get_ex", "app_local_put", "app_global_put", "app_local_del", "app_global_del", "asset_holding_get", "asset_params_get", "app_params_get", "acct_params_get", "log", "box_create", "box_extract", "box_replace", "box_del", "box_len", "box_get", "box_put", "block"},
 	"Inner Transactions":      {"itxn_begin", "itxn_next", "itxn_field", "itxn_submit", "itxn", "itxna", "itxnas", "gitxn", "gitxna", "gitxnas"},
 }
 
@@ -40,6 +40,7 @@ import (
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/secp256k1"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
@@ -200,6 +201,7 @@ type LedgerForLogic interface {
 	Authorizer(addr basics.Address) (basics.Address, error)
 	Round() basics.Round
 	LatestTimestamp() int64
+	BlockHdr(round basics.Round) (bookkeeping.BlockHeader, error)
 
 	AssetHolding(addr basics.Address, assetIdx basics.AssetIndex) (basics.AssetHolding, error)
 	AssetParams(aidx basics.AssetIndex) (basics.AssetParams, basics.Address, error)
@@ -214,6 +216,11 @@ type LedgerForLogic interface {
 	SetGlobal(appIdx basics.AppIndex, key string, value basics.TealValue) error
 	DelGlobal(appIdx basics.AppIndex, key string) error
 
+	NewBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error
+	GetBox(appIdx basics.AppIndex, key string) ([]byte, bool, error)
+	SetBox(appIdx basics.AppIndex, key string, value []byte) error
+	DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error)
+
 	Perform(gi int, ep *EvalParams) error
 	Counter() uint64
 }
@@ -2934,6 +2941,67 @@ func opGlobal(cx *EvalContext) error {
 	return nil
 }
 
+// availableRound checks that a round is one whose block header can be read by
+// the block opcode. The rounds available are the ones before the first valid
+// round of the transaction that are no more than MaxTxnLife rounds before its
+// last valid round. They only depend on the transaction, so that the result of
+// the program does not depend on the round it is evaluated in, and they are all
+// still held by the ledger when the transaction can be evaluated.
+func (cx *EvalContext) availableRound(r uint64) (basics.Round, error) {
+	firstAvail := cx.txn.Txn.LastValid.SubSaturate(basics.Round(cx.Proto.MaxTxnLife))
+	if firstAvail == 0 { // early in the chain's life, there is no block 0 header to read
+		firstAvail = 1
+	}
+	lastAvail := cx.txn.Txn.FirstValid.SubSaturate(1)
+	round := basics.Round(r)
+	if round < firstAvail || round > lastAvail {
+		return 0, fmt.Errorf("round %d is not available. It's outside [%d-%d]", r, firstAvail, lastAvail)
+	}
+	return round, nil
+}
+
+func opBlock(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // round
+	round, err := cx.availableRound(cx.stack[last].Uint)
+	if err != nil {
+		return err
+	}
+
+	f := BlockField(cx.program[cx.pc+1])
+	fs, ok := blockFieldSpecByField(f)
+	if !ok || fs.version > cx.version {
+		return fmt.Errorf("invalid block field %s", f)
+	}
+
+	hdr, err := cx.Ledger.BlockHdr(round)
+	if err != nil {
+		return err
+	}
+
+	switch fs.field {
+	case BlkSeed:
+		cx.stack[last] = stackValue{Bytes: append([]byte(nil), hdr.Seed[:]...)}
+	case BlkTimestamp:
+		if hdr.TimeStamp < 0 {
+			return fmt.Errorf("block(%d) timestamp %d < 0", round, hdr.TimeStamp)
+		}
+		cx.stack[last] = stackValue{Uint: uint64(hdr.TimeStamp)}
+	case BlkBranch:
+		cx.stack[last] = stackValue{Bytes: append([]byte(nil), hdr.Branch[:]...)}
+	case BlkTxnCommitment:
+		cx.stack[last] = stackValue{Bytes: append([]byte(nil), hdr.NativeSha512_256Commitment[:]...)}
+	case BlkTxnCounter:
+		cx.stack[last] = stackValue{Uint: hdr.TxnCounter}
+	case BlkRewardsLevel:
+		cx.stack[last] = stackValue{Uint: hdr.RewardsLevel}
+	case BlkProtocol:
+		cx.stack[last] = stackValue{Bytes: []byte(hdr.CurrentProtocol)}
+	default:
+		return fmt.Errorf("invalid block field %s", f)
+	}
+	return nil
+}
+
 // Msg is data meant to be signed and then verified with the
 // ed25519verify opcode.
 type Msg struct {
@@ -3002,6 +3070,53 @@ func opEd25519VerifyBare(cx *EvalContext) error {
 	return nil
 }
 
+// rawData is data that is hashed, signed or proven as is, without the domain
+// separation prefix of a protocol.HashID.
+type rawData []byte
+
+// ToBeHashed implements crypto.Hashable
+func (d rawData) ToBeHashed() (protocol.HashID, []byte) {
+	return "", d
+}
+
+func opVrfVerify(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // index of PK
+	prev := last - 1          // index of proof
+	pprev := prev - 1         // index of data
+
+	std := VrfStandard(cx.program[cx.pc+1])
+	fs, ok := vrfStandardSpecByField(std)
+	if !ok || fs.version > cx.version {
+		return fmt.Errorf("invalid vrf_verify standard %s", std)
+	}
+
+	var pk crypto.VrfPubkey
+	if len(cx.stack[last].Bytes) != len(pk) {
+		return fmt.Errorf("vrf pubkey wrong size %d != %d", len(cx.stack[last].Bytes), len(pk))
+	}
+	copy(pk[:], cx.stack[last].Bytes)
+
+	var proof crypto.VrfProof
+	if len(cx.stack[prev].Bytes) != len(proof) {
+		return fmt.Errorf("vrf proof wrong size %d != %d", len(cx.stack[prev].Bytes), len(proof))
+	}
+	copy(proof[:], cx.stack[prev].Bytes)
+
+	var verified bool
+	var output crypto.VrfOutput
+	switch fs.field {
+	case VrfAlgorand:
+		verified, output = pk.Verify(proof, rawData(cx.stack[pprev].Bytes))
+	default:
+		return fmt.Errorf("unsupported vrf_verify standard %s", std)
+	}
+
+	cx.stack[pprev].Bytes = output[:]
+	cx.stack[prev] = stackValue{Uint: boolToUint(verified)}
+	cx.stack = cx.stack[:last]
+	return nil
+}
+
 func leadingZeros(size int, b *big.Int) ([]byte, error) {
 	byteLength := (b.BitLen() + 7) / 8
 	if size < byteLength {
@@ -27,8 +27,13 @@ import (
 	"math/big"
 	mrand "math/rand"
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls12381fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/stretchr/testify/require"
 
@@ -175,6 +180,36 @@ ed25519verify_bare`, pkStr), v)
 	}
 }
 
+func TestVrfVerify(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// test vector from draft-irtf-cfrg-vrf-03, as in crypto/vrf_test.go
+	pk := "0x3d4017c3e843895a92b70aa74d1b7ebc9c982ccf2ec4968cc0cd55f12af4660c"
+	alpha := "0x72"
+	pi := "0xae5b66bdf04b4c010bfe32b2fc126ead2107b697634f6f7337b9bff8785ee111200095ece87dde4dbe87343f6df3b107d91798c8a7eb1245d3bb9c5aafb093358c13e6ae1111a55717e895fd15f99f07"
+	beta := "0x94f4487e1b2fec954309ef1289ecb2e15043a2461ecc7b2ae7d4470607ef82eb1cfa97d84991fe4a7bfdfd715606bc27e2967a6c557cfb5875879b671740b7d8"
+
+	testAccepts(t, "byte "+alpha+"; byte "+pi+"; byte "+pk+"; vrf_verify VrfAlgorand; assert; byte "+beta+"; ==", randomnessVersion)
+	// a proof of another message does not verify
+	testAccepts(t, "byte 0x73; byte "+pi+"; byte "+pk+"; vrf_verify VrfAlgorand; !; assert; len; int 64; ==", randomnessVersion)
+
+	// a proof made by the node's own keys
+	var seed [32]byte
+	crypto.RandBytes(seed[:])
+	vrfPk, vrfSk := crypto.VrfKeygenFromSeed(seed)
+	msg := []byte("round 1234 randomness")
+	proof, ok := vrfSk.Prove(rawData(msg))
+	require.True(t, ok)
+	output, ok := proof.Hash()
+	require.True(t, ok)
+	testAccepts(t, fmt.Sprintf("byte 0x%x; byte 0x%x; byte 0x%x; vrf_verify VrfAlgorand; assert; byte 0x%x; ==",
+		msg, proof[:], vrfPk[:], output[:]), randomnessVersion)
+
+	testPanics(t, "byte "+alpha+"; byte "+pi+"; byte 0x3d40; vrf_verify VrfAlgorand; assert; len", randomnessVersion)
+	testPanics(t, "byte "+alpha+"; byte 0xae5b; byte "+pk+"; vrf_verify VrfAlgorand; assert; len", randomnessVersion)
+}
+
 func keyToByte(tb testing.TB, b *big.Int) []byte {
 	k := make([]byte, 32)
 	require.NotPanics(tb, func() {
@@ -774,6 +809,178 @@ int 1`
 	})
 }
 
+// bls12381 generators, the encodings are from the BLS12-381 specification
+const bls12381G1Gen = "17f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb" +
+	"08b3f481e3aaa0f1a09e30ed741d8ae4fcf5e095d5d00af600db18cb2c04b3edd03cc744a2888ae40caa232946c5e7e1"
+const bls12381G2Gen = "024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8" +
+	"13e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e" +
+	"0ce5d527727d6e118cc9cdc6da2e351aadfd9baa8cbdd3a76d429a695160d12c923ac9cc3baca289e193548608b82801" +
+	"0606c4a02ea734cc32acd2b02bc28b99cb3e287e85a763af267492ab572e99ab3f370d275cec1da1aaa9075ff05f79be"
+
+// bls12381G1NotInSubgroup returns a point that is on the curve, but not in G1.
+func bls12381G1NotInSubgroup(t *testing.T) bls12381.G1Affine {
+	var p bls12381.G1Affine
+	var four bls12381fp.Element
+	four.SetUint64(4)
+	for x := uint64(1); ; x++ {
+		p.X.SetUint64(x)
+		// y^2 = x^3 + 4
+		p.Y.Square(&p.X).Mul(&p.Y, &p.X).Add(&p.Y, &four)
+		if p.Y.Sqrt(&p.Y) != nil {
+			break
+		}
+	}
+	require.True(t, p.IsOnCurve())
+	require.False(t, p.IsInSubGroup())
+	return p
+}
+
+func TestEcBLS12381(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, _, g1Gen, g2Gen := bls12381.Generators()
+	require.Equal(t, bls12381G1Gen, hex.EncodeToString(bls12381G1ToBytes(&g1Gen)))
+	require.Equal(t, bls12381G2Gen, hex.EncodeToString(bls12381G2ToBytes(&g2Gen)))
+
+	// pairing checks of more than one pair exceed the budget of a logicsig
+	ep := func() *EvalParams { return benchmarkEvalParams(nil) }
+	accepts := func(t *testing.T, program string, v uint64) { testLogic(t, program, v, ep()) }
+	rejects := func(t *testing.T, program string, v uint64) { testLogic(t, program, v, ep(), "REJECT") }
+	panics := func(t *testing.T, program string, v uint64, problem string) {
+		testLogic(t, program, v, ep(), problem)
+	}
+
+	g1 := func(p bls12381.G1Affine) string { return "0x" + hex.EncodeToString(bls12381G1ToBytes(&p)) }
+	g2 := func(p bls12381.G2Affine) string { return "0x" + hex.EncodeToString(bls12381G2ToBytes(&p)) }
+
+	var g1Double, g1Triple, g1Neg bls12381.G1Affine
+	g1Double.ScalarMultiplication(&g1Gen, big.NewInt(2))
+	g1Triple.ScalarMultiplication(&g1Gen, big.NewInt(3))
+	g1Neg.Neg(&g1Gen)
+	var g2Double bls12381.G2Affine
+	g2Double.ScalarMultiplication(&g2Gen, big.NewInt(2))
+
+	t.Run("add", func(t *testing.T) {
+		accepts(t, "byte "+g1(g1Gen)+"; dup; ec_add BLS12_381g1; byte "+g1(g1Double)+"; ==", pairingVersion)
+		accepts(t, "byte "+g2(g2Gen)+"; dup; ec_add BLS12_381g2; byte "+g2(g2Double)+"; ==", pairingVersion)
+		// the point at infinity is the identity
+		accepts(t, "byte "+g1(g1Gen)+"; byte "+g1(bls12381.G1Affine{})+"; ec_add BLS12_381g1; byte "+g1(g1Gen)+"; ==", pairingVersion)
+		accepts(t, "byte "+g1(g1Gen)+"; byte "+g1(g1Neg)+"; ec_add BLS12_381g1; byte "+g1(bls12381.G1Affine{})+"; ==", pairingVersion)
+		// addition does not check subgroup membership
+		outside := bls12381G1NotInSubgroup(t)
+		accepts(t, "byte "+g1(outside)+"; dup; ec_add BLS12_381g1; len; int 96; ==", pairingVersion)
+
+		panics(t, "byte "+g1(g1Gen)+"; byte 0x01; ec_add BLS12_381g1; len", pairingVersion, "bad length 1")
+		panics(t, "byte "+g1(g1Gen)+"; dup; ec_add BLS12_381g2; len", pairingVersion, "bad length 96")
+		offCurve := g1Gen
+		offCurve.Y.Double(&offCurve.Y)
+		panics(t, "byte "+g1(g1Gen)+"; byte "+g1(offCurve)+"; ec_add BLS12_381g1; len", pairingVersion, "not on the curve")
+		// coordinates must be less than the modulus
+		modulus := fmt.Sprintf("%096x", bls12381fp.Modulus())
+		panics(t, "byte "+g1(g1Gen)+"; byte 0x"+modulus+modulus+"; ec_add BLS12_381g1; len", pairingVersion, "not less than the modulus")
+	})
+
+	t.Run("scalar mul", func(t *testing.T) {
+		accepts(t, "byte "+g1(g1Gen)+"; byte 0x03; ec_scalar_mul BLS12_381g1; byte "+g1(g1Triple)+"; ==", pairingVersion)
+		accepts(t, "byte "+g2(g2Gen)+"; byte 0x0002; ec_scalar_mul BLS12_381g2; byte "+g2(g2Double)+"; ==", pairingVersion)
+		accepts(t, "byte "+g1(g1Gen)+"; byte 0x; ec_scalar_mul BLS12_381g1; byte "+g1(bls12381.G1Affine{})+"; ==", pairingVersion)
+		// scalars are at most 32 bytes
+		accepts(t, "byte "+g1(g1Gen)+"; byte 0x"+strings.Repeat("00", 31)+"03; ec_scalar_mul BLS12_381g1; byte "+g1(g1Triple)+"; ==", pairingVersion)
+		panics(t, "byte "+g1(g1Gen)+"; byte 0x"+strings.Repeat("00", 32)+"03; ec_scalar_mul BLS12_381g1; len", pairingVersion, "more than 32")
+		outside := bls12381G1NotInSubgroup(t)
+		panics(t, "byte "+g1(outside)+"; byte 0x03; ec_scalar_mul BLS12_381g1; len", pairingVersion, "not in the subgroup")
+	})
+
+	t.Run("multi scalar mul", func(t *testing.T) {
+		// 5*G + 7*2G = 19*G
+		var expected bls12381.G1Affine
+		expected.ScalarMultiplication(&g1Gen, big.NewInt(19))
+		scalars := fmt.Sprintf("0x%064x%064x", 5, 7)
+		accepts(t, "byte "+g1(g1Gen)+g1(g1Double)[2:]+"; byte "+scalars+"; ec_multi_scalar_mul BLS12_381g1; byte "+g1(expected)+"; ==", pairingVersion)
+		var expected2 bls12381.G2Affine
+		expected2.ScalarMultiplication(&g2Gen, big.NewInt(19))
+		accepts(t, "byte "+g2(g2Gen)+g2(g2Double)[2:]+"; byte "+scalars+"; ec_multi_scalar_mul BLS12_381g2; byte "+g2(expected2)+"; ==", pairingVersion)
+
+		// one scalar of 32 bytes per point
+		panics(t, "byte "+g1(g1Gen)+g1(g1Double)[2:]+"; byte "+fmt.Sprintf("0x%064x", 5)+"; ec_multi_scalar_mul BLS12_381g1; len", pairingVersion, "expected 2 scalars")
+		panics(t, "byte "+g1(g1Gen)+"; byte 0x05; ec_multi_scalar_mul BLS12_381g1; len", pairingVersion, "expected 1 scalars")
+		panics(t, "byte 0x; byte 0x; ec_multi_scalar_mul BLS12_381g1; len", pairingVersion, "no points")
+	})
+
+	t.Run("pairing check", func(t *testing.T) {
+		// e(G1, G2) * e(-G1, G2) = 1
+		accepts(t, "byte "+g1(g1Gen)+g1(g1Neg)[2:]+"; byte "+g2(g2Gen)+g2(g2Gen)[2:]+"; ec_pairing_check BLS12_381g1", pairingVersion)
+		accepts(t, "byte "+g2(g2Gen)+g2(g2Gen)[2:]+"; byte "+g1(g1Gen)+g1(g1Neg)[2:]+"; ec_pairing_check BLS12_381g2", pairingVersion)
+		// e(2*G1, G2) * e(-G1, 2*G2) = 1
+		accepts(t, "byte "+g1(g1Double)+g1(g1Neg)[2:]+"; byte "+g2(g2Gen)+g2(g2Double)[2:]+"; ec_pairing_check BLS12_381g1", pairingVersion)
+		rejects(t, "byte "+g1(g1Gen)+"; byte "+g2(g2Gen)+"; ec_pairing_check BLS12_381g1", pairingVersion)
+		rejects(t, "byte "+g1(g1Double)+g1(g1Neg)[2:]+"; byte "+g2(g2Gen)+g2(g2Gen)[2:]+"; ec_pairing_check BLS12_381g1", pairingVersion)
+
+		panics(t, "byte "+g1(g1Gen)+g1(g1Neg)[2:]+"; byte "+g2(g2Gen)+"; ec_pairing_check BLS12_381g1", pairingVersion, "same, non-zero number")
+		panics(t, "byte 0x; byte 0x; ec_pairing_check BLS12_381g1", pairingVersion, "same, non-zero number")
+		panics(t, "byte "+g2(g2Gen)+"; byte "+g1(g1Gen)+"; ec_pairing_check BLS12_381g1", pairingVersion, "not on the curve")
+		outside := bls12381G1NotInSubgroup(t)
+		panics(t, "byte "+g1(outside)+"; byte "+g2(g2Gen)+"; ec_pairing_check BLS12_381g1", pairingVersion, "not in the subgroup")
+	})
+
+	t.Run("map to", func(t *testing.T) {
+		var fe bls12381fp.Element
+		fe.SetUint64(12345)
+		feBytes := fe.Bytes()
+		p1 := bls12381.MapToCurveG1Svdw(fe)
+		require.True(t, p1.IsInSubGroup())
+		accepts(t, "byte 0x"+hex.EncodeToString(feBytes[:])+"; ec_map_to BLS12_381g1; byte "+g1(p1)+"; ==", pairingVersion)
+
+		fe2 := bls12381.G2Affine{}.X
+		fe2.A0.SetUint64(12345)
+		fe2.A1.SetUint64(67890)
+		p2 := bls12381.MapToCurveG2Svdw(fe2)
+		require.True(t, p2.IsInSubGroup())
+		a0 := fe2.A0.Bytes()
+		a1 := fe2.A1.Bytes()
+		accepts(t, "byte 0x"+hex.EncodeToString(a0[:])+hex.EncodeToString(a1[:])+"; ec_map_to BLS12_381g2; byte "+g2(p2)+"; ==", pairingVersion)
+
+		panics(t, "byte 0x3039; ec_map_to BLS12_381g1; len", pairingVersion, "bad length 2")
+		panics(t, fmt.Sprintf("byte 0x%096x; ec_map_to BLS12_381g1; len", bls12381fp.Modulus()), pairingVersion, "not less than the modulus")
+		panics(t, "byte 0x"+hex.EncodeToString(feBytes[:])+"; ec_map_to BLS12_381g2; len", pairingVersion, "bad length 48")
+	})
+}
+
+func TestEcCosts(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, _, g1Gen, g2Gen := bls12381.Generators()
+	g1 := "0x" + hex.EncodeToString(bls12381G1ToBytes(&g1Gen))
+	g2 := "0x" + hex.EncodeToString(bls12381G2ToBytes(&g2Gen))
+
+	// pairing checks are charged per pair, by the points on top of the stack
+	source := `
+byte ` + g1 + `
+byte ` + g2 + `
+ec_pairing_check BLS12_381g1
+!
+assert
+global OpcodeBudget
+int ` + fmt.Sprintf("%d", 20_000-9000-8200-5) + `
+==
+`
+	testAccepts(t, source, pairingVersion)
+
+	// multi scalar multiplications are charged per scalar
+	source = `
+byte ` + g1 + g1[2:] + `
+byte ` + fmt.Sprintf("0x%064x%064x", 1, 2) + `
+ec_multi_scalar_mul BLS12_381g1
+len
+pop
+global OpcodeBudget
+int ` + fmt.Sprintf("%d", 20_000-5400-2*3700-5) + `
+==
+`
+	testAccepts(t, source, pairingVersion)
+}
+
 type benchmarkBn256Data struct {
 	a        []byte
 	k        []byte
@@ -899,3 +1106,137 @@ int 1
 		benchmarkBn256(b, source)
 	})
 }
+
+func benchmarkBLS12381Points(n int) ([]bls12381.G1Affine, []bls12381.G2Affine, []fr.Element) {
+	_, _, g1Gen, g2Gen := bls12381.Generators()
+	g1s := make([]bls12381.G1Affine, n)
+	g2s := make([]bls12381.G2Affine, n)
+	scalars := make([]fr.Element, n)
+	for i := 0; i < n; i++ {
+		k := new(big.Int).SetUint64(mrand.Uint64())
+		g1s[i].ScalarMultiplication(&g1Gen, k)
+		g2s[i].ScalarMultiplication(&g2Gen, k)
+		scalars[i].SetRandom()
+	}
+	return g1s, g2s, scalars
+}
+
+func BenchmarkBLS12381Raw(b *testing.B) {
+	g1s, g2s, scalars := benchmarkBLS12381Points(64)
+	k := new(big.Int)
+	scalars[0].ToBigIntRegular(k)
+
+	b.Run("g1 add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = new(bls12381.G1Affine).Add(&g1s[0], &g1s[1])
+		}
+	})
+	b.Run("g2 add", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = new(bls12381.G2Affine).Add(&g2s[0], &g2s[1])
+		}
+	})
+	b.Run("g1 scalar mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = new(bls12381.G1Affine).ScalarMultiplication(&g1s[0], k)
+		}
+	})
+	b.Run("g2 scalar mul", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = new(bls12381.G2Affine).ScalarMultiplication(&g2s[0], k)
+		}
+	})
+	b.Run("g1 subgroup check", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = g1s[0].IsInSubGroup()
+		}
+	})
+	b.Run("g2 subgroup check", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = g2s[0].IsInSubGroup()
+		}
+	})
+	for _, n := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("pairing check %d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = bls12381.PairingCheck(g1s[:n], g2s[:n])
+			}
+		})
+	}
+	for _, n := range []int{1, 2, 4, 8, 16, 32, 64} {
+		config := ecc.MultiExpConfig{NbTasks: 1, ScalarsMont: true}
+		b.Run(fmt.Sprintf("g1 multi scalar mul %d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = new(bls12381.G1Affine).MultiExp(g1s[:n], scalars[:n], config)
+			}
+		})
+		b.Run(fmt.Sprintf("g2 multi scalar mul %d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = new(bls12381.G2Affine).MultiExp(g2s[:n], scalars[:n], config)
+			}
+		})
+	}
+	b.Run("g1 map to", func(b *testing.B) {
+		fe := g1s[0].X
+		for i := 0; i < b.N; i++ {
+			_ = bls12381.MapToCurveG1Svdw(fe)
+		}
+	})
+	b.Run("g2 map to", func(b *testing.B) {
+		fe := g2s[0].X
+		for i := 0; i < b.N; i++ {
+			_ = bls12381.MapToCurveG2Svdw(fe)
+		}
+	})
+}
+
+func BenchmarkBLS12381(b *testing.B) {
+	g1s, g2s, scalars := benchmarkBLS12381Points(16)
+	g1Bytes := make([]byte, 0, len(g1s)*bls12381G1Size)
+	g2Bytes := make([]byte, 0, len(g2s)*bls12381G2Size)
+	scalarBytes := make([]byte, 0, len(scalars)*ecScalarSize)
+	for i := range g1s {
+		g1Bytes = append(g1Bytes, bls12381G1ToBytes(&g1s[i])...)
+		g2Bytes = append(g2Bytes, bls12381G2ToBytes(&g2s[i])...)
+		scalar := scalars[i].Bytes()
+		scalarBytes = append(scalarBytes, scalar[:]...)
+	}
+	g1 := "byte 0x" + hex.EncodeToString(g1Bytes[:bls12381G1Size])
+	g2 := "byte 0x" + hex.EncodeToString(g2Bytes[:bls12381G2Size])
+	k := "byte 0x" + hex.EncodeToString(scalarBytes[:ecScalarSize])
+
+	b.Run("g1 add", func(b *testing.B) {
+		benchmarkOperation(b, g1, "dup; ec_add BLS12_381g1", "pop; int 1")
+	})
+	b.Run("g2 add", func(b *testing.B) {
+		benchmarkOperation(b, g2, "dup; ec_add BLS12_381g2", "pop; int 1")
+	})
+	b.Run("g1 scalar mul", func(b *testing.B) {
+		benchmarkBasicProgram(b, g1+"; "+k+"; ec_scalar_mul BLS12_381g1; pop; int 1")
+	})
+	b.Run("g2 scalar mul", func(b *testing.B) {
+		benchmarkBasicProgram(b, g2+"; "+k+"; ec_scalar_mul BLS12_381g2; pop; int 1")
+	})
+	for _, n := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("pairing check %d", n), func(b *testing.B) {
+			benchmarkBasicProgram(b, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_pairing_check BLS12_381g1; pop; int 1",
+				g1Bytes[:n*bls12381G1Size], g2Bytes[:n*bls12381G2Size]))
+		})
+	}
+	for _, n := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("g1 multi scalar mul %d", n), func(b *testing.B) {
+			benchmarkBasicProgram(b, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_multi_scalar_mul BLS12_381g1; pop; int 1",
+				g1Bytes[:n*bls12381G1Size], scalarBytes[:n*ecScalarSize]))
+		})
+		b.Run(fmt.Sprintf("g2 multi scalar mul %d", n), func(b *testing.B) {
+			benchmarkBasicProgram(b, fmt.Sprintf("byte 0x%x; byte 0x%x; ec_multi_scalar_mul BLS12_381g2; pop; int 1",
+				g2Bytes[:n*bls12381G2Size], scalarBytes[:n*ecScalarSize]))
+		})
+	}
+	b.Run("g1 map to", func(b *testing.B) {
+		benchmarkBasicProgram(b, "byte 0x"+hex.EncodeToString(g1Bytes[:bls12381FieldSize])+"; ec_map_to BLS12_381g1; pop; int 1")
+	})
+	b.Run("g2 map to", func(b *testing.B) {
+		benchmarkBasicProgram(b, "byte 0x"+hex.EncodeToString(g2Bytes[:2*bls12381FieldSize])+"; ec_map_to BLS12_381g2; pop; int 1")
+	})
+}
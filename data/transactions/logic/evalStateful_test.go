@@ -1133,6 +1133,38 @@ func TestAcctParams(t *testing.T) {
 	testApp(t, source, ep)
 }
 
+func TestBlock(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, _ := makeSampleEnv()
+	tx.FirstValid = 2000
+	tx.LastValid = 2500 // so rounds [1000-1999] are available, since MaxTxnLife is 1500
+
+	// the test ledger derives seeds and timestamps from the round
+	testApp(t, "int 1999; block BlkTimestamp; int 19990; ==", ep)
+	testApp(t, "int 1000; block BlkSeed; extract 0 8; btoi; int 1000; ==", ep)
+	testApp(t, "int 1000; block BlkSeed; len; int 32; ==", ep)
+	testApp(t, "int 1500; block BlkBranch; extract 0 8; btoi; int 1499; ==", ep)
+	testApp(t, "int 1500; block BlkTxnCommitment; len; int 32; ==", ep)
+	testApp(t, "int 1500; block BlkTxnCounter; int 150000; ==", ep)
+	testApp(t, "int 1500; block BlkRewardsLevel; !", ep)
+	testApp(t, "int 1500; block BlkProtocol; byte \"future\"; ==", ep)
+
+	testApp(t, "int 2000; block BlkTimestamp", ep, "not available")
+	testApp(t, "int 999; block BlkTimestamp", ep, "not available")
+
+	// early in the chain's life, there is no block 0
+	tx.FirstValid = 10
+	tx.LastValid = 100
+	testApp(t, "int 1; block BlkSeed; len", ep)
+	testApp(t, "int 0; block BlkSeed; len", ep, "not available")
+
+	// logicsigs have no access to the ledger
+	testLogic(t, "int 1; block BlkSeed; len", randomnessVersion, defaultEvalParams(nil),
+		"not allowed in current mode", "not allowed in current mode")
+}
+
 func TestGlobalNonDelete(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -2282,6 +2314,7 @@ func TestReturnTypes(t *testing.T) {
 	tx.ApplicationID = 1
 	tx.ForeignApps = []basics.AppIndex{tx.ApplicationID}
 	tx.ForeignAssets = []basics.AssetIndex{basics.AssetIndex(1), basics.AssetIndex(1)}
+	tx.Boxes = []transactions.BoxRef{{Index: 0, Name: []byte("3456")}}
 	ep.TxnGroup[0].Lsig.Args = [][]byte{
 		[]byte("aoeu"),
 		[]byte("aoeu"),
@@ -2369,6 +2402,13 @@ func TestReturnTypes(t *testing.T) {
 
 		"base64_decode": `: byte "YWJjMTIzIT8kKiYoKSctPUB+"; base64_decode StdEncoding`,
 		"json_ref":      `: byte "{\"k\": 7}"; byte "k"; json_ref JSONUint64`,
+
+		"box_create":  `: byte "3456"; int 10; box_create`,
+		"box_extract": `: byte "3456"; int 10; box_create; pop; byte "3456"; int 1; int 2; box_extract`,
+		"box_replace": `: byte "3456"; int 10; box_create; pop; byte "3456"; int 1; byte "ab"; box_replace`,
+		"box_put":     `: byte "3456"; int 10; bzero; box_put`,
+
+		"block": "block BlkSeed",
 	}
 
 	/* Make sure the specialCmd tests the opcode in question */
@@ -2388,10 +2428,17 @@ func TestReturnTypes(t *testing.T) {
 		"ecdsa_verify":        true,
 		"ecdsa_pk_recover":    true,
 		"ecdsa_pk_decompress": true,
+		"vrf_verify":          true,
 
 		"bn256_add":        true,
 		"bn256_scalar_mul": true,
 		"bn256_pairing":    true,
+
+		"ec_add":              true,
+		"ec_scalar_mul":       true,
+		"ec_pairing_check":    true,
+		"ec_multi_scalar_mul": true,
+		"ec_map_to":           true,
 	}
 
 	byName := OpsByName[LogicVersion]
@@ -103,6 +103,12 @@ func makeTestProtoV(version uint64) *config.ConsensusParams {
 		SupportBecomeNonParticipatingTransactions: true,
 
 		UnifyInnerTxIDs: true,
+
+		EnableBoxes:         version >= boxVersion,
+		MaxAppBoxReferences: 2,
+		MaxBoxSize:          1000,
+		BoxFlatMinBalance:   1007,
+		BoxByteMinBalance:   11,
 	}
 }
 
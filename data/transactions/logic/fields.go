@@ -23,7 +23,7 @@ import (
 	"github.com/algorand/go-algorand/protocol"
 )
 
-//go:generate stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding,JSONRefType -output=fields_string.go
+//go:generate stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,EcGroup,Base64Encoding,JSONRefType,VrfStandard,BlockField -output=fields_string.go
 
 // FieldSpec unifies the various specs for assembly, disassembly, and doc generation.
 type FieldSpec interface {
@@ -657,6 +657,68 @@ var EcdsaCurves = FieldGroup{
 	ecdsaCurveSpecByName,
 }
 
+// EcGroup is an enum for `ec_` opcodes
+type EcGroup int
+
+const (
+	// BLS12_381g1 is the G1 group of BLS12-381
+	BLS12_381g1 EcGroup = iota
+	// BLS12_381g2 is the G2 group of BLS12-381
+	BLS12_381g2
+	invalidEcGroup // compile-time constant for number of fields
+)
+
+var ecGroupNames [invalidEcGroup]string
+
+type ecGroupSpec struct {
+	field EcGroup
+	doc   string
+}
+
+func (fs ecGroupSpec) Field() byte {
+	return byte(fs.field)
+}
+func (fs ecGroupSpec) Type() StackType {
+	return StackNone // Will not show, since all are untyped
+}
+func (fs ecGroupSpec) OpVersion() uint64 {
+	return pairingVersion
+}
+func (fs ecGroupSpec) Version() uint64 {
+	return pairingVersion
+}
+func (fs ecGroupSpec) Note() string {
+	return fs.doc
+}
+
+var ecGroupSpecs = [...]ecGroupSpec{
+	{BLS12_381g1, "G1 of the BLS 12-381 curve. Points encoded as 48 byte X followed by 48 byte Y"},
+	{BLS12_381g2, "G2 of the BLS 12-381 curve. Points encoded as 96 byte X followed by 96 byte Y"},
+}
+
+func ecGroupSpecByField(c EcGroup) (ecGroupSpec, bool) {
+	if int(c) >= len(ecGroupSpecs) {
+		return ecGroupSpec{}, false
+	}
+	return ecGroupSpecs[c], true
+}
+
+var ecGroupSpecByName = make(ecGroupNameSpecMap, len(ecGroupNames))
+
+type ecGroupNameSpecMap map[string]ecGroupSpec
+
+func (s ecGroupNameSpecMap) get(name string) (FieldSpec, bool) {
+	fs, ok := s[name]
+	return fs, ok
+}
+
+// EcGroups collects details about the constants used to describe EcGroups
+var EcGroups = FieldGroup{
+	"EC", "Groups",
+	ecGroupNames[:],
+	ecGroupSpecByName,
+}
+
 // Base64Encoding is an enum for the `base64decode` opcode
 type Base64Encoding int
 
@@ -787,6 +849,145 @@ var JSONRefTypes = FieldGroup{
 	jsonRefSpecByName,
 }
 
+// VrfStandard is an enum for the `vrf_verify` opcode
+type VrfStandard int
+
+const (
+	// VrfAlgorand is the VRF used in Algorand. It is ECVRF-ED25519-SHA512-Elligator2, specified in the IETF internet draft draft-irtf-cfrg-vrf-03.
+	VrfAlgorand        VrfStandard = iota
+	invalidVrfStandard             // compile-time constant for number of fields
+)
+
+var vrfStandardNames [invalidVrfStandard]string
+
+type vrfStandardSpec struct {
+	field   VrfStandard
+	version uint64
+}
+
+var vrfStandardSpecs = [...]vrfStandardSpec{
+	{VrfAlgorand, randomnessVersion},
+}
+
+func vrfStandardSpecByField(r VrfStandard) (vrfStandardSpec, bool) {
+	if int(r) >= len(vrfStandardSpecs) {
+		return vrfStandardSpec{}, false
+	}
+	return vrfStandardSpecs[r], true
+}
+
+var vrfStandardSpecByName = make(vrfStandardSpecMap, len(vrfStandardNames))
+
+type vrfStandardSpecMap map[string]vrfStandardSpec
+
+func (s vrfStandardSpecMap) get(name string) (FieldSpec, bool) {
+	fs, ok := s[name]
+	return fs, ok
+}
+
+func (fs vrfStandardSpec) Field() byte {
+	return byte(fs.field)
+}
+func (fs vrfStandardSpec) Type() StackType {
+	return StackNone // Will not show, since all are the same
+}
+func (fs vrfStandardSpec) OpVersion() uint64 {
+	return randomnessVersion
+}
+func (fs vrfStandardSpec) Version() uint64 {
+	return fs.version
+}
+func (fs vrfStandardSpec) Note() string {
+	note := "" // no doc list?
+	return note
+}
+
+// VrfStandards describes the vrf_verify immediate
+var VrfStandards = FieldGroup{
+	"vrf_verify", "Standards",
+	vrfStandardNames[:],
+	vrfStandardSpecByName,
+}
+
+// BlockField is an enum for the `block` opcode
+type BlockField int
+
+const (
+	// BlkSeed is the Block's vrf seed
+	BlkSeed BlockField = iota
+	// BlkTimestamp is the Block's timestamp, seconds from epoch
+	BlkTimestamp
+	// BlkBranch is the hash of the Block's predecessor
+	BlkBranch
+	// BlkTxnCommitment is the Block's SHA512/256 commitment to its transactions
+	BlkTxnCommitment
+	// BlkTxnCounter is the number of transactions committed up to and including the Block
+	BlkTxnCounter
+	// BlkRewardsLevel is the Block's rewards level
+	BlkRewardsLevel
+	// BlkProtocol is the consensus protocol version of the Block
+	BlkProtocol
+	invalidBlockField // compile-time constant for number of fields
+)
+
+var blockFieldNames [invalidBlockField]string
+
+type blockFieldSpec struct {
+	field   BlockField
+	ftype   StackType
+	version uint64
+	doc     string
+}
+
+var blockFieldSpecs = [...]blockFieldSpec{
+	{BlkSeed, StackBytes, randomnessVersion, "the VRF seed of the block"},
+	{BlkTimestamp, StackUint64, randomnessVersion, "the timestamp of the block, in seconds since the epoch"},
+	{BlkBranch, StackBytes, randomnessVersion, "the hash of the previous block"},
+	{BlkTxnCommitment, StackBytes, randomnessVersion, "the SHA512/256 commitment to the transactions of the block"},
+	{BlkTxnCounter, StackUint64, randomnessVersion, "the number of transactions committed to the ledger by the end of the block"},
+	{BlkRewardsLevel, StackUint64, randomnessVersion, "the number of microalgos of rewards distributed to each reward unit of microalgos since genesis"},
+	{BlkProtocol, StackBytes, randomnessVersion, "the consensus protocol version of the block"},
+}
+
+func blockFieldSpecByField(r BlockField) (blockFieldSpec, bool) {
+	if int(r) >= len(blockFieldSpecs) {
+		return blockFieldSpec{}, false
+	}
+	return blockFieldSpecs[r], true
+}
+
+var blockFieldSpecByName = make(blockFieldSpecMap, len(blockFieldNames))
+
+type blockFieldSpecMap map[string]blockFieldSpec
+
+func (s blockFieldSpecMap) get(name string) (FieldSpec, bool) {
+	fs, ok := s[name]
+	return fs, ok
+}
+
+func (fs blockFieldSpec) Field() byte {
+	return byte(fs.field)
+}
+func (fs blockFieldSpec) Type() StackType {
+	return fs.ftype
+}
+func (fs blockFieldSpec) OpVersion() uint64 {
+	return randomnessVersion
+}
+func (fs blockFieldSpec) Version() uint64 {
+	return fs.version
+}
+func (fs blockFieldSpec) Note() string {
+	return fs.doc
+}
+
+// BlockFields describes the block opcode's immediates
+var BlockFields = FieldGroup{
+	"block", "Fields",
+	blockFieldNames[:],
+	blockFieldSpecByName,
+}
+
 // AssetHoldingField is an enum for `asset_holding_get` opcode
 type AssetHoldingField int
 
@@ -1132,6 +1333,13 @@ func init() {
 		ecdsaCurveSpecByName[s.field.String()] = s
 	}
 
+	equal(len(ecGroupSpecs), len(ecGroupNames))
+	for i, s := range ecGroupSpecs {
+		equal(int(s.field), i)
+		ecGroupNames[s.field] = s.field.String()
+		ecGroupSpecByName[s.field.String()] = s
+	}
+
 	equal(len(base64EncodingSpecs), len(base64EncodingNames))
 	for i, s := range base64EncodingSpecs {
 		equal(int(s.field), i)
@@ -1146,6 +1354,20 @@ func init() {
 		jsonRefSpecByName[s.field.String()] = s
 	}
 
+	equal(len(vrfStandardSpecs), len(vrfStandardNames))
+	for i, s := range vrfStandardSpecs {
+		equal(int(s.field), i)
+		vrfStandardNames[i] = s.field.String()
+		vrfStandardSpecByName[s.field.String()] = s
+	}
+
+	equal(len(blockFieldSpecs), len(blockFieldNames))
+	for i, s := range blockFieldSpecs {
+		equal(int(s.field), i)
+		blockFieldNames[i] = s.field.String()
+		blockFieldSpecByName[s.field.String()] = s
+	}
+
 	equal(len(assetHoldingFieldSpecs), len(assetHoldingFieldNames))
 	for i, s := range assetHoldingFieldSpecs {
 		equal(int(s.field), i)
@@ -1,4 +1,4 @@
-// Code generated by "stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding,JSONRefType -output=fields_string.go"; DO NOT EDIT.
+// Code generated by "stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,EcGroup,Base64Encoding,JSONRefType,VrfStandard,BlockField -output=fields_string.go"; DO NOT EDIT.
 
 package logic
 
@@ -252,6 +252,25 @@ func (i EcdsaCurve) String() string {
 	}
 	return _EcdsaCurve_name[_EcdsaCurve_index[i]:_EcdsaCurve_index[i+1]]
 }
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[BLS12_381g1-0]
+	_ = x[BLS12_381g2-1]
+	_ = x[invalidEcGroup-2]
+}
+
+const _EcGroup_name = "BLS12_381g1BLS12_381g2invalidEcGroup"
+
+var _EcGroup_index = [...]uint8{0, 11, 22, 36}
+
+func (i EcGroup) String() string {
+	if i < 0 || i >= EcGroup(len(_EcGroup_index)-1) {
+		return "EcGroup(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _EcGroup_name[_EcGroup_index[i]:_EcGroup_index[i+1]]
+}
 func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
 	// Re-run the stringer command to generate them again.
@@ -291,3 +310,45 @@ func (i JSONRefType) String() string {
 	}
 	return _JSONRefType_name[_JSONRefType_index[i]:_JSONRefType_index[i+1]]
 }
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[VrfAlgorand-0]
+	_ = x[invalidVrfStandard-1]
+}
+
+const _VrfStandard_name = "VrfAlgorandinvalidVrfStandard"
+
+var _VrfStandard_index = [...]uint8{0, 11, 29}
+
+func (i VrfStandard) String() string {
+	if i < 0 || i >= VrfStandard(len(_VrfStandard_index)-1) {
+		return "VrfStandard(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _VrfStandard_name[_VrfStandard_index[i]:_VrfStandard_index[i+1]]
+}
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[BlkSeed-0]
+	_ = x[BlkTimestamp-1]
+	_ = x[BlkBranch-2]
+	_ = x[BlkTxnCommitment-3]
+	_ = x[BlkTxnCounter-4]
+	_ = x[BlkRewardsLevel-5]
+	_ = x[BlkProtocol-6]
+	_ = x[invalidBlockField-7]
+}
+
+const _BlockField_name = "BlkSeedBlkTimestampBlkBranchBlkTxnCommitmentBlkTxnCounterBlkRewardsLevelBlkProtocolinvalidBlockField"
+
+var _BlockField_index = [...]uint8{0, 7, 19, 28, 44, 57, 72, 83, 100}
+
+func (i BlockField) String() string {
+	if i < 0 || i >= BlockField(len(_BlockField_index)-1) {
+		return "BlockField(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _BlockField_name[_BlockField_index[i]:_BlockField_index[i+1]]
+}
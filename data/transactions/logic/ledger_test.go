@@ -17,11 +17,13 @@
 package logic
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/rand"
 
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/protocol"
@@ -34,6 +36,10 @@ type balanceRecord struct {
 	locals   map[basics.AppIndex]basics.TealKeyValue
 	holdings map[basics.AssetIndex]basics.AssetHolding
 	mods     map[basics.AppIndex]map[string]basics.ValueDelta
+
+	// the boxes of the apps this address is the account of
+	totalBoxes    uint64
+	totalBoxBytes uint64
 }
 
 func makeBalanceRecord(addr basics.Address, balance uint64) balanceRecord {
@@ -65,6 +71,7 @@ type Ledger struct {
 	applications map[basics.AppIndex]appParams
 	assets       map[basics.AssetIndex]asaParams
 	mods         map[basics.AppIndex]map[string]basics.ValueDelta
+	boxes        map[basics.AppIndex]map[string][]byte
 	rnd          basics.Round
 }
 
@@ -78,6 +85,7 @@ func MakeLedger(balances map[basics.Address]uint64) *Ledger {
 	l.applications = make(map[basics.AppIndex]appParams)
 	l.assets = make(map[basics.AssetIndex]asaParams)
 	l.mods = make(map[basics.AppIndex]map[string]basics.ValueDelta)
+	l.boxes = make(map[basics.AppIndex]map[string][]byte)
 	return l
 }
 
@@ -197,6 +205,18 @@ func (l *Ledger) LatestTimestamp() int64 {
 	return int64(rand.Uint32() + 1)
 }
 
+// BlockHdr returns a block header for any round. The seed and timestamp of
+// the header are derived from the round, so that tests can predict them.
+func (l *Ledger) BlockHdr(round basics.Round) (bookkeeping.BlockHeader, error) {
+	hdr := bookkeeping.BlockHeader{Round: round}
+	binary.BigEndian.PutUint64(hdr.Seed[:], uint64(round))
+	hdr.TimeStamp = int64(round) * 10
+	binary.BigEndian.PutUint64(hdr.Branch[:], uint64(round)-1)
+	hdr.TxnCounter = uint64(round) * 100
+	hdr.CurrentProtocol = protocol.ConsensusFuture
+	return hdr, nil
+}
+
 // AccountData returns a version of the account that is good enough for
 // satisfying AVM needs. (balance, calc minbalance, and authaddr)
 func (l *Ledger) AccountData(addr basics.Address) (ledgercore.AccountData, error) {
@@ -237,6 +257,8 @@ func (l *Ledger) AccountData(addr basics.Address) (ledgercore.AccountData, error
 			TotalAppLocalStates: uint64(len(locals)),
 			TotalAssetParams:    uint64(len(assets)),
 			TotalAssets:         uint64(len(br.holdings)),
+			TotalBoxes:          br.totalBoxes,
+			TotalBoxBytes:       br.totalBoxBytes,
 		},
 	}, nil
 }
@@ -330,6 +352,60 @@ func (l *Ledger) DelGlobal(appIdx basics.AppIndex, key string) error {
 	return nil
 }
 
+// NewBox creates a box for an app, charging the min balance of the box to
+// appAddr. It fails if the box already exists.
+func (l *Ledger) NewBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error {
+	if _, ok := l.boxes[appIdx][key]; ok {
+		return fmt.Errorf("box already exists 0x%x", key)
+	}
+	if _, ok := l.boxes[appIdx]; !ok {
+		l.boxes[appIdx] = make(map[string][]byte)
+	}
+	l.boxes[appIdx][key] = value
+	br, ok := l.balances[appAddr]
+	if !ok {
+		br = makeBalanceRecord(appAddr, 0)
+	}
+	br.totalBoxes++
+	br.totalBoxBytes += uint64(len(key) + len(value))
+	l.balances[appAddr] = br
+	return nil
+}
+
+// GetBox returns the contents of an app's box, if it exists.
+func (l *Ledger) GetBox(appIdx basics.AppIndex, key string) ([]byte, bool, error) {
+	value, ok := l.boxes[appIdx][key]
+	return value, ok, nil
+}
+
+// SetBox replaces the contents of an existing box. The size may not change.
+func (l *Ledger) SetBox(appIdx basics.AppIndex, key string, value []byte) error {
+	current, ok := l.boxes[appIdx][key]
+	if !ok {
+		return fmt.Errorf("no such box 0x%x", key)
+	}
+	if len(current) != len(value) {
+		return fmt.Errorf("box size mismatch %d %d", len(current), len(value))
+	}
+	l.boxes[appIdx][key] = value
+	return nil
+}
+
+// DelBox deletes a box, if it exists, returning the min balance of the box
+// to appAddr.
+func (l *Ledger) DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error) {
+	value, ok := l.boxes[appIdx][key]
+	if !ok {
+		return false, nil
+	}
+	delete(l.boxes[appIdx], key)
+	br := l.balances[appAddr]
+	br.totalBoxes--
+	br.totalBoxBytes -= uint64(len(key) + len(value))
+	l.balances[appAddr] = br
+	return true, nil
+}
+
 // GetLocal returns the current value bound to a local key, taking
 // into account mods caused by earlier executions.
 func (l *Ledger) GetLocal(addr basics.Address, appIdx basics.AppIndex, key string, accountIdx uint64) (basics.TealValue, bool, error) {
@@ -67,6 +67,11 @@ const appAddressAvailableVersion = 7
 // their version.
 const fidoVersion = 7    // base64, json, secp256r1
 const pairingVersion = 7 // bn256 opcodes. will add bls12-381, and unify the available opcodes.// experimental-
+const boxVersion = 7     // box_*
+
+// randomnessVersion is the first version with vrf_verify and block, the
+// opcodes needed to build randomness beacons.
+const randomnessVersion = 7
 
 type linearCost struct {
 	baseCost  int
@@ -136,7 +141,7 @@ func (d *OpDetails) docCost() string {
 				if !ok {
 					continue
 				}
-				cost += fmt.Sprintf(" %s=%d", name, imm.fieldCosts[fs.Field()])
+				cost += fmt.Sprintf(" %s=%s", name, imm.fieldCosts[fs.Field()].docCost())
 			}
 		}
 	}
@@ -155,7 +160,7 @@ func (d *OpDetails) Cost(program []byte, pc int, stack []stackValue) int {
 	}
 	for i := range d.Immediates {
 		if d.Immediates[i].fieldCosts != nil {
-			cost += d.Immediates[i].fieldCosts[program[pc+1+i]]
+			cost += d.Immediates[i].fieldCosts[program[pc+1+i]].compute(stack)
 		}
 	}
 	return cost
@@ -255,9 +260,19 @@ func (d OpDetails) field(name string, group *FieldGroup) OpDetails {
 }
 
 func costByField(immediate string, group *FieldGroup, costs []int) OpDetails {
+	linearCosts := make([]linearCost, len(costs))
+	for i, cost := range costs {
+		linearCosts[i] = linearCost{baseCost: cost}
+	}
+	return costByFieldAndLength(immediate, group, linearCosts)
+}
+
+// costByFieldAndLength is like costByField, but the cost of each field may
+// also depend on the length of the top of the stack, as with costByLength.
+func costByFieldAndLength(immediate string, group *FieldGroup, costs []linearCost) OpDetails {
 	opd := immediates(immediate).costs(0)
 	opd.Immediates[0].Group = group
-	fieldCosts := make([]int, 256)
+	fieldCosts := make([]linearCost, 256)
 	copy(fieldCosts, costs)
 	opd.Immediates[0].fieldCosts = fieldCosts
 	return opd
@@ -290,7 +305,7 @@ type immediate struct {
 	Group *FieldGroup
 
 	// If non-nil, always 256 long, so cost can be checked before eval
-	fieldCosts []int
+	fieldCosts []linearCost
 }
 
 func imm(name string, kind immKind) immediate {
@@ -578,6 +593,15 @@ var OpSpecs = []OpSpec{
 	{0xb7, "gitxn", opGitxn, proto(":a"), 6, immediates("t", "f").field("f", &TxnFields).only(modeApp).assembler(asmGitxn)},
 	{0xb8, "gitxna", opGitxna, proto(":a"), 6, immediates("t", "f", "i").field("f", &TxnArrayFields).only(modeApp)},
 
+	// Box storage
+	{0xb9, "box_create", opBoxCreate, proto("bi:i"), boxVersion, only(modeApp)},
+	{0xba, "box_extract", opBoxExtract, proto("bii:b"), boxVersion, only(modeApp)},
+	{0xbb, "box_replace", opBoxReplace, proto("bib:"), boxVersion, only(modeApp)},
+	{0xbc, "box_del", opBoxDel, proto("b:i"), boxVersion, only(modeApp)},
+	{0xbd, "box_len", opBoxLen, proto("b:ii"), boxVersion, only(modeApp)},
+	{0xbe, "box_get", opBoxGet, proto("b:bi"), boxVersion, only(modeApp)},
+	{0xbf, "box_put", opBoxPut, proto("bb:"), boxVersion, only(modeApp)},
+
 	// Dynamic indexing
 	{0xc0, "txnas", opTxnas, proto("i:a"), 5, field("f", &TxnArrayFields)},
 	{0xc1, "gtxnas", opGtxnas, proto("i:a"), 5, immediates("t", "f").field("f", &TxnArrayFields)},
@@ -586,6 +610,17 @@ var OpSpecs = []OpSpec{
 	{0xc4, "gloadss", opGloadss, proto("ii:a"), 6, only(modeApp)},
 	{0xc5, "itxnas", opItxnas, proto("i:a"), 6, field("f", &TxnArrayFields).only(modeApp)},
 	{0xc6, "gitxnas", opGitxnas, proto("i:a"), 6, immediates("t", "f").field("f", &TxnArrayFields).only(modeApp)},
+
+	// Randomness
+	{0xd0, "vrf_verify", opVrfVerify, proto("bbb:bi"), randomnessVersion, field("s", &VrfStandards).costs(5700)},
+	{0xd1, "block", opBlock, proto("i:a"), randomnessVersion, field("f", &BlockFields).only(modeApp)},
+
+	// Elliptic curve operations
+	{0xe0, "ec_add", opEcAdd, proto("bb:b"), pairingVersion, costByField("g", &EcGroups, ecAddCosts)},
+	{0xe1, "ec_scalar_mul", opEcScalarMul, proto("bb:b"), pairingVersion, costByField("g", &EcGroups, ecScalarMulCosts)},
+	{0xe2, "ec_pairing_check", opEcPairingCheck, proto("bb:i"), pairingVersion, costByFieldAndLength("g", &EcGroups, ecPairingCheckCosts)},
+	{0xe3, "ec_multi_scalar_mul", opEcMultiScalarMul, proto("bb:b"), pairingVersion, costByFieldAndLength("g", &EcGroups, ecMultiScalarMulCosts)},
+	{0xe4, "ec_map_to", opEcMapTo, proto("b:b"), pairingVersion, costByField("g", &EcGroups, ecMapToCosts)},
 }
 
 type sortByOpcode []OpSpec
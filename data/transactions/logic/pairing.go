@@ -18,8 +18,13 @@ package logic
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls12381fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
 )
@@ -114,3 +119,392 @@ func opBn256Pairing(cx *EvalContext) error {
 	cx.stack[prev].Bytes = nil
 	return nil
 }
+
+// BLS12-381 points are encoded as the concatenation of their X and Y
+// coordinates, each a 48 byte big-endian field element. The point at infinity is encoded as zeros.
+// Coordinates of G2 points are elements of Fp2, encoded as A0 followed by A1.
+const (
+	bls12381FieldSize = 48
+	bls12381G1Size    = 2 * bls12381FieldSize
+	bls12381G2Size    = 4 * bls12381FieldSize
+	// ecScalarSize is the maximum length of a scalar, and the length of each
+	// scalar of ec_multi_scalar_mul.
+	ecScalarSize = 32
+)
+
+var errEcPointNotOnCurve = errors.New("point is not on the curve")
+var errEcPointNotInSubgroup = errors.New("point is not in the subgroup")
+
+var bls12381Modulus = bls12381fp.Modulus()
+
+func bytesToBLS12381Field(b []byte) (ret bls12381fp.Element, err error) {
+	// reject non-canonical encodings, SetBytes would silently reduce them
+	if new(big.Int).SetBytes(b).Cmp(bls12381Modulus) >= 0 {
+		return ret, errors.New("field element is not less than the modulus")
+	}
+	ret.SetBytes(b)
+	return ret, nil
+}
+
+func bytesToBLS12381G1(b []byte, subgroupCheck bool) (ret bls12381.G1Affine, err error) {
+	if len(b) != bls12381G1Size {
+		return ret, fmt.Errorf("bad length %d, expected %d", len(b), bls12381G1Size)
+	}
+	if ret.X, err = bytesToBLS12381Field(b[:48]); err != nil {
+		return
+	}
+	if ret.Y, err = bytesToBLS12381Field(b[48:96]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errEcPointNotOnCurve
+	}
+	if subgroupCheck && !ret.IsInSubGroup() {
+		return ret, errEcPointNotInSubgroup
+	}
+	return ret, nil
+}
+
+func bytesToBLS12381G1s(b []byte, subgroupCheck bool) ([]bls12381.G1Affine, error) {
+	if len(b)%bls12381G1Size != 0 {
+		return nil, fmt.Errorf("bad length %d, not a multiple of %d", len(b), bls12381G1Size)
+	}
+	ret := make([]bls12381.G1Affine, len(b)/bls12381G1Size)
+	for i := range ret {
+		var err error
+		ret[i], err = bytesToBLS12381G1(b[i*bls12381G1Size:(i+1)*bls12381G1Size], subgroupCheck)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func bytesToBLS12381G2(b []byte, subgroupCheck bool) (ret bls12381.G2Affine, err error) {
+	if len(b) != bls12381G2Size {
+		return ret, fmt.Errorf("bad length %d, expected %d", len(b), bls12381G2Size)
+	}
+	if ret.X.A0, err = bytesToBLS12381Field(b[:48]); err != nil {
+		return
+	}
+	if ret.X.A1, err = bytesToBLS12381Field(b[48:96]); err != nil {
+		return
+	}
+	if ret.Y.A0, err = bytesToBLS12381Field(b[96:144]); err != nil {
+		return
+	}
+	if ret.Y.A1, err = bytesToBLS12381Field(b[144:192]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errEcPointNotOnCurve
+	}
+	if subgroupCheck && !ret.IsInSubGroup() {
+		return ret, errEcPointNotInSubgroup
+	}
+	return ret, nil
+}
+
+func bytesToBLS12381G2s(b []byte, subgroupCheck bool) ([]bls12381.G2Affine, error) {
+	if len(b)%bls12381G2Size != 0 {
+		return nil, fmt.Errorf("bad length %d, not a multiple of %d", len(b), bls12381G2Size)
+	}
+	ret := make([]bls12381.G2Affine, len(b)/bls12381G2Size)
+	for i := range ret {
+		var err error
+		ret[i], err = bytesToBLS12381G2(b[i*bls12381G2Size:(i+1)*bls12381G2Size], subgroupCheck)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func bls12381G1ToBytes(g1 *bls12381.G1Affine) []byte {
+	retX := g1.X.Bytes()
+	retY := g1.Y.Bytes()
+	return append(retX[:], retY[:]...)
+}
+
+func bls12381G2ToBytes(g2 *bls12381.G2Affine) []byte {
+	xA0 := g2.X.A0.Bytes()
+	xA1 := g2.X.A1.Bytes()
+	yA0 := g2.Y.A0.Bytes()
+	yA1 := g2.Y.A1.Bytes()
+	ret := make([]byte, 0, bls12381G2Size)
+	ret = append(ret, xA0[:]...)
+	ret = append(ret, xA1[:]...)
+	ret = append(ret, yA0[:]...)
+	return append(ret, yA1[:]...)
+}
+
+func bytesToEcScalar(b []byte) (*big.Int, error) {
+	if len(b) > ecScalarSize {
+		return nil, fmt.Errorf("scalar is %d bytes, more than %d", len(b), ecScalarSize)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func bytesToEcScalars(b []byte, count int) ([]fr.Element, error) {
+	if len(b) != count*ecScalarSize {
+		return nil, fmt.Errorf("expected %d scalars of %d bytes, got %d bytes", count, ecScalarSize, len(b))
+	}
+	ret := make([]fr.Element, count)
+	for i := range ret {
+		ret[i].SetBigInt(new(big.Int).SetBytes(b[i*ecScalarSize : (i+1)*ecScalarSize]))
+	}
+	return ret, nil
+}
+
+func ecGroupImmediate(cx *EvalContext) (EcGroup, error) {
+	group := EcGroup(cx.program[cx.pc+1])
+	fs, ok := ecGroupSpecByField(group)
+	if !ok { // no version check yet, all groups were introduced together
+		return group, fmt.Errorf("invalid ec group %s", group)
+	}
+	return fs.field, nil
+}
+
+// The costs of the ec_ opcodes were measured with BenchmarkBLS12381, relative to
+// bn256_pairing and bn256_scalar_mul. They include the curve and subgroup
+// checks of the inputs, which are a large part of the work.
+
+var ecAddCosts = []int{
+	BLS12_381g1: 135,
+	BLS12_381g2: 210,
+}
+
+func opEcAdd(cx *EvalContext) error {
+	group, err := ecGroupImmediate(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	bBytes := cx.stack[last].Bytes
+
+	var res []byte
+	switch group {
+	case BLS12_381g1:
+		a, err := bytesToBLS12381G1(aBytes, false)
+		if err != nil {
+			return err
+		}
+		b, err := bytesToBLS12381G1(bBytes, false)
+		if err != nil {
+			return err
+		}
+		res = bls12381G1ToBytes(new(bls12381.G1Affine).Add(&a, &b))
+	case BLS12_381g2:
+		a, err := bytesToBLS12381G2(aBytes, false)
+		if err != nil {
+			return err
+		}
+		b, err := bytesToBLS12381G2(bBytes, false)
+		if err != nil {
+			return err
+		}
+		res = bls12381G2ToBytes(new(bls12381.G2Affine).Add(&a, &b))
+	}
+
+	cx.stack = cx.stack[:last]
+	cx.stack[prev].Bytes = res
+	return nil
+}
+
+var ecScalarMulCosts = []int{
+	BLS12_381g1: 3700,
+	BLS12_381g2: 6000,
+}
+
+func opEcScalarMul(cx *EvalContext) error {
+	group, err := ecGroupImmediate(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	k, err := bytesToEcScalar(cx.stack[last].Bytes)
+	if err != nil {
+		return err
+	}
+
+	var res []byte
+	switch group {
+	case BLS12_381g1:
+		a, err := bytesToBLS12381G1(aBytes, true)
+		if err != nil {
+			return err
+		}
+		res = bls12381G1ToBytes(new(bls12381.G1Affine).ScalarMultiplication(&a, k))
+	case BLS12_381g2:
+		a, err := bytesToBLS12381G2(aBytes, true)
+		if err != nil {
+			return err
+		}
+		res = bls12381G2ToBytes(new(bls12381.G2Affine).ScalarMultiplication(&a, k))
+	}
+
+	cx.stack = cx.stack[:last]
+	cx.stack[prev].Bytes = res
+	return nil
+}
+
+// ecPairingCheckCosts are charged by the number of points of the other group,
+// which are on top of the stack.
+var ecPairingCheckCosts = []linearCost{
+	BLS12_381g1: {baseCost: 9000, chunkCost: 8200, chunkSize: bls12381G2Size},
+	BLS12_381g2: {baseCost: 9000, chunkCost: 8200, chunkSize: bls12381G1Size},
+}
+
+func opEcPairingCheck(cx *EvalContext) error {
+	group, err := ecGroupImmediate(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	bBytes := cx.stack[last].Bytes
+
+	// A holds points of the given group, and B points of the other one.
+	g1Bytes, g2Bytes := aBytes, bBytes
+	if group == BLS12_381g2 {
+		g1Bytes, g2Bytes = bBytes, aBytes
+	}
+	g1s, err := bytesToBLS12381G1s(g1Bytes, true)
+	if err != nil {
+		return err
+	}
+	g2s, err := bytesToBLS12381G2s(g2Bytes, true)
+	if err != nil {
+		return err
+	}
+	if len(g1s) != len(g2s) || len(g1s) == 0 {
+		return fmt.Errorf("pairing check needs the same, non-zero number of points in G1 and G2, got %d and %d", len(g1s), len(g2s))
+	}
+	ok, err := bls12381.PairingCheck(g1s, g2s)
+	if err != nil {
+		return err
+	}
+
+	cx.stack = cx.stack[:last]
+	cx.stack[prev].Uint = boolToUint(ok)
+	cx.stack[prev].Bytes = nil
+	return nil
+}
+
+// ecMultiScalarMulCosts are charged by the number of scalars, which are on top
+// of the stack.
+var ecMultiScalarMulCosts = []linearCost{
+	BLS12_381g1: {baseCost: 5400, chunkCost: 3700, chunkSize: ecScalarSize},
+	BLS12_381g2: {baseCost: 14200, chunkCost: 6400, chunkSize: ecScalarSize},
+}
+
+func opEcMultiScalarMul(cx *EvalContext) error {
+	group, err := ecGroupImmediate(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	kBytes := cx.stack[last].Bytes
+
+	// multi-exponentiation runs sequentially, so that costs stay predictable.
+	// fr.Elements hold the scalars in Montgomery form.
+	config := ecc.MultiExpConfig{NbTasks: 1, ScalarsMont: true}
+	var res []byte
+	switch group {
+	case BLS12_381g1:
+		points, err := bytesToBLS12381G1s(aBytes, true)
+		if err != nil {
+			return err
+		}
+		if len(points) == 0 {
+			return errors.New("no points to multiply")
+		}
+		scalars, err := bytesToEcScalars(kBytes, len(points))
+		if err != nil {
+			return err
+		}
+		sum, err := new(bls12381.G1Affine).MultiExp(points, scalars, config)
+		if err != nil {
+			return err
+		}
+		res = bls12381G1ToBytes(sum)
+	case BLS12_381g2:
+		points, err := bytesToBLS12381G2s(aBytes, true)
+		if err != nil {
+			return err
+		}
+		if len(points) == 0 {
+			return errors.New("no points to multiply")
+		}
+		scalars, err := bytesToEcScalars(kBytes, len(points))
+		if err != nil {
+			return err
+		}
+		sum, err := new(bls12381.G2Affine).MultiExp(points, scalars, config)
+		if err != nil {
+			return err
+		}
+		res = bls12381G2ToBytes(sum)
+	}
+
+	cx.stack = cx.stack[:last]
+	cx.stack[prev].Bytes = res
+	return nil
+}
+
+var ecMapToCosts = []int{
+	BLS12_381g1: 3000,
+	BLS12_381g2: 9800,
+}
+
+func opEcMapTo(cx *EvalContext) error {
+	group, err := ecGroupImmediate(cx)
+	if err != nil {
+		return err
+	}
+
+	last := len(cx.stack) - 1
+	fpBytes := cx.stack[last].Bytes
+
+	var res []byte
+	switch group {
+	case BLS12_381g1:
+		if len(fpBytes) != bls12381FieldSize {
+			return fmt.Errorf("bad length %d, expected %d", len(fpBytes), bls12381FieldSize)
+		}
+		fe, err := bytesToBLS12381Field(fpBytes)
+		if err != nil {
+			return err
+		}
+		point := bls12381.MapToCurveG1Svdw(fe)
+		res = bls12381G1ToBytes(&point)
+	case BLS12_381g2:
+		if len(fpBytes) != 2*bls12381FieldSize {
+			return fmt.Errorf("bad length %d, expected %d", len(fpBytes), 2*bls12381FieldSize)
+		}
+		// the Fp2 element type is internal to gnark-crypto, borrow it from a point
+		fe := bls12381.G2Affine{}.X
+		if fe.A0, err = bytesToBLS12381Field(fpBytes[:48]); err != nil {
+			return err
+		}
+		if fe.A1, err = bytesToBLS12381Field(fpBytes[48:]); err != nil {
+			return err
+		}
+		point := bls12381.MapToCurveG2Svdw(fe)
+		res = bls12381G2ToBytes(&point)
+	}
+
+	cx.stack[last].Bytes = res
+	return nil
+}
@@ -314,6 +314,66 @@ func BenchmarkUnmarshalAssetTransferTxnFields(b *testing.B) {
 	}
 }
 
+func TestMarshalUnmarshalBoxRef(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := BoxRef{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingBoxRef(t *testing.T) {
+	protocol.RunEncodingTest(t, &BoxRef{})
+}
+
+func BenchmarkMarshalMsgBoxRef(b *testing.B) {
+	v := BoxRef{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgBoxRef(b *testing.B) {
+	v := BoxRef{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalBoxRef(b *testing.B) {
+	v := BoxRef{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalCompactCertTxnFields(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := CompactCertTxnFields{}
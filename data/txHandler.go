@@ -24,12 +24,14 @@ import (
 	"sync"
 
 	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/verify"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/network/messagetracer"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util/execpool"
 	"github.com/algorand/go-algorand/util/metrics"
@@ -64,6 +66,7 @@ type TxHandler struct {
 	net                   network.GossipNode
 	ctx                   context.Context
 	ctxCancel             context.CancelFunc
+	trace                 messagetracer.MessageTracer
 }
 
 // MakeTxHandler makes a new handler for transaction messages
@@ -94,6 +97,11 @@ func MakeTxHandler(txPool *pools.TransactionPool, ledger *Ledger, net network.Go
 	return handler
 }
 
+// SetTrace sets the tracer of the transaction messages received from the network.
+func (handler *TxHandler) SetTrace(trace messagetracer.MessageTracer) {
+	handler.trace = trace
+}
+
 // Start enables the processing of incoming messages at the transaction handler
 func (handler *TxHandler) Start() {
 	handler.net.RegisterHandlers([]network.TaggedMessageHandler{
@@ -216,7 +224,36 @@ func (handler *TxHandler) asyncVerifySignature(arg interface{}) interface{} {
 	return nil
 }
 
+// TxnPushFilter returns the filter of the transaction groups the network pushes
+// to every peer rather than announces: the groups paying at least feePerByte
+// microAlgos per byte of their encoding. With a zero feePerByte, every group is
+// announced.
+func TxnPushFilter(feePerByte uint64) network.TxnPushFilter {
+	return func(data []byte) bool {
+		if feePerByte == 0 {
+			return false
+		}
+		var fee uint64
+		dec := protocol.NewDecoderBytes(data)
+		for {
+			var stxn transactions.SignedTxn
+			err := dec.Decode(&stxn)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return false
+			}
+			fee = basics.AddSaturate(fee, stxn.Txn.Fee.Raw)
+		}
+		return fee >= basics.MulSaturate(feePerByte, uint64(len(data)))
+	}
+}
+
 func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) network.OutgoingMessage {
+	if handler.trace != nil {
+		messagetracer.TraceHop(handler.trace, messagetracer.Transaction, rawmsg, handler.net)
+	}
 	dec := protocol.NewDecoderBytes(rawmsg.Data)
 	ntx := 0
 	unverifiedTxGroup := make([]transactions.SignedTxn, 1)
@@ -239,6 +276,7 @@ func (handler *TxHandler) processIncomingTxn(rawmsg network.IncomingMessage) net
 	}
 	if ntx == 0 {
 		logging.Base().Warnf("Received empty tx group")
+		handler.net.ReportPeer(rawmsg.Sender, network.PeerSignalInvalidTxn)
 		return network.OutgoingMessage{Action: network.Disconnect}
 	}
 	unverifiedTxGroup = unverifiedTxGroup[:ntx]
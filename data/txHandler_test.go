@@ -33,6 +33,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/algorand/go-algorand/util/execpool"
 )
 
@@ -121,3 +122,30 @@ func BenchmarkTimeAfter(b *testing.B) {
 		}
 	}
 }
+
+func TestTxnPushFilter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	makeGroup := func(fees ...uint64) []byte {
+		var group []transactions.SignedTxn
+		for _, fee := range fees {
+			group = append(group, transactions.SignedTxn{
+				Txn: transactions.Transaction{
+					Type:   protocol.PaymentTx,
+					Header: transactions.Header{Fee: basics.MicroAlgos{Raw: fee}},
+				},
+			})
+		}
+		return reencode(group)
+	}
+
+	low := makeGroup(1000)
+	filter := TxnPushFilter(uint64(1000/len(low) + 1))
+	require.False(t, filter(low))
+	require.True(t, filter(makeGroup(1000000)))
+	// the fees of the whole group count
+	require.True(t, filter(makeGroup(1000, 1000000)))
+	require.False(t, filter([]byte("not a transaction")))
+
+	require.False(t, TxnPushFilter(0)(makeGroup(1000000)))
+}
@@ -45,6 +45,11 @@ type accountsDbQueries struct {
 	lookupResourcesStmt         *sql.Stmt
 	lookupAllResourcesStmt      *sql.Stmt
 	lookupCreatorStmt           *sql.Stmt
+	lookupKvPairStmt            *sql.Stmt
+	lookupKeysByRangeStmt       *sql.Stmt
+	lookupAccountHistoryStmt    *sql.Stmt
+	lookupResourceHistoryStmt   *sql.Stmt
+	lookupResourcesHistoryStmt  *sql.Stmt
 	deleteStoredCatchpoint      *sql.Stmt
 	insertStoredCatchpoint      *sql.Stmt
 	selectOldestCatchpointFiles *sql.Stmt
@@ -120,6 +125,34 @@ var createResourcesTable = []string{
 		PRIMARY KEY (addrid, aidx) ) WITHOUT ROWID`,
 }
 
+var createKvStoreTable = []string{
+	`CREATE TABLE IF NOT EXISTS kvstore (
+		key BLOB PRIMARY KEY,
+		value BLOB)`,
+}
+
+// createAccountsHistoryTables creates the tables holding the per-round history
+// of account and resource states. These are only maintained by archival nodes.
+var createAccountsHistoryTables = []string{
+	`CREATE TABLE IF NOT EXISTS accounthistory (
+		address BLOB NOT NULL,
+		rnd INTEGER NOT NULL,
+		data BLOB NOT NULL,
+		PRIMARY KEY (address, rnd) ) WITHOUT ROWID`,
+	`CREATE TABLE IF NOT EXISTS resourcehistory (
+		address BLOB NOT NULL,
+		aidx INTEGER NOT NULL,
+		rnd INTEGER NOT NULL,
+		data BLOB NOT NULL,
+		PRIMARY KEY (address, aidx, rnd) ) WITHOUT ROWID`,
+}
+
+var dropAccountsHistoryTables = []string{
+	`DROP TABLE IF EXISTS accounthistory`,
+	`DROP TABLE IF EXISTS resourcehistory`,
+	`DELETE FROM acctrounds WHERE id='hstbase'`,
+}
+
 var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS acctrounds`,
 	`DROP TABLE IF EXISTS accounttotals`,
@@ -129,12 +162,15 @@ var accountsResetExprs = []string{
 	`DROP TABLE IF EXISTS catchpointstate`,
 	`DROP TABLE IF EXISTS accounthashes`,
 	`DROP TABLE IF EXISTS resources`,
+	`DROP TABLE IF EXISTS kvstore`,
+	`DROP TABLE IF EXISTS accounthistory`,
+	`DROP TABLE IF EXISTS resourcehistory`,
 }
 
 // accountDBVersion is the database version that this binary would know how to support and how to upgrade to.
 // details about the content of each of the versions can be found in the upgrade functions upgradeDatabaseSchemaXXXX
 // and their descriptions.
-var accountDBVersion = int32(6)
+var accountDBVersion = int32(7)
 
 // persistedAccountData is used for representing a single account stored on the disk. In addition to the
 // basics.AccountData, it also stores complete referencing information used to maintain the base accounts
@@ -254,6 +290,9 @@ const (
 	catchpointStateCatchupState = catchpointState("catchpointCatchupState")
 	// catchpointStateCatchupLabel is the label to which the currently catchpoint catchup process is trying to catchup to.
 	catchpointStateCatchupLabel = catchpointState("catchpointCatchupLabel")
+	// catchpointStateCatchupSource is the location of the catchpoint file the currently running catchpoint catchup process is loading the
+	// ledger from. It is empty when the catchpoint file is downloaded from the peers.
+	catchpointStateCatchupSource = catchpointState("catchpointCatchupSource")
 	// catchpointCatchupBlockRound is the block round that is associated with the current running catchpoint catchup.
 	catchpointStateCatchupBlockRound = catchpointState("catchpointCatchupBlockRound")
 	// catchpointStateCatchupBalancesRound is the balance round that is associated with the current running catchpoint catchup. Typically it would be
@@ -263,6 +302,18 @@ const (
 	// however, it could differ when we catchup from a catchpoint that was created using a different version : in this case,
 	// we set it to zero in order to reset the merkle trie. This would force the merkle trie to be re-build on startup ( if needed ).
 	catchpointStateCatchupHashRound = catchpointState("catchpointCatchupHashRound")
+	// catchpointStateCatchupStagedBalancesChunks, catchpointStateCatchupStagedCreatablesChunks and catchpointStateCatchupStagedHashesChunks
+	// are the number of catchpoint file balances chunks whose content was written into the respective staging tables. They're updated
+	// along with the staging tables, so that a chunk which is processed again after a restart isn't written twice.
+	catchpointStateCatchupStagedBalancesChunks   = catchpointState("catchpointCatchupStagedBalancesChunks")
+	catchpointStateCatchupStagedCreatablesChunks = catchpointState("catchpointCatchupStagedCreatablesChunks")
+	catchpointStateCatchupStagedHashesChunks     = catchpointState("catchpointCatchupStagedHashesChunks")
+	// catchpointStateCatchupChunksManifest is the manifest of the catchpoint file that the currently running catchpoint catchup
+	// process is downloading in chunks.
+	catchpointStateCatchupChunksManifest = catchpointState("catchpointCatchupChunksManifest")
+	// catchpointStateCatchupChunksProgress is the progress of the catchpoint file chunks download, which allows a restarted node
+	// to resume it.
+	catchpointStateCatchupChunksProgress = catchpointState("catchpointCatchupChunksProgress")
 )
 
 // normalizedAccountBalance is a staging area for a catchpoint file account information before it's being added to the catchpoint staging tables.
@@ -806,6 +857,47 @@ func writeCatchpointStagingHashes(ctx context.Context, tx *sql.Tx, bals []normal
 	return nil
 }
 
+// writeCatchpointStagingKVs inserts all the key/value store entries in the provided array into the catchpoint key/value
+// staging table catchpointkvstore.
+func writeCatchpointStagingKVs(ctx context.Context, tx *sql.Tx, kvs []encodedKVRecord) error {
+	insertStmt, err := tx.PrepareContext(ctx, "INSERT INTO catchpointkvstore(key, value) VALUES(?, ?)")
+	if err != nil {
+		return err
+	}
+	defer insertStmt.Close()
+
+	for _, kv := range kvs {
+		// an empty value is stored as a non-NULL blob, as it would be in the kvstore table.
+		value := kv.Value
+		if value == nil {
+			value = []byte{}
+		}
+		_, err = insertStmt.ExecContext(ctx, kv.Key, value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeCatchpointStagingKVHashes inserts the hashes of the provided key/value store entries into the catchpoint pending
+// hashes table catchpointpendinghashes.
+func writeCatchpointStagingKVHashes(ctx context.Context, tx *sql.Tx, kvs []encodedKVRecord) error {
+	insertStmt, err := tx.PrepareContext(ctx, "INSERT INTO catchpointpendinghashes(data) VALUES(?)")
+	if err != nil {
+		return err
+	}
+	defer insertStmt.Close()
+
+	for _, kv := range kvs {
+		_, err = insertStmt.ExecContext(ctx, kvHashBuilderV6(string(kv.Key), kv.Value))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // createCatchpointStagingHashesIndex creates an index on catchpointpendinghashes to allow faster scanning according to the hash order
 func createCatchpointStagingHashesIndex(ctx context.Context, tx *sql.Tx) (err error) {
 	_, err = tx.ExecContext(ctx, "CREATE INDEX IF NOT EXISTS catchpointpendinghashesidx ON catchpointpendinghashes(data)")
@@ -850,6 +942,24 @@ func writeCatchpointStagingCreatable(ctx context.Context, tx *sql.Tx, bals []nor
 	return nil
 }
 
+// readCatchpointStagedChunks returns the number of catchpoint file balances chunks whose content was written into the
+// staging table associated with the given state.
+func readCatchpointStagedChunks(ctx context.Context, tx *sql.Tx, stateName catchpointState) (chunks uint64, err error) {
+	var val sql.NullInt64
+	err = tx.QueryRowContext(ctx, "SELECT intval FROM catchpointstate WHERE id=?", stateName).Scan(&val)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return uint64(val.Int64), err
+}
+
+// writeCatchpointStagedChunks sets the number of catchpoint file balances chunks whose content was written into the
+// staging table associated with the given state.
+func writeCatchpointStagedChunks(ctx context.Context, tx *sql.Tx, stateName catchpointState, chunks uint64) error {
+	_, err := tx.ExecContext(ctx, "INSERT OR REPLACE INTO catchpointstate(id, intval) VALUES(?, ?)", stateName, chunks)
+	return err
+}
+
 func resetCatchpointStagingBalances(ctx context.Context, tx *sql.Tx, newCatchup bool) (err error) {
 	s := []string{
 		"DROP TABLE IF EXISTS catchpointbalances",
@@ -857,7 +967,11 @@ func resetCatchpointStagingBalances(ctx context.Context, tx *sql.Tx, newCatchup
 		"DROP TABLE IF EXISTS catchpointaccounthashes",
 		"DROP TABLE IF EXISTS catchpointpendinghashes",
 		"DROP TABLE IF EXISTS catchpointresources",
+		"DROP TABLE IF EXISTS catchpointkvstore",
 		"DELETE FROM accounttotals where id='catchpointStaging'",
+		fmt.Sprintf("DELETE FROM catchpointstate WHERE id IN ('%s', '%s', '%s', '%s', '%s')",
+			catchpointStateCatchupStagedBalancesChunks, catchpointStateCatchupStagedCreatablesChunks, catchpointStateCatchupStagedHashesChunks,
+			catchpointStateCatchupChunksManifest, catchpointStateCatchupChunksProgress),
 	}
 
 	if newCatchup {
@@ -877,6 +991,7 @@ func resetCatchpointStagingBalances(ctx context.Context, tx *sql.Tx, newCatchup
 			"CREATE TABLE IF NOT EXISTS catchpointpendinghashes (data blob)",
 			"CREATE TABLE IF NOT EXISTS catchpointaccounthashes (id integer primary key, data blob)",
 			"CREATE TABLE IF NOT EXISTS catchpointresources (addrid INTEGER NOT NULL, aidx INTEGER NOT NULL, data BLOB NOT NULL, PRIMARY KEY (addrid, aidx) ) WITHOUT ROWID",
+			"CREATE TABLE IF NOT EXISTS catchpointkvstore (key BLOB PRIMARY KEY, value BLOB)",
 			createNormalizedOnlineBalanceIndex(idxnameBalances, "catchpointbalances"),
 			createUniqueAddressBalanceIndex(idxnameAddress, "catchpointbalances"),
 		)
@@ -900,17 +1015,23 @@ func applyCatchpointStagingBalances(ctx context.Context, tx *sql.Tx, balancesRou
 		"ALTER TABLE assetcreators RENAME TO assetcreators_old",
 		"ALTER TABLE accounthashes RENAME TO accounthashes_old",
 		"ALTER TABLE resources RENAME TO resources_old",
+		"ALTER TABLE kvstore RENAME TO kvstore_old",
 
 		"ALTER TABLE catchpointbalances RENAME TO accountbase",
 		"ALTER TABLE catchpointassetcreators RENAME TO assetcreators",
 		"ALTER TABLE catchpointaccounthashes RENAME TO accounthashes",
 		"ALTER TABLE catchpointresources RENAME TO resources",
+		"ALTER TABLE catchpointkvstore RENAME TO kvstore",
 
 		"DROP TABLE IF EXISTS accountbase_old",
 		"DROP TABLE IF EXISTS assetcreators_old",
 		"DROP TABLE IF EXISTS accounthashes_old",
 		"DROP TABLE IF EXISTS resources_old",
+		"DROP TABLE IF EXISTS kvstore_old",
 	}
+	// the accounts history no longer connects to the new balances; it is
+	// seeded again the next time an archival node loads the accounts.
+	stmts = append(stmts, dropAccountsHistoryTables...)
 
 	for _, stmt := range stmts {
 		_, err = tx.Exec(stmt)
@@ -1075,6 +1196,114 @@ func accountsCreateResourceTable(ctx context.Context, tx *sql.Tx) error {
 	return nil
 }
 
+// accountsCreateKvTable creates the key/value store table in the database.
+func accountsCreateKvTable(ctx context.Context, tx *sql.Tx) error {
+	for _, stmt := range createKvStoreTable {
+		_, err := tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accountsHistoryInit creates the accounts history tables if needed, and returns the
+// earliest round for which the history is available. When the history is not
+// initialized yet, it is seeded with the accounts and resources of the current
+// database round.
+func accountsHistoryInit(ctx context.Context, tx *sql.Tx) (base basics.Round, err error) {
+	for _, stmt := range createAccountsHistoryTables {
+		_, err = tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, "SELECT rnd FROM acctrounds WHERE id='hstbase'").Scan(&base)
+	if err == nil {
+		return base, nil
+	}
+	if err != sql.ErrNoRows {
+		return 0, err
+	}
+
+	base, err = accountsRound(tx)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO accounthistory (address, rnd, data) SELECT address, ?, data FROM accountbase", base)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO resourcehistory (address, aidx, rnd, data) SELECT accountbase.address, resources.aidx, ?, resources.data FROM resources JOIN accountbase ON accountbase.rowid = resources.addrid", base)
+	if err != nil {
+		return 0, err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO acctrounds (id, rnd) VALUES ('hstbase', ?)", base)
+	if err != nil {
+		return 0, err
+	}
+	return base, nil
+}
+
+// accountsHistoryDrop removes the accounts history, if there is any.
+func accountsHistoryDrop(ctx context.Context, tx *sql.Tx) error {
+	for _, stmt := range dropAccountsHistoryTables {
+		_, err := tx.ExecContext(ctx, stmt)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// accountsHistoryNewRound records the account and resource states produced by each of
+// the given rounds deltas, the first of which is for round baseRound+1.
+func accountsHistoryNewRound(tx *sql.Tx, deltas []ledgercore.AccountDeltas, baseRound basics.Round) error {
+	accountStmt, err := tx.Prepare("INSERT OR REPLACE INTO accounthistory (address, rnd, data) VALUES (?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer accountStmt.Close()
+
+	resourceStmt, err := tx.Prepare("INSERT OR REPLACE INTO resourcehistory (address, aidx, rnd, data) VALUES (?, ?, ?, ?)")
+	if err != nil {
+		return err
+	}
+	defer resourceStmt.Close()
+
+	for i := range deltas {
+		rnd := baseRound + basics.Round(i) + 1
+		for j := 0; j < deltas[i].Len(); j++ {
+			addr, data := deltas[i].GetByIdx(j)
+			var ba baseAccountData
+			ba.SetCoreAccountData(&data)
+			ba.UpdateRound = uint64(rnd)
+			_, err = accountStmt.Exec(addr[:], rnd, protocol.Encode(&ba))
+			if err != nil {
+				return err
+			}
+		}
+		for _, res := range deltas[i].GetAllAssetResources() {
+			rd := makeResourcesData(uint64(rnd))
+			rd.SetAssetData(res.Params, res.Holding)
+			_, err = resourceStmt.Exec(res.Addr[:], res.Aidx, rnd, protocol.Encode(&rd))
+			if err != nil {
+				return err
+			}
+		}
+		for _, res := range deltas[i].GetAllAppResources() {
+			rd := makeResourcesData(uint64(rnd))
+			rd.SetAppData(res.Params, res.State)
+			_, err = resourceStmt.Exec(res.Addr[:], res.Aidx, rnd, protocol.Encode(&rd))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 type baseOnlineAccountData struct {
 	_struct struct{} `codec:",omitempty,omitemptyarray"`
 
@@ -1101,6 +1330,8 @@ type baseAccountData struct {
 	TotalAssets                uint64            `codec:"j"`
 	TotalAppParams             uint64            `codec:"k"`
 	TotalAppLocalStates        uint64            `codec:"l"`
+	TotalBoxes                 uint64            `codec:"m"`
+	TotalBoxBytes              uint64            `codec:"n"`
 
 	baseOnlineAccountData
 
@@ -1125,6 +1356,8 @@ func (ba *baseAccountData) IsEmpty() bool {
 		ba.TotalAssets == 0 &&
 		ba.TotalAppParams == 0 &&
 		ba.TotalAppLocalStates == 0 &&
+		ba.TotalBoxes == 0 &&
+		ba.TotalBoxBytes == 0 &&
 		ba.VoteID.MsgIsZero() &&
 		ba.SelectionID.MsgIsZero() &&
 		ba.StateProofID.MsgIsZero() &&
@@ -1156,6 +1389,8 @@ func (ba *baseAccountData) SetCoreAccountData(ad *ledgercore.AccountData) {
 	ba.TotalAssets = ad.TotalAssets
 	ba.TotalAppParams = ad.TotalAppParams
 	ba.TotalAppLocalStates = ad.TotalAppLocalStates
+	ba.TotalBoxes = ad.TotalBoxes
+	ba.TotalBoxBytes = ad.TotalBoxBytes
 }
 
 func (ba *baseAccountData) SetAccountData(ad *basics.AccountData) {
@@ -1177,6 +1412,8 @@ func (ba *baseAccountData) SetAccountData(ad *basics.AccountData) {
 	ba.TotalAssets = uint64(len(ad.Assets))
 	ba.TotalAppParams = uint64(len(ad.AppParams))
 	ba.TotalAppLocalStates = uint64(len(ad.AppLocalStates))
+	ba.TotalBoxes = ad.TotalBoxes
+	ba.TotalBoxBytes = ad.TotalBoxBytes
 }
 
 func (ba *baseAccountData) GetLedgerCoreAccountData() ledgercore.AccountData {
@@ -1196,6 +1433,8 @@ func (ba *baseAccountData) GetLedgerCoreAccountData() ledgercore.AccountData {
 			TotalAppLocalStates: ba.TotalAppLocalStates,
 			TotalAssetParams:    ba.TotalAssetParams,
 			TotalAssets:         ba.TotalAssets,
+			TotalBoxes:          ba.TotalBoxes,
+			TotalBoxBytes:       ba.TotalBoxBytes,
 		},
 		VotingData: ledgercore.VotingData{
 			VoteID:          ba.VoteID,
@@ -1226,6 +1465,8 @@ func (ba *baseAccountData) GetAccountData() basics.AccountData {
 			NumByteSlice: ba.TotalAppSchemaNumByteSlice,
 		},
 		TotalExtraAppPages: ba.TotalExtraAppPages,
+		TotalBoxes:         ba.TotalBoxes,
+		TotalBoxBytes:      ba.TotalBoxBytes,
 	}
 }
 
@@ -1877,6 +2118,16 @@ func accountsInitDbQueries(r db.Queryable, w db.Queryable) (*accountsDbQueries,
 		return nil, err
 	}
 
+	qs.lookupKvPairStmt, err = r.Prepare("SELECT acctrounds.rnd, kvstore.value FROM acctrounds LEFT JOIN kvstore ON key = ? WHERE id='acctbase'")
+	if err != nil {
+		return nil, err
+	}
+
+	qs.lookupKeysByRangeStmt, err = r.Prepare("SELECT acctrounds.rnd, kvstore.key FROM acctrounds LEFT JOIN kvstore ON kvstore.key >= ? AND kvstore.key < ? WHERE id='acctbase' ORDER BY kvstore.key")
+	if err != nil {
+		return nil, err
+	}
+
 	qs.deleteStoredCatchpoint, err = w.Prepare("DELETE FROM storedcatchpoints WHERE round=?")
 	if err != nil {
 		return nil, err
@@ -1976,6 +2227,234 @@ func (qs *accountsDbQueries) lookupCreator(cidx basics.CreatableIndex, ctype bas
 	return
 }
 
+// lookupKeyValue returns the value stored under key, or nil if there is none.
+func (qs *accountsDbQueries) lookupKeyValue(key string) (value []byte, dbRound basics.Round, err error) {
+	err = db.Retry(func() error {
+		var v []byte
+		err := qs.lookupKvPairStmt.QueryRow([]byte(key)).Scan(&dbRound, &v)
+
+		// this shouldn't happen unless we can't figure the round number.
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("lookupKeyValue was unable to retrieve round number")
+		}
+
+		// Some other database error
+		if err != nil {
+			return err
+		}
+
+		value = v
+		return nil
+	})
+	return
+}
+
+// lookupKeysByPrefix returns all the keys of the key/value store that start with prefix.
+func (qs *accountsDbQueries) lookupKeysByPrefix(prefix string) (keys []string, dbRound basics.Round, err error) {
+	start, end := keyPrefixRange([]byte(prefix))
+	err = db.Retry(func() error {
+		keys = nil
+		rows, err := qs.lookupKeysByRangeStmt.Query(start, end)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var k []byte
+			err = rows.Scan(&dbRound, &k)
+			if err != nil {
+				return err
+			}
+			// a single row with a NULL key is returned when there is no match.
+			if k != nil {
+				keys = append(keys, string(k))
+			}
+		}
+		return rows.Err()
+	})
+	return
+}
+
+// initHistoryQueries prepares the statements used to look up the accounts history.
+// The history tables have to exist already, see accountsHistoryInit.
+func (qs *accountsDbQueries) initHistoryQueries(r db.Queryable) (err error) {
+	qs.lookupAccountHistoryStmt, err = r.Prepare("SELECT data FROM accounthistory WHERE address = ? AND rnd <= ? ORDER BY rnd DESC LIMIT 1")
+	if err != nil {
+		return err
+	}
+
+	qs.lookupResourceHistoryStmt, err = r.Prepare("SELECT data FROM resourcehistory WHERE address = ? AND aidx = ? AND rnd <= ? ORDER BY rnd DESC LIMIT 1")
+	if err != nil {
+		return err
+	}
+
+	// sqlite takes the bare columns of an aggregate query using max() from the row holding the maximum.
+	qs.lookupResourcesHistoryStmt, err = r.Prepare("SELECT aidx, data, MAX(rnd) FROM resourcehistory WHERE address = ? AND rnd <= ? GROUP BY aidx")
+	return err
+}
+
+// lookupAccountHistory returns the account data of addr as of round rnd. The round
+// has to be covered by the accounts history.
+func (qs *accountsDbQueries) lookupAccountHistory(addr basics.Address, rnd basics.Round) (data ledgercore.AccountData, err error) {
+	err = db.Retry(func() error {
+		var buf []byte
+		err := qs.lookupAccountHistoryStmt.QueryRow(addr[:], rnd).Scan(&buf)
+		if err == sql.ErrNoRows {
+			// the account did not exist at that round.
+			data = ledgercore.AccountData{}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		var ba baseAccountData
+		err = protocol.Decode(buf, &ba)
+		if err != nil {
+			return err
+		}
+		data = ba.GetLedgerCoreAccountData()
+		return nil
+	})
+	return
+}
+
+// lookupResourceHistory returns the resource aidx of addr as of round rnd. The round
+// has to be covered by the accounts history.
+func (qs *accountsDbQueries) lookupResourceHistory(addr basics.Address, aidx basics.CreatableIndex, rnd basics.Round) (data ledgercore.AccountResource, err error) {
+	err = db.Retry(func() error {
+		var buf []byte
+		err := qs.lookupResourceHistoryStmt.QueryRow(addr[:], aidx, rnd).Scan(&buf)
+		if err == sql.ErrNoRows {
+			data = ledgercore.AccountResource{}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		prd := persistedResourcesData{aidx: aidx}
+		err = protocol.Decode(buf, &prd.data)
+		if err != nil {
+			return err
+		}
+		data = prd.AccountResource()
+		return nil
+	})
+	return
+}
+
+// lookupResourcesHistory returns all the resources addr had as of round rnd. The round
+// has to be covered by the accounts history.
+func (qs *accountsDbQueries) lookupResourcesHistory(addr basics.Address, rnd basics.Round) (data map[basics.CreatableIndex]ledgercore.AccountResource, err error) {
+	err = db.Retry(func() error {
+		data = make(map[basics.CreatableIndex]ledgercore.AccountResource)
+		rows, err := qs.lookupResourcesHistoryStmt.Query(addr[:], rnd)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var buf []byte
+			var maxRnd basics.Round
+			prd := persistedResourcesData{}
+			err = rows.Scan(&prd.aidx, &buf, &maxRnd)
+			if err != nil {
+				return err
+			}
+			err = protocol.Decode(buf, &prd.data)
+			if err != nil {
+				return err
+			}
+			// resources deleted before rnd are recorded with empty data.
+			if !prd.data.IsEmpty() {
+				data[prd.aidx] = prd.AccountResource()
+			}
+		}
+		return rows.Err()
+	})
+	return
+}
+
+// keyPrefixRange returns the half-open interval [start, end) covering all the
+// keys that start with prefix. The prefix must contain a byte other than 0xff.
+func keyPrefixRange(prefix []byte) (start, end []byte) {
+	start = append([]byte(nil), prefix...)
+	end = append([]byte(nil), prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return start, end[:i+1]
+		}
+	}
+	panic(fmt.Sprintf("keyPrefixRange: no upper bound for prefix %x", prefix))
+}
+
+// kvstoreLoadOld loads the values currently stored for the provided key/value updates, so that the
+// catchpoint tracker could remove them from the merkle trie.
+func kvstoreLoadOld(tx *sql.Tx, kvs map[string]modifiedKvValue) error {
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	selectStmt, err := tx.Prepare("SELECT value FROM kvstore WHERE key = ?")
+	if err != nil {
+		return err
+	}
+	defer selectStmt.Close()
+
+	for key, mv := range kvs {
+		var value []byte
+		err = selectStmt.QueryRow([]byte(key)).Scan(&value)
+		switch err {
+		case nil:
+			if value == nil {
+				value = []byte{}
+			}
+			mv.oldData = value
+			kvs[key] = mv
+		case sql.ErrNoRows:
+			// the key is new; there is no previous value.
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// kvstoreNewRound applies the provided key/value updates to the kvstore table.
+func kvstoreNewRound(tx *sql.Tx, kvs map[string]modifiedKvValue) error {
+	if len(kvs) == 0 {
+		return nil
+	}
+
+	upsertStmt, err := tx.Prepare("INSERT OR REPLACE INTO kvstore (key, value) VALUES (?, ?)")
+	if err != nil {
+		return err
+	}
+	defer upsertStmt.Close()
+
+	deleteStmt, err := tx.Prepare("DELETE FROM kvstore WHERE key = ?")
+	if err != nil {
+		return err
+	}
+	defer deleteStmt.Close()
+
+	for key, mv := range kvs {
+		if mv.data == nil {
+			_, err = deleteStmt.Exec([]byte(key))
+		} else {
+			_, err = upsertStmt.Exec([]byte(key), mv.data)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (qs *accountsDbQueries) lookupResources(addr basics.Address, aidx basics.CreatableIndex, ctype basics.CreatableType) (data persistedResourcesData, err error) {
 	err = db.Retry(func() error {
 		var buf []byte
@@ -2198,6 +2677,11 @@ func (qs *accountsDbQueries) close() {
 		&qs.lookupResourcesStmt,
 		&qs.lookupAllResourcesStmt,
 		&qs.lookupCreatorStmt,
+		&qs.lookupKvPairStmt,
+		&qs.lookupKeysByRangeStmt,
+		&qs.lookupAccountHistoryStmt,
+		&qs.lookupResourceHistoryStmt,
+		&qs.lookupResourcesHistoryStmt,
 		&qs.deleteStoredCatchpoint,
 		&qs.insertStoredCatchpoint,
 		&qs.selectOldestCatchpointFiles,
@@ -2766,6 +3250,41 @@ func totalAccounts(ctx context.Context, tx *sql.Tx) (total uint64, err error) {
 	return
 }
 
+// totalKVs returns the number of entries in the key/value store.
+func totalKVs(ctx context.Context, tx *sql.Tx) (total uint64, err error) {
+	err = tx.QueryRowContext(ctx, "SELECT count(*) FROM kvstore").Scan(&total)
+	if err == sql.ErrNoRows {
+		total = 0
+		err = nil
+		return
+	}
+	return
+}
+
+// readCatchpointKVs returns up to count key/value store entries whose key follows afterKey, ordered by key.
+// an empty afterKey returns the entries from the first key.
+func readCatchpointKVs(ctx context.Context, tx *sql.Tx, afterKey []byte, count int) (kvs []encodedKVRecord, err error) {
+	if afterKey == nil {
+		afterKey = []byte{}
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT key, value FROM kvstore WHERE key > ? ORDER BY key LIMIT ?", afterKey, count)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	kvs = make([]encodedKVRecord, 0, count)
+	for rows.Next() {
+		var kv encodedKVRecord
+		err = rows.Scan(&kv.Key, &kv.Value)
+		if err != nil {
+			return nil, err
+		}
+		kvs = append(kvs, kv)
+	}
+	return kvs, rows.Err()
+}
+
 // reencodeAccounts reads all the accounts in the accountbase table, decode and reencode the account data.
 // if the account data is found to have a different encoding, it would update the encoded account on disk.
 // on return, it returns the number of modified accounts as well as an error ( if we had any )
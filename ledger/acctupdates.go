@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -107,6 +108,22 @@ type modifiedAccount struct {
 	ndeltas int
 }
 
+// A modifiedKvValue represents a key/value store entry that has been modified
+// since the persistent state stored in the account DB.
+type modifiedKvValue struct {
+	// data stores the most recent value, or nil if the key was deleted.
+	data []byte
+
+	// oldData stores the value persisted in the account DB before the
+	// change, or nil if the key did not exist. It is only loaded by
+	// commitRound, for the catchpoint tracker to update the merkle trie.
+	oldData []byte
+
+	// ndeltas keeps track of how many times this key appears in
+	// accountUpdates.kvDeltas.
+	ndeltas int
+}
+
 // accountCreatable is used as a map key.
 type accountCreatable struct {
 	address basics.Address
@@ -154,6 +171,18 @@ type accountUpdates struct {
 	// appears in creatableDeltas
 	creatables map[basics.CreatableIndex]ledgercore.ModifiedCreatable
 
+	// kvDeltas stores the key/value store updates for every round after dbRound.
+	kvDeltas []map[string]ledgercore.KvValueDelta
+
+	// kvStore has the most recent value of every key that appears in kvDeltas.
+	kvStore map[string]modifiedKvValue
+
+	// stateDeltas stores the complete state delta for the last (up to
+	// maxStateDeltas, when set) rounds after dbRound; i.e., it is never longer
+	// than deltas. The account, creatable and kv deltas above share their
+	// storage with these.
+	stateDeltas []ledgercore.StateDelta
+
 	// versions stores consensus version dbRound and every
 	// round after it; i.e., versions is one longer than deltas.
 	versions []protocol.ConsensusVersion
@@ -188,6 +217,17 @@ type accountUpdates struct {
 	// baseResources stores the most recently used resources, at exactly dbRound
 	baseResources lruResources
 
+	// accountsHistory is set on archival nodes, which keep the per-round history of
+	// the account states on disk so that rounds before dbRound can be looked up.
+	accountsHistory bool
+
+	// maxStateDeltas bounds the number of rounds kept in stateDeltas, or is zero
+	// to keep the state delta of every round in deltas.
+	maxStateDeltas uint64
+
+	// historyBaseRound is the earliest round covered by the accounts history.
+	historyBaseRound basics.Round
+
 	// logAccountUpdatesMetrics is a flag for enable/disable metrics logging
 	logAccountUpdatesMetrics bool
 
@@ -263,6 +303,9 @@ func (au *accountUpdates) initialize(cfg config.Local) {
 	// log metrics
 	au.logAccountUpdatesMetrics = cfg.EnableAccountUpdatesStats
 	au.logAccountUpdatesInterval = cfg.AccountUpdatesStatsInterval
+
+	au.accountsHistory = cfg.Archival
+	au.maxStateDeltas = cfg.MaxStateDeltasInMemory
 }
 
 // loadFromDisk is the 2nd level initialization, and is required before the accountUpdates becomes functional
@@ -532,6 +575,18 @@ func (au *accountUpdates) GetCreatorForRound(rnd basics.Round, cidx basics.Creat
 	return au.getCreatorForRound(rnd, cidx, ctype, true /* take the lock */)
 }
 
+// LookupKv returns the value stored under key at round rnd, or nil if there is none.
+func (au *accountUpdates) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return au.lookupKv(rnd, key, true /* take the lock */)
+}
+
+// LookupKeysByPrefix returns up to maxKeyNum keys of the key/value store that
+// start with prefix at round rnd, in increasing order. A maxKeyNum of zero
+// means no limit.
+func (au *accountUpdates) LookupKeysByPrefix(rnd basics.Round, prefix string, maxKeyNum uint64) ([]string, error) {
+	return au.lookupKeysByPrefix(rnd, prefix, maxKeyNum)
+}
+
 // committedUpTo implements the ledgerTracker interface for accountUpdates.
 // The method informs the tracker that committedRound and all it's previous rounds have
 // been committed to the block database. The method returns what is the oldest round
@@ -723,6 +778,11 @@ func (aul *accountUpdatesLedgerEvaluator) LookupAsset(rnd basics.Round, addr bas
 	return ledgercore.AssetResource{AssetParams: r.AssetParams, AssetHolding: r.AssetHolding}, err
 }
 
+// LookupKv returns the value stored under key at round rnd, or nil if there is none.
+func (aul *accountUpdatesLedgerEvaluator) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return aul.au.lookupKv(rnd, key, false /* don't sync */)
+}
+
 // GetCreatorForRound returns the asset/app creator for a given asset/app index at a given round
 func (aul *accountUpdatesLedgerEvaluator) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (creator basics.Address, ok bool, err error) {
 	return aul.au.getCreatorForRound(rnd, cidx, ctype, false /* don't sync */)
@@ -762,7 +822,13 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 		}
 
 		au.roundTotals = []ledgercore.AccountTotals{totals}
-		return nil
+
+		if au.accountsHistory {
+			au.historyBaseRound, err0 = accountsHistoryInit(ctx, tx)
+			return err0
+		}
+		// a history left behind by an earlier archival run would not be kept up to date.
+		return accountsHistoryDrop(ctx, tx)
 	})
 
 	ledgerAccountsinitMicros.AddMicrosecondsSince(start, nil)
@@ -774,6 +840,12 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 	if err != nil {
 		return
 	}
+	if au.accountsHistory {
+		err = au.accountsq.initHistoryQueries(au.dbs.Rdb.Handle)
+		if err != nil {
+			return
+		}
+	}
 
 	hdr, err := l.BlockHdr(lastBalancesRound)
 	if err != nil {
@@ -786,6 +858,9 @@ func (au *accountUpdates) initializeFromDisk(l ledgerForTracker, lastBalancesRou
 	au.accounts = make(map[basics.Address]modifiedAccount)
 	au.resources = resourcesUpdates(make(map[accountCreatable]modifiedResource))
 	au.creatables = make(map[basics.CreatableIndex]ledgercore.ModifiedCreatable)
+	au.kvDeltas = nil
+	au.kvStore = make(map[string]modifiedKvValue)
+	au.stateDeltas = nil
 	au.deltasAccum = []int{0}
 
 	au.baseAccounts.init(au.log, baseAccountsPendingAccountsBufferSize, baseAccountsPendingAccountsWarnThreshold)
@@ -809,6 +884,12 @@ func (au *accountUpdates) newBlockImpl(blk bookkeeping.Block, delta ledgercore.S
 	au.deltas = append(au.deltas, delta.Accts)
 	au.versions = append(au.versions, blk.CurrentProtocol)
 	au.creatableDeltas = append(au.creatableDeltas, delta.Creatables)
+	au.kvDeltas = append(au.kvDeltas, delta.KvMods)
+	au.stateDeltas = append(au.stateDeltas, delta)
+	if au.maxStateDeltas > 0 && uint64(len(au.stateDeltas)) > au.maxStateDeltas {
+		au.stateDeltas[0] = ledgercore.StateDelta{}
+		au.stateDeltas = au.stateDeltas[1:]
+	}
 	au.deltasAccum = append(au.deltasAccum, delta.Accts.Len()+au.deltasAccum[len(au.deltasAccum)-1])
 
 	au.baseAccounts.flushPendingWrites()
@@ -853,6 +934,13 @@ func (au *accountUpdates) newBlockImpl(blk bookkeeping.Block, delta ledgercore.S
 		au.creatables[cidx] = mcreat
 	}
 
+	for key, kvdelta := range delta.KvMods {
+		mkv := au.kvStore[key]
+		mkv.data = kvdelta.Data
+		mkv.ndeltas++
+		au.kvStore[key] = mkv
+	}
+
 	au.roundTotals = append(au.roundTotals, delta.Totals)
 
 	// calling prune would drop old entries from the base accounts.
@@ -1196,6 +1284,13 @@ func (au *accountUpdates) lookupResource(rnd basics.Round, addr basics.Address,
 	for {
 		currentDbRound := au.cachedDBRound
 		currentDeltaLen := len(au.deltas)
+		if au.accountsHistory && rnd < currentDbRound {
+			if synchronized {
+				au.accountsMu.RUnlock()
+				needUnlock = false
+			}
+			return au.lookupResourceHistory(rnd, addr, aidx)
+		}
 		offset, err = au.roundOffset(rnd)
 		if err != nil {
 			return
@@ -1292,6 +1387,13 @@ func (au *accountUpdates) lookupWithoutRewards(rnd basics.Round, addr basics.Add
 	for {
 		currentDbRound := au.cachedDBRound
 		currentDeltaLen := len(au.deltas)
+		if au.accountsHistory && rnd < currentDbRound {
+			if synchronized {
+				au.accountsMu.RUnlock()
+				needUnlock = false
+			}
+			return au.lookupAccountHistory(rnd, addr)
+		}
 		offset, err = au.roundOffset(rnd)
 		if err != nil {
 			return
@@ -1374,6 +1476,129 @@ func (au *accountUpdates) lookupWithoutRewards(rnd basics.Round, addr basics.Add
 	}
 }
 
+// lookupAllResources returns all the resources held or created by addr at a given round.
+func (au *accountUpdates) lookupAllResources(rnd basics.Round, addr basics.Address) (map[basics.CreatableIndex]ledgercore.AccountResource, error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.deltas)
+		if au.accountsHistory && rnd < currentDbRound {
+			au.accountsMu.RUnlock()
+			needUnlock = false
+			return au.lookupAllResourcesHistory(rnd, addr)
+		}
+		offset, err := au.roundOffset(rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		// collect the resources modified in the deltas up to rnd; the later
+		// updates take priority, so traverse the deltas backwards.
+		found := make(map[basics.CreatableIndex]ledgercore.AccountResource)
+		for i := int(offset) - 1; i >= 0; i-- {
+			for _, rec := range au.deltas[i].GetAllAssetResources() {
+				cidx := basics.CreatableIndex(rec.Aidx)
+				if _, ok := found[cidx]; rec.Addr == addr && !ok {
+					found[cidx] = ledgercore.AccountResource{AssetParams: rec.Params.Params, AssetHolding: rec.Holding.Holding}
+				}
+			}
+			for _, rec := range au.deltas[i].GetAllAppResources() {
+				cidx := basics.CreatableIndex(rec.Aidx)
+				if _, ok := found[cidx]; rec.Addr == addr && !ok {
+					found[cidx] = ledgercore.AccountResource{AppParams: rec.Params.Params, AppLocalState: rec.State.LocalState}
+				}
+			}
+		}
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		persistedResources, resourceDbRound, err := au.accountsq.lookupAllResources(addr)
+		if err != nil {
+			return nil, err
+		}
+		if resourceDbRound == currentDbRound {
+			for _, prd := range persistedResources {
+				if _, ok := found[prd.aidx]; !ok {
+					found[prd.aidx] = prd.AccountResource()
+				}
+			}
+			// drop the resources that were deleted by the deltas
+			for cidx, res := range found {
+				if res.AssetParams == nil && res.AssetHolding == nil && res.AppParams == nil && res.AppLocalState == nil {
+					delete(found, cidx)
+				}
+			}
+			return found, nil
+		}
+		if resourceDbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupAllResources: resource database round %d is behind in-memory round %d", resourceDbRound, currentDbRound)
+			return nil, &StaleDatabaseRoundError{databaseRound: resourceDbRound, memoryRound: currentDbRound}
+		}
+
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.deltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
+// checkHistoryRound makes sure that rnd is covered by the accounts history, and returns
+// the header of the block of that round.
+func (au *accountUpdates) checkHistoryRound(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd < au.historyBaseRound {
+		return bookkeeping.BlockHeader{}, &RoundOffsetError{
+			round:   rnd,
+			dbRound: au.historyBaseRound,
+		}
+	}
+	return au.ledger.BlockHdr(rnd)
+}
+
+// lookupAccountHistory returns the account data for a given address at a given round
+// preceding dbRound, using the accounts history.
+func (au *accountUpdates) lookupAccountHistory(rnd basics.Round, addr basics.Address) (data ledgercore.AccountData, validThrough basics.Round, rewardsVersion protocol.ConsensusVersion, rewardsLevel uint64, err error) {
+	hdr, err := au.checkHistoryRound(rnd)
+	if err != nil {
+		return
+	}
+	data, err = au.accountsq.lookupAccountHistory(addr, rnd)
+	if err != nil {
+		return
+	}
+	return data, rnd, hdr.CurrentProtocol, hdr.RewardsLevel, nil
+}
+
+// lookupResourceHistory returns a resource of a given address at a given round
+// preceding dbRound, using the accounts history.
+func (au *accountUpdates) lookupResourceHistory(rnd basics.Round, addr basics.Address, aidx basics.CreatableIndex) (data ledgercore.AccountResource, validThrough basics.Round, err error) {
+	_, err = au.checkHistoryRound(rnd)
+	if err != nil {
+		return
+	}
+	data, err = au.accountsq.lookupResourceHistory(addr, aidx, rnd)
+	if err != nil {
+		return
+	}
+	return data, rnd, nil
+}
+
+// lookupAllResourcesHistory returns all the resources of a given address at a given
+// round preceding dbRound, using the accounts history.
+func (au *accountUpdates) lookupAllResourcesHistory(rnd basics.Round, addr basics.Address) (map[basics.CreatableIndex]ledgercore.AccountResource, error) {
+	_, err := au.checkHistoryRound(rnd)
+	if err != nil {
+		return nil, err
+	}
+	return au.accountsq.lookupResourcesHistory(addr, rnd)
+}
+
 // getCreatorForRound returns the asset/app creator for a given asset/app index at a given round
 func (au *accountUpdates) getCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType, synchronized bool) (creator basics.Address, ok bool, err error) {
 	unlock := false
@@ -1447,6 +1672,140 @@ func (au *accountUpdates) getCreatorForRound(rnd basics.Round, cidx basics.Creat
 	}
 }
 
+func (au *accountUpdates) lookupKv(rnd basics.Round, key string, synchronized bool) (value []byte, err error) {
+	needUnlock := false
+	if synchronized {
+		au.accountsMu.RLock()
+		needUnlock = true
+	}
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.kvDeltas)
+		var offset uint64
+		offset, err = au.roundOffset(rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		// If this is the most recent round, au.kvStore has the latest value
+		// and we can skip scanning backwards over kvDeltas
+		if offset == uint64(len(au.kvDeltas)) {
+			if mkv, ok := au.kvStore[key]; ok {
+				return mkv.data, nil
+			}
+		} else {
+			for offset > 0 {
+				offset--
+				if kvdelta, ok := au.kvDeltas[offset][key]; ok {
+					return kvdelta.Data, nil
+				}
+			}
+		}
+
+		if synchronized {
+			au.accountsMu.RUnlock()
+			needUnlock = false
+		}
+
+		var dbRound basics.Round
+		value, dbRound, err = au.accountsq.lookupKeyValue(key)
+		if err != nil {
+			return nil, err
+		}
+		if dbRound == currentDbRound {
+			return value, nil
+		}
+
+		if synchronized {
+			if dbRound < currentDbRound {
+				au.log.Errorf("accountUpdates.lookupKv: database round %d is behind in-memory round %d", dbRound, currentDbRound)
+				return nil, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+			}
+			au.accountsMu.RLock()
+			needUnlock = true
+			for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.kvDeltas) {
+				au.accountsReadCond.Wait()
+			}
+		} else {
+			au.log.Errorf("accountUpdates.lookupKv: database round %d mismatching in-memory round %d", dbRound, currentDbRound)
+			return nil, &MismatchingDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+		}
+	}
+}
+
+func (au *accountUpdates) lookupKeysByPrefix(rnd basics.Round, prefix string, maxKeyNum uint64) (keys []string, err error) {
+	au.accountsMu.RLock()
+	needUnlock := true
+	defer func() {
+		if needUnlock {
+			au.accountsMu.RUnlock()
+		}
+	}()
+
+	for {
+		currentDbRound := au.cachedDBRound
+		currentDeltaLen := len(au.kvDeltas)
+		var offset uint64
+		offset, err = au.roundOffset(rnd)
+		if err != nil {
+			return nil, err
+		}
+
+		// the keys that were created or deleted in memory, as of rnd
+		modified := make(map[string]bool)
+		for i := uint64(0); i < offset; i++ {
+			for key, kvdelta := range au.kvDeltas[i] {
+				if strings.HasPrefix(key, prefix) {
+					modified[key] = kvdelta.Data != nil
+				}
+			}
+		}
+
+		au.accountsMu.RUnlock()
+		needUnlock = false
+
+		var dbKeys []string
+		var dbRound basics.Round
+		dbKeys, dbRound, err = au.accountsq.lookupKeysByPrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		if dbRound == currentDbRound {
+			for _, key := range dbKeys {
+				if _, ok := modified[key]; !ok {
+					keys = append(keys, key)
+				}
+			}
+			for key, exists := range modified {
+				if exists {
+					keys = append(keys, key)
+				}
+			}
+			sort.Strings(keys)
+			if maxKeyNum > 0 && uint64(len(keys)) > maxKeyNum {
+				keys = keys[:maxKeyNum]
+			}
+			return keys, nil
+		}
+
+		if dbRound < currentDbRound {
+			au.log.Errorf("accountUpdates.lookupKeysByPrefix: database round %d is behind in-memory round %d", dbRound, currentDbRound)
+			return nil, &StaleDatabaseRoundError{databaseRound: dbRound, memoryRound: currentDbRound}
+		}
+		au.accountsMu.RLock()
+		needUnlock = true
+		for currentDbRound >= au.cachedDBRound && currentDeltaLen == len(au.kvDeltas) {
+			au.accountsReadCond.Wait()
+		}
+	}
+}
+
 // roundOffset calculates the offset of the given round compared to the current dbRound. Requires that the lock would be taken.
 func (au *accountUpdates) roundOffset(rnd basics.Round) (offset uint64, err error) {
 	if rnd < au.cachedDBRound {
@@ -1469,6 +1828,28 @@ func (au *accountUpdates) roundOffset(rnd basics.Round) (offset uint64, err erro
 func (au *accountUpdates) handleUnorderedCommit(dcc *deferredCommitContext) {
 }
 
+// lookupStateDelta returns the state delta that round rnd applied to the ledger.
+// Only the rounds that were not yet flushed to disk are available, and only the
+// last maxStateDeltas of them when it is set.
+func (au *accountUpdates) lookupStateDelta(rnd basics.Round) (ledgercore.StateDelta, error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+
+	// the state deltas of the first rounds after dbRound may have been dropped.
+	firstRound := au.cachedDBRound + 1 + basics.Round(len(au.deltas)-len(au.stateDeltas))
+	if rnd < firstRound {
+		return ledgercore.StateDelta{}, &RoundOffsetError{
+			round:   rnd,
+			dbRound: firstRound,
+		}
+	}
+	offset, err := au.roundOffset(rnd)
+	if err != nil {
+		return ledgercore.StateDelta{}, err
+	}
+	return au.stateDeltas[int(offset)-1-(len(au.deltas)-len(au.stateDeltas))], nil
+}
+
 // prepareCommit prepares data to write to the database a "chunk" of rounds, and update the cached dbRound accordingly.
 func (au *accountUpdates) prepareCommit(dcc *deferredCommitContext) error {
 	if au.logAccountUpdatesMetrics {
@@ -1486,9 +1867,11 @@ func (au *accountUpdates) prepareCommit(dcc *deferredCommitContext) error {
 	// create a copy of the deltas, round totals and protos for the range we're going to flush.
 	dcc.deltas = make([]ledgercore.AccountDeltas, offset)
 	creatableDeltas := make([]map[basics.CreatableIndex]ledgercore.ModifiedCreatable, offset)
+	kvDeltas := make([]map[string]ledgercore.KvValueDelta, offset)
 	dcc.roundTotals = au.roundTotals[offset]
 	copy(dcc.deltas, au.deltas[:offset])
 	copy(creatableDeltas, au.creatableDeltas[:offset])
+	copy(kvDeltas, au.kvDeltas[:offset])
 
 	// verify version correctness : all the entries in the au.versions[1:offset+1] should have the *same* version, and the committedUpTo should be enforcing that.
 	if au.versions[1] != au.versions[offset] {
@@ -1513,6 +1896,7 @@ func (au *accountUpdates) prepareCommit(dcc *deferredCommitContext) error {
 	dcc.compactAccountDeltas = makeCompactAccountDeltas(dcc.deltas, dcc.oldBase, setUpdateRound, au.baseAccounts)
 	dcc.compactResourcesDeltas = makeCompactResourceDeltas(dcc.deltas, dcc.oldBase, setUpdateRound, au.baseAccounts, au.baseResources)
 	dcc.compactCreatableDeltas = compactCreatableDeltas(creatableDeltas)
+	dcc.compactKvDeltas = compactKvDeltas(kvDeltas)
 
 	au.accountsMu.RUnlock()
 
@@ -1583,6 +1967,23 @@ func (au *accountUpdates) commitRound(ctx context.Context, tx *sql.Tx, dcc *defe
 		return err
 	}
 
+	err = kvstoreLoadOld(tx, dcc.compactKvDeltas)
+	if err != nil {
+		return err
+	}
+
+	err = kvstoreNewRound(tx, dcc.compactKvDeltas)
+	if err != nil {
+		return err
+	}
+
+	if au.accountsHistory {
+		err = accountsHistoryNewRound(tx, dcc.deltas, dbRound)
+		if err != nil {
+			return err
+		}
+	}
+
 	if dcc.updateStats {
 		dcc.stats.AccountsWritingDuration = time.Duration(time.Now().UnixNano()) - dcc.stats.AccountsWritingDuration
 	}
@@ -1673,11 +2074,33 @@ func (au *accountUpdates) postCommit(ctx context.Context, dcc *deferredCommitCon
 		}
 	}
 
+	for key, mkv := range dcc.compactKvDeltas {
+		cnt := mkv.ndeltas
+		current, ok := au.kvStore[key]
+		if !ok {
+			au.log.Panicf("inconsistency: flushed %d changes to key %x, but not in au.kvStore", cnt, key)
+		}
+
+		if cnt > current.ndeltas {
+			au.log.Panicf("inconsistency: flushed %d changes to key %x, but au.kvStore had %d", cnt, key, current.ndeltas)
+		} else if cnt == current.ndeltas {
+			delete(au.kvStore, key)
+		} else {
+			current.ndeltas -= cnt
+			au.kvStore[key] = current
+		}
+	}
+
+	// only the state deltas of the flushed rounds that were retained are dropped.
+	if dropped := int(offset) - (len(au.deltas) - len(au.stateDeltas)); dropped > 0 {
+		au.stateDeltas = au.stateDeltas[dropped:]
+	}
 	au.deltas = au.deltas[offset:]
 	au.deltasAccum = au.deltasAccum[offset:]
 	au.versions = au.versions[offset:]
 	au.roundTotals = au.roundTotals[offset:]
 	au.creatableDeltas = au.creatableDeltas[offset:]
+	au.kvDeltas = au.kvDeltas[offset:]
 	au.cachedDBRound = newBase
 
 	au.accountsMu.Unlock()
@@ -1739,6 +2162,24 @@ func compactCreatableDeltas(creatableDeltas []map[basics.CreatableIndex]ledgerco
 	return
 }
 
+// compactKvDeltas takes an array of key/value store deltas and compacts them
+// into a single map, keeping the most recent value of every key.
+func compactKvDeltas(kvDeltas []map[string]ledgercore.KvValueDelta) (outKvDeltas map[string]modifiedKvValue) {
+	if len(kvDeltas) == 0 {
+		return
+	}
+	outKvDeltas = make(map[string]modifiedKvValue, 1+len(kvDeltas[0])*len(kvDeltas))
+	for _, roundKv := range kvDeltas {
+		for key, kvdelta := range roundKv {
+			mkv := outKvDeltas[key]
+			mkv.data = kvdelta.Data
+			mkv.ndeltas++
+			outKvDeltas[key] = mkv
+		}
+	}
+	return
+}
+
 // latest returns the latest round
 func (au *accountUpdates) latest() basics.Round {
 	return au.cachedDBRound + basics.Round(len(au.deltas))
@@ -2656,3 +2656,43 @@ func TestAcctUpdatesLookupResources(t *testing.T) {
 	require.Contains(t, data.Assets, aidx3)
 	require.NotContains(t, data.Assets, aidx2)
 }
+
+func TestLookupStateDeltaRetention(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const dbRound = basics.Round(10)
+	const rounds = 84
+	const maxStateDeltas = 64
+
+	for _, limit := range []uint64{0, maxStateDeltas} {
+		t.Run(fmt.Sprintf("limit=%d", limit), func(t *testing.T) {
+			var au accountUpdates
+			au.initialize(config.Local{MaxStateDeltasInMemory: limit})
+			au.cachedDBRound = dbRound
+			au.deltas = make([]ledgercore.AccountDeltas, rounds)
+			retained := basics.Round(rounds)
+			if limit > 0 {
+				retained = basics.Round(limit)
+			}
+			// only the deltas of the last retained rounds are kept.
+			for rnd := dbRound + rounds - retained + 1; rnd <= dbRound+rounds; rnd++ {
+				au.stateDeltas = append(au.stateDeltas, ledgercore.StateDelta{Hdr: &bookkeeping.BlockHeader{Round: rnd}})
+			}
+
+			var roundOffsetError *RoundOffsetError
+			_, err := au.lookupStateDelta(dbRound)
+			require.ErrorAs(t, err, &roundOffsetError)
+			_, err = au.lookupStateDelta(dbRound + rounds - retained)
+			require.ErrorAs(t, err, &roundOffsetError)
+
+			for rnd := dbRound + rounds - retained + 1; rnd <= dbRound+rounds; rnd++ {
+				delta, err := au.lookupStateDelta(rnd)
+				require.NoError(t, err)
+				require.Equal(t, rnd, delta.Hdr.Round)
+			}
+
+			_, err = au.lookupStateDelta(dbRound + rounds + 1)
+			require.Error(t, err)
+		})
+	}
+}
@@ -844,3 +844,127 @@ func checkTrackers(t *testing.T, wl *wrappedLedger, rnd basics.Round) (basics.Ro
 
 	return minMinSave, nil
 }
+
+func TestArchivalAccountHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// disable deadlock checking code
+	deadlockDisable := deadlock.Opts.Disable
+	deadlock.Opts.Disable = true
+	defer func() {
+		deadlock.Opts.Disable = deadlockDisable
+	}()
+
+	dbTempDir, err := ioutil.TempDir("", "testdir"+t.Name())
+	require.NoError(t, err)
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	dbPrefix := filepath.Join(dbTempDir, dbName)
+	defer os.RemoveAll(dbTempDir)
+
+	genesisInitState := getInitState()
+	genesisInitState.Block.CurrentProtocol = protocol.ConsensusFuture
+	genesisInitState.GenesisHash = crypto.Digest{1}
+	genesisInitState.Block.BlockHeader.GenesisHash = crypto.Digest{1}
+
+	var creator basics.Address
+	_, err = rand.Read(creator[:])
+	require.NoError(t, err)
+	genesisInitState.Accounts[creator] = basics.MakeAccountData(basics.Offline, basics.MicroAlgos{Raw: 1234567890})
+
+	const inMem = false // use persistent storage
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	l, err := OpenLedger(logging.Base(), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer func() {
+		l.Close()
+	}()
+
+	// the asset created in the first block is destroyed in the last one, and
+	// every block in between moves some algos out of the creator account
+	const maxBlocks = 500
+	assetIdx := basics.AssetIndex(genesisInitState.Block.TxnCounter + 1)
+	balances := make(map[basics.Round]basics.MicroAlgos)
+	blk := genesisInitState.Block
+	for i := 0; i < maxBlocks; i++ {
+		blk.BlockHeader.Round++
+		blk.BlockHeader.TimeStamp += 1000
+
+		var tx transactions.Transaction
+		switch i {
+		case 0:
+			creatorEncoded := creator.String()
+			tx, err = makeUnsignedAssetCreateTx(blk.BlockHeader.Round-1, blk.BlockHeader.Round+3, 100, false, creatorEncoded, creatorEncoded, creatorEncoded, creatorEncoded, "m", "m", "", nil)
+			require.NoError(t, err)
+		case maxBlocks - 1:
+			tx, err = makeUnsignedAssetDestroyTx(blk.BlockHeader.Round-1, blk.BlockHeader.Round+3, uint64(assetIdx))
+			require.NoError(t, err)
+		default:
+			tx.Type = protocol.PaymentTx
+			tx.FirstValid = blk.BlockHeader.Round - 1
+			tx.LastValid = blk.BlockHeader.Round + 3
+			tx.Receiver = testPoolAddr
+			tx.Amount = basics.MicroAlgos{Raw: uint64(i)}
+		}
+		tx.Sender = creator
+		blk.BlockHeader.TxnCounter++
+		blk.Payset = transactions.Payset{makeSignedTxnInBlock(tx)}
+
+		err = l.AddBlock(blk, agreement.Certificate{})
+		require.NoError(t, err)
+
+		data, _, _, err := l.LookupAccount(blk.Round(), creator)
+		require.NoError(t, err)
+		balances[blk.Round()] = data.MicroAlgos
+	}
+	l.WaitForCommit(blk.Round())
+
+	checkHistory := func(l *Ledger) {
+		for rnd := basics.Round(1); rnd < blk.Round(); rnd += 7 {
+			data, _, _, err := l.LookupAccount(rnd, creator)
+			require.NoError(t, err)
+			require.Equal(t, balances[rnd], data.MicroAlgos, "round %d", rnd)
+			require.Equal(t, uint64(1), data.TotalAssetParams)
+
+			ad, _, err := l.LookupAccountWithResources(rnd, creator)
+			require.NoError(t, err)
+			require.Equal(t, balances[rnd], ad.MicroAlgos)
+			require.Contains(t, ad.AssetParams, assetIdx)
+			require.Contains(t, ad.Assets, assetIdx)
+
+			res, err := l.LookupAsset(rnd, creator, assetIdx)
+			require.NoError(t, err)
+			require.NotNil(t, res.AssetParams)
+			require.Equal(t, uint64(100), res.AssetParams.Total)
+		}
+
+		ad, _, err := l.LookupAccountWithResources(blk.Round(), creator)
+		require.NoError(t, err)
+		require.NotContains(t, ad.AssetParams, assetIdx)
+
+		// an address that never existed is reported as an empty account
+		var other basics.Address
+		other[0] = 1
+		data, _, _, err := l.LookupAccount(1, other)
+		require.NoError(t, err)
+		require.True(t, data.MicroAlgos.IsZero())
+	}
+	checkHistory(l)
+
+	// the history has to survive a restart
+	l.Close()
+	l, err = OpenLedger(logging.Base(), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	checkHistory(l)
+
+	// a non-archival node drops the history and can only answer for the
+	// rounds it keeps in memory
+	l.Close()
+	cfg.Archival = false
+	l, err = OpenLedger(logging.Base(), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	_, _, _, err = l.LookupAccount(1, creator)
+	require.Error(t, err)
+	var roundOffsetError *RoundOffsetError
+	require.ErrorAs(t, err, &roundOffsetError)
+}
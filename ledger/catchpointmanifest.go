@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+const (
+	// CatchpointFileManifestSuffix is appended to the path of a catchpoint file to get the path of its manifest.
+	CatchpointFileManifestSuffix = ".manifest"
+
+	// CatchpointFileManifestMaxChunks is the maximum number of chunks a catchpoint file manifest could list.
+	CatchpointFileManifestMaxChunks = 1 << 22
+)
+
+// CatchpointFileChunk describes a single section of a catchpoint file. Each section of the catchpoint tar archive
+// is compressed as an independent gzip member, so that it could be served and verified on its own.
+type CatchpointFileChunk struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Name is the name of the section in the tar archive.
+	Name string `codec:"name"`
+	// Offset and Length locate the gzip member holding the section within the catchpoint file.
+	Offset uint64 `codec:"offset"`
+	Length uint64 `codec:"length"`
+	// Size is the size of the uncompressed section content, and Hash its hash.
+	Size uint64        `codec:"size"`
+	Hash crypto.Digest `codec:"hash"`
+}
+
+// CatchpointFileManifest lists the chunks of a catchpoint file, in the order they appear in the file.
+type CatchpointFileManifest struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Chunks []CatchpointFileChunk `codec:"chunks,allocbound=CatchpointFileManifestMaxChunks"`
+}
+
+// Digest returns the hash of the encoded manifest. Catchpoint files that have the same manifest digest have
+// the exact same chunks.
+func (m *CatchpointFileManifest) Digest() crypto.Digest {
+	return crypto.Hash(protocol.Encode(m))
+}
+
+// DecodeCatchpointFileChunk decompresses the given gzip member of a catchpoint file, and verifies that it holds
+// the section described by the chunk. It returns the section content.
+func DecodeCatchpointFileChunk(chunk CatchpointFileChunk, member []byte) ([]byte, error) {
+	if uint64(len(member)) != chunk.Length {
+		return nil, fmt.Errorf("DecodeCatchpointFileChunk: chunk '%s' has a length of %d bytes rather than %d", chunk.Name, len(member), chunk.Length)
+	}
+	gzipReader, err := gzip.NewReader(bytes.NewReader(member))
+	if err != nil {
+		return nil, fmt.Errorf("DecodeCatchpointFileChunk: unable to decompress chunk '%s' : %v", chunk.Name, err)
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	header, err := tarReader.Next()
+	if err != nil {
+		return nil, fmt.Errorf("DecodeCatchpointFileChunk: unable to read chunk '%s' : %v", chunk.Name, err)
+	}
+	if header.Name != chunk.Name || header.Size < 0 || uint64(header.Size) != chunk.Size {
+		return nil, fmt.Errorf("DecodeCatchpointFileChunk: chunk '%s' of %d bytes holds section '%s' of %d bytes", chunk.Name, chunk.Size, header.Name, header.Size)
+	}
+	content := make([]byte, header.Size)
+	_, err = io.ReadFull(tarReader, content)
+	if err != nil {
+		return nil, fmt.Errorf("DecodeCatchpointFileChunk: unable to read the content of chunk '%s' : %v", chunk.Name, err)
+	}
+	if crypto.Hash(content) != chunk.Hash {
+		return nil, fmt.Errorf("DecodeCatchpointFileChunk: chunk '%s' content does not match its hash", chunk.Name)
+	}
+	return content, nil
+}
+
+// writeCatchpointManifest writes the manifest of the catchpoint file at filePath next to it.
+func writeCatchpointManifest(filePath string, manifest *CatchpointFileManifest) error {
+	return os.WriteFile(filePath+CatchpointFileManifestSuffix, protocol.Encode(manifest), 0644)
+}
+
+// readCatchpointManifest reads the manifest of the catchpoint file at filePath.
+func readCatchpointManifest(filePath string) (manifest CatchpointFileManifest, err error) {
+	encoded, err := os.ReadFile(filePath + CatchpointFileManifestSuffix)
+	if err != nil {
+		return
+	}
+	err = protocol.Decode(encoded, &manifest)
+	return
+}
@@ -130,6 +130,13 @@ type catchpointTracker struct {
 	// accountDataResourceSeparationRound in isCatchpointRound(), so that we could generate
 	// catchpoint files even before the protocol upgrade took place.
 	forceCatchpointFileWriting bool
+
+	// manifestCacheMu synchronizes access to the manifest cache.
+	manifestCacheMu deadlock.Mutex
+	// manifestCacheRound and manifestCache are the round and manifest of the catchpoint file which was most recently
+	// served, so that serving its chunks one after the other doesn't require decoding the manifest again and again.
+	manifestCacheRound basics.Round
+	manifestCache      *CatchpointFileManifest
 }
 
 // initialize initializes the catchpointTracker structure
@@ -374,7 +381,7 @@ func (ct *catchpointTracker) commitRound(ctx context.Context, tx *sql.Tx, dcc *d
 		dcc.stats.MerkleTrieUpdateDuration = time.Duration(time.Now().UnixNano())
 	}
 
-	err = ct.accountsUpdateBalances(dcc.compactAccountDeltas, dcc.compactResourcesDeltas)
+	err = ct.accountsUpdateBalances(dcc.compactAccountDeltas, dcc.compactResourcesDeltas, dcc.compactKvDeltas)
 	if err != nil {
 		return err
 	}
@@ -464,8 +471,8 @@ func (ct *catchpointTracker) close() {
 
 }
 
-// accountsUpdateBalances applies the given compactAccountDeltas to the merkle trie
-func (ct *catchpointTracker) accountsUpdateBalances(accountsDeltas compactAccountDeltas, resourcesDeltas compactResourcesDeltas) (err error) {
+// accountsUpdateBalances applies the given compactAccountDeltas, compactResourcesDeltas and key/value store deltas to the merkle trie
+func (ct *catchpointTracker) accountsUpdateBalances(accountsDeltas compactAccountDeltas, resourcesDeltas compactResourcesDeltas, kvDeltas map[string]modifiedKvValue) (err error) {
 	if !ct.catchpointEnabled() {
 		return nil
 	}
@@ -547,6 +554,34 @@ func (ct *catchpointTracker) accountsUpdateBalances(accountsDeltas compactAccoun
 		}
 	}
 
+	for key, mkv := range kvDeltas {
+		if mkv.oldData != nil {
+			deleteHash := kvHashBuilderV6(key, mkv.oldData)
+			deleted, err = ct.balancesTrie.Delete(deleteHash)
+			if err != nil {
+				return fmt.Errorf("failed to delete key/value hash '%s' from merkle trie for key %x: %w", hex.EncodeToString(deleteHash), key, err)
+			}
+			if !deleted {
+				ct.log.Warnf("failed to delete key/value hash '%s' from merkle trie for key %x", hex.EncodeToString(deleteHash), key)
+			} else {
+				accumulatedChanges++
+			}
+		}
+
+		if mkv.data != nil {
+			addHash := kvHashBuilderV6(key, mkv.data)
+			added, err = ct.balancesTrie.Add(addHash)
+			if err != nil {
+				return fmt.Errorf("attempted to add duplicate key/value hash '%s' to merkle trie for key %x: %w", hex.EncodeToString(addHash), key, err)
+			}
+			if !added {
+				ct.log.Warnf("attempted to add duplicate key/value hash '%s' to merkle trie for key %x", hex.EncodeToString(addHash), key)
+			} else {
+				accumulatedChanges++
+			}
+		}
+	}
+
 	if accumulatedChanges >= trieAccumulatedChangesFlush {
 		accumulatedChanges = 0
 		_, err = ct.balancesTrie.Commit()
@@ -741,6 +776,7 @@ func (ct *catchpointTracker) saveCatchpointFile(round basics.Round, fileName str
 			ct.log.Warnf("accountUpdates: saveCatchpoint: unable to remove file (%s): %v", fileName, err)
 			return
 		}
+		os.Remove(fileName + CatchpointFileManifestSuffix)
 	}
 	if ct.catchpointFileHistoryLength == -1 {
 		return
@@ -765,6 +801,75 @@ func (ct *catchpointTracker) saveCatchpointFile(round basics.Round, fileName str
 
 // GetCatchpointStream returns a ReadCloseSizer to the catchpoint file associated with the provided round
 func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseSizer, error) {
+	file, fileSize, err := ct.openCatchpointFile(round)
+	if err != nil {
+		return nil, err
+	}
+	return &readCloseSizer{ReadCloser: file, size: fileSize}, nil
+}
+
+// GetCatchpointManifest returns the manifest of the catchpoint file associated with the provided round. Catchpoint
+// files which were written before manifests were introduced have no manifest, in which case ledgercore.ErrNoEntry
+// is returned.
+func (ct *catchpointTracker) GetCatchpointManifest(round basics.Round) (CatchpointFileManifest, error) {
+	file, _, err := ct.openCatchpointFile(round)
+	if err != nil {
+		return CatchpointFileManifest{}, err
+	}
+	defer file.Close()
+	manifest, err := ct.catchpointManifest(round, file.Name())
+	if err != nil {
+		return CatchpointFileManifest{}, err
+	}
+	return *manifest, nil
+}
+
+// GetCatchpointChunk returns the gzip member holding the given chunk of the catchpoint file associated with the
+// provided round, along with the chunk description.
+func (ct *catchpointTracker) GetCatchpointChunk(round basics.Round, chunkIndex uint64) ([]byte, CatchpointFileChunk, error) {
+	file, _, err := ct.openCatchpointFile(round)
+	if err != nil {
+		return nil, CatchpointFileChunk{}, err
+	}
+	defer file.Close()
+	manifest, err := ct.catchpointManifest(round, file.Name())
+	if err != nil {
+		return nil, CatchpointFileChunk{}, err
+	}
+	if chunkIndex >= uint64(len(manifest.Chunks)) {
+		return nil, CatchpointFileChunk{}, ledgercore.ErrNoEntry{}
+	}
+	chunk := manifest.Chunks[chunkIndex]
+	member := make([]byte, chunk.Length)
+	_, err = file.ReadAt(member, int64(chunk.Offset))
+	if err != nil {
+		return nil, CatchpointFileChunk{}, fmt.Errorf("accountUpdates: getCatchpointChunk: unable to read chunk %d of catchpoint file '%s' %v", chunkIndex, file.Name(), err)
+	}
+	return member, chunk, nil
+}
+
+// catchpointManifest returns the manifest of the given catchpoint file, using the manifest cache if possible.
+func (ct *catchpointTracker) catchpointManifest(round basics.Round, catchpointPath string) (*CatchpointFileManifest, error) {
+	ct.manifestCacheMu.Lock()
+	defer ct.manifestCacheMu.Unlock()
+	if ct.manifestCache != nil && ct.manifestCacheRound == round {
+		return ct.manifestCache, nil
+	}
+	manifest, err := readCatchpointManifest(catchpointPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ledgercore.ErrNoEntry{}
+		}
+		return nil, fmt.Errorf("accountUpdates: getCatchpointManifest: unable to read manifest of catchpoint file '%s' %v", catchpointPath, err)
+	}
+	ct.manifestCacheRound = round
+	ct.manifestCache = &manifest
+	return ct.manifestCache, nil
+}
+
+// openCatchpointFile opens the catchpoint file associated with the provided round, and returns it along with its size.
+// The size is -1 if it is unknown.
+func (ct *catchpointTracker) openCatchpointFile(round basics.Round) (*os.File, int64, error) {
 	dbFileName := ""
 	fileSize := int64(0)
 	start := time.Now()
@@ -776,13 +881,13 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 	ledgerGetcatchpointMicros.AddMicrosecondsSince(start, nil)
 	if err != nil && err != sql.ErrNoRows {
 		// we had some sql error.
-		return nil, fmt.Errorf("accountUpdates: getCatchpointStream: unable to lookup catchpoint %d: %v", round, err)
+		return nil, 0, fmt.Errorf("accountUpdates: getCatchpointStream: unable to lookup catchpoint %d: %v", round, err)
 	}
 	if dbFileName != "" {
 		catchpointPath := filepath.Join(ct.dbDirectory, dbFileName)
 		file, err := os.OpenFile(catchpointPath, os.O_RDONLY, 0666)
 		if err == nil && file != nil {
-			return &readCloseSizer{ReadCloser: file, size: fileSize}, nil
+			return file, fileSize, nil
 		}
 		// else, see if this is a file-not-found error
 		if os.IsNotExist(err) {
@@ -791,13 +896,13 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 			err := ct.saveCatchpointFile(round, "", 0, "")
 			if err != nil {
 				ct.log.Warnf("accountUpdates: getCatchpointStream: unable to delete missing catchpoint entry: %v", err)
-				return nil, err
+				return nil, 0, err
 			}
 
-			return nil, ledgercore.ErrNoEntry{}
+			return nil, 0, ledgercore.ErrNoEntry{}
 		}
 		// it's some other error.
-		return nil, fmt.Errorf("accountUpdates: getCatchpointStream: unable to open catchpoint file '%s' %v", catchpointPath, err)
+		return nil, 0, fmt.Errorf("accountUpdates: getCatchpointStream: unable to open catchpoint file '%s' %v", catchpointPath, err)
 	}
 
 	// if the database doesn't know about that round, see if we have that file anyway:
@@ -809,16 +914,16 @@ func (ct *catchpointTracker) GetCatchpointStream(round basics.Round) (ReadCloseS
 		fileInfo, err := file.Stat()
 		if err != nil {
 			// we couldn't get the stat, so just return with the file.
-			return &readCloseSizer{ReadCloser: file, size: -1}, nil
+			return file, -1, nil
 		}
 
 		err = ct.saveCatchpointFile(round, fileName, fileInfo.Size(), "")
 		if err != nil {
 			ct.log.Warnf("accountUpdates: getCatchpointStream: unable to save missing catchpoint entry: %v", err)
 		}
-		return &readCloseSizer{ReadCloser: file, size: fileInfo.Size()}, nil
+		return file, fileInfo.Size(), nil
 	}
-	return nil, ledgercore.ErrNoEntry{}
+	return nil, 0, ledgercore.ErrNoEntry{}
 }
 
 // deleteStoredCatchpoints iterates over the storedcatchpoints table and deletes all the files stored on disk.
@@ -852,6 +957,10 @@ func deleteStoredCatchpoints(ctx context.Context, dbQueries *accountsDbQueries,
 // This function remove a single catchpoint file from the disk. this function does not leave empty directories
 func removeSingleCatchpointFileFromDisk(dbDirectory, fileToDelete string) (err error) {
 	absCatchpointFileName := filepath.Join(dbDirectory, fileToDelete)
+	err = os.Remove(absCatchpointFileName + CatchpointFileManifestSuffix)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to delete old catchpoint manifest '%s' : %v", absCatchpointFileName+CatchpointFileManifestSuffix, err)
+	}
 	err = os.Remove(absCatchpointFileName)
 	if err == nil || os.IsNotExist(err) {
 		// it's ok if the file doesn't exist.
@@ -939,6 +1048,21 @@ func resourcesHashBuilderV6(addr basics.Address, cidx basics.CreatableIndex, cty
 	return hash[:]
 }
 
+// kvHashBuilderV6 calculates the hash key used for the trie by combining the key and the value of a key/value store entry
+func kvHashBuilderV6(key string, value []byte) []byte {
+	hash := make([]byte, 4+crypto.DigestSize)
+	// key/value entries have no update round, so the first four bytes are left as zeros.
+	hash[4] = 3 // set the 5th byte to three so we could differentiate the key/value hashes from the account and resource ones.
+
+	prehash := make([]byte, 4+len(key)+len(value))
+	binary.LittleEndian.PutUint32(prehash, uint32(len(key)))
+	copy(prehash[4:], key)
+	copy(prehash[4+len(key):], value)
+	entryHash := crypto.Hash(prehash)
+	copy(hash[5:], entryHash[1:])
+	return hash[:]
+}
+
 // accountHashBuilder calculates the hash key used for the trie by combining the account address and the account data
 func accountHashBuilder(addr basics.Address, accountData basics.AccountData, encodedAccountData []byte) []byte {
 	hash := make([]byte, 4+crypto.DigestSize)
@@ -1060,6 +1184,37 @@ func (ct *catchpointTracker) accountsInitializeHashes(ctx context.Context, tx *s
 			}
 		}
 
+		// add the key/value store entries, which are not part of the accounts iterator.
+		var kvs []encodedKVRecord
+		var lastKey []byte
+		for {
+			kvs, err = readCatchpointKVs(ctx, tx, lastKey, trieRebuildAccountChunkSize)
+			if err != nil {
+				return fmt.Errorf("accountsInitialize was unable to read the key/value store: %v", err)
+			}
+			for _, kv := range kvs {
+				hash := kvHashBuilderV6(string(kv.Key), kv.Value)
+				added, err := trie.Add(hash)
+				if err != nil {
+					return fmt.Errorf("accountsInitialize was unable to add changes to trie: %v", err)
+				}
+				if !added {
+					ct.log.Warnf("accountsInitialize attempted to add duplicate hash '%s' to merkle trie for key %x", hex.EncodeToString(hash), kv.Key)
+				}
+			}
+			trieHashCount += len(kvs)
+			if len(kvs) < trieRebuildAccountChunkSize {
+				break
+			}
+			lastKey = kvs[len(kvs)-1].Key
+			// this trie Evict will commit using the current transaction.
+			// if anything goes wrong, it will still get rolled back.
+			_, err = trie.Evict(true)
+			if err != nil {
+				return fmt.Errorf("accountsInitialize was unable to commit changes to trie: %v", err)
+			}
+		}
+
 		// this trie Evict will commit using the current transaction.
 		// if anything goes wrong, it will still get rolled back.
 		_, err = trie.Evict(true)
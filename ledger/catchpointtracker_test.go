@@ -25,6 +25,7 @@ import (
 	"path"
 	"path/filepath"
 	"runtime"
+	"strings"
 
 	"sync/atomic"
 	"testing"
@@ -268,7 +269,7 @@ func TestSchemaUpdateDeleteStoredCatchpoints(t *testing.T) {
 func getNumberOfCatchpointFilesInDir(catchpointDir string) (int, error) {
 	numberOfCatchpointFiles := 0
 	err := filepath.Walk(catchpointDir, func(path string, d os.FileInfo, err error) error {
-		if !d.IsDir() {
+		if !d.IsDir() && !strings.HasSuffix(path, CatchpointFileManifestSuffix) {
 			numberOfCatchpointFiles++
 		}
 		return nil
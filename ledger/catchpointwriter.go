@@ -22,6 +22,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -43,12 +44,25 @@ const (
 
 	// CatchpointFileVersionV6 is the catchpoint file version that is matching database schema V6
 	CatchpointFileVersionV6 = uint64(0201)
+
+	// CatchpointFileVersionV7 is the catchpoint file version that adds the contents of the key/value store
+	CatchpointFileVersionV7 = uint64(0202)
+
+	// encodedKVRecordMaxKeyLength is the maximum length of a key/value store key in a catchpoint file.
+	// box keys are made of an 11 bytes prefix followed by a name of up to 64 bytes.
+	encodedKVRecordMaxKeyLength = 128
+
+	// encodedKVRecordMaxValueLength is the maximum length of a key/value store value in a catchpoint file,
+	// matching the largest box size.
+	encodedKVRecordMaxValueLength = 32768
 )
 
 // catchpointWriter is the struct managing the persistence of accounts data into the catchpoint file.
 // it's designed to work in a step fashion : a caller will call the WriteStep method in a loop until
 // the writing is complete. It might take multiple steps until the operation is over, and the caller
 // has the option of throttling the CPU utilization in between the calls.
+// Each section of the tar archive is compressed as an independent gzip member, and listed in the
+// manifest written next to the catchpoint file once it's complete.
 type catchpointWriter struct {
 	ctx               context.Context
 	tx                *sql.Tx
@@ -66,6 +80,10 @@ type catchpointWriter struct {
 	blockHeaderDigest crypto.Digest
 	label             string
 	accountsIterator  encodedAccountsBatchIter
+	accountChunks     uint64
+	lastKey           []byte
+	manifest          CatchpointFileManifest
+	chunkOffset       int64
 }
 
 type encodedBalanceRecordV5 struct {
@@ -92,9 +110,23 @@ type encodedBalanceRecordV6 struct {
 	Resources   map[uint64]msgp.Raw `codec:"c,allocbound=basics.MaxEncodedAccountDataSize"`
 }
 
+type encodedKVRecord struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Key   []byte `codec:"k,allocbound=encodedKVRecordMaxKeyLength"`
+	Value []byte `codec:"v,allocbound=encodedKVRecordMaxValueLength"`
+}
+
+// catchpointFileBalancesChunkV6 is a chunk of a V6 or V7 catchpoint file. The accounts are written first, followed by the
+// key/value store entries, which are only present in V7 files. A chunk holds either accounts or key/value entries.
 type catchpointFileBalancesChunkV6 struct {
 	_struct  struct{}                 `codec:",omitempty,omitemptyarray"`
 	Balances []encodedBalanceRecordV6 `codec:"bl,allocbound=BalancesPerCatchpointFileChunk"`
+	KVs      []encodedKVRecord        `codec:"kv,allocbound=BalancesPerCatchpointFileChunk"`
+}
+
+func (chunk *catchpointFileBalancesChunkV6) empty() bool {
+	return len(chunk.Balances) == 0 && len(chunk.KVs) == 0
 }
 
 // CatchpointFileHeader is the content we would have in the "content.msgpack" file in the catchpoint tar archive.
@@ -107,6 +139,7 @@ type CatchpointFileHeader struct {
 	BlocksRound       basics.Round             `codec:"blocksRound"`
 	Totals            ledgercore.AccountTotals `codec:"accountTotals"`
 	TotalAccounts     uint64                   `codec:"accountsCount"`
+	TotalKVs          uint64                   `codec:"kvsCount"`
 	TotalChunks       uint64                   `codec:"chunksCount"`
 	Catchpoint        string                   `codec:"catchpoint"`
 	BlockHeaderDigest crypto.Digest            `codec:"blockHeaderDigest"`
@@ -134,6 +167,7 @@ func (cw *catchpointWriter) Abort() error {
 	if cw.file != nil {
 		cw.gzip.Close()
 	}
+	os.Remove(cw.filePath + CatchpointFileManifestSuffix)
 	err := os.Remove(cw.filePath)
 	return err
 }
@@ -183,6 +217,10 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 		if err != nil {
 			return
 		}
+		err = cw.finishChunk("content.msgpack", encodedHeader, false)
+		if err != nil {
+			return
+		}
 		cw.headerWritten = true
 	}
 
@@ -210,11 +248,14 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 			return
 		}
 
-		if len(cw.balancesChunk.Balances) == 0 {
+		if cw.balancesChunk.empty() {
 			err = cw.readDatabaseStep(cw.ctx, cw.tx)
 			if err != nil {
 				return
 			}
+			if cw.balancesChunk.empty() {
+				return false, fmt.Errorf("catchpoint file writer ran out of data after %d out of %d chunks", cw.balancesChunkNum, cw.fileHeader.TotalChunks)
+			}
 		}
 
 		// have we timed-out / canceled by that point ?
@@ -232,10 +273,10 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 		}
 
 		// write to disk.
-		if len(cw.balancesChunk.Balances) > 0 {
+		if !cw.balancesChunk.empty() {
 			cw.balancesChunkNum++
 			writerRequest <- cw.balancesChunk
-			if len(cw.balancesChunk.Balances) < BalancesPerCatchpointFileChunk || cw.balancesChunkNum == cw.fileHeader.TotalChunks {
+			if cw.balancesChunkNum == cw.fileHeader.TotalChunks {
 				cw.accountsIterator.Close()
 				// if we're done, wait for the writer to complete it's writing.
 				err, opened := <-writerResponse
@@ -247,7 +288,7 @@ func (cw *catchpointWriter) WriteStep(stepCtx context.Context) (more bool, err e
 				// channel is closed. we're done writing and no issues detected.
 				return false, nil
 			}
-			cw.balancesChunk.Balances = nil
+			cw.balancesChunk = catchpointFileBalancesChunkV6{}
 		}
 	}
 }
@@ -257,13 +298,14 @@ func (cw *catchpointWriter) asyncWriter(balances chan catchpointFileBalancesChun
 	balancesChunkNum := initialBalancesChunkNum
 	for bc := range balances {
 		balancesChunkNum++
-		if len(bc.Balances) == 0 {
+		if bc.empty() {
 			break
 		}
 
 		encodedChunk := protocol.Encode(&bc)
+		chunkName := fmt.Sprintf("balances.%d.%d.msgpack", balancesChunkNum, cw.fileHeader.TotalChunks)
 		err := cw.tar.WriteHeader(&tar.Header{
-			Name: fmt.Sprintf("balances.%d.%d.msgpack", balancesChunkNum, cw.fileHeader.TotalChunks),
+			Name: chunkName,
 			Mode: 0600,
 			Size: int64(len(encodedChunk)),
 		})
@@ -277,9 +319,14 @@ func (cw *catchpointWriter) asyncWriter(balances chan catchpointFileBalancesChun
 			break
 		}
 
-		if len(bc.Balances) < BalancesPerCatchpointFileChunk || balancesChunkNum == cw.fileHeader.TotalChunks {
-			cw.tar.Close()
-			cw.gzip.Close()
+		lastChunk := balancesChunkNum == cw.fileHeader.TotalChunks
+		err = cw.finishChunk(chunkName, encodedChunk, lastChunk)
+		if err != nil {
+			response <- err
+			break
+		}
+
+		if lastChunk {
 			cw.file.Close()
 			cw.file = nil
 			var fileInfo os.FileInfo
@@ -289,15 +336,61 @@ func (cw *catchpointWriter) asyncWriter(balances chan catchpointFileBalancesChun
 				break
 			}
 			cw.writtenBytes = fileInfo.Size()
+			err = writeCatchpointManifest(cw.filePath, &cw.manifest)
+			if err != nil {
+				response <- err
+			}
 			break
 		}
 	}
 }
 
+// finishChunk completes the gzip member holding the tar section that was just written, and adds it to the manifest.
+// The last chunk also holds the end of the tar archive.
+func (cw *catchpointWriter) finishChunk(name string, content []byte, last bool) (err error) {
+	if last {
+		err = cw.tar.Close()
+	} else {
+		err = cw.tar.Flush()
+	}
+	if err != nil {
+		return
+	}
+	err = cw.gzip.Close()
+	if err != nil {
+		return
+	}
+	offset, err := cw.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return
+	}
+	cw.manifest.Chunks = append(cw.manifest.Chunks, CatchpointFileChunk{
+		Name:   name,
+		Offset: uint64(cw.chunkOffset),
+		Length: uint64(offset - cw.chunkOffset),
+		Size:   uint64(len(content)),
+		Hash:   crypto.Hash(content),
+	})
+	cw.chunkOffset = offset
+	if !last {
+		cw.gzip.Reset(cw.file)
+	}
+	return
+}
+
+// readDatabaseStep reads the content of the next chunk : the accounts chunks come first, followed by the
+// key/value store chunks.
 func (cw *catchpointWriter) readDatabaseStep(ctx context.Context, tx *sql.Tx) (err error) {
-	cw.balancesChunk.Balances, err = cw.accountsIterator.Next(ctx, tx, BalancesPerCatchpointFileChunk)
-	if err == nil {
-		cw.balancesOffset += BalancesPerCatchpointFileChunk
+	if cw.balancesChunkNum < cw.accountChunks {
+		cw.balancesChunk.Balances, err = cw.accountsIterator.Next(ctx, tx, BalancesPerCatchpointFileChunk)
+		if err == nil {
+			cw.balancesOffset += BalancesPerCatchpointFileChunk
+		}
+		return
+	}
+	cw.balancesChunk.KVs, err = readCatchpointKVs(ctx, tx, cw.lastKey, BalancesPerCatchpointFileChunk)
+	if err == nil && len(cw.balancesChunk.KVs) > 0 {
+		cw.lastKey = cw.balancesChunk.KVs[len(cw.balancesChunk.KVs)-1].Key
 	}
 	return
 }
@@ -316,10 +409,15 @@ func (cw *catchpointWriter) readHeaderFromDatabase(ctx context.Context, tx *sql.
 	if err != nil {
 		return
 	}
-	header.TotalChunks = (header.TotalAccounts + BalancesPerCatchpointFileChunk - 1) / BalancesPerCatchpointFileChunk
+	header.TotalKVs, err = totalKVs(ctx, tx)
+	if err != nil {
+		return
+	}
+	cw.accountChunks = (header.TotalAccounts + BalancesPerCatchpointFileChunk - 1) / BalancesPerCatchpointFileChunk
+	header.TotalChunks = cw.accountChunks + (header.TotalKVs+BalancesPerCatchpointFileChunk-1)/BalancesPerCatchpointFileChunk
 	header.BlocksRound = cw.blocksRound
 	header.Catchpoint = cw.label
-	header.Version = CatchpointFileVersionV6
+	header.Version = CatchpointFileVersionV7
 	header.BlockHeaderDigest = cw.blockHeaderDigest
 	cw.fileHeader = &header
 	return
@@ -35,6 +35,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
@@ -397,3 +398,230 @@ func TestFullCatchpointWriter(t *testing.T) {
 		require.Equal(t, basics.Round(0), validThrough)
 	}
 }
+
+// TestCatchpointWriterManifest tests that each of the chunks listed in the catchpoint file manifest could be
+// decompressed and verified on its own, and that the catchpoint file could still be read as a single gzip stream.
+func TestCatchpointWriterManifest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// create new protocol version, which has lower lookback
+	testProtocolVersion := protocol.ConsensusVersion("test-protocol-TestCatchpointWriterManifest")
+	protoParams := config.Consensus[protocol.ConsensusCurrentVersion]
+	protoParams.MaxBalLookback = 32
+	protoParams.SeedLookback = 2
+	protoParams.SeedRefreshInterval = 8
+	config.Consensus[testProtocolVersion] = protoParams
+	temporaryDirectroy := t.TempDir()
+	defer func() {
+		delete(config.Consensus, testProtocolVersion)
+	}()
+
+	accts := ledgertesting.RandomAccounts(BalancesPerCatchpointFileChunk*2+10, false)
+	ml := makeMockLedgerForTracker(t, true, 10, testProtocolVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.Archival = true
+	au := newAcctUpdates(t, ml, conf, ".")
+	err := au.loadFromDisk(ml, 0)
+	require.NoError(t, err)
+	au.close()
+	fileName := filepath.Join(temporaryDirectroy, "15.catchpoint")
+	blocksRound := basics.Round(12345)
+	blockHeaderDigest := crypto.Hash([]byte{1, 2, 3})
+	catchpointLabel := fmt.Sprintf("%d#%v", blocksRound, blockHeaderDigest)
+	readDb := ml.trackerDB().Rdb
+	err = readDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		writer := makeCatchpointWriter(context.Background(), fileName, tx, blocksRound, blockHeaderDigest, catchpointLabel)
+		for {
+			more, err := writer.WriteStep(context.Background())
+			require.NoError(t, err)
+			if !more {
+				break
+			}
+		}
+		return
+	})
+	require.NoError(t, err)
+
+	fileContent, err := ioutil.ReadFile(fileName)
+	require.NoError(t, err)
+	manifest, err := readCatchpointManifest(fileName)
+	require.NoError(t, err)
+	require.Len(t, manifest.Chunks, 4)
+
+	// the chunks cover the whole file, in order.
+	offset := uint64(0)
+	accounts := 0
+	for i, chunk := range manifest.Chunks {
+		require.Equal(t, offset, chunk.Offset)
+		offset += chunk.Length
+		member := fileContent[chunk.Offset : chunk.Offset+chunk.Length]
+		content, err := DecodeCatchpointFileChunk(chunk, member)
+		require.NoError(t, err)
+		if i == 0 {
+			require.Equal(t, "content.msgpack", chunk.Name)
+			continue
+		}
+		var balances catchpointFileBalancesChunkV6
+		require.NoError(t, protocol.Decode(content, &balances))
+		accounts += len(balances.Balances)
+
+		// a chunk that doesn't match the manifest is rejected.
+		_, err = DecodeCatchpointFileChunk(manifest.Chunks[0], member)
+		require.Error(t, err)
+		corrupted := chunk
+		corrupted.Hash[0]++
+		_, err = DecodeCatchpointFileChunk(corrupted, member)
+		require.Error(t, err)
+	}
+	require.Equal(t, uint64(len(fileContent)), offset)
+	require.Equal(t, len(accts), accounts)
+
+	// the file is still a single valid tar stream.
+	gzipReader, err := gzip.NewReader(bytes.NewBuffer(fileContent))
+	require.NoError(t, err)
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	sections := 0
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.Equal(t, manifest.Chunks[sections].Name, header.Name)
+		sections++
+	}
+	require.Equal(t, len(manifest.Chunks), sections)
+}
+
+// TestFullCatchpointWriterKVs tests that the key/value store is carried by the catchpoint file, and that the
+// merkle trie rebuilt from the catchpoint file matches the one of the ledger that generated it.
+func TestFullCatchpointWriterKVs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	accts := ledgertesting.RandomAccounts(20, false)
+	ml := makeMockLedgerForTracker(t, true, 10, protocol.ConsensusCurrentVersion, []map[basics.Address]basics.AccountData{accts})
+	defer ml.Close()
+
+	conf := config.GetDefaultLocal()
+	conf.CatchpointInterval = 1
+	conf.Archival = true
+	ct := newCatchpointTracker(t, ml, conf, ".")
+	defer ct.close()
+
+	// enough entries to span two chunks.
+	kvs := make(map[string][]byte)
+	for i := 0; i < BalancesPerCatchpointFileChunk+10; i++ {
+		kvs[ledgercore.MakeBoxKey(basics.AppIndex(i%7+1), fmt.Sprintf("box%d", i))] = []byte(makeString(i%100 + 1))
+	}
+
+	var rootWithoutKVs, rootWithKVs crypto.Digest
+	writeDb := ml.trackerDB().Wdb
+	err := writeDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		rootWithoutKVs, err = ct.balancesTrie.RootHash()
+		if err != nil {
+			return err
+		}
+		for key, value := range kvs {
+			_, err = tx.Exec("INSERT INTO kvstore (key, value) VALUES (?, ?)", []byte(key), value)
+			if err != nil {
+				return err
+			}
+		}
+		// rebuild the trie, which now has to include the key/value store.
+		err = resetAccountHashes(tx)
+		if err != nil {
+			return err
+		}
+		rnd, err := accountsRound(tx)
+		if err != nil {
+			return err
+		}
+		err = ct.accountsInitializeHashes(ctx, tx, rnd)
+		if err != nil {
+			return err
+		}
+		rootWithKVs, err = ct.balancesTrie.RootHash()
+		return err
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, rootWithoutKVs, rootWithKVs)
+
+	fileName := filepath.Join(t.TempDir(), "15.catchpoint")
+	blocksRound := basics.Round(12345)
+	blockHeaderDigest := crypto.Hash([]byte{1, 2, 3})
+	catchpointLabel := fmt.Sprintf("%d#%v", blocksRound, blockHeaderDigest)
+	readDb := ml.trackerDB().Rdb
+	err = readDb.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		writer := makeCatchpointWriter(context.Background(), fileName, tx, blocksRound, blockHeaderDigest, catchpointLabel)
+		for {
+			more, err := writer.WriteStep(context.Background())
+			require.NoError(t, err)
+			if !more {
+				break
+			}
+		}
+		return
+	})
+	require.NoError(t, err)
+
+	var initState ledgercore.InitState
+	initState.Block.CurrentProtocol = protocol.ConsensusCurrentVersion
+	l, err := OpenLedger(ml.log, t.Name(), true, initState, conf)
+	require.NoError(t, err)
+	defer l.Close()
+	accessor := MakeCatchpointCatchupAccessor(l, l.log)
+	err = accessor.ResetStagingBalances(context.Background(), true)
+	require.NoError(t, err)
+
+	fileContent, err := ioutil.ReadFile(fileName)
+	require.NoError(t, err)
+	gzipReader, err := gzip.NewReader(bytes.NewBuffer(fileContent))
+	require.NoError(t, err)
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+	var catchupProgress CatchpointCatchupAccessorProgress
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := ioutil.ReadAll(tarReader)
+		require.NoError(t, err)
+		err = accessor.ProgressStagingBalances(context.Background(), header.Name, content, &catchupProgress)
+		require.NoError(t, err)
+	}
+	require.Equal(t, uint64(len(kvs)), catchupProgress.TotalKVs)
+	require.Equal(t, uint64(len(kvs)), catchupProgress.ProcessedKVs)
+	require.Equal(t, uint64(3), catchupProgress.TotalChunks)
+
+	err = accessor.BuildMerkleTrie(context.Background(), nil)
+	require.NoError(t, err)
+	err = l.trackerDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		mc, err := MakeMerkleCommitter(tx, true)
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(mc, TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		root, err := trie.RootHash()
+		if err != nil {
+			return err
+		}
+		require.Equal(t, rootWithKVs, root)
+		return applyCatchpointStagingBalances(ctx, tx, 0, 0)
+	})
+	require.NoError(t, err)
+
+	for key, value := range kvs {
+		restored, err := l.LookupKv(0, key)
+		require.NoError(t, err)
+		require.Equal(t, value, restored)
+	}
+}
@@ -19,6 +19,7 @@ package ledger
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -26,6 +27,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/algorand/msgp/msgp"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/crypto/merkletrie"
@@ -52,6 +55,25 @@ type CatchpointCatchupAccessor interface {
 	// SetLabel set the catchpoint catchup label
 	SetLabel(ctx context.Context, label string) (err error)
 
+	// GetSource returns the location of the catchpoint file the catchpoint catchup loads the ledger from,
+	// or an empty string if the catchpoint file is downloaded from the peers
+	GetSource(ctx context.Context) (source string, err error)
+
+	// SetSource set the location of the catchpoint file the catchpoint catchup loads the ledger from
+	SetSource(ctx context.Context, source string) (err error)
+
+	// GetChunksManifest returns the manifest of the catchpoint file being downloaded in chunks, if any
+	GetChunksManifest(ctx context.Context) (manifest CatchpointFileManifest, found bool, err error)
+
+	// SetChunksManifest sets the manifest of the catchpoint file being downloaded in chunks
+	SetChunksManifest(ctx context.Context, manifest *CatchpointFileManifest) (err error)
+
+	// GetChunksProgress returns the persisted progress of the catchpoint file chunks download
+	GetChunksProgress(ctx context.Context) (progress CatchpointCatchupChunksProgress, err error)
+
+	// SetChunksProgress persists the progress of the catchpoint file chunks download
+	SetChunksProgress(ctx context.Context, progress CatchpointCatchupChunksProgress) (err error)
+
 	// ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 	ResetStagingBalances(ctx context.Context, newCatchup bool) (err error)
 
@@ -180,6 +202,75 @@ func (c *CatchpointCatchupAccessorImpl) SetLabel(ctx context.Context, label stri
 	return
 }
 
+// GetSource returns the location of the catchpoint file the catchpoint catchup loads the ledger from,
+// or an empty string if the catchpoint file is downloaded from the peers
+func (c *CatchpointCatchupAccessorImpl) GetSource(ctx context.Context) (source string, err error) {
+	source, _, err = c.accountsq.readCatchpointStateString(ctx, catchpointStateCatchupSource)
+	if err != nil {
+		return "", fmt.Errorf("unable to read catchpoint catchup state '%s': %v", catchpointStateCatchupSource, err)
+	}
+	return
+}
+
+// SetSource set the location of the catchpoint file the catchpoint catchup loads the ledger from
+func (c *CatchpointCatchupAccessorImpl) SetSource(ctx context.Context, source string) (err error) {
+	_, err = c.accountsq.writeCatchpointStateString(ctx, catchpointStateCatchupSource, source)
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", catchpointStateCatchupSource, err)
+	}
+	return
+}
+
+// GetChunksManifest returns the manifest of the catchpoint file being downloaded in chunks, if any
+func (c *CatchpointCatchupAccessorImpl) GetChunksManifest(ctx context.Context) (manifest CatchpointFileManifest, found bool, err error) {
+	found, err = c.readCatchpointStateObject(ctx, catchpointStateCatchupChunksManifest, &manifest)
+	return
+}
+
+// SetChunksManifest sets the manifest of the catchpoint file being downloaded in chunks
+func (c *CatchpointCatchupAccessorImpl) SetChunksManifest(ctx context.Context, manifest *CatchpointFileManifest) (err error) {
+	return c.writeCatchpointStateObject(ctx, catchpointStateCatchupChunksManifest, manifest)
+}
+
+// GetChunksProgress returns the persisted progress of the catchpoint file chunks download
+func (c *CatchpointCatchupAccessorImpl) GetChunksProgress(ctx context.Context) (progress CatchpointCatchupChunksProgress, err error) {
+	_, err = c.readCatchpointStateObject(ctx, catchpointStateCatchupChunksProgress, &progress)
+	return
+}
+
+// SetChunksProgress persists the progress of the catchpoint file chunks download
+func (c *CatchpointCatchupAccessorImpl) SetChunksProgress(ctx context.Context, progress CatchpointCatchupChunksProgress) (err error) {
+	return c.writeCatchpointStateObject(ctx, catchpointStateCatchupChunksProgress, &progress)
+}
+
+// readCatchpointStateObject decodes the object stored in the given catchpoint state, if any
+func (c *CatchpointCatchupAccessorImpl) readCatchpointStateObject(ctx context.Context, stateName catchpointState, obj msgp.Unmarshaler) (found bool, err error) {
+	encoded, _, err := c.accountsq.readCatchpointStateString(ctx, stateName)
+	if err != nil {
+		return false, fmt.Errorf("unable to read catchpoint catchup state '%s': %v", stateName, err)
+	}
+	if encoded == "" {
+		return false, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err == nil {
+		err = protocol.Decode(decoded, obj)
+	}
+	if err != nil {
+		return false, fmt.Errorf("unable to decode catchpoint catchup state '%s': %v", stateName, err)
+	}
+	return true, nil
+}
+
+// writeCatchpointStateObject stores the given object in the given catchpoint state
+func (c *CatchpointCatchupAccessorImpl) writeCatchpointStateObject(ctx context.Context, stateName catchpointState, obj msgp.Marshaler) (err error) {
+	_, err = c.accountsq.writeCatchpointStateString(ctx, stateName, base64.StdEncoding.EncodeToString(protocol.Encode(obj)))
+	if err != nil {
+		return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", stateName, err)
+	}
+	return
+}
+
 // ResetStagingBalances resets the current staging balances, preparing for a new set of balances to be added
 func (c *CatchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context, newCatchup bool) (err error) {
 	wdb := c.ledger.trackerDB().Wdb
@@ -213,6 +304,10 @@ func (c *CatchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 			if err != nil {
 				return err
 			}
+			_, err = sq.writeCatchpointStateString(ctx, catchpointStateCatchupSource, "")
+			if err != nil {
+				return err
+			}
 			_, err = sq.writeCatchpointStateUint64(ctx, catchpointStateCatchupState, 0)
 			if err != nil {
 				return fmt.Errorf("unable to write catchpoint catchup state '%s': %v", catchpointStateCatchupState, err)
@@ -228,11 +323,15 @@ func (c *CatchpointCatchupAccessorImpl) ResetStagingBalances(ctx context.Context
 type CatchpointCatchupAccessorProgress struct {
 	TotalAccounts      uint64
 	ProcessedAccounts  uint64
+	TotalKVs           uint64
+	ProcessedKVs       uint64
 	ProcessedBytes     uint64
 	TotalChunks        uint64
 	SeenHeader         bool
 	Version            uint64
 	TotalAccountHashes uint64
+	// ProcessedChunks is the number of balances chunks processed so far.
+	ProcessedChunks uint64
 
 	// Having the cachedTrie here would help to accelerate the catchup process since the trie maintain an internal cache of nodes.
 	// While rebuilding the trie, we don't want to force and reload (some) of these nodes into the cache for each catchpoint file chunk.
@@ -244,6 +343,61 @@ type CatchpointCatchupAccessorProgress struct {
 	HashesWriteDuration     time.Duration
 }
 
+// CatchpointCatchupChunksProgress is the persisted progress of a catchpoint file download made of individually verified
+// chunks, from which a restarted node resumes the download.
+type CatchpointCatchupChunksProgress struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// NextChunk is the index, in the catchpoint file manifest, of the next chunk to process.
+	NextChunk uint64 `codec:"next"`
+
+	// the remaining fields are the staging balances progress once the chunks before NextChunk were processed.
+	TotalAccounts      uint64 `codec:"accounts"`
+	ProcessedAccounts  uint64 `codec:"processedAccounts"`
+	TotalKVs           uint64 `codec:"kvs"`
+	ProcessedKVs       uint64 `codec:"processedKVs"`
+	ProcessedBytes     uint64 `codec:"processedBytes"`
+	TotalChunks        uint64 `codec:"chunks"`
+	SeenHeader         bool   `codec:"header"`
+	Version            uint64 `codec:"version"`
+	TotalAccountHashes uint64 `codec:"hashes"`
+	ProcessedChunks    uint64 `codec:"processedChunks"`
+}
+
+// MakeCatchpointCatchupChunksProgress returns the chunks download progress once the chunks before nextChunk were processed
+// into the given staging balances progress.
+func MakeCatchpointCatchupChunksProgress(nextChunk uint64, progress *CatchpointCatchupAccessorProgress) CatchpointCatchupChunksProgress {
+	return CatchpointCatchupChunksProgress{
+		NextChunk:          nextChunk,
+		TotalAccounts:      progress.TotalAccounts,
+		ProcessedAccounts:  progress.ProcessedAccounts,
+		TotalKVs:           progress.TotalKVs,
+		ProcessedKVs:       progress.ProcessedKVs,
+		ProcessedBytes:     progress.ProcessedBytes,
+		TotalChunks:        progress.TotalChunks,
+		SeenHeader:         progress.SeenHeader,
+		Version:            progress.Version,
+		TotalAccountHashes: progress.TotalAccountHashes,
+		ProcessedChunks:    progress.ProcessedChunks,
+	}
+}
+
+// AccessorProgress returns the staging balances progress to resume processing the chunks from.
+func (p CatchpointCatchupChunksProgress) AccessorProgress() CatchpointCatchupAccessorProgress {
+	return CatchpointCatchupAccessorProgress{
+		TotalAccounts:      p.TotalAccounts,
+		ProcessedAccounts:  p.ProcessedAccounts,
+		TotalKVs:           p.TotalKVs,
+		ProcessedKVs:       p.ProcessedKVs,
+		ProcessedBytes:     p.ProcessedBytes,
+		TotalChunks:        p.TotalChunks,
+		SeenHeader:         p.SeenHeader,
+		Version:            p.Version,
+		TotalAccountHashes: p.TotalAccountHashes,
+		ProcessedChunks:    p.ProcessedChunks,
+	}
+}
+
 // ProgressStagingBalances deserialize the given bytes as a temporary staging balances
 func (c *CatchpointCatchupAccessorImpl) ProgressStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *CatchpointCatchupAccessorProgress) (err error) {
 	if sectionName == "content.msgpack" {
@@ -270,6 +424,7 @@ func (c *CatchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 	switch fileHeader.Version {
 	case CatchpointFileVersionV5:
 	case CatchpointFileVersionV6:
+	case CatchpointFileVersionV7:
 	default:
 		return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to process catchpoint - version %d is not supported", fileHeader.Version)
 	}
@@ -290,7 +445,7 @@ func (c *CatchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 		if err != nil {
 			return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to write catchpoint catchup state '%s': %v", catchpointStateCatchupBlockRound, err)
 		}
-		if fileHeader.Version == CatchpointFileVersionV6 {
+		if fileHeader.Version >= CatchpointFileVersionV6 {
 			_, err = sq.writeCatchpointStateUint64(ctx, catchpointStateCatchupHashRound, uint64(fileHeader.BlocksRound))
 			if err != nil {
 				return fmt.Errorf("CatchpointCatchupAccessorImpl::processStagingContent: unable to write catchpoint catchup state '%s': %v", catchpointStateCatchupHashRound, err)
@@ -303,6 +458,7 @@ func (c *CatchpointCatchupAccessorImpl) processStagingContent(ctx context.Contex
 	if err == nil {
 		progress.SeenHeader = true
 		progress.TotalAccounts = fileHeader.TotalAccounts
+		progress.TotalKVs = fileHeader.TotalKVs
 		progress.TotalChunks = fileHeader.TotalChunks
 		progress.Version = fileHeader.Version
 		c.ledger.setSynchronousMode(ctx, c.ledger.accountsRebuildSynchronousMode)
@@ -321,6 +477,7 @@ func (c *CatchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 	ledgerProcessstagingbalancesCount.Inc(nil)
 
 	var normalizedAccountBalances []normalizedAccountBalance
+	var kvs []encodedKVRecord
 
 	switch progress.Version {
 	default:
@@ -340,24 +497,41 @@ func (c *CatchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 
 		normalizedAccountBalances, err = prepareNormalizedBalancesV5(balances.Balances, c.ledger.GenesisProto())
 
-	case CatchpointFileVersionV6:
+	case CatchpointFileVersionV6, CatchpointFileVersionV7:
 		var balances catchpointFileBalancesChunkV6
 		err = protocol.Decode(bytes, &balances)
 		if err != nil {
 			return err
 		}
 
-		if len(balances.Balances) == 0 {
-			return fmt.Errorf("processStagingBalances received a chunk with no accounts")
+		if balances.empty() {
+			return fmt.Errorf("processStagingBalances received a chunk with no accounts or key/value store entries")
+		}
+		if len(balances.KVs) > 0 && progress.Version < CatchpointFileVersionV7 {
+			return fmt.Errorf("processStagingBalances received key/value store entries in a version %d catchpoint file", progress.Version)
 		}
 
 		normalizedAccountBalances, err = prepareNormalizedBalancesV6(balances.Balances, c.ledger.GenesisProto())
+		kvs = balances.KVs
 	}
 
 	if err != nil {
 		return fmt.Errorf("processStagingBalances failed to prepare normalized balances : %w", err)
 	}
 
+	if progress.Version < CatchpointFileVersionV7 {
+		// older catchpoint files do not carry the key/value store, so catching up from them would silently lose
+		// the boxes of the accounts that have any.
+		for _, balance := range normalizedAccountBalances {
+			if balance.accountData.TotalBoxes > 0 {
+				return fmt.Errorf("processStagingBalances: account %v has %d boxes, which a version %d catchpoint file does not carry", balance.address, balance.accountData.TotalBoxes, progress.Version)
+			}
+		}
+	}
+
+	// each writer skips the chunk if its content is already in its staging table, which happens when the chunk
+	// is processed again after a restart.
+	chunk := progress.ProcessedChunks
 	wg := sync.WaitGroup{}
 
 	var errBalances error
@@ -373,9 +547,20 @@ func (c *CatchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 		defer wg.Done()
 		errBalances = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 			start := time.Now()
-			err := writeCatchpointStagingBalances(ctx, tx, normalizedAccountBalances)
+			staged, err := readCatchpointStagedChunks(ctx, tx, catchpointStateCatchupStagedBalancesChunks)
+			if err != nil || staged > chunk {
+				return err
+			}
+			err = writeCatchpointStagingBalances(ctx, tx, normalizedAccountBalances)
+			if err != nil {
+				return err
+			}
+			err = writeCatchpointStagingKVs(ctx, tx, kvs)
+			if err != nil {
+				return err
+			}
 			durBalances = time.Since(start)
-			return err
+			return writeCatchpointStagedChunks(ctx, tx, catchpointStateCatchupStagedBalancesChunks, chunk+1)
 		})
 	}()
 
@@ -400,9 +585,16 @@ func (c *CatchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 		if hasCreatables {
 			errCreatables = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 				start := time.Now()
-				err := writeCatchpointStagingCreatable(ctx, tx, normalizedAccountBalances)
+				staged, err := readCatchpointStagedChunks(ctx, tx, catchpointStateCatchupStagedCreatablesChunks)
+				if err != nil || staged > chunk {
+					return err
+				}
+				err = writeCatchpointStagingCreatable(ctx, tx, normalizedAccountBalances)
+				if err != nil {
+					return err
+				}
 				durCreatables = time.Since(start)
-				return err
+				return writeCatchpointStagedChunks(ctx, tx, catchpointStateCatchupStagedCreatablesChunks, chunk+1)
 			})
 		}
 	}()
@@ -418,9 +610,20 @@ func (c *CatchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 		defer wg.Done()
 		errHashes = wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
 			start := time.Now()
-			err := writeCatchpointStagingHashes(ctx, tx, normalizedAccountBalances)
+			staged, err := readCatchpointStagedChunks(ctx, tx, catchpointStateCatchupStagedHashesChunks)
+			if err != nil || staged > chunk {
+				return err
+			}
+			err = writeCatchpointStagingHashes(ctx, tx, normalizedAccountBalances)
+			if err != nil {
+				return err
+			}
+			err = writeCatchpointStagingKVHashes(ctx, tx, kvs)
+			if err != nil {
+				return err
+			}
 			durHashes = time.Since(start)
-			return err
+			return writeCatchpointStagedChunks(ctx, tx, catchpointStateCatchupStagedHashesChunks, chunk+1)
 		})
 	}()
 
@@ -442,13 +645,16 @@ func (c *CatchpointCatchupAccessorImpl) processStagingBalances(ctx context.Conte
 
 	ledgerProcessstagingbalancesMicros.AddMicrosecondsSince(start, nil)
 	progress.ProcessedAccounts += uint64(len(normalizedAccountBalances))
+	progress.ProcessedKVs += uint64(len(kvs))
 	progress.ProcessedBytes += uint64(len(bytes))
+	progress.ProcessedChunks++
 	for _, acctBal := range normalizedAccountBalances {
 		progress.TotalAccountHashes += uint64(len(acctBal.accountHashes))
 	}
+	progress.TotalAccountHashes += uint64(len(kvs))
 
 	// not strictly required, but clean up the pointer when we're done.
-	if progress.ProcessedAccounts == progress.TotalAccounts {
+	if progress.ProcessedAccounts == progress.TotalAccounts && progress.ProcessedKVs == progress.TotalKVs {
 		progress.cachedTrie = nil
 		// restore "normal" synchronous mode
 		c.ledger.setSynchronousMode(ctx, c.ledger.synchronousMode)
@@ -879,6 +1085,11 @@ func (c *CatchpointCatchupAccessorImpl) finishBalances(ctx context.Context) (err
 			return err
 		}
 
+		_, err = sq.writeCatchpointStateString(ctx, catchpointStateCatchupSource, "")
+		if err != nil {
+			return err
+		}
+
 		if hashRound != 0 {
 			_, err = sq.writeCatchpointStateUint64(ctx, catchpointStateCatchupHashRound, 0)
 			if err != nil {
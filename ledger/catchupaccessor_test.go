@@ -18,6 +18,7 @@ package ledger
 
 import (
 	"context"
+	"database/sql"
 	"encoding/binary"
 	"fmt"
 	"os"
@@ -191,8 +192,24 @@ func TestCatchupAccessorFoo(t *testing.T) {
 	require.Equal(t, calabel, label)
 	t.Logf("catchpoint label %#v", label)
 
+	casource := "s3://catchpoints/98.catchpoint"
+	err = catchpointAccessor.SetSource(context.Background(), casource)
+	require.NoError(t, err, "catchpointAccessor.SetSource")
+
+	source, err := catchpointAccessor.GetSource(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetSource")
+	require.Equal(t, casource, source)
+
 	err = catchpointAccessor.ResetStagingBalances(context.Background(), false)
 	require.NoError(t, err, "ResetStagingBalances")
+
+	// the label and the source are cleared along with the staging balances
+	label, err = catchpointAccessor.GetLabel(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetLabel")
+	require.Empty(t, label)
+	source, err = catchpointAccessor.GetSource(context.Background())
+	require.NoError(t, err, "catchpointAccessor.GetSource")
+	require.Empty(t, source)
 }
 
 func TestBuildMerkleTrie(t *testing.T) {
@@ -287,6 +304,125 @@ func TestBuildMerkleTrie(t *testing.T) {
 	require.Equal(t, basics.Round(0), blockRound)
 }
 
+// TestCatchupAccessorResumeChunks tests that the staging balances chunks could be processed again when a download is
+// resumed from its persisted progress, without staging their accounts twice.
+func TestCatchupAccessorResumeChunks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	dbBaseFileName := t.Name()
+	const inMem = true
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(log, dbBaseFileName, inMem, genesisInitState, cfg)
+	require.NoError(t, err, "could not open ledger")
+	defer func() {
+		l.Close()
+	}()
+	catchpointAccessor := MakeCatchpointCatchupAccessor(l, log)
+	ctx := context.Background()
+
+	err = catchpointAccessor.ResetStagingBalances(ctx, true)
+	require.NoError(t, err)
+	_, found, err := catchpointAccessor.GetChunksManifest(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	manifest := CatchpointFileManifest{Chunks: []CatchpointFileChunk{{Name: "content.msgpack", Length: 1, Size: 1}}}
+	err = catchpointAccessor.SetChunksManifest(ctx, &manifest)
+	require.NoError(t, err)
+	storedManifest, found, err := catchpointAccessor.GetChunksManifest(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, manifest, storedManifest)
+
+	accountsCount := uint64(3 * BalancesPerCatchpointFileChunk)
+	fileHeader := CatchpointFileHeader{
+		Version:       CatchpointFileVersionV6,
+		TotalAccounts: accountsCount,
+		TotalChunks:   3,
+	}
+	var progress CatchpointCatchupAccessorProgress
+	err = catchpointAccessor.ProgressStagingBalances(ctx, "content.msgpack", protocol.Encode(&fileHeader), &progress)
+	require.NoError(t, err)
+	encodedAccountChunks, _ := createTestingEncodedChunks(accountsCount)
+	err = catchpointAccessor.ProgressStagingBalances(ctx, "balances.1.1.msgpack", encodedAccountChunks[0], &progress)
+	require.NoError(t, err)
+	err = catchpointAccessor.SetChunksProgress(ctx, MakeCatchpointCatchupChunksProgress(2, &progress))
+	require.NoError(t, err)
+
+	// the second chunk is staged, but the node restarts before persisting the progress.
+	err = catchpointAccessor.ProgressStagingBalances(ctx, "balances.1.2.msgpack", encodedAccountChunks[1], &progress)
+	require.NoError(t, err)
+
+	storedProgress, err := catchpointAccessor.GetChunksProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), storedProgress.NextChunk)
+	progress = storedProgress.AccessorProgress()
+	require.Equal(t, uint64(BalancesPerCatchpointFileChunk), progress.ProcessedAccounts)
+	for i := 1; i < len(encodedAccountChunks); i++ {
+		err = catchpointAccessor.ProgressStagingBalances(ctx, fmt.Sprintf("balances.1.%d.msgpack", i+1), encodedAccountChunks[i], &progress)
+		require.NoError(t, err)
+	}
+	require.Equal(t, accountsCount, progress.ProcessedAccounts)
+
+	var stagedAccounts uint64
+	err = l.trackerDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		return tx.QueryRowContext(ctx, "SELECT count(1) FROM catchpointbalances").Scan(&stagedAccounts)
+	})
+	require.NoError(t, err)
+	require.Equal(t, accountsCount, stagedAccounts)
+
+	// resetting the staging balances discards the manifest and the progress.
+	err = catchpointAccessor.ResetStagingBalances(ctx, true)
+	require.NoError(t, err)
+	_, found, err = catchpointAccessor.GetChunksManifest(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+	storedProgress, err = catchpointAccessor.GetChunksProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, CatchpointCatchupChunksProgress{}, storedProgress)
+}
+
+// TestCatchupAccessorRejectsBoxesInOldVersion tests that a catchpoint file older than CatchpointFileVersionV7, which
+// does not carry the key/value store, is rejected when one of its accounts owns boxes.
+func TestCatchupAccessorRejectsBoxesInOldVersion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	log := logging.TestingLog(t)
+	dbBaseFileName := t.Name()
+	const inMem = true
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(log, dbBaseFileName, inMem, genesisInitState, cfg)
+	require.NoError(t, err, "could not open ledger")
+	defer func() {
+		l.Close()
+	}()
+	catchpointAccessor := MakeCatchpointCatchupAccessor(l, log)
+	ctx := context.Background()
+
+	err = catchpointAccessor.ResetStagingBalances(ctx, true)
+	require.NoError(t, err)
+	fileHeader := CatchpointFileHeader{
+		Version:       CatchpointFileVersionV6,
+		TotalAccounts: 1,
+		TotalChunks:   1,
+	}
+	var progress CatchpointCatchupAccessorProgress
+	err = catchpointAccessor.ProgressStagingBalances(ctx, "content.msgpack", protocol.Encode(&fileHeader), &progress)
+	require.NoError(t, err)
+
+	accountData := baseAccountData{TotalBoxes: 1}
+	accountData.MicroAlgos.Raw = 1000000
+	var balances catchpointFileBalancesChunkV6
+	balances.Balances = []encodedBalanceRecordV6{{AccountData: protocol.Encode(&accountData)}}
+	crypto.RandBytes(balances.Balances[0].Address[:])
+	err = catchpointAccessor.ProgressStagingBalances(ctx, "balances.1.1.msgpack", protocol.Encode(&balances), &progress)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "boxes")
+}
+
 // blockdb.go code
 // TODO: blockStartCatchupStaging called from StoreFirstBlock()
 // TODO: blockCompleteCatchup called from FinishBlocks()
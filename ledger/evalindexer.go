@@ -142,6 +142,12 @@ func (l indexerLedgerConnector) lookupResource(round basics.Round, address basic
 	return accountResourceMap[address][Creatable{aidx, ctype}], nil
 }
 
+// LookupKv is part of LedgerForEvaluator interface. The indexer does not track
+// the contents of the key/value store, so it cannot evaluate blocks that read it.
+func (l indexerLedgerConnector) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return nil, fmt.Errorf("LookupKv: key/value store is not available to the indexer (round %d)", rnd)
+}
+
 // GetCreatorForRound is part of LedgerForEvaluator interface.
 func (l indexerLedgerConnector) GetCreatorForRound(_ basics.Round, cindex basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
 	var foundAddress FoundAddress
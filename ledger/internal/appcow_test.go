@@ -87,6 +87,10 @@ func (ml *emptyLedger) getKey(addr basics.Address, aidx basics.AppIndex, global
 	return basics.TealValue{}, false, nil
 }
 
+func (ml *emptyLedger) kvGet(key string) ([]byte, bool, error) {
+	return nil, false, nil
+}
+
 func (ml *emptyLedger) txnCounter() uint64 {
 	return 0
 }
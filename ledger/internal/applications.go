@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/apply"
@@ -43,8 +44,14 @@ type cowForLogicLedger interface {
 	SetKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, value basics.TealValue, accountIdx uint64) error
 	DelKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, accountIdx uint64) error
 
+	Put(addr basics.Address, record ledgercore.AccountData) error
+	kvGet(key string) ([]byte, bool, error)
+	kvPut(key string, value []byte) error
+	kvDel(key string) error
+
 	round() basics.Round
 	prevTimestamp() int64
+	blockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error)
 	allocated(addr basics.Address, aidx basics.AppIndex, global bool) (bool, error)
 	txnCounter() uint64
 	incTxnCount()
@@ -153,6 +160,10 @@ func (al *logicLedger) LatestTimestamp() int64 {
 	return al.cow.prevTimestamp()
 }
 
+func (al *logicLedger) BlockHdr(round basics.Round) (bookkeeping.BlockHeader, error) {
+	return al.cow.blockHdr(round)
+}
+
 func (al *logicLedger) OptedIn(addr basics.Address, appIdx basics.AppIndex) (bool, error) {
 	return al.cow.allocated(addr, appIdx, false)
 }
@@ -206,6 +217,80 @@ func (al *logicLedger) DelGlobal(appIdx basics.AppIndex, key string) error {
 	return al.cow.DelKey(creator, appIdx, true, key, 0)
 }
 
+// NewBox creates a box for appIdx, charging its min balance to appAddr.
+func (al *logicLedger) NewBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error {
+	fullKey := ledgercore.MakeBoxKey(appIdx, key)
+	_, exists, err := al.cow.kvGet(fullKey)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("attempt to recreate box %#x for app %d", key, appIdx)
+	}
+
+	record, err := al.cow.Get(appAddr, false)
+	if err != nil {
+		return err
+	}
+	record.TotalBoxes = basics.AddSaturate(record.TotalBoxes, 1)
+	record.TotalBoxBytes = basics.AddSaturate(record.TotalBoxBytes, uint64(len(key)+len(value)))
+	err = al.cow.Put(appAddr, record)
+	if err != nil {
+		return err
+	}
+	return al.cow.kvPut(fullKey, value)
+}
+
+// GetBox returns the contents of a box of appIdx, and whether it exists.
+func (al *logicLedger) GetBox(appIdx basics.AppIndex, key string) ([]byte, bool, error) {
+	return al.cow.kvGet(ledgercore.MakeBoxKey(appIdx, key))
+}
+
+// SetBox replaces the contents of an existing box of appIdx.
+func (al *logicLedger) SetBox(appIdx basics.AppIndex, key string, value []byte) error {
+	fullKey := ledgercore.MakeBoxKey(appIdx, key)
+	old, exists, err := al.cow.kvGet(fullKey)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("box %#x does not exist for app %d", key, appIdx)
+	}
+	if len(old) != len(value) {
+		return fmt.Errorf("box %#x for app %d cannot change size from %d to %d", key, appIdx, len(old), len(value))
+	}
+	return al.cow.kvPut(fullKey, value)
+}
+
+// DelBox deletes a box of appIdx, releasing its min balance from appAddr.
+// It reports whether the box existed.
+func (al *logicLedger) DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error) {
+	fullKey := ledgercore.MakeBoxKey(appIdx, key)
+	value, exists, err := al.cow.kvGet(fullKey)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+
+	record, err := al.cow.Get(appAddr, false)
+	if err != nil {
+		return false, err
+	}
+	size := uint64(len(key) + len(value))
+	if record.TotalBoxes == 0 || record.TotalBoxBytes < size {
+		return false, fmt.Errorf("box accounting of %s is inconsistent: %d boxes, %d bytes", appAddr, record.TotalBoxes, record.TotalBoxBytes)
+	}
+	record.TotalBoxes--
+	record.TotalBoxBytes -= size
+	err = al.cow.Put(appAddr, record)
+	if err != nil {
+		return false, err
+	}
+	return true, al.cow.kvDel(fullKey)
+}
+
 func (al *logicLedger) balances() (apply.Balances, error) {
 	balances, ok := al.cow.(apply.Balances)
 	if !ok {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
@@ -46,6 +47,8 @@ type mockCowForLogicLedger struct {
 	brs    map[basics.Address]basics.AccountData
 	stores map[storeLocator]basics.TealKeyValue
 	txc    uint64
+	kvs    map[string][]byte
+	hdrs   map[basics.Round]bookkeeping.BlockHeader
 }
 
 func (c *mockCowForLogicLedger) Get(addr basics.Address, withPendingRewards bool) (ledgercore.AccountData, error) {
@@ -122,6 +125,31 @@ func (c *mockCowForLogicLedger) SetKey(addr basics.Address, aidx basics.AppIndex
 	return nil
 }
 
+func (c *mockCowForLogicLedger) Put(addr basics.Address, record ledgercore.AccountData) error {
+	br := c.brs[addr]
+	ledgercore.AssignAccountData(&br, record)
+	c.brs[addr] = br
+	return nil
+}
+
+func (c *mockCowForLogicLedger) kvGet(key string) ([]byte, bool, error) {
+	value, ok := c.kvs[key]
+	return value, ok, nil
+}
+
+func (c *mockCowForLogicLedger) kvPut(key string, value []byte) error {
+	if c.kvs == nil {
+		c.kvs = make(map[string][]byte)
+	}
+	c.kvs[key] = value
+	return nil
+}
+
+func (c *mockCowForLogicLedger) kvDel(key string) error {
+	delete(c.kvs, key)
+	return nil
+}
+
 func (c *mockCowForLogicLedger) DelKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, accountIdx uint64) error {
 	kv, ok := c.stores[storeLocator{addr, aidx, global}]
 	if !ok {
@@ -140,6 +168,14 @@ func (c *mockCowForLogicLedger) prevTimestamp() int64 {
 	return c.ts
 }
 
+func (c *mockCowForLogicLedger) blockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	hdr, ok := c.hdrs[rnd]
+	if !ok {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("no block header for round %d", rnd)
+	}
+	return hdr, nil
+}
+
 func (c *mockCowForLogicLedger) allocated(addr basics.Address, aidx basics.AppIndex, global bool) (bool, error) {
 	_, found := c.stores[storeLocator{addr, aidx, global}]
 	return found, nil
@@ -208,8 +244,14 @@ func TestLogicLedgerGetters(t *testing.T) {
 
 	addr1 := ledgertesting.RandomAddress()
 	c.stores = map[storeLocator]basics.TealKeyValue{{addr1, aidx, false}: {}}
+	c.hdrs = map[basics.Round]bookkeeping.BlockHeader{round - 1: {Round: round - 1, TimeStamp: ts}}
 	a.Equal(round, l.Round())
 	a.Equal(ts, l.LatestTimestamp())
+	hdr, err := l.BlockHdr(round - 1)
+	a.NoError(err)
+	a.Equal(ts, hdr.TimeStamp)
+	_, err = l.BlockHdr(round)
+	a.Error(err)
 	a.True(l.OptedIn(addr1, aidx))
 	a.False(l.OptedIn(addr, aidx))
 }
@@ -17,6 +17,7 @@
 package internal_test
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"strings"
@@ -3257,3 +3258,73 @@ done:
 		require.Equal(t, "Y", vb.Block().Payset[3].EvalDelta.LocalDeltas[1]["X"].Bytes)
 	})
 }
+
+// TestBlockAccess ensures that apps can read the headers of recent blocks
+func TestBlockAccess(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	// v33 = block
+	testConsensusRange(t, 33, 0, func(t *testing.T, ver int) {
+		dl := NewDoubleLedger(t, genBalances, consensusByNumber[ver])
+		defer dl.Close()
+
+		// logs the fields of the block whose round is the first app arg
+		app := txntest.Txn{
+			Type:   "appl",
+			Sender: addrs[0],
+			ApprovalProgram: main(`
+txn ApplicationArgs 0; btoi; block BlkSeed; log
+txn ApplicationArgs 0; btoi; block BlkTimestamp; itob; log
+txn ApplicationArgs 0; btoi; block BlkBranch; log
+txn ApplicationArgs 0; btoi; block BlkTxnCommitment; log
+txn ApplicationArgs 0; btoi; block BlkTxnCounter; itob; log
+txn ApplicationArgs 0; btoi; block BlkRewardsLevel; itob; log
+txn ApplicationArgs 0; btoi; block BlkProtocol; log
+`),
+		}
+		vb := dl.fullBlock(&app)
+		appIndex := vb.Block().Payset[0].ApplicationID
+		created := vb.Block().Round()
+
+		round := make([]byte, 8)
+		binary.BigEndian.PutUint64(round, uint64(created))
+		call := txntest.Txn{
+			Type:            "appl",
+			Sender:          addrs[0],
+			ApplicationID:   appIndex,
+			ApplicationArgs: [][]byte{round},
+		}
+		// a txn with the longest possible lifetime can not read any block
+		dl.txn(&call, "not available")
+
+		// shortening it makes the rounds before FirstValid available
+		call.FirstValid = created + 1
+		call.LastValid = created + 11
+		vb = dl.fullBlock(&call)
+
+		hdr, err := dl.generator.BlockHdr(created)
+		require.NoError(t, err)
+		uint64Log := func(v uint64) string {
+			b := make([]byte, 8)
+			binary.BigEndian.PutUint64(b, v)
+			return string(b)
+		}
+		require.Equal(t, []string{
+			string(hdr.Seed[:]),
+			uint64Log(uint64(hdr.TimeStamp)),
+			string(hdr.Branch[:]),
+			string(hdr.NativeSha512_256Commitment[:]),
+			uint64Log(hdr.TxnCounter),
+			uint64Log(hdr.RewardsLevel),
+			string(hdr.CurrentProtocol),
+		}, vb.Block().Payset[0].ApplyData.EvalDelta.Logs)
+
+		// the block being evaluated, and round 0, are not available
+		binary.BigEndian.PutUint64(round, uint64(vb.Block().Round()+1))
+		dl.txn(&call, "not available")
+		binary.BigEndian.PutUint64(round, 0)
+		dl.txn(&call, "not available")
+	})
+}
@@ -61,6 +61,7 @@ type roundCowParent interface {
 	getStorageLimits(addr basics.Address, aidx basics.AppIndex, global bool) (basics.StateSchema, error)
 	allocated(addr basics.Address, aidx basics.AppIndex, global bool) (bool, error)
 	getKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, accountIdx uint64) (basics.TealValue, bool, error)
+	kvGet(key string) ([]byte, bool, error)
 }
 
 type roundCowState struct {
@@ -196,6 +197,24 @@ func (cb *roundCowState) lookupAssetHolding(addr basics.Address, aidx basics.Ass
 	return cb.lookupParent.lookupAssetHolding(addr, aidx, cacheOnly)
 }
 
+func (cb *roundCowState) kvGet(key string) ([]byte, bool, error) {
+	delta, ok := cb.mods.KvMods[key]
+	if ok {
+		return delta.Data, delta.Data != nil, nil
+	}
+	return cb.lookupParent.kvGet(key)
+}
+
+func (cb *roundCowState) kvPut(key string, value []byte) error {
+	cb.mods.KvMods[key] = ledgercore.KvValueDelta{Data: value}
+	return nil
+}
+
+func (cb *roundCowState) kvDel(key string) error {
+	cb.mods.KvMods[key] = ledgercore.KvValueDelta{Data: nil}
+	return nil
+}
+
 func (cb *roundCowState) checkDup(firstValid, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
 	_, present := cb.mods.Txids[txid]
 	if present {
@@ -273,6 +292,9 @@ func (cb *roundCowState) commitToParent() {
 	for cidx, delta := range cb.mods.Creatables {
 		cb.commitParent.mods.Creatables[cidx] = delta
 	}
+	for key, delta := range cb.mods.KvMods {
+		cb.commitParent.mods.KvMods[key] = delta
+	}
 	for addr, smod := range cb.sdeltas {
 		for aapp, nsd := range smod {
 			lsd, ok := cb.commitParent.sdeltas[addr][aapp]
@@ -46,6 +46,7 @@ type LedgerForCowBase interface {
 	LookupAsset(basics.Round, basics.Address, basics.AssetIndex) (ledgercore.AssetResource, error)
 	LookupApplication(basics.Round, basics.Address, basics.AppIndex) (ledgercore.AppResource, error)
 	GetCreatorForRound(basics.Round, basics.CreatableIndex, basics.CreatableType) (basics.Address, bool, error)
+	LookupKv(basics.Round, string) ([]byte, error)
 }
 
 // ErrRoundZero is self-explanatory
@@ -344,6 +345,16 @@ func (x *roundCowBase) allocated(addr basics.Address, aidx basics.AppIndex, glob
 	return ok, err
 }
 
+// kvGet gets the value stored under key in the ledger's key/value store
+// (used for application boxes), and whether it exists
+func (x *roundCowBase) kvGet(key string) ([]byte, bool, error) {
+	value, err := x.l.LookupKv(x.rnd, key)
+	if err != nil {
+		return nil, false, err
+	}
+	return value, value != nil, nil
+}
+
 // getKey gets the value for a particular key in some storage
 // associated with an application globally or locally
 func (x *roundCowBase) getKey(addr basics.Address, aidx basics.AppIndex, global bool, key string, accountIdx uint64) (basics.TealValue, bool, error) {
@@ -929,20 +940,29 @@ func (eval *BlockEvaluator) Transaction(txn transactions.SignedTxn, ad transacti
 // If the transaction group cannot be added to the block without violating some constraints,
 // an error is returned and the block evaluator state is unchanged.
 func (eval *BlockEvaluator) TransactionGroup(txads []transactions.SignedTxnWithAD) error {
+	_, err := eval.transactionGroup(txads)
+	return err
+}
+
+// TransactionGroupFailedAt is identical to TransactionGroup, except that when the
+// group is rejected it also returns the index within txads of the transaction that
+// caused the rejection. The returned index is -1 if the group was rejected as a whole.
+func (eval *BlockEvaluator) TransactionGroupFailedAt(txads []transactions.SignedTxnWithAD) (int, error) {
 	return eval.transactionGroup(txads)
 }
 
 // transactionGroup tentatively executes a group of transactions as part of this block evaluation.
 // If the transaction group cannot be added to the block without violating some constraints,
-// an error is returned and the block evaluator state is unchanged.
-func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWithAD) error {
+// an error is returned, along with the index of the offending transaction, and the
+// block evaluator state is unchanged.
+func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWithAD) (int, error) {
 	// Nothing to do if there are no transactions.
 	if len(txgroup) == 0 {
-		return nil
+		return -1, nil
 	}
 
 	if len(txgroup) > eval.proto.MaxTxGroupSize {
-		return fmt.Errorf("group size %d exceeds maximum %d", len(txgroup), eval.proto.MaxTxGroupSize)
+		return -1, fmt.Errorf("group size %d exceeds maximum %d", len(txgroup), eval.proto.MaxTxGroupSize)
 	}
 
 	var txibs []transactions.SignedTxnInBlock
@@ -959,7 +979,7 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 
 		err := eval.transaction(txad.SignedTxn, evalParams, gi, txad.ApplyData, cow, &txib)
 		if err != nil {
-			return err
+			return gi, err
 		}
 
 		txibs = append(txibs, txib)
@@ -967,13 +987,13 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 		if eval.validate {
 			groupTxBytes += txib.GetEncodedLength()
 			if eval.blockTxBytes+groupTxBytes > eval.maxTxnBytesPerBlock {
-				return ledgercore.ErrNoSpace
+				return gi, ledgercore.ErrNoSpace
 			}
 		}
 
 		// Make sure all transactions in group have the same group value
 		if txad.SignedTxn.Txn.Group != txgroup[0].SignedTxn.Txn.Group {
-			return fmt.Errorf("transactionGroup: inconsistent group values: %v != %v",
+			return gi, fmt.Errorf("transactionGroup: inconsistent group values: %v != %v",
 				txad.SignedTxn.Txn.Group, txgroup[0].SignedTxn.Txn.Group)
 		}
 
@@ -983,14 +1003,14 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 
 			group.TxGroupHashes = append(group.TxGroupHashes, crypto.Digest(txWithoutGroup.ID()))
 		} else if len(txgroup) > 1 {
-			return fmt.Errorf("transactionGroup: [%d] had zero Group but was submitted in a group of %d", gi, len(txgroup))
+			return gi, fmt.Errorf("transactionGroup: [%d] had zero Group but was submitted in a group of %d", gi, len(txgroup))
 		}
 	}
 
 	// If we had a non-zero Group value, check that all group members are present.
 	if group.TxGroupHashes != nil {
 		if txgroup[0].SignedTxn.Txn.Group != crypto.HashObj(group) {
-			return fmt.Errorf("transactionGroup: incomplete group: %v != %v (%v)",
+			return -1, fmt.Errorf("transactionGroup: incomplete group: %v != %v (%v)",
 				txgroup[0].SignedTxn.Txn.Group, crypto.HashObj(group), group)
 		}
 	}
@@ -999,7 +1019,7 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 	eval.blockTxBytes += groupTxBytes
 	cow.commitToParent()
 
-	return nil
+	return -1, nil
 }
 
 // Check the minimum balance requirement for the modified accounts in `cow`.
@@ -627,6 +627,11 @@ func (ledger *evalTestLedger) CompactCertVoters(rnd basics.Round) (*ledgercore.V
 	return nil, errors.New("untested code path")
 }
 
+// LookupKv implements LedgerForCowBase; the test ledger has no key/value store.
+func (ledger *evalTestLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return nil, nil
+}
+
 // GetCreator is like GetCreatorForRound, but for the latest round and race-free
 // with respect to ledger.Latest()
 func (ledger *evalTestLedger) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
@@ -736,6 +741,10 @@ func (l *testCowBaseLedger) LookupAsset(rnd basics.Round, addr basics.Address, a
 	return ledgercore.AssetResource{}, errors.New("not implemented")
 }
 
+func (l *testCowBaseLedger) LookupKv(basics.Round, string) ([]byte, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (l *testCowBaseLedger) GetCreatorForRound(_ basics.Round, cindex basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
 	res := l.creators[0]
 	l.creators = l.creators[1:]
@@ -102,6 +102,10 @@ func (l *prefetcherTestLedger) LookupAsset(rnd basics.Round, addr basics.Address
 	}
 	return ledgercore.AssetResource{}, nil
 }
+func (l *prefetcherTestLedger) LookupKv(basics.Round, string) ([]byte, error) {
+	return nil, nil
+}
+
 func (l *prefetcherTestLedger) GetCreatorForRound(_ basics.Round, cidx basics.CreatableIndex, _ basics.CreatableType) (basics.Address, bool, error) {
 	if cidx == errorTriggerCreatableIndex {
 		return basics.Address{}, false, getCreatorError{}
@@ -422,6 +422,32 @@ func (l *Ledger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex
 	return l.accts.GetCreatorForRound(rnd, cidx, ctype)
 }
 
+// LookupKv loads the value stored under key in the key/value store as of round
+// rnd. It returns nil if there is no such key.
+func (l *Ledger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.LookupKv(rnd, key)
+}
+
+// LookupKeysByPrefix returns up to maxKeyNum keys of the key/value store that
+// start with prefix as of round rnd, in increasing order. A maxKeyNum of zero
+// means no limit.
+func (l *Ledger) LookupKeysByPrefix(rnd basics.Round, prefix string, maxKeyNum uint64) ([]string, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.LookupKeysByPrefix(rnd, prefix, maxKeyNum)
+}
+
+// GetStateDeltaForRound returns the state delta applied by the block of round
+// rnd. Deltas are only kept in memory for the rounds that were not yet flushed
+// to disk; older rounds return a *RoundOffsetError.
+func (l *Ledger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.accts.lookupStateDelta(rnd)
+}
+
 // GetCreator is like GetCreatorForRound, but for the latest round and race-free
 // with respect to ledger.Latest()
 func (l *Ledger) GetCreator(cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
@@ -495,6 +521,36 @@ func (l *Ledger) LookupAccount(round basics.Round, addr basics.Address) (data le
 	return data, rnd, withoutRewards, nil
 }
 
+// LookupAccountWithResources returns the account state, including the assets and
+// applications it holds or created, for a given address as of a given round. Unlike
+// LookupLatest, the round may be any round still covered by the accounts tracker,
+// which on archival nodes includes the rounds kept in the accounts history.
+// The returned AccountData contains the rewards applied up to that round, and the
+// additional withoutRewards return value contains the value before rewards were applied.
+func (l *Ledger) LookupAccountWithResources(rnd basics.Round, addr basics.Address) (data basics.AccountData, withoutRewards basics.MicroAlgos, err error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+
+	ad, _, rewardsVersion, rewardsLevel, err := l.accts.lookupWithoutRewards(rnd, addr, true /* take lock */)
+	if err != nil {
+		return basics.AccountData{}, basics.MicroAlgos{}, err
+	}
+	resources, err := l.accts.lookupAllResources(rnd, addr)
+	if err != nil {
+		return basics.AccountData{}, basics.MicroAlgos{}, err
+	}
+
+	ledgercore.AssignAccountData(&data, ad)
+	for cidx, res := range resources {
+		ledgercore.AssignAccountResourceToAccountData(cidx, res, &data)
+	}
+
+	// Intentionally apply (pending) rewards up to rnd, remembering the old value
+	withoutRewards = data.MicroAlgos
+	data = data.WithUpdatedRewards(config.Consensus[rewardsVersion], rewardsLevel)
+	return data, withoutRewards, nil
+}
+
 // LookupApplication loads an application resource that matches the request parameters from the ledger.
 func (l *Ledger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
 	r, err := l.lookupResource(rnd, addr, basics.CreatableIndex(aidx), basics.AppCreatable)
@@ -703,6 +759,22 @@ func (l *Ledger) GetCatchpointStream(round basics.Round) (ReadCloseSizer, error)
 	return l.catchpoint.GetCatchpointStream(round)
 }
 
+// GetCatchpointManifest returns the manifest of the catchpoint file for the provided round, which lists
+// the chunks the catchpoint file could be retrieved by using GetCatchpointChunk.
+func (l *Ledger) GetCatchpointManifest(round basics.Round) (CatchpointFileManifest, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.GetCatchpointManifest(round)
+}
+
+// GetCatchpointChunk returns the gzip member holding the given chunk of the catchpoint file for the
+// provided round, along with the chunk description from the catchpoint file manifest.
+func (l *Ledger) GetCatchpointChunk(round basics.Round, chunkIndex uint64) ([]byte, CatchpointFileChunk, error) {
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.catchpoint.GetCatchpointChunk(round, chunkIndex)
+}
+
 // ledgerForTracker methods
 func (l *Ledger) trackerDB() db.Pair {
 	return l.trackerDBs
@@ -1735,6 +1735,69 @@ func TestLookupAgreement(t *testing.T) {
 	require.Equal(t, oad, ad.OnlineAccountData())
 }
 
+func TestGetStateDeltaForRound(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, initSecrets := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = true
+	log := logging.TestingLog(t)
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	l, err := OpenLedger(log, t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err, "could not open ledger")
+	defer l.Close()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	initAccounts := genesisInitState.Accounts
+	var addrList []basics.Address
+	for addr := range initAccounts {
+		if addr != testPoolAddr && addr != testSinkAddr {
+			addrList = append(addrList, addr)
+		}
+	}
+
+	pay := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:      addrList[0],
+			Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+			FirstValid:  l.Latest() + 1,
+			LastValid:   l.Latest() + 10,
+			GenesisID:   t.Name(),
+			GenesisHash: genesisInitState.GenesisHash,
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: addrList[1],
+			Amount:   basics.MicroAlgos{Raw: 1000},
+		},
+	}
+	require.NoError(t, l.appendUnvalidatedTx(t, initAccounts, initSecrets, pay, transactions.ApplyData{}))
+	addEmptyValidatedBlock(t, l, initAccounts)
+
+	// the genesis round was flushed when the ledger was created
+	_, err = l.GetStateDeltaForRound(0)
+	var roundOffsetError *RoundOffsetError
+	require.ErrorAs(t, err, &roundOffsetError)
+
+	delta, err := l.GetStateDeltaForRound(1)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(1), delta.Hdr.Round)
+	require.Contains(t, delta.Txids, pay.ID())
+	sender, ok := delta.Accts.GetData(addrList[0])
+	require.True(t, ok)
+	require.Equal(t, initAccounts[addrList[0]].MicroAlgos.Raw-1000-proto.MinTxnFee, sender.MicroAlgos.Raw)
+	_, ok = delta.Accts.GetData(addrList[1])
+	require.True(t, ok)
+
+	delta, err = l.GetStateDeltaForRound(2)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(2), delta.Hdr.Round)
+	require.Empty(t, delta.Txids)
+
+	_, err = l.GetStateDeltaForRound(3)
+	require.Error(t, err)
+}
+
 func BenchmarkLedgerStartup(b *testing.B) {
 	log := logging.TestingLog(b)
 	tmpDir, err := ioutil.TempDir(os.TempDir(), "BenchmarkLedgerStartup")
@@ -48,6 +48,8 @@ type AccountBaseData struct {
 	TotalAppLocalStates uint64
 	TotalAssetParams    uint64
 	TotalAssets         uint64
+	TotalBoxes          uint64
+	TotalBoxBytes       uint64
 }
 
 // VotingData holds participation information
@@ -80,6 +82,8 @@ func ToAccountData(acct basics.AccountData) AccountData {
 			TotalAssets:         uint64(len(acct.Assets)),
 			TotalAppParams:      uint64(len(acct.AppParams)),
 			TotalAppLocalStates: uint64(len(acct.AppLocalStates)),
+			TotalBoxes:          acct.TotalBoxes,
+			TotalBoxBytes:       acct.TotalBoxBytes,
 		},
 		VotingData: VotingData{
 			VoteID:          acct.VoteID,
@@ -110,6 +114,8 @@ func AssignAccountData(a *basics.AccountData, acct AccountData) {
 	a.AuthAddr = acct.AuthAddr
 	a.TotalAppSchema = acct.TotalAppSchema
 	a.TotalExtraAppPages = acct.TotalExtraAppPages
+	a.TotalBoxes = acct.TotalBoxes
+	a.TotalBoxBytes = acct.TotalBoxBytes
 }
 
 // WithUpdatedRewards calls basics account data WithUpdatedRewards
@@ -135,7 +141,7 @@ func (u AccountData) MinBalance(proto *config.ConsensusParams) (res basics.Micro
 		uint64(u.TotalAssets),
 		u.TotalAppSchema,
 		uint64(u.TotalAppParams), uint64(u.TotalAppLocalStates),
-		uint64(u.TotalExtraAppPages),
+		uint64(u.TotalExtraAppPages), u.TotalBoxes, u.TotalBoxBytes,
 	)
 }
 
@@ -0,0 +1,55 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+)
+
+// boxKeyPrefix is prepended to the keys of application boxes in the key/value store.
+const boxKeyPrefix = "bx:"
+
+// boxKeyAppLen is the length of the encoded application index within a box key.
+const boxKeyAppLen = 8
+
+// MakeBoxKey returns the key/value store key of the box called name, which belongs
+// to the application app. Keys of boxes belonging to the same application share
+// a common prefix, returned by MakeBoxKeyPrefix.
+func MakeBoxKey(app basics.AppIndex, name string) string {
+	return MakeBoxKeyPrefix(app) + name
+}
+
+// MakeBoxKeyPrefix returns the prefix shared by the keys of all boxes of app.
+func MakeBoxKeyPrefix(app basics.AppIndex) string {
+	key := make([]byte, len(boxKeyPrefix)+boxKeyAppLen)
+	copy(key, boxKeyPrefix)
+	binary.BigEndian.PutUint64(key[len(boxKeyPrefix):], uint64(app))
+	return string(key)
+}
+
+// SplitBoxKey is the inverse of MakeBoxKey, returning the application and the
+// name of the box that key refers to.
+func SplitBoxKey(key string) (basics.AppIndex, string, error) {
+	if len(key) < len(boxKeyPrefix)+boxKeyAppLen || key[:len(boxKeyPrefix)] != boxKeyPrefix {
+		return 0, "", fmt.Errorf("SplitBoxKey() cannot extract app and name from %x", key)
+	}
+	app := binary.BigEndian.Uint64([]byte(key[len(boxKeyPrefix) : len(boxKeyPrefix)+boxKeyAppLen]))
+	return basics.AppIndex(app), key[len(boxKeyPrefix)+boxKeyAppLen:], nil
+}
@@ -85,6 +85,9 @@ type StateDelta struct {
 	// new creatables creator lookup table
 	Creatables map[basics.CreatableIndex]ModifiedCreatable
 
+	// modified key/value pairs of the application box storage, keyed by MakeBoxKey
+	KvMods map[string]KvValueDelta
+
 	// new block header; read-only
 	Hdr *bookkeeping.BlockHeader
 
@@ -102,6 +105,12 @@ type StateDelta struct {
 	Totals AccountTotals
 }
 
+// KvValueDelta holds the new value of a modified key/value pair
+type KvValueDelta struct {
+	// Data is the new value, or nil if the key was deleted
+	Data []byte
+}
+
 // NewBalanceRecord is similar to basics.BalanceRecord but with decoupled base and voting data
 type NewBalanceRecord struct {
 	Addr basics.Address
@@ -180,6 +189,7 @@ func MakeStateDelta(hdr *bookkeeping.BlockHeader, prevTimestamp int64, hint int,
 		Txleases: make(map[Txlease]basics.Round, hint),
 		// asset or application creation are considered as rare events so do not pre-allocate space for them
 		Creatables:               make(map[basics.CreatableIndex]ModifiedCreatable),
+		KvMods:                   make(map[string]KvValueDelta),
 		Hdr:                      hdr,
 		CompactCertNext:          compactCertNext,
 		PrevTimestamp:            prevTimestamp,
@@ -12,6 +12,14 @@ import (
 )
 
 // The following msgp objects are implemented in this file:
+// CatchpointCatchupChunksProgress
+//                |-----> (*) MarshalMsg
+//                |-----> (*) CanMarshalMsg
+//                |-----> (*) UnmarshalMsg
+//                |-----> (*) CanUnmarshalMsg
+//                |-----> (*) Msgsize
+//                |-----> (*) MsgIsZero
+//
 // CatchpointCatchupState
 //            |-----> MarshalMsg
 //            |-----> CanMarshalMsg
@@ -20,6 +28,14 @@ import (
 //            |-----> Msgsize
 //            |-----> MsgIsZero
 //
+// CatchpointFileChunk
+//          |-----> (*) MarshalMsg
+//          |-----> (*) CanMarshalMsg
+//          |-----> (*) UnmarshalMsg
+//          |-----> (*) CanUnmarshalMsg
+//          |-----> (*) Msgsize
+//          |-----> (*) MsgIsZero
+//
 // CatchpointFileHeader
 //           |-----> (*) MarshalMsg
 //           |-----> (*) CanMarshalMsg
@@ -28,6 +44,14 @@ import (
 //           |-----> (*) Msgsize
 //           |-----> (*) MsgIsZero
 //
+// CatchpointFileManifest
+//            |-----> (*) MarshalMsg
+//            |-----> (*) CanMarshalMsg
+//            |-----> (*) UnmarshalMsg
+//            |-----> (*) CanUnmarshalMsg
+//            |-----> (*) Msgsize
+//            |-----> (*) MsgIsZero
+//
 // baseAccountData
 //        |-----> (*) MarshalMsg
 //        |-----> (*) CanMarshalMsg
@@ -84,6 +108,14 @@ import (
 //            |-----> (*) Msgsize
 //            |-----> (*) MsgIsZero
 //
+// encodedKVRecord
+//        |-----> (*) MarshalMsg
+//        |-----> (*) CanMarshalMsg
+//        |-----> (*) UnmarshalMsg
+//        |-----> (*) CanUnmarshalMsg
+//        |-----> (*) Msgsize
+//        |-----> (*) MsgIsZero
+//
 // resourceFlags
 //       |-----> MarshalMsg
 //       |-----> CanMarshalMsg
@@ -101,6 +133,342 @@ import (
 //       |-----> (*) MsgIsZero
 //
 
+// MarshalMsg implements msgp.Marshaler
+func (z *CatchpointCatchupChunksProgress) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(11)
+	var zb0001Mask uint16 /* 12 bits */
+	if (*z).TotalAccounts == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if (*z).TotalChunks == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if (*z).TotalAccountHashes == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if (*z).SeenHeader == false {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if (*z).TotalKVs == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	if (*z).NextChunk == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x40
+	}
+	if (*z).ProcessedAccounts == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x80
+	}
+	if (*z).ProcessedBytes == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x100
+	}
+	if (*z).ProcessedChunks == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
+	if (*z).ProcessedKVs == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400
+	}
+	if (*z).Version == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x800
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "accounts"
+			o = append(o, 0xa8, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73)
+			o = msgp.AppendUint64(o, (*z).TotalAccounts)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "chunks"
+			o = append(o, 0xa6, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73)
+			o = msgp.AppendUint64(o, (*z).TotalChunks)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "hashes"
+			o = append(o, 0xa6, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73)
+			o = msgp.AppendUint64(o, (*z).TotalAccountHashes)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not empty
+			// string "header"
+			o = append(o, 0xa6, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72)
+			o = msgp.AppendBool(o, (*z).SeenHeader)
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not empty
+			// string "kvs"
+			o = append(o, 0xa3, 0x6b, 0x76, 0x73)
+			o = msgp.AppendUint64(o, (*z).TotalKVs)
+		}
+		if (zb0001Mask & 0x40) == 0 { // if not empty
+			// string "next"
+			o = append(o, 0xa4, 0x6e, 0x65, 0x78, 0x74)
+			o = msgp.AppendUint64(o, (*z).NextChunk)
+		}
+		if (zb0001Mask & 0x80) == 0 { // if not empty
+			// string "processedAccounts"
+			o = append(o, 0xb1, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73)
+			o = msgp.AppendUint64(o, (*z).ProcessedAccounts)
+		}
+		if (zb0001Mask & 0x100) == 0 { // if not empty
+			// string "processedBytes"
+			o = append(o, 0xae, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73)
+			o = msgp.AppendUint64(o, (*z).ProcessedBytes)
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not empty
+			// string "processedChunks"
+			o = append(o, 0xaf, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x73)
+			o = msgp.AppendUint64(o, (*z).ProcessedChunks)
+		}
+		if (zb0001Mask & 0x400) == 0 { // if not empty
+			// string "processedKVs"
+			o = append(o, 0xac, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x64, 0x4b, 0x56, 0x73)
+			o = msgp.AppendUint64(o, (*z).ProcessedKVs)
+		}
+		if (zb0001Mask & 0x800) == 0 { // if not empty
+			// string "version"
+			o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+			o = msgp.AppendUint64(o, (*z).Version)
+		}
+	}
+	return
+}
+
+func (_ *CatchpointCatchupChunksProgress) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointCatchupChunksProgress)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CatchpointCatchupChunksProgress) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).NextChunk, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "NextChunk")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).TotalAccounts, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalAccounts")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).ProcessedAccounts, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "ProcessedAccounts")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).TotalKVs, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalKVs")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).ProcessedKVs, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "ProcessedKVs")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).ProcessedBytes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "ProcessedBytes")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).TotalChunks, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalChunks")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).SeenHeader, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "SeenHeader")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Version, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Version")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).TotalAccountHashes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalAccountHashes")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).ProcessedChunks, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "ProcessedChunks")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = CatchpointCatchupChunksProgress{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "next":
+				(*z).NextChunk, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "NextChunk")
+					return
+				}
+			case "accounts":
+				(*z).TotalAccounts, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalAccounts")
+					return
+				}
+			case "processedAccounts":
+				(*z).ProcessedAccounts, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcessedAccounts")
+					return
+				}
+			case "kvs":
+				(*z).TotalKVs, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalKVs")
+					return
+				}
+			case "processedKVs":
+				(*z).ProcessedKVs, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcessedKVs")
+					return
+				}
+			case "processedBytes":
+				(*z).ProcessedBytes, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcessedBytes")
+					return
+				}
+			case "chunks":
+				(*z).TotalChunks, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalChunks")
+					return
+				}
+			case "header":
+				(*z).SeenHeader, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "SeenHeader")
+					return
+				}
+			case "version":
+				(*z).Version, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Version")
+					return
+				}
+			case "hashes":
+				(*z).TotalAccountHashes, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalAccountHashes")
+					return
+				}
+			case "processedChunks":
+				(*z).ProcessedChunks, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "ProcessedChunks")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *CatchpointCatchupChunksProgress) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointCatchupChunksProgress)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CatchpointCatchupChunksProgress) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size + 9 + msgp.Uint64Size + 18 + msgp.Uint64Size + 4 + msgp.Uint64Size + 13 + msgp.Uint64Size + 15 + msgp.Uint64Size + 7 + msgp.Uint64Size + 7 + msgp.BoolSize + 8 + msgp.Uint64Size + 7 + msgp.Uint64Size + 16 + msgp.Uint64Size
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *CatchpointCatchupChunksProgress) MsgIsZero() bool {
+	return ((*z).NextChunk == 0) && ((*z).TotalAccounts == 0) && ((*z).ProcessedAccounts == 0) && ((*z).TotalKVs == 0) && ((*z).ProcessedKVs == 0) && ((*z).ProcessedBytes == 0) && ((*z).TotalChunks == 0) && ((*z).SeenHeader == false) && ((*z).Version == 0) && ((*z).TotalAccountHashes == 0) && ((*z).ProcessedChunks == 0)
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z CatchpointCatchupState) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
@@ -125,34 +493,232 @@ func (z *CatchpointCatchupState) UnmarshalMsg(bts []byte) (o []byte, err error)
 			err = msgp.WrapError(err)
 			return
 		}
-		(*z) = CatchpointCatchupState(zb0001)
+		(*z) = CatchpointCatchupState(zb0001)
+	}
+	o = bts
+	return
+}
+
+func (_ *CatchpointCatchupState) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointCatchupState)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z CatchpointCatchupState) Msgsize() (s int) {
+	s = msgp.Int32Size
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z CatchpointCatchupState) MsgIsZero() bool {
+	return z == 0
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CatchpointFileChunk) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(5)
+	var zb0001Mask uint8 /* 6 bits */
+	if (*z).Hash.MsgIsZero() {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if (*z).Length == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if (*z).Name == "" {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	if (*z).Offset == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x10
+	}
+	if (*z).Size == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x20
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "hash"
+			o = append(o, 0xa4, 0x68, 0x61, 0x73, 0x68)
+			o = (*z).Hash.MarshalMsg(o)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "length"
+			o = append(o, 0xa6, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68)
+			o = msgp.AppendUint64(o, (*z).Length)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "name"
+			o = append(o, 0xa4, 0x6e, 0x61, 0x6d, 0x65)
+			o = msgp.AppendString(o, (*z).Name)
+		}
+		if (zb0001Mask & 0x10) == 0 { // if not empty
+			// string "offset"
+			o = append(o, 0xa6, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74)
+			o = msgp.AppendUint64(o, (*z).Offset)
+		}
+		if (zb0001Mask & 0x20) == 0 { // if not empty
+			// string "size"
+			o = append(o, 0xa4, 0x73, 0x69, 0x7a, 0x65)
+			o = msgp.AppendUint64(o, (*z).Size)
+		}
+	}
+	return
+}
+
+func (_ *CatchpointFileChunk) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileChunk)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CatchpointFileChunk) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Name")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Offset, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Offset")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Length, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Length")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Size, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Size")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			bts, err = (*z).Hash.UnmarshalMsg(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Hash")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = CatchpointFileChunk{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "name":
+				(*z).Name, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Name")
+					return
+				}
+			case "offset":
+				(*z).Offset, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Offset")
+					return
+				}
+			case "length":
+				(*z).Length, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Length")
+					return
+				}
+			case "size":
+				(*z).Size, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Size")
+					return
+				}
+			case "hash":
+				bts, err = (*z).Hash.UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Hash")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
 	}
 	o = bts
 	return
 }
 
-func (_ *CatchpointCatchupState) CanUnmarshalMsg(z interface{}) bool {
-	_, ok := (z).(*CatchpointCatchupState)
+func (_ *CatchpointFileChunk) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileChunk)
 	return ok
 }
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
-func (z CatchpointCatchupState) Msgsize() (s int) {
-	s = msgp.Int32Size
+func (z *CatchpointFileChunk) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len((*z).Name) + 7 + msgp.Uint64Size + 7 + msgp.Uint64Size + 5 + msgp.Uint64Size + 5 + (*z).Hash.Msgsize()
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
-func (z CatchpointCatchupState) MsgIsZero() bool {
-	return z == 0
+func (z *CatchpointFileChunk) MsgIsZero() bool {
+	return ((*z).Name == "") && ((*z).Offset == 0) && ((*z).Length == 0) && ((*z).Size == 0) && ((*z).Hash.MsgIsZero())
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(8)
-	var zb0001Mask uint16 /* 9 bits */
+	zb0001Len := uint32(9)
+	var zb0001Mask uint16 /* 10 bits */
 	if (*z).Totals.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x2
@@ -181,10 +747,14 @@ func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 		zb0001Len--
 		zb0001Mask |= 0x80
 	}
-	if (*z).Version == 0 {
+	if (*z).TotalKVs == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x100
 	}
+	if (*z).Version == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x200
+	}
 	// variable map header, size zb0001Len
 	o = append(o, 0x80|uint8(zb0001Len))
 	if zb0001Len != 0 {
@@ -224,6 +794,11 @@ func (z *CatchpointFileHeader) MarshalMsg(b []byte) (o []byte) {
 			o = msgp.AppendUint64(o, (*z).TotalChunks)
 		}
 		if (zb0001Mask & 0x100) == 0 { // if not empty
+			// string "kvsCount"
+			o = append(o, 0xa8, 0x6b, 0x76, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74)
+			o = msgp.AppendUint64(o, (*z).TotalKVs)
+		}
+		if (zb0001Mask & 0x200) == 0 { // if not empty
 			// string "version"
 			o = append(o, 0xa7, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
 			o = msgp.AppendUint64(o, (*z).Version)
@@ -290,6 +865,14 @@ func (z *CatchpointFileHeader) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).TotalKVs, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalKVs")
+				return
+			}
+		}
 		if zb0001 > 0 {
 			zb0001--
 			(*z).TotalChunks, bts, err = msgp.ReadUint64Bytes(bts)
@@ -367,6 +950,12 @@ func (z *CatchpointFileHeader) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					err = msgp.WrapError(err, "TotalAccounts")
 					return
 				}
+			case "kvsCount":
+				(*z).TotalKVs, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalKVs")
+					return
+				}
 			case "chunksCount":
 				(*z).TotalChunks, bts, err = msgp.ReadUint64Bytes(bts)
 				if err != nil {
@@ -405,21 +994,179 @@ func (_ *CatchpointFileHeader) CanUnmarshalMsg(z interface{}) bool {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *CatchpointFileHeader) Msgsize() (s int) {
-	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 12 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize()
+	s = 1 + 8 + msgp.Uint64Size + 14 + (*z).BalancesRound.Msgsize() + 12 + (*z).BlocksRound.Msgsize() + 14 + (*z).Totals.Msgsize() + 14 + msgp.Uint64Size + 9 + msgp.Uint64Size + 12 + msgp.Uint64Size + 11 + msgp.StringPrefixSize + len((*z).Catchpoint) + 18 + (*z).BlockHeaderDigest.Msgsize()
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *CatchpointFileHeader) MsgIsZero() bool {
-	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalChunks == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero())
+	return ((*z).Version == 0) && ((*z).BalancesRound.MsgIsZero()) && ((*z).BlocksRound.MsgIsZero()) && ((*z).Totals.MsgIsZero()) && ((*z).TotalAccounts == 0) && ((*z).TotalKVs == 0) && ((*z).TotalChunks == 0) && ((*z).Catchpoint == "") && ((*z).BlockHeaderDigest.MsgIsZero())
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CatchpointFileManifest) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(1)
+	var zb0002Mask uint8 /* 2 bits */
+	if len((*z).Chunks) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "chunks"
+			o = append(o, 0xa6, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x73)
+			if (*z).Chunks == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Chunks)))
+			}
+			for zb0001 := range (*z).Chunks {
+				o = (*z).Chunks[zb0001].MarshalMsg(o)
+			}
+		}
+	}
+	return
+}
+
+func (_ *CatchpointFileManifest) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileManifest)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CatchpointFileManifest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Chunks")
+				return
+			}
+			if zb0004 > CatchpointFileManifestMaxChunks {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(CatchpointFileManifestMaxChunks))
+				err = msgp.WrapError(err, "struct-from-array", "Chunks")
+				return
+			}
+			if zb0005 {
+				(*z).Chunks = nil
+			} else if (*z).Chunks != nil && cap((*z).Chunks) >= zb0004 {
+				(*z).Chunks = ((*z).Chunks)[:zb0004]
+			} else {
+				(*z).Chunks = make([]CatchpointFileChunk, zb0004)
+			}
+			for zb0001 := range (*z).Chunks {
+				bts, err = (*z).Chunks[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Chunks", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = CatchpointFileManifest{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "chunks":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Chunks")
+					return
+				}
+				if zb0006 > CatchpointFileManifestMaxChunks {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(CatchpointFileManifestMaxChunks))
+					err = msgp.WrapError(err, "Chunks")
+					return
+				}
+				if zb0007 {
+					(*z).Chunks = nil
+				} else if (*z).Chunks != nil && cap((*z).Chunks) >= zb0006 {
+					(*z).Chunks = ((*z).Chunks)[:zb0006]
+				} else {
+					(*z).Chunks = make([]CatchpointFileChunk, zb0006)
+				}
+				for zb0001 := range (*z).Chunks {
+					bts, err = (*z).Chunks[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Chunks", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *CatchpointFileManifest) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*CatchpointFileManifest)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CatchpointFileManifest) Msgsize() (s int) {
+	s = 1 + 7 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Chunks {
+		s += (*z).Chunks[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *CatchpointFileManifest) MsgIsZero() bool {
+	return (len((*z).Chunks) == 0)
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *baseAccountData) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0001Len := uint32(19)
-	var zb0001Mask uint32 /* 21 bits */
+	zb0001Len := uint32(21)
+	var zb0001Mask uint32 /* 23 bits */
 	if (*z).baseOnlineAccountData.VoteID.MsgIsZero() {
 		zb0001Len--
 		zb0001Mask |= 0x1
@@ -492,10 +1239,18 @@ func (z *baseAccountData) MarshalMsg(b []byte) (o []byte) {
 		zb0001Len--
 		zb0001Mask |= 0x80000
 	}
-	if (*z).UpdateRound == 0 {
+	if (*z).TotalBoxes == 0 {
 		zb0001Len--
 		zb0001Mask |= 0x100000
 	}
+	if (*z).TotalBoxBytes == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x200000
+	}
+	if (*z).UpdateRound == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x400000
+	}
 	// variable map header, size zb0001Len
 	o = msgp.AppendMapHeader(o, zb0001Len)
 	if zb0001Len != 0 {
@@ -590,6 +1345,16 @@ func (z *baseAccountData) MarshalMsg(b []byte) (o []byte) {
 			o = msgp.AppendUint64(o, (*z).TotalAppLocalStates)
 		}
 		if (zb0001Mask & 0x100000) == 0 { // if not empty
+			// string "m"
+			o = append(o, 0xa1, 0x6d)
+			o = msgp.AppendUint64(o, (*z).TotalBoxes)
+		}
+		if (zb0001Mask & 0x200000) == 0 { // if not empty
+			// string "n"
+			o = append(o, 0xa1, 0x6e)
+			o = msgp.AppendUint64(o, (*z).TotalBoxBytes)
+		}
+		if (zb0001Mask & 0x400000) == 0 { // if not empty
 			// string "z"
 			o = append(o, 0xa1, 0x7a)
 			o = msgp.AppendUint64(o, (*z).UpdateRound)
@@ -712,6 +1477,22 @@ func (z *baseAccountData) UnmarshalMsg(bts []byte) (o []byte, err error) {
 				return
 			}
 		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).TotalBoxes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalBoxes")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).TotalBoxBytes, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "TotalBoxBytes")
+				return
+			}
+		}
 		if zb0001 > 0 {
 			zb0001--
 			bts, err = (*z).baseOnlineAccountData.VoteID.UnmarshalMsg(bts)
@@ -863,6 +1644,18 @@ func (z *baseAccountData) UnmarshalMsg(bts []byte) (o []byte, err error) {
 					err = msgp.WrapError(err, "TotalAppLocalStates")
 					return
 				}
+			case "m":
+				(*z).TotalBoxes, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalBoxes")
+					return
+				}
+			case "n":
+				(*z).TotalBoxBytes, bts, err = msgp.ReadUint64Bytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "TotalBoxBytes")
+					return
+				}
 			case "A":
 				bts, err = (*z).baseOnlineAccountData.VoteID.UnmarshalMsg(bts)
 				if err != nil {
@@ -925,13 +1718,13 @@ func (_ *baseAccountData) CanUnmarshalMsg(z interface{}) bool {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *baseAccountData) Msgsize() (s int) {
-	s = 3 + 2 + (*z).Status.Msgsize() + 2 + (*z).MicroAlgos.Msgsize() + 2 + msgp.Uint64Size + 2 + (*z).RewardedMicroAlgos.Msgsize() + 2 + (*z).AuthAddr.Msgsize() + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint32Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + (*z).baseOnlineAccountData.VoteID.Msgsize() + 2 + (*z).baseOnlineAccountData.SelectionID.Msgsize() + 2 + (*z).baseOnlineAccountData.VoteFirstValid.Msgsize() + 2 + (*z).baseOnlineAccountData.VoteLastValid.Msgsize() + 2 + msgp.Uint64Size + 2 + (*z).baseOnlineAccountData.StateProofID.Msgsize() + 2 + msgp.Uint64Size
+	s = 3 + 2 + (*z).Status.Msgsize() + 2 + (*z).MicroAlgos.Msgsize() + 2 + msgp.Uint64Size + 2 + (*z).RewardedMicroAlgos.Msgsize() + 2 + (*z).AuthAddr.Msgsize() + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint32Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + msgp.Uint64Size + 2 + (*z).baseOnlineAccountData.VoteID.Msgsize() + 2 + (*z).baseOnlineAccountData.SelectionID.Msgsize() + 2 + (*z).baseOnlineAccountData.VoteFirstValid.Msgsize() + 2 + (*z).baseOnlineAccountData.VoteLastValid.Msgsize() + 2 + msgp.Uint64Size + 2 + (*z).baseOnlineAccountData.StateProofID.Msgsize() + 2 + msgp.Uint64Size
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *baseAccountData) MsgIsZero() bool {
-	return ((*z).Status.MsgIsZero()) && ((*z).MicroAlgos.MsgIsZero()) && ((*z).RewardsBase == 0) && ((*z).RewardedMicroAlgos.MsgIsZero()) && ((*z).AuthAddr.MsgIsZero()) && ((*z).TotalAppSchemaNumUint == 0) && ((*z).TotalAppSchemaNumByteSlice == 0) && ((*z).TotalExtraAppPages == 0) && ((*z).TotalAssetParams == 0) && ((*z).TotalAssets == 0) && ((*z).TotalAppParams == 0) && ((*z).TotalAppLocalStates == 0) && ((*z).baseOnlineAccountData.VoteID.MsgIsZero()) && ((*z).baseOnlineAccountData.SelectionID.MsgIsZero()) && ((*z).baseOnlineAccountData.VoteFirstValid.MsgIsZero()) && ((*z).baseOnlineAccountData.VoteLastValid.MsgIsZero()) && ((*z).baseOnlineAccountData.VoteKeyDilution == 0) && ((*z).baseOnlineAccountData.StateProofID.MsgIsZero()) && ((*z).UpdateRound == 0)
+	return ((*z).Status.MsgIsZero()) && ((*z).MicroAlgos.MsgIsZero()) && ((*z).RewardsBase == 0) && ((*z).RewardedMicroAlgos.MsgIsZero()) && ((*z).AuthAddr.MsgIsZero()) && ((*z).TotalAppSchemaNumUint == 0) && ((*z).TotalAppSchemaNumByteSlice == 0) && ((*z).TotalExtraAppPages == 0) && ((*z).TotalAssetParams == 0) && ((*z).TotalAssets == 0) && ((*z).TotalAppParams == 0) && ((*z).TotalAppLocalStates == 0) && ((*z).TotalBoxes == 0) && ((*z).TotalBoxBytes == 0) && ((*z).baseOnlineAccountData.VoteID.MsgIsZero()) && ((*z).baseOnlineAccountData.SelectionID.MsgIsZero()) && ((*z).baseOnlineAccountData.VoteFirstValid.MsgIsZero()) && ((*z).baseOnlineAccountData.VoteLastValid.MsgIsZero()) && ((*z).baseOnlineAccountData.VoteKeyDilution == 0) && ((*z).baseOnlineAccountData.StateProofID.MsgIsZero()) && ((*z).UpdateRound == 0)
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -1467,16 +2260,20 @@ func (z *catchpointFileBalancesChunkV5) MsgIsZero() bool {
 func (z *catchpointFileBalancesChunkV6) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
 	// omitempty: check for empty values
-	zb0002Len := uint32(1)
-	var zb0002Mask uint8 /* 2 bits */
+	zb0003Len := uint32(2)
+	var zb0003Mask uint8 /* 3 bits */
 	if len((*z).Balances) == 0 {
-		zb0002Len--
-		zb0002Mask |= 0x2
+		zb0003Len--
+		zb0003Mask |= 0x2
 	}
-	// variable map header, size zb0002Len
-	o = append(o, 0x80|uint8(zb0002Len))
-	if zb0002Len != 0 {
-		if (zb0002Mask & 0x2) == 0 { // if not empty
+	if len((*z).KVs) == 0 {
+		zb0003Len--
+		zb0003Mask |= 0x4
+	}
+	// variable map header, size zb0003Len
+	o = append(o, 0x80|uint8(zb0003Len))
+	if zb0003Len != 0 {
+		if (zb0003Mask & 0x2) == 0 { // if not empty
 			// string "bl"
 			o = append(o, 0xa2, 0x62, 0x6c)
 			if (*z).Balances == nil {
@@ -1488,6 +2285,40 @@ func (z *catchpointFileBalancesChunkV6) MarshalMsg(b []byte) (o []byte) {
 				o = (*z).Balances[zb0001].MarshalMsg(o)
 			}
 		}
+		if (zb0003Mask & 0x4) == 0 { // if not empty
+			// string "kv"
+			o = append(o, 0xa2, 0x6b, 0x76)
+			if (*z).KVs == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).KVs)))
+			}
+			for zb0002 := range (*z).KVs {
+				// omitempty: check for empty values
+				zb0004Len := uint32(2)
+				var zb0004Mask uint8 /* 3 bits */
+				if len((*z).KVs[zb0002].Key) == 0 {
+					zb0004Len--
+					zb0004Mask |= 0x2
+				}
+				if len((*z).KVs[zb0002].Value) == 0 {
+					zb0004Len--
+					zb0004Mask |= 0x4
+				}
+				// variable map header, size zb0004Len
+				o = append(o, 0x80|uint8(zb0004Len))
+				if (zb0004Mask & 0x2) == 0 { // if not empty
+					// string "k"
+					o = append(o, 0xa1, 0x6b)
+					o = msgp.AppendBytes(o, (*z).KVs[zb0002].Key)
+				}
+				if (zb0004Mask & 0x4) == 0 { // if not empty
+					// string "v"
+					o = append(o, 0xa1, 0x76)
+					o = msgp.AppendBytes(o, (*z).KVs[zb0002].Value)
+				}
+			}
+		}
 	}
 	return
 }
@@ -1501,35 +2332,35 @@ func (_ *catchpointFileBalancesChunkV6) CanMarshalMsg(z interface{}) bool {
 func (z *catchpointFileBalancesChunkV6) UnmarshalMsg(bts []byte) (o []byte, err error) {
 	var field []byte
 	_ = field
-	var zb0002 int
-	var zb0003 bool
-	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	var zb0003 int
+	var zb0004 bool
+	zb0003, zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
 	if _, ok := err.(msgp.TypeError); ok {
-		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		zb0003, zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
 		if err != nil {
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0002 > 0 {
-			zb0002--
-			var zb0004 int
-			var zb0005 bool
-			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if zb0003 > 0 {
+			zb0003--
+			var zb0005 int
+			var zb0006 bool
+			zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array", "Balances")
 				return
 			}
-			if zb0004 > BalancesPerCatchpointFileChunk {
-				err = msgp.ErrOverflow(uint64(zb0004), uint64(BalancesPerCatchpointFileChunk))
+			if zb0005 > BalancesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0005), uint64(BalancesPerCatchpointFileChunk))
 				err = msgp.WrapError(err, "struct-from-array", "Balances")
 				return
 			}
-			if zb0005 {
+			if zb0006 {
 				(*z).Balances = nil
-			} else if (*z).Balances != nil && cap((*z).Balances) >= zb0004 {
-				(*z).Balances = ((*z).Balances)[:zb0004]
+			} else if (*z).Balances != nil && cap((*z).Balances) >= zb0005 {
+				(*z).Balances = ((*z).Balances)[:zb0005]
 			} else {
-				(*z).Balances = make([]encodedBalanceRecordV6, zb0004)
+				(*z).Balances = make([]encodedBalanceRecordV6, zb0005)
 			}
 			for zb0001 := range (*z).Balances {
 				bts, err = (*z).Balances[zb0001].UnmarshalMsg(bts)
@@ -1539,8 +2370,141 @@ func (z *catchpointFileBalancesChunkV6) UnmarshalMsg(bts []byte) (o []byte, err
 				}
 			}
 		}
-		if zb0002 > 0 {
-			err = msgp.ErrTooManyArrayFields(zb0002)
+		if zb0003 > 0 {
+			zb0003--
+			var zb0007 int
+			var zb0008 bool
+			zb0007, zb0008, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "KVs")
+				return
+			}
+			if zb0007 > BalancesPerCatchpointFileChunk {
+				err = msgp.ErrOverflow(uint64(zb0007), uint64(BalancesPerCatchpointFileChunk))
+				err = msgp.WrapError(err, "struct-from-array", "KVs")
+				return
+			}
+			if zb0008 {
+				(*z).KVs = nil
+			} else if (*z).KVs != nil && cap((*z).KVs) >= zb0007 {
+				(*z).KVs = ((*z).KVs)[:zb0007]
+			} else {
+				(*z).KVs = make([]encodedKVRecord, zb0007)
+			}
+			for zb0002 := range (*z).KVs {
+				var zb0009 int
+				var zb0010 bool
+				zb0009, zb0010, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if _, ok := err.(msgp.TypeError); ok {
+					zb0009, zb0010, bts, err = msgp.ReadArrayHeaderBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002)
+						return
+					}
+					if zb0009 > 0 {
+						zb0009--
+						var zb0011 int
+						zb0011, err = msgp.ReadBytesBytesHeader(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "struct-from-array", "Key")
+							return
+						}
+						if zb0011 > encodedKVRecordMaxKeyLength {
+							err = msgp.ErrOverflow(uint64(zb0011), uint64(encodedKVRecordMaxKeyLength))
+							return
+						}
+						(*z).KVs[zb0002].Key, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Key)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "struct-from-array", "Key")
+							return
+						}
+					}
+					if zb0009 > 0 {
+						zb0009--
+						var zb0012 int
+						zb0012, err = msgp.ReadBytesBytesHeader(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "struct-from-array", "Value")
+							return
+						}
+						if zb0012 > encodedKVRecordMaxValueLength {
+							err = msgp.ErrOverflow(uint64(zb0012), uint64(encodedKVRecordMaxValueLength))
+							return
+						}
+						(*z).KVs[zb0002].Value, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Value)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "struct-from-array", "Value")
+							return
+						}
+					}
+					if zb0009 > 0 {
+						err = msgp.ErrTooManyArrayFields(zb0009)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "struct-from-array")
+							return
+						}
+					}
+				} else {
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002)
+						return
+					}
+					if zb0010 {
+						(*z).KVs[zb0002] = encodedKVRecord{}
+					}
+					for zb0009 > 0 {
+						zb0009--
+						field, bts, err = msgp.ReadMapKeyZC(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002)
+							return
+						}
+						switch string(field) {
+						case "k":
+							var zb0013 int
+							zb0013, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "Key")
+								return
+							}
+							if zb0013 > encodedKVRecordMaxKeyLength {
+								err = msgp.ErrOverflow(uint64(zb0013), uint64(encodedKVRecordMaxKeyLength))
+								return
+							}
+							(*z).KVs[zb0002].Key, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Key)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "Key")
+								return
+							}
+						case "v":
+							var zb0014 int
+							zb0014, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "Value")
+								return
+							}
+							if zb0014 > encodedKVRecordMaxValueLength {
+								err = msgp.ErrOverflow(uint64(zb0014), uint64(encodedKVRecordMaxValueLength))
+								return
+							}
+							(*z).KVs[zb0002].Value, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Value)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002, "Value")
+								return
+							}
+						default:
+							err = msgp.ErrNoField(string(field))
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "KVs", zb0002)
+								return
+							}
+						}
+					}
+				}
+			}
+		}
+		if zb0003 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0003)
 			if err != nil {
 				err = msgp.WrapError(err, "struct-from-array")
 				return
@@ -1551,11 +2515,11 @@ func (z *catchpointFileBalancesChunkV6) UnmarshalMsg(bts []byte) (o []byte, err
 			err = msgp.WrapError(err)
 			return
 		}
-		if zb0003 {
+		if zb0004 {
 			(*z) = catchpointFileBalancesChunkV6{}
 		}
-		for zb0002 > 0 {
-			zb0002--
+		for zb0003 > 0 {
+			zb0003--
 			field, bts, err = msgp.ReadMapKeyZC(bts)
 			if err != nil {
 				err = msgp.WrapError(err)
@@ -1563,24 +2527,24 @@ func (z *catchpointFileBalancesChunkV6) UnmarshalMsg(bts []byte) (o []byte, err
 			}
 			switch string(field) {
 			case "bl":
-				var zb0006 int
-				var zb0007 bool
-				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				var zb0015 int
+				var zb0016 bool
+				zb0015, zb0016, bts, err = msgp.ReadArrayHeaderBytes(bts)
 				if err != nil {
 					err = msgp.WrapError(err, "Balances")
 					return
 				}
-				if zb0006 > BalancesPerCatchpointFileChunk {
-					err = msgp.ErrOverflow(uint64(zb0006), uint64(BalancesPerCatchpointFileChunk))
+				if zb0015 > BalancesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0015), uint64(BalancesPerCatchpointFileChunk))
 					err = msgp.WrapError(err, "Balances")
 					return
 				}
-				if zb0007 {
+				if zb0016 {
 					(*z).Balances = nil
-				} else if (*z).Balances != nil && cap((*z).Balances) >= zb0006 {
-					(*z).Balances = ((*z).Balances)[:zb0006]
+				} else if (*z).Balances != nil && cap((*z).Balances) >= zb0015 {
+					(*z).Balances = ((*z).Balances)[:zb0015]
 				} else {
-					(*z).Balances = make([]encodedBalanceRecordV6, zb0006)
+					(*z).Balances = make([]encodedBalanceRecordV6, zb0015)
 				}
 				for zb0001 := range (*z).Balances {
 					bts, err = (*z).Balances[zb0001].UnmarshalMsg(bts)
@@ -1589,6 +2553,137 @@ func (z *catchpointFileBalancesChunkV6) UnmarshalMsg(bts []byte) (o []byte, err
 						return
 					}
 				}
+			case "kv":
+				var zb0017 int
+				var zb0018 bool
+				zb0017, zb0018, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "KVs")
+					return
+				}
+				if zb0017 > BalancesPerCatchpointFileChunk {
+					err = msgp.ErrOverflow(uint64(zb0017), uint64(BalancesPerCatchpointFileChunk))
+					err = msgp.WrapError(err, "KVs")
+					return
+				}
+				if zb0018 {
+					(*z).KVs = nil
+				} else if (*z).KVs != nil && cap((*z).KVs) >= zb0017 {
+					(*z).KVs = ((*z).KVs)[:zb0017]
+				} else {
+					(*z).KVs = make([]encodedKVRecord, zb0017)
+				}
+				for zb0002 := range (*z).KVs {
+					var zb0019 int
+					var zb0020 bool
+					zb0019, zb0020, bts, err = msgp.ReadMapHeaderBytes(bts)
+					if _, ok := err.(msgp.TypeError); ok {
+						zb0019, zb0020, bts, err = msgp.ReadArrayHeaderBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "KVs", zb0002)
+							return
+						}
+						if zb0019 > 0 {
+							zb0019--
+							var zb0021 int
+							zb0021, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "KVs", zb0002, "struct-from-array", "Key")
+								return
+							}
+							if zb0021 > encodedKVRecordMaxKeyLength {
+								err = msgp.ErrOverflow(uint64(zb0021), uint64(encodedKVRecordMaxKeyLength))
+								return
+							}
+							(*z).KVs[zb0002].Key, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Key)
+							if err != nil {
+								err = msgp.WrapError(err, "KVs", zb0002, "struct-from-array", "Key")
+								return
+							}
+						}
+						if zb0019 > 0 {
+							zb0019--
+							var zb0022 int
+							zb0022, err = msgp.ReadBytesBytesHeader(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "KVs", zb0002, "struct-from-array", "Value")
+								return
+							}
+							if zb0022 > encodedKVRecordMaxValueLength {
+								err = msgp.ErrOverflow(uint64(zb0022), uint64(encodedKVRecordMaxValueLength))
+								return
+							}
+							(*z).KVs[zb0002].Value, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Value)
+							if err != nil {
+								err = msgp.WrapError(err, "KVs", zb0002, "struct-from-array", "Value")
+								return
+							}
+						}
+						if zb0019 > 0 {
+							err = msgp.ErrTooManyArrayFields(zb0019)
+							if err != nil {
+								err = msgp.WrapError(err, "KVs", zb0002, "struct-from-array")
+								return
+							}
+						}
+					} else {
+						if err != nil {
+							err = msgp.WrapError(err, "KVs", zb0002)
+							return
+						}
+						if zb0020 {
+							(*z).KVs[zb0002] = encodedKVRecord{}
+						}
+						for zb0019 > 0 {
+							zb0019--
+							field, bts, err = msgp.ReadMapKeyZC(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "KVs", zb0002)
+								return
+							}
+							switch string(field) {
+							case "k":
+								var zb0023 int
+								zb0023, err = msgp.ReadBytesBytesHeader(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "KVs", zb0002, "Key")
+									return
+								}
+								if zb0023 > encodedKVRecordMaxKeyLength {
+									err = msgp.ErrOverflow(uint64(zb0023), uint64(encodedKVRecordMaxKeyLength))
+									return
+								}
+								(*z).KVs[zb0002].Key, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Key)
+								if err != nil {
+									err = msgp.WrapError(err, "KVs", zb0002, "Key")
+									return
+								}
+							case "v":
+								var zb0024 int
+								zb0024, err = msgp.ReadBytesBytesHeader(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "KVs", zb0002, "Value")
+									return
+								}
+								if zb0024 > encodedKVRecordMaxValueLength {
+									err = msgp.ErrOverflow(uint64(zb0024), uint64(encodedKVRecordMaxValueLength))
+									return
+								}
+								(*z).KVs[zb0002].Value, bts, err = msgp.ReadBytesBytes(bts, (*z).KVs[zb0002].Value)
+								if err != nil {
+									err = msgp.WrapError(err, "KVs", zb0002, "Value")
+									return
+								}
+							default:
+								err = msgp.ErrNoField(string(field))
+								if err != nil {
+									err = msgp.WrapError(err, "KVs", zb0002)
+									return
+								}
+							}
+						}
+					}
+				}
 			default:
 				err = msgp.ErrNoField(string(field))
 				if err != nil {
@@ -1613,12 +2708,16 @@ func (z *catchpointFileBalancesChunkV6) Msgsize() (s int) {
 	for zb0001 := range (*z).Balances {
 		s += (*z).Balances[zb0001].Msgsize()
 	}
+	s += 3 + msgp.ArrayHeaderSize
+	for zb0002 := range (*z).KVs {
+		s += 1 + 2 + msgp.BytesPrefixSize + len((*z).KVs[zb0002].Key) + 2 + msgp.BytesPrefixSize + len((*z).KVs[zb0002].Value)
+	}
 	return
 }
 
 // MsgIsZero returns whether this is a zero value
 func (z *catchpointFileBalancesChunkV6) MsgIsZero() bool {
-	return (len((*z).Balances) == 0)
+	return (len((*z).Balances) == 0) && (len((*z).KVs) == 0)
 }
 
 // MarshalMsg implements msgp.Marshaler
@@ -2026,6 +3125,175 @@ func (z *encodedBalanceRecordV6) MsgIsZero() bool {
 	return ((*z).Address.MsgIsZero()) && ((*z).AccountData.MsgIsZero()) && (len((*z).Resources) == 0)
 }
 
+// MarshalMsg implements msgp.Marshaler
+func (z *encodedKVRecord) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(2)
+	var zb0001Mask uint8 /* 3 bits */
+	if len((*z).Key) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if len((*z).Value) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "k"
+			o = append(o, 0xa1, 0x6b)
+			o = msgp.AppendBytes(o, (*z).Key)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "v"
+			o = append(o, 0xa1, 0x76)
+			o = msgp.AppendBytes(o, (*z).Value)
+		}
+	}
+	return
+}
+
+func (_ *encodedKVRecord) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*encodedKVRecord)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *encodedKVRecord) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Key")
+				return
+			}
+			if zb0003 > encodedKVRecordMaxKeyLength {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(encodedKVRecordMaxKeyLength))
+				return
+			}
+			(*z).Key, bts, err = msgp.ReadBytesBytes(bts, (*z).Key)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Key")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0004 int
+			zb0004, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+			if zb0004 > encodedKVRecordMaxValueLength {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(encodedKVRecordMaxValueLength))
+				return
+			}
+			(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Value")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = encodedKVRecord{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "k":
+				var zb0005 int
+				zb0005, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Key")
+					return
+				}
+				if zb0005 > encodedKVRecordMaxKeyLength {
+					err = msgp.ErrOverflow(uint64(zb0005), uint64(encodedKVRecordMaxKeyLength))
+					return
+				}
+				(*z).Key, bts, err = msgp.ReadBytesBytes(bts, (*z).Key)
+				if err != nil {
+					err = msgp.WrapError(err, "Key")
+					return
+				}
+			case "v":
+				var zb0006 int
+				zb0006, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+				if zb0006 > encodedKVRecordMaxValueLength {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(encodedKVRecordMaxValueLength))
+					return
+				}
+				(*z).Value, bts, err = msgp.ReadBytesBytes(bts, (*z).Value)
+				if err != nil {
+					err = msgp.WrapError(err, "Value")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *encodedKVRecord) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*encodedKVRecord)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *encodedKVRecord) Msgsize() (s int) {
+	s = 1 + 2 + msgp.BytesPrefixSize + len((*z).Key) + 2 + msgp.BytesPrefixSize + len((*z).Value)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *encodedKVRecord) MsgIsZero() bool {
+	return (len((*z).Key) == 0) && (len((*z).Value) == 0)
+}
+
 // MarshalMsg implements msgp.Marshaler
 func (z resourceFlags) MarshalMsg(b []byte) (o []byte) {
 	o = msgp.Require(b, z.Msgsize())
@@ -14,6 +14,126 @@ import (
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
+func TestMarshalUnmarshalCatchpointCatchupChunksProgress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := CatchpointCatchupChunksProgress{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingCatchpointCatchupChunksProgress(t *testing.T) {
+	protocol.RunEncodingTest(t, &CatchpointCatchupChunksProgress{})
+}
+
+func BenchmarkMarshalMsgCatchpointCatchupChunksProgress(b *testing.B) {
+	v := CatchpointCatchupChunksProgress{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgCatchpointCatchupChunksProgress(b *testing.B) {
+	v := CatchpointCatchupChunksProgress{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalCatchpointCatchupChunksProgress(b *testing.B) {
+	v := CatchpointCatchupChunksProgress{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestMarshalUnmarshalCatchpointFileChunk(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := CatchpointFileChunk{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingCatchpointFileChunk(t *testing.T) {
+	protocol.RunEncodingTest(t, &CatchpointFileChunk{})
+}
+
+func BenchmarkMarshalMsgCatchpointFileChunk(b *testing.B) {
+	v := CatchpointFileChunk{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgCatchpointFileChunk(b *testing.B) {
+	v := CatchpointFileChunk{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalCatchpointFileChunk(b *testing.B) {
+	v := CatchpointFileChunk{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalCatchpointFileHeader(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := CatchpointFileHeader{}
@@ -74,6 +194,66 @@ func BenchmarkUnmarshalCatchpointFileHeader(b *testing.B) {
 	}
 }
 
+func TestMarshalUnmarshalCatchpointFileManifest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := CatchpointFileManifest{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingCatchpointFileManifest(t *testing.T) {
+	protocol.RunEncodingTest(t, &CatchpointFileManifest{})
+}
+
+func BenchmarkMarshalMsgCatchpointFileManifest(b *testing.B) {
+	v := CatchpointFileManifest{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgCatchpointFileManifest(b *testing.B) {
+	v := CatchpointFileManifest{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalCatchpointFileManifest(b *testing.B) {
+	v := CatchpointFileManifest{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalbaseAccountData(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := baseAccountData{}
@@ -434,6 +614,66 @@ func BenchmarkUnmarshalencodedBalanceRecordV6(b *testing.B) {
 	}
 }
 
+func TestMarshalUnmarshalencodedKVRecord(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	v := encodedKVRecord{}
+	bts := v.MarshalMsg(nil)
+	left, err := v.UnmarshalMsg(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after UnmarshalMsg(): %q", len(left), left)
+	}
+
+	left, err = msgp.Skip(bts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) > 0 {
+		t.Errorf("%d bytes left over after Skip(): %q", len(left), left)
+	}
+}
+
+func TestRandomizedEncodingencodedKVRecord(t *testing.T) {
+	protocol.RunEncodingTest(t, &encodedKVRecord{})
+}
+
+func BenchmarkMarshalMsgencodedKVRecord(b *testing.B) {
+	v := encodedKVRecord{}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.MarshalMsg(nil)
+	}
+}
+
+func BenchmarkAppendMsgencodedKVRecord(b *testing.B) {
+	v := encodedKVRecord{}
+	bts := make([]byte, 0, v.Msgsize())
+	bts = v.MarshalMsg(bts[0:0])
+	b.SetBytes(int64(len(bts)))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bts = v.MarshalMsg(bts[0:0])
+	}
+}
+
+func BenchmarkUnmarshalencodedKVRecord(b *testing.B) {
+	v := encodedKVRecord{}
+	bts := v.MarshalMsg(nil)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(bts)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := v.UnmarshalMsg(bts)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
 func TestMarshalUnmarshalresourcesData(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	v := resourcesData{}
@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/ledger/internal"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// LedgerForSimulator describes the ledger methods used by the Simulator.
+type LedgerForSimulator interface {
+	Latest() basics.Round
+	BlockHdr(basics.Round) (bookkeeping.BlockHeader, error)
+	LookupWithoutRewards(basics.Round, basics.Address) (ledgercore.AccountData, basics.Round, error)
+	StartEvaluator(hdr bookkeeping.BlockHeader, paysetHint, maxTxnBytesPerBlock int) (*internal.BlockEvaluator, error)
+}
+
+// ErrEmptyGroup is returned when asked to simulate a group with no transactions.
+var ErrEmptyGroup = errors.New("simulation: empty transaction group")
+
+// TxnResult contains the simulated outcome of a single transaction in the group.
+type TxnResult struct {
+	// Txn is the transaction as it was evaluated, including the ApplyData
+	// (inner transactions, logs and local/global state deltas) it produced.
+	// It is only populated when the whole group would succeed.
+	Txn transactions.SignedTxnWithAD
+
+	// MissingSignature is set when the transaction was not signed. Such a
+	// transaction was evaluated as if it had been correctly authorized.
+	MissingSignature bool
+}
+
+// Result contains the outcome of simulating a transaction group.
+type Result struct {
+	// Round is the round the group was simulated in, i.e. the round after
+	// the latest round of the ledger.
+	Round basics.Round
+
+	// TxnResults has one entry per transaction in the simulated group.
+	TxnResults []TxnResult
+
+	// WouldSucceed is true if the group would have been accepted by the
+	// ledger, provided every transaction was correctly signed.
+	WouldSucceed bool
+
+	// FailedAt is the index of the transaction that caused the group to be
+	// rejected, or -1 if the group was not rejected or if the rejection was
+	// not attributable to a single transaction.
+	FailedAt int
+
+	// FailureMessage describes why the group was rejected.
+	FailureMessage string
+
+	// Delta is the state delta that committing the group in a block of its
+	// own would produce. It is only populated when WouldSucceed is true.
+	Delta ledgercore.StateDelta
+}
+
+// Simulator evaluates transaction groups against the latest ledger state
+// without requiring them to be signed and without committing them.
+type Simulator struct {
+	ledger LedgerForSimulator
+}
+
+// MakeSimulator creates a new Simulator on top of the given ledger.
+func MakeSimulator(ledger LedgerForSimulator) *Simulator {
+	return &Simulator{ledger: ledger}
+}
+
+// SimulateTransactionGroup evaluates txgroup as the only group of a block
+// following the latest round of the ledger. Transactions that carry a
+// signature, multisig or logicsig are verified as usual; unsigned ones are
+// accepted as if they had been signed by the sender's authorizing address.
+// A non-nil error is only returned when the simulation itself could not be
+// carried out; a group that would be rejected is reported through Result.
+func (s *Simulator) SimulateTransactionGroup(txgroup []transactions.SignedTxn) (result Result, err error) {
+	if len(txgroup) == 0 {
+		return Result{}, ErrEmptyGroup
+	}
+
+	latest := s.ledger.Latest()
+	prevHdr, err := s.ledger.BlockHdr(latest)
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation: cannot get header for round %d: %w", latest, err)
+	}
+
+	// Make sure we know about the next protocol version, as MakeBlock panics otherwise.
+	_, upgradeState, err := bookkeeping.ProcessUpgradeParams(prevHdr)
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation: error processing upgrade params for round %d: %w", latest+1, err)
+	}
+	if _, ok := config.Consensus[upgradeState.CurrentProtocol]; !ok {
+		return Result{}, fmt.Errorf("simulation: next protocol version %v is not supported", upgradeState.CurrentProtocol)
+	}
+	hdr := bookkeeping.MakeBlock(prevHdr).BlockHeader
+
+	result = Result{
+		Round:      hdr.Round,
+		TxnResults: make([]TxnResult, len(txgroup)),
+		FailedAt:   -1,
+	}
+
+	// work on a copy, since unsigned transactions get their AuthAddr filled in below.
+	group := make([]transactions.SignedTxn, len(txgroup))
+	copy(group, txgroup)
+
+	failedAt, err := s.checkSignatures(group, hdr, latest, result.TxnResults)
+	if err != nil {
+		result.FailedAt = failedAt
+		result.FailureMessage = err.Error()
+		return result, nil
+	}
+
+	eval, err := s.ledger.StartEvaluator(hdr, len(group), 0)
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation: cannot start evaluator for round %d: %w", hdr.Round, err)
+	}
+
+	failedAt, err = eval.TransactionGroupFailedAt(transactions.WrapSignedTxnsWithAD(group))
+	if err != nil {
+		result.FailedAt = failedAt
+		result.FailureMessage = err.Error()
+		return result, nil
+	}
+
+	vb, err := eval.GenerateBlock()
+	if err != nil {
+		result.FailureMessage = err.Error()
+		return result, nil
+	}
+
+	payset, err := vb.Block().DecodePaysetFlat()
+	if err != nil {
+		return Result{}, fmt.Errorf("simulation: cannot decode evaluated payset: %w", err)
+	}
+	if len(payset) != len(group) {
+		return Result{}, fmt.Errorf("simulation: evaluated payset has %d transactions, expected %d", len(payset), len(group))
+	}
+	for i := range payset {
+		// report the transaction as it was submitted, rather than with the AuthAddr we filled in.
+		payset[i].SignedTxn = txgroup[i]
+		result.TxnResults[i].Txn = payset[i]
+	}
+
+	result.WouldSucceed = true
+	result.Delta = vb.Delta()
+	return result, nil
+}
+
+// checkSignatures verifies the signatures of the signed transactions in group and
+// marks the unsigned ones in results. Unsigned transactions sent from a rekeyed
+// account get the AuthAddr of that account, so that the evaluator treats them as
+// properly authorized. On failure, the index of the offending transaction (or -1)
+// is returned along with the error.
+func (s *Simulator) checkSignatures(group []transactions.SignedTxn, hdr bookkeeping.BlockHeader, latest basics.Round, results []TxnResult) (int, error) {
+	groupCtx, err := verify.PrepareGroupContext(group, hdr)
+	if err != nil {
+		return -1, err
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+	specAddrs := transactions.SpecialAddresses{
+		FeeSink:     hdr.FeeSink,
+		RewardsPool: hdr.RewardsPool,
+	}
+
+	minFeeCount := uint64(0)
+	feesPaid := uint64(0)
+	for i := range group {
+		stxn := &group[i]
+		if stxn.Txn.Type != protocol.CompactCertTx {
+			minFeeCount++
+		}
+		feesPaid = basics.AddSaturate(feesPaid, stxn.Txn.Fee.Raw)
+
+		if !isUnsigned(stxn) {
+			err = verify.Txn(stxn, i, groupCtx)
+			if err != nil {
+				return i, fmt.Errorf("transaction %v invalid: %w", stxn.ID(), err)
+			}
+			continue
+		}
+
+		err = stxn.Txn.WellFormed(specAddrs, proto)
+		if err != nil {
+			return i, fmt.Errorf("transaction %v: malformed: %w", stxn.ID(), err)
+		}
+		results[i].MissingSignature = true
+
+		if stxn.AuthAddr.IsZero() {
+			acct, _, err := s.ledger.LookupWithoutRewards(latest, stxn.Txn.Sender)
+			if err != nil {
+				return i, err
+			}
+			stxn.AuthAddr = acct.AuthAddr
+		}
+	}
+
+	feeNeeded, overflow := basics.OMul(proto.MinTxnFee, minFeeCount)
+	if overflow {
+		return -1, fmt.Errorf("txgroup fee requirement overflow")
+	}
+	if feesPaid < feeNeeded {
+		return -1, fmt.Errorf("txgroup had %d in fees, which is less than the minimum %d * %d",
+			feesPaid, minFeeCount, proto.MinTxnFee)
+	}
+	return -1, nil
+}
+
+// isUnsigned returns true if stxn carries no signature of any kind. Compact
+// certificate transactions are never signed, so they are not considered unsigned.
+func isUnsigned(stxn *transactions.SignedTxn) bool {
+	if stxn.Txn.Type == protocol.CompactCertTx && stxn.Txn.Sender == transactions.CompactCertSender {
+		return false
+	}
+	return stxn.Sig == (crypto.Signature{}) && stxn.Msig.Blank() && stxn.Lsig.Blank()
+}
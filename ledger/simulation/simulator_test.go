@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package simulation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+type simulationTestEnv struct {
+	ledger  *ledger.Ledger
+	addrs   []basics.Address
+	secrets []*crypto.SignatureSecrets
+	genHash crypto.Digest
+}
+
+func makeSimulationTestEnv(t *testing.T) simulationTestEnv {
+	balances, addrs, secrets := ledgertesting.NewTestGenesis()
+
+	var genHash crypto.Digest
+	crypto.RandBytes(genHash[:])
+	genBlock, err := bookkeeping.MakeGenesisBlock(protocol.ConsensusFuture, balances, "test", genHash)
+	require.NoError(t, err)
+
+	dbName := fmt.Sprintf("%s.%d", t.Name(), crypto.RandUint64())
+	cfg := config.GetDefaultLocal()
+	l, err := ledger.OpenLedger(logging.TestingLog(t), dbName, true, ledgercore.InitState{
+		Block:       genBlock,
+		Accounts:    balances.Balances,
+		GenesisHash: genHash,
+	}, cfg)
+	require.NoError(t, err)
+	t.Cleanup(l.Close)
+
+	return simulationTestEnv{ledger: l, addrs: addrs, secrets: secrets, genHash: genHash}
+}
+
+func (env simulationTestEnv) header(sender basics.Address) transactions.Header {
+	return transactions.Header{
+		Sender:      sender,
+		Fee:         basics.MicroAlgos{Raw: config.Consensus[protocol.ConsensusFuture].MinTxnFee},
+		FirstValid:  env.ledger.Latest(),
+		LastValid:   env.ledger.Latest() + 100,
+		GenesisHash: env.genHash,
+	}
+}
+
+func (env simulationTestEnv) payment(from, to int, amount uint64) transactions.Transaction {
+	return transactions.Transaction{
+		Type:   protocol.PaymentTx,
+		Header: env.header(env.addrs[from]),
+		PaymentTxnFields: transactions.PaymentTxnFields{
+			Receiver: env.addrs[to],
+			Amount:   basics.MicroAlgos{Raw: amount},
+		},
+	}
+}
+
+func TestSimulateUnsignedPayment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	env := makeSimulationTestEnv(t)
+	s := MakeSimulator(env.ledger)
+
+	pay := env.payment(0, 1, 1000000)
+	result, err := s.SimulateTransactionGroup([]transactions.SignedTxn{{Txn: pay}})
+	require.NoError(t, err)
+	require.True(t, result.WouldSucceed, result.FailureMessage)
+	require.Equal(t, -1, result.FailedAt)
+	require.Equal(t, env.ledger.Latest()+1, result.Round)
+	require.Len(t, result.TxnResults, 1)
+	require.True(t, result.TxnResults[0].MissingSignature)
+	require.Equal(t, pay, result.TxnResults[0].Txn.Txn)
+
+	before, _, err := env.ledger.LookupWithoutRewards(env.ledger.Latest(), env.addrs[1])
+	require.NoError(t, err)
+	after, ok := result.Delta.Accts.GetData(env.addrs[1])
+	require.True(t, ok)
+	require.Equal(t, before.MicroAlgos.Raw+1000000, after.MicroAlgos.Raw)
+
+	// nothing was committed to the ledger
+	require.Equal(t, basics.Round(0), env.ledger.Latest())
+	current, _, err := env.ledger.LookupWithoutRewards(env.ledger.Latest(), env.addrs[1])
+	require.NoError(t, err)
+	require.Equal(t, before, current)
+}
+
+func TestSimulateSignedPayment(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	env := makeSimulationTestEnv(t)
+	s := MakeSimulator(env.ledger)
+
+	pay := env.payment(0, 1, 1000)
+	result, err := s.SimulateTransactionGroup([]transactions.SignedTxn{pay.Sign(env.secrets[0])})
+	require.NoError(t, err)
+	require.True(t, result.WouldSucceed, result.FailureMessage)
+	require.False(t, result.TxnResults[0].MissingSignature)
+
+	// signed by the wrong key
+	result, err = s.SimulateTransactionGroup([]transactions.SignedTxn{pay.Sign(env.secrets[1])})
+	require.NoError(t, err)
+	require.False(t, result.WouldSucceed)
+	require.Equal(t, 0, result.FailedAt)
+	require.NotEmpty(t, result.FailureMessage)
+}
+
+func TestSimulateFailurePoint(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	env := makeSimulationTestEnv(t)
+	s := MakeSimulator(env.ledger)
+
+	acct, _, err := env.ledger.LookupWithoutRewards(env.ledger.Latest(), env.addrs[2])
+	require.NoError(t, err)
+
+	txns := []transactions.Transaction{
+		env.payment(0, 1, 1000),
+		env.payment(2, 1, acct.MicroAlgos.Raw+1), // overspend
+		env.payment(1, 0, 1000),
+	}
+	gid := crypto.HashObj(transactions.TxGroup{TxGroupHashes: []crypto.Digest{
+		crypto.Digest(txns[0].ID()), crypto.Digest(txns[1].ID()), crypto.Digest(txns[2].ID()),
+	}})
+	group := make([]transactions.SignedTxn, len(txns))
+	for i := range txns {
+		txns[i].Group = gid
+		group[i] = transactions.SignedTxn{Txn: txns[i]}
+	}
+
+	result, err := s.SimulateTransactionGroup(group)
+	require.NoError(t, err)
+	require.False(t, result.WouldSucceed)
+	require.Equal(t, 1, result.FailedAt)
+	require.Contains(t, result.FailureMessage, "overspend")
+	require.Len(t, result.TxnResults, 3)
+	for _, tr := range result.TxnResults {
+		require.True(t, tr.MissingSignature)
+	}
+
+	_, err = s.SimulateTransactionGroup(nil)
+	require.ErrorIs(t, err, ErrEmptyGroup)
+}
+
+func TestSimulateRekeyedSender(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	env := makeSimulationTestEnv(t)
+
+	// rekey account 0 to account 1 and commit the block
+	rekey := env.payment(0, 0, 0)
+	rekey.RekeyTo = env.addrs[1]
+	genHdr, err := env.ledger.BlockHdr(0)
+	require.NoError(t, err)
+	blk := bookkeeping.MakeBlock(genHdr)
+	eval, err := env.ledger.StartEvaluator(blk.BlockHeader, 0, 0)
+	require.NoError(t, err)
+	require.NoError(t, eval.Transaction(rekey.Sign(env.secrets[0]), transactions.ApplyData{}))
+	vb, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, env.ledger.AddValidatedBlock(*vb, agreement.Certificate{}))
+
+	s := MakeSimulator(env.ledger)
+	pay := env.payment(0, 2, 1000)
+	result, err := s.SimulateTransactionGroup([]transactions.SignedTxn{{Txn: pay}})
+	require.NoError(t, err)
+	require.True(t, result.WouldSucceed, result.FailureMessage)
+	// the reported transaction is the one that was submitted
+	require.True(t, result.TxnResults[0].Txn.AuthAddr.IsZero())
+}
+
+func TestSimulateAppCallLogs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	env := makeSimulationTestEnv(t)
+	s := MakeSimulator(env.ledger)
+
+	ops, err := logic.AssembleString("#pragma version 6\nbyte \"hello\"\nlog\nint 1")
+	require.NoError(t, err)
+	clear, err := logic.AssembleString("#pragma version 6\nint 1")
+	require.NoError(t, err)
+
+	create := transactions.Transaction{
+		Type:   protocol.ApplicationCallTx,
+		Header: env.header(env.addrs[0]),
+		ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+			ApprovalProgram:   ops.Program,
+			ClearStateProgram: clear.Program,
+		},
+	}
+	result, err := s.SimulateTransactionGroup([]transactions.SignedTxn{{Txn: create}})
+	require.NoError(t, err)
+	require.True(t, result.WouldSucceed, result.FailureMessage)
+	ad := result.TxnResults[0].Txn.ApplyData
+	require.Equal(t, []string{"hello"}, ad.EvalDelta.Logs)
+	require.NotZero(t, ad.ApplicationID)
+	require.Contains(t, result.Delta.Creatables, basics.CreatableIndex(ad.ApplicationID))
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/internal"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// speculativeLedger is the ledger as it would be after committing a validated
+// block that follows its latest round. It is used to evaluate the block of the
+// next round before that block is committed.
+type speculativeLedger struct {
+	*Ledger
+
+	hdr   bookkeeping.BlockHeader
+	delta ledgercore.StateDelta
+}
+
+// BlockHdr is part of LedgerForEvaluator interface.
+func (l speculativeLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd == l.hdr.Round {
+		return l.hdr, nil
+	}
+	return l.Ledger.BlockHdr(rnd)
+}
+
+// CheckDup is part of LedgerForEvaluator interface.
+func (l speculativeLedger) CheckDup(currentProto config.ConsensusParams, current basics.Round, firstValid basics.Round, lastValid basics.Round, txid transactions.Txid, txl ledgercore.Txlease) error {
+	if _, confirmed := l.delta.Txids[txid]; confirmed {
+		return &ledgercore.TransactionInLedgerError{Txid: txid}
+	}
+
+	if currentProto.SupportTransactionLeases && (txl.Lease != [32]byte{}) {
+		// mirror the rounds txTail.checkDup looks the leases up in
+		checked := currentProto.FixTransactionLeases || (firstValid <= l.hdr.Round && l.hdr.Round <= lastValid)
+		if expires, ok := l.delta.Txleases[txl]; ok && checked && current <= expires {
+			return ledgercore.MakeLeaseInLedgerError(txid, txl)
+		}
+	}
+
+	return l.Ledger.CheckDup(currentProto, current, firstValid, lastValid, txid, txl)
+}
+
+// LookupWithoutRewards is part of LedgerForEvaluator interface.
+func (l speculativeLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	if rnd == l.hdr.Round {
+		if data, ok := l.delta.Accts.GetData(addr); ok {
+			return data, rnd, nil
+		}
+		data, _, err := l.Ledger.LookupWithoutRewards(rnd-1, addr)
+		return data, rnd, err
+	}
+	return l.Ledger.LookupWithoutRewards(rnd, addr)
+}
+
+// LookupApplication is part of LedgerForEvaluator interface.
+func (l speculativeLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	if rnd == l.hdr.Round {
+		if r, ok := l.delta.Accts.GetResource(addr, basics.CreatableIndex(aidx), basics.AppCreatable); ok {
+			return ledgercore.AppResource{AppParams: r.AppParams, AppLocalState: r.AppLocalState}, nil
+		}
+		rnd--
+	}
+	return l.Ledger.LookupApplication(rnd, addr, aidx)
+}
+
+// LookupAsset is part of LedgerForEvaluator interface.
+func (l speculativeLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	if rnd == l.hdr.Round {
+		if r, ok := l.delta.Accts.GetResource(addr, basics.CreatableIndex(aidx), basics.AssetCreatable); ok {
+			return ledgercore.AssetResource{AssetParams: r.AssetParams, AssetHolding: r.AssetHolding}, nil
+		}
+		rnd--
+	}
+	return l.Ledger.LookupAsset(rnd, addr, aidx)
+}
+
+// GetCreatorForRound is part of LedgerForEvaluator interface.
+func (l speculativeLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	if rnd == l.hdr.Round {
+		if creatable, ok := l.delta.Creatables[cidx]; ok && creatable.Ctype == ctype {
+			if !creatable.Created {
+				return basics.Address{}, false, nil
+			}
+			return creatable.Creator, true, nil
+		}
+		rnd--
+	}
+	return l.Ledger.GetCreatorForRound(rnd, cidx, ctype)
+}
+
+// LookupKv is part of LedgerForEvaluator interface.
+func (l speculativeLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	if rnd == l.hdr.Round {
+		if mod, ok := l.delta.KvMods[key]; ok {
+			return mod.Data, nil
+		}
+		rnd--
+	}
+	return l.Ledger.LookupKv(rnd, key)
+}
+
+// LatestTotals is part of LedgerForEvaluator interface.
+func (l speculativeLedger) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	return l.hdr.Round, l.delta.Totals, nil
+}
+
+// StartSpeculativeEvaluator creates a BlockEvaluator for the block that
+// follows prev, a validated block of the round following the latest round of
+// the ledger which is not yet committed to the ledger. The block it generates
+// is only valid if prev is the block committed next.
+func (l *Ledger) StartSpeculativeEvaluator(prev ledgercore.ValidatedBlock, hdr bookkeeping.BlockHeader, paysetHint, maxTxnBytesPerBlock int) (*internal.BlockEvaluator, error) {
+	prevHdr := prev.Block().BlockHeader
+	if latest := l.Latest(); prevHdr.Round != latest+1 {
+		return nil, ledgercore.ErrNonSequentialBlockEval{EvaluatorRound: hdr.Round, LatestRound: latest}
+	}
+	return internal.StartEvaluator(speculativeLedger{Ledger: l, hdr: prevHdr, delta: prev.Delta()}, hdr,
+		internal.EvaluatorOptions{
+			PaysetHint:          paysetHint,
+			Generate:            true,
+			Validate:            true,
+			MaxTxnBytesPerBlock: maxTxnBytesPerBlock,
+		})
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/execpool"
+)
+
+func TestSpeculativeEvaluator(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, keys := ledgertesting.NewTestGenesis()
+	secrets := make(map[basics.Address]*crypto.SignatureSecrets)
+	for i, addr := range addrs {
+		secrets[addr] = keys[i]
+	}
+	l := newTestLedger(t, genBalances)
+	defer l.Close()
+
+	proto := config.Consensus[protocol.ConsensusFuture]
+	genesisAmount := genBalances.Balances[addrs[0]].MicroAlgos.Raw
+	pay := func(hdr bookkeeping.BlockHeader, from, to basics.Address, amount uint64) transactions.SignedTxnWithAD {
+		txn := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      from,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  hdr.Round,
+				LastValid:   hdr.Round + 100,
+				GenesisHash: l.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: to,
+				Amount:   basics.MicroAlgos{Raw: amount},
+			},
+		}
+		return transactions.SignedTxnWithAD{SignedTxn: sign(secrets, txn)}
+	}
+
+	// round 1 moves most of the algos of addrs[0] to addrs[1]
+	genesisHdr, err := l.BlockHdr(0)
+	require.NoError(t, err)
+	eval, err := l.StartEvaluator(bookkeeping.MakeBlock(genesisHdr).BlockHeader, 0, 0)
+	require.NoError(t, err)
+	moved := pay(genesisHdr, addrs[0], addrs[1], genesisAmount-1000000)
+	require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{moved}))
+	vb1, err := eval.GenerateBlock()
+	require.NoError(t, err)
+
+	// round 2 is evaluated on top of round 1 before it is committed
+	hdr2 := bookkeeping.MakeBlock(vb1.Block().BlockHeader).BlockHeader
+	eval, err = l.StartSpeculativeEvaluator(*vb1, hdr2, 0, 0)
+	require.NoError(t, err)
+	err = eval.TransactionGroup([]transactions.SignedTxnWithAD{moved})
+	var inLedger *ledgercore.TransactionInLedgerError
+	require.True(t, errors.As(err, &inLedger), "%v", err)
+	require.Error(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{pay(genesisHdr, addrs[0], addrs[2], 1000000)}))
+	require.NoError(t, eval.TransactionGroup([]transactions.SignedTxnWithAD{pay(genesisHdr, addrs[1], addrs[2], genesisAmount+1000000)}))
+	vb2, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.Len(t, vb2.Block().Payset, 1)
+
+	// the speculative evaluator is only available for the round following the latest one
+	_, err = l.StartSpeculativeEvaluator(*vb2, bookkeeping.MakeBlock(vb2.Block().BlockHeader).BlockHeader, 0, 0)
+	var nonSeq ledgercore.ErrNonSequentialBlockEval
+	require.True(t, errors.As(err, &nonSeq), "%v", err)
+
+	// once round 1 is committed, the block of round 2 is valid, with the same state delta
+	require.NoError(t, l.AddValidatedBlock(*vb1, agreement.Certificate{}))
+	backlogPool := execpool.MakeBacklog(nil, 0, execpool.LowPriority, nil)
+	defer backlogPool.Shutdown()
+	validated, err := l.Validate(context.Background(), vb2.Block(), backlogPool)
+	require.NoError(t, err)
+	require.Equal(t, vb2.Delta().Totals, validated.Delta().Totals)
+	for _, addr := range addrs[:3] {
+		expected, ok := vb2.Delta().Accts.GetData(addr)
+		actual, _ := validated.Delta().Accts.GetData(addr)
+		require.Equal(t, expected, actual)
+		require.Equal(t, addr != addrs[0], ok)
+	}
+	require.NoError(t, l.AddValidatedBlock(*vb2, agreement.Certificate{}))
+}
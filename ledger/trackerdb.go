@@ -167,6 +167,12 @@ func trackerDBInitializeImpl(ctx context.Context, tx *sql.Tx, params trackerDBPa
 					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 5 : %v", err)
 					return
 				}
+			case 6:
+				err = tu.upgradeDatabaseSchema6(ctx, tx)
+				if err != nil {
+					tu.log.Warnf("trackerDBInitialize failed to upgrade accounts database (ledger.tracker.sqlite) from schema 6 : %v", err)
+					return
+				}
 			default:
 				return trackerDBInitParams{}, fmt.Errorf("trackerDBInitialize unable to upgrade database from schema version %d", tu.schemaVersion)
 			}
@@ -412,6 +418,18 @@ func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema5(ctx context.Context
 	return tu.setVersion(ctx, tx, 6)
 }
 
+// upgradeDatabaseSchema6 upgrades the database schema from version 6 to version 7,
+// adding the kvstore table used for application boxes.
+func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema6(ctx context.Context, tx *sql.Tx) (err error) {
+	err = accountsCreateKvTable(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("upgradeDatabaseSchema6 unable to create kvstore table : %v", err)
+	}
+
+	// update version
+	return tu.setVersion(ctx, tx, 7)
+}
+
 // isDirEmpty returns if a given directory is empty or not.
 func isDirEmpty(path string) (bool, error) {
 	dir, err := os.Open(path)
@@ -26,6 +26,7 @@ import (
 	algodclient "github.com/algorand/go-algorand/daemon/algod/api/client"
 	v2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2"
 	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	privateV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/private"
 	kmdclient "github.com/algorand/go-algorand/daemon/kmd/client"
 	"github.com/algorand/go-algorand/rpcs"
 
@@ -963,6 +964,15 @@ func (c *Client) GetParticipationKeys() (resp generated.ParticipationKeysRespons
 	return
 }
 
+// AgreementTimeline gets the agreement timelines of the recent rounds, or of the given round if it is not zero.
+func (c *Client) AgreementTimeline(round uint64) (resp privateV2.AgreementTimelineResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.AgreementTimeline(round)
+	}
+	return
+}
+
 // GetParticipationKeyByID looks up a specific participation key by its participationID.
 func (c *Client) GetParticipationKeyByID(id string) (resp generated.ParticipationKeyResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -1035,13 +1045,14 @@ func (c *Client) AbortCatchup() error {
 	return nil
 }
 
-// Catchup start catching up to the give catchpoint label.
-func (c *Client) Catchup(catchpointLabel string) error {
+// Catchup start catching up to the give catchpoint label. If source isn't empty, the node loads the ledger
+// from the catchpoint file at that location instead of downloading it from the peers.
+func (c *Client) Catchup(catchpointLabel string, source string) error {
 	algod, err := c.ensureAlgodClient()
 	if err != nil {
 		return err
 	}
-	_, err = algod.Catchup(catchpointLabel)
+	_, err = algod.Catchup(catchpointLabel, source)
 	if err != nil {
 		return err
 	}
@@ -1184,6 +1195,16 @@ func (c *Client) Dryrun(data []byte) (resp generatedV2.DryrunResponse, err error
 	return
 }
 
+// CompactCert returns the compact certificate covering the given round, along with the block headers linking
+// the block header of the round to the certified one.
+func (c *Client) CompactCert(round uint64) (resp generatedV2.CompactCertResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.CompactCert(round)
+	}
+	return
+}
+
 // TxnProof returns a Merkle proof for a transaction in a block.
 func (c *Client) TxnProof(txid string, round uint64, hashType crypto.HashType) (resp generatedV2.ProofResponse, err error) {
 	algod, err := c.ensureAlgodClient()
@@ -0,0 +1,242 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package lightclient follows the chain through compact certificates only. Starting from a trusted
+// block header, it verifies the block header of every CompactCertRounds-th round with the compact
+// certificate signed by the voters committed to by the previously verified one; the headers in between
+// are verified on demand through their hash links to the following verified header.
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// ErrRoundNotCertified is returned for block headers following the latest certified block header.
+var ErrRoundNotCertified = errors.New("block header not certified yet")
+
+// maxCachedHeaders is the number of block headers verified through their hash links that are kept in memory.
+const maxCachedHeaders = 4096
+
+// advancePollInterval is the time to wait before polling for the next compact certificate again.
+const advancePollInterval = 5 * time.Second
+
+// ParseTrustedHeader parses a trusted block header given as "<round>#<block hash>".
+func ParseTrustedHeader(s string) (rnd basics.Round, hash bookkeeping.BlockHash, err error) {
+	parts := strings.Split(s, "#")
+	if len(parts) != 2 {
+		return 0, bookkeeping.BlockHash{}, fmt.Errorf("trusted block header '%s' is not of the form <round>#<block hash>", s)
+	}
+	round, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return 0, bookkeeping.BlockHash{}, fmt.Errorf("invalid trusted block header round '%s' : %w", parts[0], err)
+	}
+	digest, err := crypto.DigestFromString(parts[1])
+	if err != nil {
+		return 0, bookkeeping.BlockHash{}, fmt.Errorf("invalid trusted block header hash '%s' : %w", parts[1], err)
+	}
+	return basics.Round(round), bookkeeping.BlockHash(digest), nil
+}
+
+// Follower tracks the block headers certified by compact certificates, starting from a trusted block header.
+type Follower struct {
+	source Source
+	log    logging.Logger
+
+	mu deadlock.RWMutex
+	// trusted is the block header the follower started from.
+	trusted bookkeeping.BlockHeader
+	// latest is the latest block header verified through a compact certificate.
+	latest bookkeeping.BlockHeader
+	// certified holds the trusted block header and every block header verified through a compact certificate.
+	certified map[basics.Round]bookkeeping.BlockHeader
+	// linked holds block headers verified through their hash links; it is reset once it reaches maxCachedHeaders.
+	linked map[basics.Round]bookkeeping.BlockHeader
+}
+
+// MakeFollower creates a follower starting from the block header with the given round and hash. The trusted
+// block header must be one committing to compact cert voters, that is, one whose round is a multiple of
+// CompactCertRounds.
+func MakeFollower(ctx context.Context, source Source, trustedRound basics.Round, trustedHash bookkeeping.BlockHash, log logging.Logger) (*Follower, error) {
+	hdr, err := source.BlockHeader(ctx, trustedRound)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve trusted block header %d : %w", trustedRound, err)
+	}
+	if hdr.Round != trustedRound || hdr.Hash() != trustedHash {
+		return nil, fmt.Errorf("retrieved block header %d does not match the trusted block header %d#%s", hdr.Round, trustedRound, crypto.Digest(trustedHash).String())
+	}
+	proto := config.Consensus[hdr.CurrentProtocol]
+	if proto.CompactCertRounds == 0 {
+		return nil, fmt.Errorf("compact certs are not enabled in protocol %s of the trusted block header", hdr.CurrentProtocol)
+	}
+	if hdr.Round%basics.Round(proto.CompactCertRounds) != 0 {
+		return nil, fmt.Errorf("trusted block header %d is not a multiple of %d", hdr.Round, proto.CompactCertRounds)
+	}
+	return &Follower{
+		source:    source,
+		log:       log,
+		trusted:   hdr,
+		latest:    hdr,
+		certified: map[basics.Round]bookkeeping.BlockHeader{hdr.Round: hdr},
+		linked:    make(map[basics.Round]bookkeeping.BlockHeader),
+	}, nil
+}
+
+// Latest returns the latest block header verified through a compact certificate.
+func (f *Follower) Latest() bookkeeping.BlockHeader {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.latest
+}
+
+// Advance verifies the block header certified by the compact certificate following the latest certified
+// block header. It returns ErrNoCompactCert if that compact certificate has not been committed yet.
+func (f *Follower) Advance(ctx context.Context) error {
+	latest := f.Latest()
+	proto := config.Consensus[latest.CurrentProtocol]
+	if proto.CompactCertRounds == 0 {
+		return fmt.Errorf("compact certs are not enabled in protocol %s of block header %d", latest.CurrentProtocol, latest.Round)
+	}
+	rnd := latest.Round + basics.Round(proto.CompactCertRounds)
+	cert, err := f.source.CompactCert(ctx, rnd)
+	if err != nil {
+		return err
+	}
+	hdr, err := f.source.BlockHeader(ctx, rnd)
+	if err != nil {
+		return err
+	}
+	err = verifyCompactCert(latest, hdr, &cert)
+	if err != nil {
+		return err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.certified[hdr.Round] = hdr
+	f.latest = hdr
+	f.log.Infof("lightclient: verified block header %d through its compact cert", hdr.Round)
+	return nil
+}
+
+// Run advances the follower as compact certificates get committed, until the context is canceled.
+func (f *Follower) Run(ctx context.Context) {
+	for {
+		err := f.Advance(ctx)
+		if err == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if !errors.Is(err, ErrNoCompactCert) {
+			f.log.Warnf("lightclient: unable to advance past block header %d : %v", f.Latest().Round, err)
+		}
+		select {
+		case <-time.After(advancePollInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// lookup returns the verified block header of the given round, if it is held in memory.
+// The caller must hold the read lock.
+func (f *Follower) lookup(rnd basics.Round) (hdr bookkeeping.BlockHeader, ok bool) {
+	hdr, ok = f.certified[rnd]
+	if !ok {
+		hdr, ok = f.linked[rnd]
+	}
+	return
+}
+
+// BlockHeader returns the verified block header of the given round. Block headers between the trusted block
+// header and the latest certified one are verified by following the hash links back from the nearest
+// following verified block header.
+func (f *Follower) BlockHeader(ctx context.Context, rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	f.mu.RLock()
+	hdr, ok := f.lookup(rnd)
+	trusted, latest := f.trusted.Round, f.latest.Round
+	var next bookkeeping.BlockHeader
+	if !ok && rnd > trusted && rnd < latest {
+		for r := rnd + 1; ; r++ {
+			if next, ok = f.lookup(r); ok {
+				break
+			}
+		}
+		ok = false
+	}
+	f.mu.RUnlock()
+	if ok {
+		return hdr, nil
+	}
+	if rnd < trusted {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("block header %d precedes the trusted block header %d", rnd, trusted)
+	}
+	if rnd > latest {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("%w: block header %d follows the latest certified block header %d", ErrRoundNotCertified, rnd, latest)
+	}
+
+	for next.Round > rnd {
+		hdr, err := f.source.BlockHeader(ctx, next.Round-1)
+		if err != nil {
+			return bookkeeping.BlockHeader{}, err
+		}
+		err = verifyBranch(hdr, next)
+		if err != nil {
+			return bookkeeping.BlockHeader{}, err
+		}
+		f.mu.Lock()
+		if len(f.linked) >= maxCachedHeaders {
+			f.linked = make(map[basics.Round]bookkeeping.BlockHeader)
+		}
+		f.linked[hdr.Round] = hdr
+		f.mu.Unlock()
+		next = hdr
+	}
+	return next, nil
+}
+
+// TransactionProof returns the proof that the given transaction is committed to by the block header of the
+// given round, after verifying it against the verified block header.
+func (f *Follower) TransactionProof(ctx context.Context, rnd basics.Round, txid transactions.Txid) (TransactionProof, error) {
+	hdr, err := f.BlockHeader(ctx, rnd)
+	if err != nil {
+		return TransactionProof{}, err
+	}
+	proof, err := f.source.TransactionProof(ctx, rnd, txid)
+	if err != nil {
+		return TransactionProof{}, err
+	}
+	err = verifyTransactionProof(hdr, txid, &proof)
+	if err != nil {
+		return TransactionProof{}, err
+	}
+	return proof, nil
+}
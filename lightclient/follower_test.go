@@ -0,0 +1,288 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testCompactCertRounds = 256
+
+// testSource is a Source serving a chain of block headers built by makeTestChain.
+type testSource struct {
+	headers []bookkeeping.BlockHeader
+	certs   map[basics.Round]compactcert.Cert
+	proofs  map[transactions.Txid]TransactionProof
+}
+
+func (s *testSource) BlockHeader(ctx context.Context, rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if int(rnd) >= len(s.headers) {
+		return bookkeeping.BlockHeader{}, fmt.Errorf("no block header %d", rnd)
+	}
+	return s.headers[rnd], nil
+}
+
+func (s *testSource) CompactCert(ctx context.Context, rnd basics.Round) (compactcert.Cert, error) {
+	cert, ok := s.certs[rnd]
+	if !ok {
+		return compactcert.Cert{}, ErrNoCompactCert
+	}
+	return cert, nil
+}
+
+func (s *testSource) TransactionProof(ctx context.Context, rnd basics.Round, txid transactions.Txid) (TransactionProof, error) {
+	proof, ok := s.proofs[txid]
+	if !ok {
+		return TransactionProof{}, fmt.Errorf("no transaction %s", txid.String())
+	}
+	return proof, nil
+}
+
+// makeTestChain builds a chain of block headers up to the given round, committing to compact cert voters every
+// testCompactCertRounds rounds, along with the compact certificates of these block headers. The block of
+// txnRound holds a few transactions, whose proofs are served by the source.
+func makeTestChain(t *testing.T, lastRound basics.Round, txnRound basics.Round) (*testSource, []transactions.Txid) {
+	const numParticipants = 4
+	var signers []*merklesignature.Secrets
+	var parts []basics.Participant
+	for i := 0; i < numParticipants; i++ {
+		signer, err := merklesignature.New(0, uint64(lastRound)+testCompactCertRounds, testCompactCertRounds)
+		require.NoError(t, err)
+		signers = append(signers, signer)
+		parts = append(parts, basics.Participant{PK: *signer.GetVerifier(), Weight: 1000})
+	}
+	partcom, err := merklearray.BuildVectorCommitmentTree(basics.ParticipantsArray(parts), crypto.HashFactory{HashType: compactcert.HashType})
+	require.NoError(t, err)
+
+	source := &testSource{
+		certs:  make(map[basics.Round]compactcert.Cert),
+		proofs: make(map[transactions.Txid]TransactionProof),
+	}
+	var genesisHash crypto.Digest
+	crypto.RandBytes(genesisHash[:])
+	var txids []transactions.Txid
+	for rnd := basics.Round(0); rnd <= lastRound; rnd++ {
+		var blk bookkeeping.Block
+		blk.CurrentProtocol = protocol.ConsensusFuture
+		blk.BlockHeader.Round = rnd
+		blk.BlockHeader.GenesisHash = genesisHash
+		if rnd > 0 {
+			blk.Branch = source.headers[rnd-1].Hash()
+		}
+		if rnd%testCompactCertRounds == 0 {
+			blk.CompactCert = map[protocol.CompactCertType]bookkeeping.CompactCertState{
+				protocol.CompactCertBasic: {
+					CompactCertVoters:      partcom.Root(),
+					CompactCertVotersTotal: basics.MicroAlgos{Raw: numParticipants * 1000},
+				},
+			}
+		}
+		if rnd == txnRound {
+			for i := uint64(0); i < 5; i++ {
+				txn := transactions.Transaction{
+					Type:             protocol.PaymentTx,
+					Header:           transactions.Header{GenesisHash: genesisHash},
+					PaymentTxnFields: transactions.PaymentTxnFields{Amount: basics.MicroAlgos{Raw: i}},
+				}
+				stib, err := blk.BlockHeader.EncodeSignedTxn(transactions.SignedTxn{Txn: txn}, transactions.ApplyData{})
+				require.NoError(t, err)
+				blk.Payset = append(blk.Payset, stib)
+				txids = append(txids, txn.ID())
+			}
+			blk.TxnCommitments, err = blk.PaysetCommit()
+			require.NoError(t, err)
+			tree, err := blk.TxnMerkleTree()
+			require.NoError(t, err)
+			for i, txid := range txids {
+				singleLeafProof, err := tree.ProveSingleLeaf(uint64(i))
+				require.NoError(t, err)
+				stibHash := blk.Payset[i].Hash()
+				proof, err := MakeTransactionProof(uint64(i), stibHash[:], uint64(singleLeafProof.TreeDepth), singleLeafProof.GetConcatenatedProof())
+				require.NoError(t, err)
+				source.proofs[txid] = proof
+			}
+		}
+		source.headers = append(source.headers, blk.BlockHeader)
+	}
+
+	for rnd := basics.Round(2 * testCompactCertRounds); rnd <= lastRound; rnd += testCompactCertRounds {
+		hdr := source.headers[rnd]
+		params, err := ledger.CompactCertParams(source.headers[rnd-testCompactCertRounds], hdr)
+		require.NoError(t, err)
+		builder, err := compactcert.MkBuilder(params, parts, partcom)
+		require.NoError(t, err)
+		for i, signer := range signers {
+			sig, err := signer.GetSigner(uint64(rnd)).Sign(hdr)
+			require.NoError(t, err)
+			require.NoError(t, builder.Add(uint64(i), sig, true))
+		}
+		cert, err := builder.Build()
+		require.NoError(t, err)
+		source.certs[rnd] = *cert
+	}
+	return source, txids
+}
+
+func TestFollower(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source, txids := makeTestChain(t, 4*testCompactCertRounds+10, 3*testCompactCertRounds-50)
+	trusted := source.headers[testCompactCertRounds]
+	ctx := context.Background()
+
+	_, err := MakeFollower(ctx, source, trusted.Round, source.headers[0].Hash(), logging.TestingLog(t))
+	require.Error(t, err)
+	_, err = MakeFollower(ctx, source, trusted.Round+1, source.headers[trusted.Round+1].Hash(), logging.TestingLog(t))
+	require.Error(t, err)
+
+	follower, err := MakeFollower(ctx, source, trusted.Round, trusted.Hash(), logging.TestingLog(t))
+	require.NoError(t, err)
+	require.NoError(t, follower.Advance(ctx))
+	require.Equal(t, source.headers[2*testCompactCertRounds], follower.Latest())
+	require.NoError(t, follower.Advance(ctx))
+	require.NoError(t, follower.Advance(ctx))
+	require.Equal(t, source.headers[4*testCompactCertRounds], follower.Latest())
+	require.True(t, errors.Is(follower.Advance(ctx), ErrNoCompactCert))
+
+	// block headers between certified ones are verified through their hash links.
+	for _, rnd := range []basics.Round{testCompactCertRounds, 2*testCompactCertRounds - 1, 3*testCompactCertRounds - 50, 4 * testCompactCertRounds} {
+		hdr, err := follower.BlockHeader(ctx, rnd)
+		require.NoError(t, err)
+		require.Equal(t, source.headers[rnd], hdr)
+	}
+	_, err = follower.BlockHeader(ctx, testCompactCertRounds-1)
+	require.Error(t, err)
+	_, err = follower.BlockHeader(ctx, 4*testCompactCertRounds+1)
+	require.True(t, errors.Is(err, ErrRoundNotCertified))
+
+	for _, txid := range txids {
+		proof, err := follower.TransactionProof(ctx, 3*testCompactCertRounds-50, txid)
+		require.NoError(t, err)
+		require.Equal(t, source.proofs[txid], proof)
+	}
+	// a proof of a transaction against a block header that does not commit to it is rejected.
+	_, err = follower.TransactionProof(ctx, 3*testCompactCertRounds-51, txids[0])
+	require.Error(t, err)
+	source.proofs[txids[1]] = source.proofs[txids[0]]
+	_, err = follower.TransactionProof(ctx, 3*testCompactCertRounds-50, txids[1])
+	require.Error(t, err)
+}
+
+func TestFollowerRejectsForgedHeaders(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source, _ := makeTestChain(t, 3*testCompactCertRounds, 0)
+	trusted := source.headers[testCompactCertRounds]
+	ctx := context.Background()
+
+	// a certified block header differing from the one signed by the voters is rejected.
+	certified := source.headers[2*testCompactCertRounds]
+	source.headers[2*testCompactCertRounds].TimeStamp++
+	follower, err := MakeFollower(ctx, source, trusted.Round, trusted.Hash(), logging.TestingLog(t))
+	require.NoError(t, err)
+	require.Error(t, follower.Advance(ctx))
+	require.Equal(t, trusted, follower.Latest())
+	source.headers[2*testCompactCertRounds] = certified
+
+	// a compact certificate of another block header is rejected.
+	cert := source.certs[2*testCompactCertRounds]
+	source.certs[2*testCompactCertRounds] = source.certs[3*testCompactCertRounds]
+	require.Error(t, follower.Advance(ctx))
+	require.Equal(t, trusted, follower.Latest())
+	delete(source.certs, 2*testCompactCertRounds)
+	require.True(t, errors.Is(follower.Advance(ctx), ErrNoCompactCert))
+	source.certs[2*testCompactCertRounds] = cert
+	require.NoError(t, follower.Advance(ctx))
+
+	// an intermediate block header not linked to the following verified block header is rejected.
+	source.headers[testCompactCertRounds+100].TimeStamp++
+	_, err = follower.BlockHeader(ctx, testCompactCertRounds+50)
+	require.Error(t, err)
+	_, err = follower.BlockHeader(ctx, testCompactCertRounds+101)
+	require.NoError(t, err)
+}
+
+func TestCertifiedHeaders(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	source, _ := makeTestChain(t, 2*testCompactCertRounds, 0)
+	certRound := basics.Round(2 * testCompactCertRounds)
+	cert := source.certs[certRound]
+	response := generatedV2.CompactCertResponse{
+		CertRound:    uint64(certRound),
+		FirstRound:   uint64(certRound - testCompactCertRounds + 1),
+		Cert:         protocol.Encode(&cert),
+		VotersHeader: protocol.Encode(&source.headers[testCompactCertRounds]),
+	}
+	for rnd := certRound - 100; rnd <= certRound; rnd++ {
+		response.Headers = append(response.Headers, protocol.Encode(&source.headers[rnd]))
+	}
+
+	certified, err := DecodeCertifiedHeaders(response)
+	require.NoError(t, err)
+	require.Equal(t, source.headers[certRound-100:], certified.Headers)
+	require.NoError(t, certified.Verify())
+
+	// a block header not linked to the following one is rejected.
+	certified.Headers[50].TimeStamp++
+	require.Error(t, certified.Verify())
+	certified.Headers[50].TimeStamp--
+
+	// a compact certificate verified against other voters is rejected.
+	certified.VotersHeader = source.headers[0]
+	require.Error(t, certified.Verify())
+
+	// the block headers must end at the certified block header.
+	response.Headers = response.Headers[:len(response.Headers)-1]
+	_, err = DecodeCertifiedHeaders(response)
+	require.Error(t, err)
+}
+
+func TestParseTrustedHeader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var hdr bookkeeping.BlockHeader
+	hdr.Round = 512
+	hash := hdr.Hash()
+	rnd, parsedHash, err := ParseTrustedHeader(fmt.Sprintf("512#%s", crypto.Digest(hash).String()))
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(512), rnd)
+	require.Equal(t, hash, parsedHash)
+
+	for _, invalid := range []string{"", "512", "512#", "x#" + crypto.Digest(hash).String(), "512#abc#def"} {
+		_, _, err = ParseTrustedHeader(invalid)
+		require.Error(t, err, invalid)
+	}
+}
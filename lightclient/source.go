@@ -0,0 +1,145 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	"github.com/algorand/go-algorand/daemon/algod/api/client"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+// ErrNoCompactCert is returned by a Source when no compact certificate for the requested round has been
+// committed yet.
+var ErrNoCompactCert = errors.New("no compact certificate committed for round")
+
+// Source provides the light client with unverified block headers, compact certificates and
+// transaction proofs. None of the data returned by a Source is trusted; the Follower verifies it.
+type Source interface {
+	// BlockHeader returns the header of the block of the given round.
+	BlockHeader(ctx context.Context, rnd basics.Round) (bookkeeping.BlockHeader, error)
+	// CompactCert returns the compact certificate certifying the block header of the given round,
+	// or ErrNoCompactCert if no such certificate has been committed yet.
+	CompactCert(ctx context.Context, rnd basics.Round) (compactcert.Cert, error)
+	// TransactionProof returns the proof that the given transaction is committed to by the
+	// block header of the given round.
+	TransactionProof(ctx context.Context, rnd basics.Round, txid transactions.Txid) (TransactionProof, error)
+}
+
+// TransactionProof is a proof that a transaction is committed to by the transactions commitment of a block header.
+type TransactionProof struct {
+	// Index is the index of the transaction in the block's payset.
+	Index uint64
+	// StibHash is the hash of the SignedTxnInBlock, as committed to in the Merkle tree leaf.
+	StibHash crypto.Digest
+	// Proof is the Merkle proof of the leaf against the block header's NativeSha512_256Commitment.
+	Proof merklearray.Proof
+}
+
+// MakeTransactionProof decodes a transaction proof in the format returned by
+// /v2/blocks/{round}/transactions/{txid}/proof, for the sha512_256 hash type.
+func MakeTransactionProof(index uint64, stibHash []byte, treeDepth uint64, concatenatedProof []byte) (proof TransactionProof, err error) {
+	if len(stibHash) != crypto.DigestSize {
+		return TransactionProof{}, fmt.Errorf("invalid stibhash length %d", len(stibHash))
+	}
+	if treeDepth > merklearray.MaxEncodedTreeDepth || len(concatenatedProof) != int(treeDepth)*crypto.DigestSize {
+		return TransactionProof{}, fmt.Errorf("invalid proof of length %d for tree depth %d", len(concatenatedProof), treeDepth)
+	}
+	proof.Index = index
+	copy(proof.StibHash[:], stibHash)
+	proof.Proof.HashFactory = crypto.HashFactory{HashType: crypto.Sha512_256}
+	proof.Proof.TreeDepth = uint8(treeDepth)
+	for len(concatenatedProof) > 0 {
+		proof.Proof.Path = append(proof.Proof.Path, crypto.GenericDigest(concatenatedProof[:crypto.DigestSize]))
+		concatenatedProof = concatenatedProof[crypto.DigestSize:]
+	}
+	return proof, nil
+}
+
+// RESTSource is a Source backed by the REST API of an algod node.
+type RESTSource struct {
+	client client.RestClient
+}
+
+// MakeRESTSource creates a Source retrieving its data from the algod node serving the REST API at the given URL.
+func MakeRESTSource(upstream url.URL, apiToken string) *RESTSource {
+	restClient := client.MakeRestClient(upstream, apiToken)
+	restClient.SetAPIVersionAffinity(client.APIVersionV2)
+	return &RESTSource{client: restClient}
+}
+
+func (s *RESTSource) block(rnd basics.Round) (bookkeeping.Block, error) {
+	raw, err := s.client.RawBlock(uint64(rnd))
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	var encoded rpcs.EncodedBlockCert
+	err = protocol.DecodeReflect(raw, &encoded)
+	if err != nil {
+		return bookkeeping.Block{}, err
+	}
+	if encoded.Block.Round() != rnd {
+		return bookkeeping.Block{}, fmt.Errorf("requested block %d but received block %d", rnd, encoded.Block.Round())
+	}
+	return encoded.Block, nil
+}
+
+// BlockHeader implements Source.
+func (s *RESTSource) BlockHeader(ctx context.Context, rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	blk, err := s.block(rnd)
+	return blk.BlockHeader, err
+}
+
+// CompactCert implements Source.
+func (s *RESTSource) CompactCert(ctx context.Context, rnd basics.Round) (compactcert.Cert, error) {
+	response, err := s.client.CompactCert(uint64(rnd))
+	if err != nil {
+		var httpError client.HTTPError
+		if errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
+			return compactcert.Cert{}, ErrNoCompactCert
+		}
+		return compactcert.Cert{}, err
+	}
+	if basics.Round(response.CertRound) != rnd {
+		return compactcert.Cert{}, fmt.Errorf("requested the compact certificate of round %d but received the one of round %d", rnd, response.CertRound)
+	}
+	certified, err := DecodeCertifiedHeaders(response)
+	if err != nil {
+		return compactcert.Cert{}, err
+	}
+	return certified.Cert, nil
+}
+
+// TransactionProof implements Source.
+func (s *RESTSource) TransactionProof(ctx context.Context, rnd basics.Round, txid transactions.Txid) (TransactionProof, error) {
+	response, err := s.client.Proof(txid.String(), uint64(rnd), crypto.Sha512_256)
+	if err != nil {
+		return TransactionProof{}, err
+	}
+	return MakeTransactionProof(response.Idx, response.Stibhash, response.Treedepth, response.Proof)
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/compactcert"
+	"github.com/algorand/go-algorand/crypto/merklearray"
+	generatedV2 "github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// verifyCompactCert verifies that the given compact certificate certifies the block header hdr, signed by the
+// voters committed to by the block header votersHdr.
+func verifyCompactCert(votersHdr bookkeeping.BlockHeader, hdr bookkeeping.BlockHeader, cert *compactcert.Cert) error {
+	voters := votersHdr.CompactCert[protocol.CompactCertBasic].CompactCertVoters
+	if voters.IsEmpty() {
+		return fmt.Errorf("block header %d does not commit to compact cert voters", votersHdr.Round)
+	}
+	params, err := ledger.CompactCertParams(votersHdr, hdr)
+	if err != nil {
+		return err
+	}
+	err = compactcert.MkVerifier(params, voters).Verify(cert)
+	if err != nil {
+		return fmt.Errorf("invalid compact cert for block header %d : %w", hdr.Round, err)
+	}
+	return nil
+}
+
+// verifyBranch verifies that the block header hdr is the one preceding the block header next.
+func verifyBranch(hdr bookkeeping.BlockHeader, next bookkeeping.BlockHeader) error {
+	if hdr.Round+1 != next.Round {
+		return fmt.Errorf("block header %d does not precede block header %d", hdr.Round, next.Round)
+	}
+	if hdr.Hash() != next.Branch {
+		return fmt.Errorf("hash of block header %d does not match the branch of block header %d", hdr.Round, next.Round)
+	}
+	return nil
+}
+
+// txnMerkleLeaf is a leaf of the transactions Merkle tree of a block, as committed to by the
+// block header's NativeSha512_256Commitment.
+type txnMerkleLeaf struct {
+	txid     crypto.Digest
+	stibHash crypto.Digest
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (l *txnMerkleLeaf) ToBeHashed() (protocol.HashID, []byte) {
+	buf := make([]byte, 2*crypto.DigestSize)
+	copy(buf, l.txid[:])
+	copy(buf[crypto.DigestSize:], l.stibHash[:])
+	return protocol.TxnMerkleLeaf, buf
+}
+
+// verifyTransactionProof verifies that the given transaction is committed to by the block header hdr.
+func verifyTransactionProof(hdr bookkeeping.BlockHeader, txid transactions.Txid, proof *TransactionProof) error {
+	elems := map[uint64]crypto.Hashable{
+		proof.Index: &txnMerkleLeaf{txid: crypto.Digest(txid), stibHash: proof.StibHash},
+	}
+	err := merklearray.Verify(hdr.TxnCommitments.NativeSha512_256Commitment.ToSlice(), elems, &proof.Proof)
+	if err != nil {
+		return fmt.Errorf("invalid proof of transaction %s in block header %d : %w", txid.String(), hdr.Round, err)
+	}
+	return nil
+}
+
+// CertifiedHeaders is a compact certificate along with block headers of the interval it certifies, as returned
+// by /v2/compactcert/{round}.
+type CertifiedHeaders struct {
+	Cert compactcert.Cert
+	// VotersHeader is the block header committing to the voters that signed the compact certificate.
+	VotersHeader bookkeeping.BlockHeader
+	// Headers are the block headers from the requested round through the certified one.
+	Headers []bookkeeping.BlockHeader
+}
+
+// DecodeCertifiedHeaders decodes a /v2/compactcert/{round} response.
+func DecodeCertifiedHeaders(response generatedV2.CompactCertResponse) (certified CertifiedHeaders, err error) {
+	err = protocol.Decode(response.Cert, &certified.Cert)
+	if err != nil {
+		return CertifiedHeaders{}, fmt.Errorf("unable to decode compact certificate : %w", err)
+	}
+	err = protocol.Decode(response.VotersHeader, &certified.VotersHeader)
+	if err != nil {
+		return CertifiedHeaders{}, fmt.Errorf("unable to decode voters block header : %w", err)
+	}
+	certified.Headers = make([]bookkeeping.BlockHeader, len(response.Headers))
+	for i, encoded := range response.Headers {
+		err = protocol.Decode(encoded, &certified.Headers[i])
+		if err != nil {
+			return CertifiedHeaders{}, fmt.Errorf("unable to decode block header : %w", err)
+		}
+	}
+	if len(certified.Headers) == 0 || certified.Headers[len(certified.Headers)-1].Round != basics.Round(response.CertRound) {
+		return CertifiedHeaders{}, fmt.Errorf("block headers do not end at the certified block header %d", response.CertRound)
+	}
+	return certified, nil
+}
+
+// Verify verifies the compact certificate against the voters committed to by the voters block header, and the
+// hash links from the first block header to the certified one. The voters block header itself is not verified.
+func (c *CertifiedHeaders) Verify() error {
+	if len(c.Headers) == 0 {
+		return errors.New("no certified block header")
+	}
+	for i := 0; i+1 < len(c.Headers); i++ {
+		err := verifyBranch(c.Headers[i], c.Headers[i+1])
+		if err != nil {
+			return err
+		}
+	}
+	return verifyCompactCert(c.VotersHeader, c.Headers[len(c.Headers)-1], &c.Cert)
+}
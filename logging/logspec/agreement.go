@@ -115,6 +115,10 @@ const (
 	// This happens before any vote is (possibly) emitted.
 	Persisted
 
+	// BlockValidated is emitted when the source validated the block of a
+	// proposal it received.
+	BlockValidated
+
 	numAgreementTypes // keep this last
 )
 
@@ -139,6 +143,7 @@ type AgreementEvent struct {
 	// - ThresholdReached: the hash for which a vote threshold was reached
 	// - ProposalFrozen: the hash on which a proposal was frozen
 	// - BlockAssembled: the hash of the block (proposal) that was assembled
+	// - BlockValidated: the hash of the block (proposal) that was validated
 	// - ProposalBroadcast/Accepted/Rejected/Resent: the hash of the proposal
 	// - VoteBroadcast/Accepted/Rejected: the hash a vote endorses
 	// - BundleBroadcast/Accepted/Rejected: the hash a bundle endorses
@@ -71,6 +71,9 @@ const AssembleBlockEmpty = "pool-empty"
 // AssembleBlockAbandon represents the block generation being abandoned since it won't be needed.
 const AssembleBlockAbandon = "block-abandon"
 
+// AssembleBlockSpeculative represents the block being assembled before the previous block was committed.
+const AssembleBlockSpeculative = "speculative"
+
 const assembleBlockMetricsIdentifier Metric = "AssembleBlock"
 
 // AssembleBlockMetrics is the set of metrics captured when we compute AssemblePayset
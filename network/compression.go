@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// A compressed message is sent with the protocol.CompressedMsgTag tag, and
+// carries the tag of the original message, the length of the original payload
+// as a uvarint and the payload compressed with deflate, primed with
+// compressionDictionary:
+//
+//   "CZ" | tag (2 bytes) | uvarint payload length | deflate(payload)
+//
+// Compressed messages are only sent to peers that negotiated one of the
+// compressionProtocolVersions, and only for the compressibleTags.
+
+// compressionProtocolVersions are the protocol versions whose peers accept compressed messages.
+var compressionProtocolVersions = map[string]bool{
+	"2.2": true,
+	"2.3": true,
+}
+
+// compressibleTags are the tags of the messages that are compressed when
+// EnableGossipCompression is set. These are the large messages made of msgpack
+// encoded transactions, which compress well.
+var compressibleTags = map[protocol.Tag]bool{
+	protocol.ProposalPayloadTag: true,
+	protocol.TxnTag:             true,
+}
+
+// tagLength is the length of the tag that starts every message.
+const tagLength = 2
+
+// maxDeflateRatio bounds the ratio of the decompressed length to the compressed
+// length of deflate data: a 258 bytes match takes at least 2 bits to encode.
+const maxDeflateRatio = 1032
+
+var networkSentCompressedBytesByTag = metrics.NewTagCounter("algod_network_sent_compressed_bytes_{TAG}", "Number of bytes that were sent over the network for compressed {TAG} messages", string(protocol.ProposalPayloadTag), string(protocol.TxnTag))
+var networkSentCompressedRawBytesByTag = metrics.NewTagCounter("algod_network_sent_compressed_raw_bytes_{TAG}", "Number of bytes that compressed {TAG} messages sent over the network would have taken uncompressed", string(protocol.ProposalPayloadTag), string(protocol.TxnTag))
+var networkReceivedCompressedBytesByTag = metrics.NewTagCounter("algod_network_received_compressed_bytes_{TAG}", "Number of bytes that were received from the network for compressed {TAG} messages", string(protocol.ProposalPayloadTag), string(protocol.TxnTag))
+var networkReceivedCompressedRawBytesByTag = metrics.NewTagCounter("algod_network_received_compressed_raw_bytes_{TAG}", "Number of bytes that compressed {TAG} messages received from the network took once decompressed", string(protocol.ProposalPayloadTag), string(protocol.TxnTag))
+
+var errCompressedMsgTooShort = errors.New("compressed message is too short")
+var errCompressedMsgTrailingData = errors.New("compressed message has trailing data")
+
+// compressionDictionary holds byte strings that are common in msgpack encoded
+// transactions, blocks and proposals, the most frequent ones last, so that
+// even short messages such as single transactions compress well. Changing it
+// requires a new entry in compressionProtocolVersions.
+var compressionDictionary = makeCompressionDictionary([]string{
+	// asset and application parameters
+	"nbs", "nui", "am", "an", "au", "c", "dc", "df", "f", "m", "r", "t", "un",
+	"apap", "apep", "apgs", "apls", "apsu", "apbx", "apan", "apar",
+	// keyregs
+	"nonpart", "selkey", "sprfkey", "votefst", "votekd", "votekey", "votelst",
+	// block headers and proposals
+	"cc", "earn", "fees", "frac", "nextbefore", "nextproto", "nextswitch", "nextyes",
+	"partupdrmv", "rate", "rwcalr", "rwd", "tc", "upgradedelay", "upgradeprop", "upgradeyes",
+	"oper", "oprop", "pi", "prev", "proto", "rnd", "sdpf", "seed", "ts", "txn256",
+	"betanet-v1.0", "testnet-v1.0", "mainnet-v1.0",
+	// apply data and signed transactions in blocks
+	"aca", "ca", "dt", "gd", "ld", "lg", "itx", "rc", "rs", "hgh", "hgi",
+	// signatures
+	"l", "arg", "lsig", "msig", "subsig", "thr", "pk", "s", "v",
+	// transactions
+	"acfg", "afrz", "appl", "axfer", "keyreg", "pay",
+	"aclose", "apid", "apaa", "apas", "apat", "apfa", "caid", "faid", "fadd",
+	"aamt", "arcv", "asnd", "xaid", "close", "rekey", "lx", "grp", "note",
+	"amt", "fee", "fv", "gen", "gh", "lv", "rcv", "snd", "type", "sig", "txn",
+})
+
+// makeCompressionDictionary concatenates the msgpack encodings of the given strings.
+func makeCompressionDictionary(strs []string) []byte {
+	var dict []byte
+	for _, s := range strs {
+		// all the strings are short enough to be msgpack fixstrs
+		dict = append(dict, 0xa0|byte(len(s)))
+		dict = append(dict, s...)
+	}
+	return dict
+}
+
+// smallMessageLength is the length under which messages are compressed with
+// flate.DefaultCompression rather than flate.BestSpeed. Only the former makes
+// use of the dictionary, which matters for short messages, while the latter
+// is fast enough for large proposals.
+const smallMessageLength = 16 * 1024
+
+var smallMessageCompressorPool = makeCompressorPool(flate.DefaultCompression)
+var largeMessageCompressorPool = makeCompressorPool(flate.BestSpeed)
+
+func makeCompressorPool(level int) *sync.Pool {
+	return &sync.Pool{
+		New: func() interface{} {
+			w, err := flate.NewWriterDict(nil, level, compressionDictionary)
+			if err != nil {
+				// only fails on an invalid compression level
+				panic(err)
+			}
+			return w
+		},
+	}
+}
+
+var decompressorPool = sync.Pool{
+	New: func() interface{} {
+		return flate.NewReaderDict(nil, compressionDictionary)
+	},
+}
+
+// compressMessage returns the compressed version of a message, made of a tag
+// followed by the payload. It returns false if the message would not be any
+// shorter compressed.
+func compressMessage(msg []byte) ([]byte, bool) {
+	payload := msg[tagLength:]
+	var buf bytes.Buffer
+	buf.Grow(len(msg))
+	buf.WriteString(string(protocol.CompressedMsgTag))
+	buf.Write(msg[:tagLength])
+	var length [binary.MaxVarintLen64]byte
+	buf.Write(length[:binary.PutUvarint(length[:], uint64(len(payload)))])
+
+	pool := largeMessageCompressorPool
+	if len(payload) < smallMessageLength {
+		pool = smallMessageCompressorPool
+	}
+	w := pool.Get().(*flate.Writer)
+	defer pool.Put(w)
+	w.Reset(&buf)
+	// writes to a bytes.Buffer do not fail
+	w.Write(payload)
+	w.Close()
+
+	if buf.Len() >= len(msg) {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+// compressMessages returns the messages as they should be sent to a peer that
+// accepts compressed messages.
+func compressMessages(tags []protocol.Tag, data [][]byte) [][]byte {
+	out := make([][]byte, len(data))
+	for i, msg := range data {
+		out[i] = msg
+		if !compressibleTags[tags[i]] {
+			continue
+		}
+		if compressed, ok := compressMessage(msg); ok {
+			out[i] = compressed
+		}
+	}
+	return out
+}
+
+// compressedMessageInfo returns the tag and the uncompressed payload length of
+// a compressed message, from its payload following the compressed message tag.
+func compressedMessageInfo(data []byte) (tag protocol.Tag, length uint64, headerLength int, err error) {
+	if len(data) < tagLength {
+		return "", 0, 0, errCompressedMsgTooShort
+	}
+	tag = protocol.Tag(data[:tagLength])
+	length, n := binary.Uvarint(data[tagLength:])
+	if n <= 0 {
+		return "", 0, 0, errCompressedMsgTooShort
+	}
+	return tag, length, tagLength + n, nil
+}
+
+// decompressMessage returns the tag and the payload of the message held by a
+// compressed message, given the payload following the compressed message tag.
+func decompressMessage(data []byte) (protocol.Tag, []byte, error) {
+	tag, length, headerLength, err := compressedMessageInfo(data)
+	if err != nil {
+		return "", nil, err
+	}
+	if tag == protocol.CompressedMsgTag {
+		return "", nil, fmt.Errorf("compressed message holds another compressed message")
+	}
+	if length > maxMessageLength {
+		return "", nil, fmt.Errorf("compressed %s message is too large: %d > %d", tag, length, maxMessageLength)
+	}
+	compressedLength := uint64(len(data) - headerLength)
+	if length > compressedLength*maxDeflateRatio {
+		return "", nil, fmt.Errorf("compressed %s message of %d bytes cannot hold %d bytes", tag, compressedLength, length)
+	}
+
+	r := decompressorPool.Get().(io.ReadCloser)
+	defer decompressorPool.Put(r)
+	in := bytes.NewReader(data[headerLength:])
+	err = r.(flate.Resetter).Reset(in, compressionDictionary)
+	if err != nil {
+		return "", nil, err
+	}
+	// the length is claimed by the peer, so rather than being allocated upfront,
+	// the payload grows with the data that actually decompresses.
+	initialCapacity := length
+	if initialCapacity > 2*compressedLength {
+		initialCapacity = 2 * compressedLength
+	}
+	payload := bytes.NewBuffer(make([]byte, 0, initialCapacity))
+	n, err := payload.ReadFrom(io.LimitReader(r, int64(length)))
+	if err == nil && uint64(n) != length {
+		err = io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot decompress %s message: %v", tag, err)
+	}
+	// the payload must be all there is, in the compressed data as well
+	var extra [1]byte
+	if n, err := r.Read(extra[:]); n != 0 || err != io.EOF || in.Len() != 0 {
+		return "", nil, errCompressedMsgTrailingData
+	}
+	return tag, payload.Bytes(), nil
+}
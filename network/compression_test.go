@@ -0,0 +1,234 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"compress/flate"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// makeTestTxnMessage returns a transaction message holding a group of n payments
+func makeTestTxnMessage(t *testing.T, n int) []byte {
+	msg := []byte(protocol.TxnTag)
+	for i := 0; i < n; i++ {
+		var sender, receiver basics.Address
+		crypto.RandBytes(sender[:])
+		crypto.RandBytes(receiver[:])
+		stxn := transactions.SignedTxn{
+			Txn: transactions.Transaction{
+				Type: protocol.PaymentTx,
+				Header: transactions.Header{
+					Sender:      sender,
+					Fee:         basics.MicroAlgos{Raw: 1000},
+					FirstValid:  1000,
+					LastValid:   2000,
+					GenesisID:   "testnet-v1.0",
+					GenesisHash: crypto.Hash([]byte("genesis")),
+				},
+				PaymentTxnFields: transactions.PaymentTxnFields{
+					Receiver: receiver,
+					Amount:   basics.MicroAlgos{Raw: 1234567},
+				},
+			},
+		}
+		crypto.RandBytes(stxn.Sig[:])
+		msg = append(msg, protocol.Encode(&stxn)...)
+	}
+	return msg
+}
+
+func TestCompressMessage(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	msg := makeTestTxnMessage(t, 2)
+	compressed, ok := compressMessage(msg)
+	require.True(t, ok)
+	require.Less(t, len(compressed), len(msg))
+	require.Equal(t, protocol.CompressedMsgTag, protocol.Tag(compressed[:tagLength]))
+
+	tag, length, _, err := compressedMessageInfo(compressed[tagLength:])
+	require.NoError(t, err)
+	require.Equal(t, protocol.TxnTag, tag)
+	require.Equal(t, uint64(len(msg)-tagLength), length)
+
+	tag, payload, err := decompressMessage(compressed[tagLength:])
+	require.NoError(t, err)
+	require.Equal(t, protocol.TxnTag, tag)
+	require.Equal(t, msg[tagLength:], payload)
+
+	// random data does not compress
+	random := make([]byte, 1000)
+	crypto.RandBytes(random)
+	_, ok = compressMessage(append([]byte(protocol.TxnTag), random...))
+	require.False(t, ok)
+
+	// only the compressible tags are compressed
+	data := compressMessages([]protocol.Tag{protocol.TxnTag, protocol.AgreementVoteTag}, [][]byte{msg, msg})
+	require.Equal(t, compressed, data[0])
+	require.Equal(t, msg, data[1])
+}
+
+func TestCompressionDictionary(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// the dictionary is what makes small groups of transactions worth compressing
+	msg := makeTestTxnMessage(t, 2)
+	compressed, ok := compressMessage(msg)
+	require.True(t, ok)
+
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	require.NoError(t, err)
+	_, err = w.Write(msg[tagLength:])
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Less(t, len(compressed), buf.Len())
+
+	// large messages are compressed too, faster
+	msg = makeTestTxnMessage(t, 100)
+	require.Greater(t, len(msg), smallMessageLength)
+	compressed, ok = compressMessage(msg)
+	require.True(t, ok)
+	require.Less(t, len(compressed), len(msg)*3/4)
+	_, payload, err := decompressMessage(compressed[tagLength:])
+	require.NoError(t, err)
+	require.Equal(t, msg[tagLength:], payload)
+}
+
+func TestDecompressMessageErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	msg := makeTestTxnMessage(t, 2)
+	compressed, ok := compressMessage(msg)
+	require.True(t, ok)
+	body := compressed[tagLength:]
+
+	_, _, err := decompressMessage(body[:1])
+	require.ErrorIs(t, err, errCompressedMsgTooShort)
+	_, _, err = decompressMessage(body[:tagLength])
+	require.ErrorIs(t, err, errCompressedMsgTooShort)
+
+	// truncated data
+	_, _, err = decompressMessage(body[:len(body)-4])
+	require.Error(t, err)
+
+	// trailing data
+	_, _, err = decompressMessage(append(append([]byte{}, body...), 0))
+	require.ErrorIs(t, err, errCompressedMsgTrailingData)
+
+	// a wrong length
+	wrongLength := append([]byte{}, body...)
+	wrongLength[tagLength]--
+	_, _, err = decompressMessage(wrongLength)
+	require.ErrorIs(t, err, errCompressedMsgTrailingData)
+	wrongLength[tagLength] += 2
+	_, _, err = decompressMessage(wrongLength)
+	require.Error(t, err)
+
+	// no nesting
+	nested := append([]byte(protocol.CompressedMsgTag), body[tagLength:]...)
+	_, _, err = decompressMessage(nested)
+	require.ErrorContains(t, err, "another compressed message")
+
+	// no messages larger than the uncompressed ones could be
+	tooLarge := []byte(protocol.TxnTag)
+	tooLarge = append(tooLarge, 0x81, 0x80, 0x80, 0x02) // maxMessageLength + 1
+	_, _, err = decompressMessage(tooLarge)
+	require.ErrorContains(t, err, "too large")
+
+	// nor larger than the compressed data could hold
+	tooShort := []byte(protocol.TxnTag)
+	tooShort = append(tooShort, 0x80, 0x80, 0x80, 0x01) // 2MB
+	tooShort = append(tooShort, body[len(body)-8:]...)
+	_, _, err = decompressMessage(tooShort)
+	require.ErrorContains(t, err, "cannot hold")
+}
+
+func TestWebsocketNetworkCompression(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.config.EnableGossipCompression = true
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netB.Stop()
+
+	received := make(chan IncomingMessage, 2)
+	handler := HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		received <- msg
+		return OutgoingMessage{}
+	})
+	netB.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.TxnTag, MessageHandler: handler},
+		{Tag: protocol.AgreementVoteTag, MessageHandler: handler},
+	})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	// A compresses what it sends to B, but not the other way around
+	peersA, _ := netA.peerSnapshot(nil)
+	require.Len(t, peersA, 1)
+	require.Equal(t, ProtocolVersion, peersA[0].version)
+	require.True(t, peersA[0].compressOutgoing)
+	peersB, _ := netB.peerSnapshot(nil)
+	require.Len(t, peersB, 1)
+	require.False(t, peersB[0].compressOutgoing)
+
+	before := make(map[string]float64)
+	networkReceivedCompressedBytesByTag.AddMetric(before)
+
+	txn := makeTestTxnMessage(t, 2)
+	netA.Broadcast(context.Background(), protocol.TxnTag, txn[tagLength:], false, nil)
+	vote := makeTestTxnMessage(t, 2)
+	netA.Broadcast(context.Background(), protocol.AgreementVoteTag, vote[tagLength:], false, nil)
+
+	got := make(map[protocol.Tag][]byte)
+	for len(got) < 2 {
+		select {
+		case msg := <-received:
+			got[msg.Tag] = msg.Data
+		case <-time.After(2 * time.Second):
+			require.FailNow(t, "timeout waiting for the messages")
+		}
+	}
+	require.Equal(t, txn[tagLength:], got[protocol.TxnTag])
+	require.Equal(t, vote[tagLength:], got[protocol.AgreementVoteTag])
+
+	after := make(map[string]float64)
+	networkReceivedCompressedBytesByTag.AddMetric(after)
+	require.Greater(t, after["algod_network_received_compressed_bytes_TX"], before["algod_network_received_compressed_bytes_TX"])
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagetracer
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"hash/fnv"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+)
+
+// Hop is the reception of a message by a node, as sent to the trace collector.
+// The collector receives the hops as JSON objects, one per line, over a TCP
+// connection to the NetworkMessageTraceServer.
+type Hop struct {
+	// Node is the address of the node that received the message. It is empty
+	// for the nodes that do not listen for connections, in which case the
+	// collector names the node after its connection.
+	Node string `json:"node"`
+	// From is the address of the peer the message was received from, if known
+	From string `json:"from,omitempty"`
+	// Prefix is the kind of message: Proposal, Vote or Transaction
+	Prefix string `json:"prefix"`
+	// Hash identifies the message, see HashMessage
+	Hash string `json:"hash"`
+	// Time is when the message was received, in nanoseconds since the epoch
+	Time int64 `json:"time"`
+}
+
+// HashMessage returns the hash identifying a message in the hops, the hex
+// encoded 64 bits FNV-1a hash of the message, as used by graphtrace.
+func HashMessage(data []byte) string {
+	hasher := fnv.New64a()
+	hasher.Write(data)
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// collectorHopQueueLength is the number of hops waiting to be sent to the
+// collector. Past it, the hops are dropped.
+const collectorHopQueueLength = 10000
+
+// collectorRetryInterval is how long the tracer waits before connecting again
+// to the collector after a failure.
+const collectorRetryInterval = 5 * time.Second
+
+type collectorMessageTracer struct {
+	log  logging.Logger
+	hops chan Hop
+
+	// ctx is canceled by Close to stop the sendThread
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewCollectorMessageTracer returns a new MessageTracer that sends the hops of
+// the messages to a trace collector, such as cmd/tracecollector.
+func NewCollectorMessageTracer(log logging.Logger) MessageTracer {
+	return &collectorMessageTracer{log: log}
+}
+
+func (cmt *collectorMessageTracer) Init(cfg config.Local) MessageTracer {
+	if cfg.NetworkMessageTraceServer == "" {
+		return nil
+	}
+	cmt.hops = make(chan Hop, collectorHopQueueLength)
+	cmt.ctx, cmt.cancel = context.WithCancel(context.Background())
+	cmt.wg.Add(1)
+	go cmt.sendThread(cfg.NetworkMessageTraceServer)
+	cmt.log.Infof("tracing network messages to %s", cfg.NetworkMessageTraceServer)
+	return cmt
+}
+
+func (cmt *collectorMessageTracer) HashTrace(prefix string, data []byte) {
+	cmt.HopTrace(prefix, data, "", "")
+}
+
+func (cmt *collectorMessageTracer) HopTrace(prefix string, data []byte, node string, from string) {
+	hop := Hop{
+		Node:   node,
+		From:   from,
+		Prefix: prefix,
+		Hash:   HashMessage(data),
+		Time:   time.Now().UnixNano(),
+	}
+	select {
+	case cmt.hops <- hop:
+	default:
+		// the collector is not keeping up, drop the hop rather than slowing down the node
+	}
+}
+
+// Close stops the sendThread and waits for it to exit. The hops still queued are dropped.
+func (cmt *collectorMessageTracer) Close() {
+	cmt.cancel()
+	cmt.wg.Wait()
+}
+
+// sendThread sends the hops to the collector, connecting again after failures,
+// until the tracer is closed.
+func (cmt *collectorMessageTracer) sendThread(server string) {
+	defer cmt.wg.Done()
+	for {
+		err := cmt.send(server)
+		if cmt.ctx.Err() != nil {
+			return
+		}
+		cmt.log.Warnf("unable to send the network message traces to %s: %v", server, err)
+		select {
+		case <-time.After(collectorRetryInterval):
+		case <-cmt.ctx.Done():
+			return
+		}
+	}
+}
+
+func (cmt *collectorMessageTracer) send(server string) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(cmt.ctx, "tcp", server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// closing the connection interrupts a write blocked on the collector when the tracer is closed
+	sent := make(chan struct{})
+	defer close(sent)
+	go func() {
+		select {
+		case <-cmt.ctx.Done():
+			conn.Close()
+		case <-sent:
+		}
+	}()
+	writer := bufio.NewWriter(conn)
+	enc := json.NewEncoder(writer)
+	for {
+		var hop Hop
+		select {
+		case hop = <-cmt.hops:
+		case <-cmt.ctx.Done():
+			return cmt.ctx.Err()
+		}
+		err = enc.Encode(&hop)
+		if err != nil {
+			return err
+		}
+		// flush once the queue is drained, to batch the hops that arrive together
+		if len(cmt.hops) == 0 {
+			err = writer.Flush()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagetracer
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCollectorMessageTracer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	cfg := config.GetDefaultLocal()
+	require.Nil(t, NewCollectorMessageTracer(logging.TestingLog(t)).Init(cfg))
+
+	cfg.NetworkMessageTraceServer = listener.Addr().String()
+	tracer := NewCollectorMessageTracer(logging.TestingLog(t)).Init(cfg)
+	require.NotNil(t, tracer)
+	tracer.HopTrace(Vote, []byte("vote"), "127.0.0.1:4160", "127.0.0.1:4161")
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	line, err := bufio.NewReader(conn).ReadBytes('\n')
+	require.NoError(t, err)
+	var hop Hop
+	require.NoError(t, json.Unmarshal(line, &hop))
+	require.Equal(t, Hop{Node: "127.0.0.1:4160", From: "127.0.0.1:4161", Prefix: Vote, Hash: HashMessage([]byte("vote")), Time: hop.Time}, hop)
+
+	// closing the tracer stops sending the hops, and closes the connection
+	closed := make(chan struct{})
+	go func() {
+		tracer.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the tracer did not close")
+	}
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	_, err = conn.Read(make([]byte, 1))
+	require.Equal(t, io.EOF, err)
+}
+
+func TestCollectorMessageTracerCloseWhileRetrying(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// nothing listens on the address of a closed listener
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	listener.Close()
+
+	cfg := config.GetDefaultLocal()
+	cfg.NetworkMessageTraceServer = listener.Addr().String()
+	tracer := NewCollectorMessageTracer(logging.TestingLog(t)).Init(cfg)
+	require.NotNil(t, tracer)
+	time.Sleep(10 * time.Millisecond)
+
+	start := time.Now()
+	tracer.Close()
+	require.Less(t, int64(time.Since(start)), int64(collectorRetryInterval))
+}
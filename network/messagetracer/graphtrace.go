@@ -57,6 +57,15 @@ func (gmt *graphtraceMessageTracer) HashTrace(prefix string, data []byte) {
 	gmt.tracer.Trace(hash)
 }
 
+// HopTrace submits the message, graphtrace does not keep track of the hops.
+func (gmt *graphtraceMessageTracer) HopTrace(prefix string, data []byte, node string, from string) {
+	gmt.HashTrace(prefix, data)
+}
+
+// Close is a no-op, the graphtrace client is left to the process.
+func (gmt *graphtraceMessageTracer) Close() {
+}
+
 // NewGraphtraceMessageTracer returns a new MessageTracer that sends data to a graphtrace collector
 func NewGraphtraceMessageTracer(log logging.Logger) MessageTracer {
 	return &graphtraceMessageTracer{log: log}
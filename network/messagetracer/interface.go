@@ -17,8 +17,11 @@
 package messagetracer
 
 import (
+	"net/url"
+
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
 )
 
 // MessageTracer interface for configuring trace client and sending trace messages
@@ -29,30 +32,53 @@ type MessageTracer interface {
 
 	// HashTrace submits a trace message to the statistics server.
 	HashTrace(prefix string, data []byte)
-}
-
-var implFactory func(logging.Logger) MessageTracer
 
-type nopMessageTracer struct {
-}
+	// HopTrace submits to the statistics server the hop of a message from the
+	// peer at address from to the node at address node. Either address is empty
+	// when unknown.
+	HopTrace(prefix string, data []byte, node string, from string)
 
-func (gmt *nopMessageTracer) Init(cfg config.Local) MessageTracer {
-	return nil
-}
-func (gmt *nopMessageTracer) HashTrace(prefix string, data []byte) {
+	// Close stops sending the trace messages, and releases the resources of the trace client.
+	Close()
 }
 
-var singletonNopMessageTracer nopMessageTracer
+var implFactory func(logging.Logger) MessageTracer
 
-// NewTracer constructs a new MessageTracer if that has been compiled in with the build tag `msgtrace`
+// NewTracer constructs a new MessageTracer. It is a graphtrace client if that has been compiled in with the build tag `msgtrace`,
+// and a client of the trace collector otherwise.
 func NewTracer(log logging.Logger) MessageTracer {
 	if implFactory != nil {
 		log.Info("graphtrace factory enabled")
 		return implFactory(log)
 	}
-	log.Info("graphtrace factory DISabled")
-	return &singletonNopMessageTracer
+	return NewCollectorMessageTracer(log)
 }
 
 // Proposal is a prefix for HashTrace()
 const Proposal = "prop"
+
+// Vote is a prefix for HashTrace()
+const Vote = "vote"
+
+// Transaction is a prefix for HashTrace()
+const Transaction = "txn"
+
+// TraceHop submits the hop of a message received by the node of the given network.
+// The addresses are reduced to their host and port, so that the address of a node
+// matches the one of its outgoing peers.
+func TraceHop(trace MessageTracer, prefix string, msg network.IncomingMessage, net network.GossipNode) {
+	node, _ := net.Address()
+	var from string
+	if peer, ok := msg.Sender.(network.HTTPPeer); ok {
+		from = peer.GetAddress()
+	}
+	trace.HopTrace(prefix, msg.Data, hostAddress(node), hostAddress(from))
+}
+
+func hostAddress(addr string) string {
+	parsed, err := url.Parse(addr)
+	if err != nil || parsed.Host == "" {
+		return addr
+	}
+	return parsed.Host
+}
@@ -0,0 +1,34 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package messagetracer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestHostAddress(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "127.0.0.1:4160", hostAddress("http://127.0.0.1:4160"))
+	require.Equal(t, "127.0.0.1:4160", hostAddress("127.0.0.1:4160"))
+	require.Equal(t, "r1.algorand.network:4160", hostAddress("ws://r1.algorand.network:4160"))
+	require.Equal(t, "", hostAddress(""))
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// P2PPeerIDHeader holds the peer ID of the node opening or accepting a peer-to-peer connection
+const P2PPeerIDHeader = "X-Algorand-P2P-PeerID"
+
+// P2PChallengeHeader holds the nonce that the other end of a peer-to-peer connection has to sign
+const P2PChallengeHeader = "X-Algorand-P2P-Challenge"
+
+// P2PSignatureHeader holds the signature of the challenge sent by the node opening a peer-to-peer connection
+const P2PSignatureHeader = "X-Algorand-P2P-Signature"
+
+// PeerID identifies a node of the peer-to-peer network. It is the encoding of
+// the public key of the node, so that no peer can claim the ID of another one.
+type PeerID string
+
+var peerIDEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// makePeerID returns the peer ID of the node holding the secret key of pk.
+func makePeerID(pk crypto.SignatureVerifier) PeerID {
+	return PeerID(peerIDEncoding.EncodeToString(pk[:]))
+}
+
+// publicKey returns the public key the peer ID is derived from.
+func (id PeerID) publicKey() (pk crypto.SignatureVerifier, err error) {
+	decoded, err := peerIDEncoding.DecodeString(string(id))
+	if err != nil {
+		return pk, fmt.Errorf("malformed peer ID %s: %v", filterASCII(string(id)), err)
+	}
+	if len(decoded) != len(pk) {
+		return pk, fmt.Errorf("malformed peer ID %s: %d bytes instead of %d", filterASCII(string(id)), len(decoded), len(pk))
+	}
+	copy(pk[:], decoded)
+	return pk, nil
+}
+
+// loadP2PIdentity returns the key of the node, stored in dataDir. The key is
+// generated and saved the first time. Without a dataDir, the key is ephemeral
+// and the node gets a different peer ID every time it starts.
+func loadP2PIdentity(dataDir string) (*crypto.SignatureSecrets, error) {
+	var seed crypto.Seed
+	if dataDir == "" {
+		crypto.RandBytes(seed[:])
+		return crypto.GenerateSignatureSecrets(seed), nil
+	}
+
+	keyPath := filepath.Join(dataDir, config.P2PIdentityKeyFilename)
+	data, err := ioutil.ReadFile(keyPath)
+	if err == nil {
+		if len(data) != len(seed) {
+			return nil, fmt.Errorf("%s holds %d bytes instead of %d", keyPath, len(data), len(seed))
+		}
+		copy(seed[:], data)
+		return crypto.GenerateSignatureSecrets(seed), nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	crypto.RandBytes(seed[:])
+	err = ioutil.WriteFile(keyPath, seed[:], 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to save the peer-to-peer key: %v", err)
+	}
+	return crypto.GenerateSignatureSecrets(seed), nil
+}
+
+// p2pChallengeLength is the length of the nonces signed during the handshake.
+const p2pChallengeLength = 32
+
+// p2pIdentityChallenge is what each end of a peer-to-peer connection signs to
+// prove that it holds the key of its peer ID. The challenge includes the peer
+// ID of the node checking the signature, so that a signature obtained by
+// connecting to a node cannot be replayed to another one.
+type p2pIdentityChallenge struct {
+	nonce     [p2pChallengeLength]byte
+	genesisID string
+	signer    PeerID
+	verifier  PeerID
+}
+
+// ToBeHashed implements the crypto.Hashable interface.
+func (c p2pIdentityChallenge) ToBeHashed() (protocol.HashID, []byte) {
+	data := append([]byte{}, c.nonce[:]...)
+	for _, s := range []string{c.genesisID, string(c.signer), string(c.verifier)} {
+		// the peer IDs have a fixed length, but the genesis ID does not
+		data = append(data, byte(len(s)))
+		data = append(data, s...)
+	}
+	return protocol.NetIdentityChallenge, data
+}
+
+// makeP2PChallenge returns a new nonce, and its encoding for the P2PChallengeHeader.
+func makeP2PChallenge() (nonce [p2pChallengeLength]byte, encoded string) {
+	crypto.RandBytes(nonce[:])
+	return nonce, base64.StdEncoding.EncodeToString(nonce[:])
+}
+
+// parseP2PChallenge decodes the P2PChallengeHeader.
+func parseP2PChallenge(encoded string) (nonce [p2pChallengeLength]byte, err error) {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nonce, fmt.Errorf("malformed challenge: %v", err)
+	}
+	if len(decoded) != len(nonce) {
+		return nonce, fmt.Errorf("malformed challenge: %d bytes instead of %d", len(decoded), len(nonce))
+	}
+	copy(nonce[:], decoded)
+	return nonce, nil
+}
+
+// verifyP2PChallenge checks that the signer of the challenge signed it with the key of its peer ID.
+func verifyP2PChallenge(challenge p2pIdentityChallenge, sig []byte) error {
+	pk, err := challenge.signer.publicKey()
+	if err != nil {
+		return err
+	}
+	var signature crypto.Signature
+	if len(sig) != len(signature) {
+		return fmt.Errorf("malformed signature from %s: %d bytes instead of %d", challenge.signer, len(sig), len(signature))
+	}
+	copy(signature[:], sig)
+	if !pk.Verify(challenge, signature, false) {
+		return fmt.Errorf("peer %s failed to prove its identity", challenge.signer)
+	}
+	return nil
+}
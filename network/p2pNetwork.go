@@ -0,0 +1,970 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/algorand/websocket"
+	"github.com/gorilla/mux"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network/limitlistener"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The peer-to-peer network is a mesh of nodes that gossip messages to each
+// other, rather than through relays. Every node is identified by a PeerID
+// derived from a key stored in its data directory, and proves that it holds
+// that key when connecting to another node: each end signs a challenge sent by
+// the other one in the websocket handshake.
+//
+// A node keeps GossipFanout outgoing connections, picked among the addresses
+// of its phonebook, and accepts up to IncomingConnectionsLimit incoming ones.
+// When two nodes connect, each of them sends the other the addresses of its
+// peers with a protocol.PeerExchangeTag message, so that the mesh grows from
+// the few addresses a node starts with. The addresses a peer sends replace the
+// ones it sent before, and a peer may only send them every
+// p2pPeerExchangeInterval.
+//
+// Messages are dispatched to the same TaggedMessageHandlers as on the
+// WebsocketNetwork, and every node relays the messages its handlers accept to
+// all its peers but the one it got them from. As on the WebsocketNetwork, a
+// node only sends its peers the messages whose tags they are interested in, as
+// they tell with a protocol.MsgOfInterestTag message.
+
+// P2PNetworkPath is the URL path to connect to the peer-to-peer gossip node at.
+// Contains {genesisID} param to be handled by gorilla/mux
+const P2PNetworkPath = "/v1/{genesisID}/p2p"
+
+// p2pPeerExchangeNetworkName prefixes the phonebook network names of the addresses learned from peers.
+const p2pPeerExchangeNetworkName = "p2p"
+
+// p2pMaxPeerExchangeAddresses is the largest number of addresses in a peer exchange message.
+const p2pMaxPeerExchangeAddresses = 64
+
+// p2pMaxPeerExchangeSources is the largest number of peers whose addresses are kept in the phonebook. Past it, the
+// addresses of the peer that sent its addresses the longest time ago are dropped.
+const p2pMaxPeerExchangeSources = 64
+
+// p2pPeerExchangeInterval is the shortest interval between two peer exchange messages from the same peer.
+const p2pPeerExchangeInterval = 10 * time.Minute
+
+// p2pHandshakeTimeout is how long a peer has to prove its identity.
+const p2pHandshakeTimeout = 10 * time.Second
+
+// p2pMeshInterval is how often a node looks for more peers, besides when it loses one.
+const p2pMeshInterval = 30 * time.Second
+
+var errP2PNoPriorityPeers = errors.New("the peer-to-peer network has no priority peers")
+
+// P2PNetwork implements GossipNode over a mesh of peers
+type P2PNetwork struct {
+	listener net.Listener
+	server   http.Server
+	router   *mux.Router
+	scheme   string
+
+	upgrader websocket.Upgrader
+
+	config config.Local
+
+	log logging.Logger
+
+	GenesisID string
+	NetworkID protocol.NetworkID
+
+	identity *crypto.SignatureSecrets
+	peerID   PeerID
+
+	phonebook Phonebook
+	dialer    Dialer
+	transport rateLimitingTransport
+
+	requestsTracker *RequestTracker
+
+	handlers   Multiplexer
+	readBuffer chan IncomingMessage
+
+	// seenMessages drops the messages that reach the node more than once
+	// through the mesh.
+	seenMessages *messageFilter
+
+	// reputation tracks the misbehaving peers by peer ID, and bans them
+	reputation *peerReputation
+
+	// peerExchangeSources are the peers whose addresses are in the phonebook, from the one that sent them the
+	// longest time ago, locked by peerExchangeMu
+	peerExchangeSources []PeerID
+	peerExchangeMu      deadlock.Mutex
+
+	// messagesOfInterest are the tags of the messages the node wants to receive, or nil until any is registered,
+	// and messagesOfInterestEnc is their encoding sent to the peers. Both are locked by messagesOfInterestMu.
+	messagesOfInterest    map[protocol.Tag]bool
+	messagesOfInterestEnc []byte
+	messagesOfInterestMu  deadlock.Mutex
+
+	peersLock deadlock.RWMutex
+	peers     map[PeerID]*p2pPeer
+	// dialing holds the addresses being connected to
+	dialing map[string]bool
+	// selfAddrs holds the addresses that turned out to be the node itself
+	selfAddrs map[string]bool
+
+	meshUpdateRequests chan meshRequest
+
+	readyChan chan struct{}
+	readyOnce sync.Once
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+// NewP2PNetwork constructs a peer-to-peer gossip network. The key of the node
+// is loaded from dataDir, or generated there the first time.
+func NewP2PNetwork(log logging.Logger, config config.Local, dataDir string, phonebookAddresses []string, genesisID string, networkID protocol.NetworkID) (*P2PNetwork, error) {
+	identity, err := loadP2PIdentity(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	phonebook := MakePhonebook(config.ConnectionsRateLimitingCount,
+		time.Duration(config.ConnectionsRateLimitingWindowSeconds)*time.Second)
+	phonebook.ReplacePeerList(phonebookAddresses, config.DNSBootstrapID, PhoneBookEntryRelayRole)
+	n := &P2PNetwork{
+		log:       log,
+		config:    config,
+		phonebook: phonebook,
+		GenesisID: genesisID,
+		NetworkID: networkID,
+		identity:  identity,
+		peerID:    makePeerID(identity.SignatureVerifier),
+	}
+	n.setup()
+	return n, nil
+}
+
+func (n *P2PNetwork) setup() {
+	n.dialer = makeRateLimitingDialer(n.phonebook, nil)
+	n.transport = makeRateLimitingTransport(n.phonebook, 10*time.Second, &n.dialer, int(n.config.ConnectionsRateLimitingCount))
+
+	n.upgrader.ReadBufferSize = 4096
+	n.upgrader.WriteBufferSize = 4096
+	n.upgrader.EnableCompression = false
+
+	n.router = mux.NewRouter()
+	n.router.Handle(P2PNetworkPath, n)
+	n.requestsTracker = makeRequestsTracker(n.router, n.log, n.config)
+	n.server.Handler = n.requestsTracker
+	n.server.ReadHeaderTimeout = httpServerReadHeaderTimeout
+	n.server.WriteTimeout = httpServerWriteTimeout
+	n.server.IdleTimeout = httpServerIdleTimeout
+	n.server.MaxHeaderBytes = httpServerMaxHeaderBytes
+	n.ctx, n.ctxCancel = context.WithCancel(context.Background())
+
+	n.handlers.log = n.log
+	readBufferLen := n.config.IncomingConnectionsLimit + n.config.GossipFanout
+	if readBufferLen < 100 {
+		readBufferLen = 100
+	}
+	if readBufferLen > 10000 {
+		readBufferLen = 10000
+	}
+	n.readBuffer = make(chan IncomingMessage, readBufferLen)
+	n.seenMessages = makeMessageFilter(n.config.IncomingMessageFilterBucketCount, n.config.IncomingMessageFilterBucketSize)
+	n.reputation = makePeerReputation(n.log, n.config)
+
+	n.peers = make(map[PeerID]*p2pPeer)
+	n.dialing = make(map[string]bool)
+	n.selfAddrs = make(map[string]bool)
+	n.meshUpdateRequests = make(chan meshRequest, 5)
+	n.readyChan = make(chan struct{})
+	// every node of the mesh relays, as relays do on the WebsocketNetwork.
+	n.RegisterMessageInterest(protocol.CompactCertSigTag)
+}
+
+// PeerID returns the peer ID of the node.
+func (n *P2PNetwork) PeerID() PeerID {
+	return n.peerID
+}
+
+// Address returns a string and whether that is a 'final' address or guessed.
+// Part of GossipNode interface
+func (n *P2PNetwork) Address() (string, bool) {
+	parsedURL := url.URL{Scheme: n.scheme}
+	if n.listener == nil {
+		if n.config.NetAddress == "" {
+			parsedURL.Scheme = ""
+		}
+		parsedURL.Host = n.config.NetAddress
+		return parsedURL.String(), false
+	}
+	parsedURL.Host = n.listener.Addr().String()
+	return parsedURL.String(), true
+}
+
+// PublicAddress is the address the peers are told to connect to, or empty if
+// the node does not accept connections.
+func (n *P2PNetwork) PublicAddress() string {
+	if len(n.config.PublicAddress) > 0 {
+		return n.config.PublicAddress
+	}
+	if n.listener == nil {
+		return ""
+	}
+	localAddr, _ := n.Address()
+	return localAddr
+}
+
+// Broadcast sends a message to all the peers but except.
+func (n *P2PNetwork) Broadcast(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	if dedupSafeTag(tag) {
+		// the message will come back through the mesh
+		n.seenMessages.CheckIncomingMessage(tag, data, true, false)
+	}
+	for _, peer := range n.peerSnapshot() {
+		if Peer(peer) == except {
+			continue
+		}
+		peer.send(ctx, tag, data, wait)
+	}
+	return nil
+}
+
+// BroadcastArray sends an array of messages to all the peers but except.
+func (n *P2PNetwork) BroadcastArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	if len(tags) != len(data) {
+		return errBcastInvalidArray
+	}
+	for i := range tags {
+		err := n.Broadcast(ctx, tags[i], data[i], wait, except)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Relay is the same as Broadcast: every node of the mesh relays messages, to
+// the peers interested in them.
+func (n *P2PNetwork) Relay(ctx context.Context, tag protocol.Tag, data []byte, wait bool, except Peer) error {
+	return n.Broadcast(ctx, tag, data, wait, except)
+}
+
+// RelayArray is the same as BroadcastArray: every node of the mesh relays messages.
+func (n *P2PNetwork) RelayArray(ctx context.Context, tags []protocol.Tag, data [][]byte, wait bool, except Peer) error {
+	return n.BroadcastArray(ctx, tags, data, wait, except)
+}
+
+// Disconnect closes the connection to the peer.
+func (n *P2PNetwork) Disconnect(badnode Peer) {
+	if peer, ok := badnode.(*p2pPeer); ok {
+		n.log.Infof("disconnecting peer %s", peer.id)
+		peer.close()
+	}
+}
+
+// ReportPeer lowers the reputation of a peer that misbehaved, and disconnects it once it gets banned.
+func (n *P2PNetwork) ReportPeer(peer Peer, signal PeerSignal) {
+	if p, ok := peer.(*p2pPeer); ok && n.reputation.report(string(p.id), signal) {
+		p.close()
+	}
+}
+
+// GetPeerBans returns the peers currently banned.
+func (n *P2PNetwork) GetPeerBans() []PeerBan {
+	return n.reputation.list()
+}
+
+// ClearPeerBans lifts the ban of the peer with the given peer ID, or all the
+// bans if the peer ID is empty. It returns the number of bans lifted.
+func (n *P2PNetwork) ClearPeerBans(address string) int {
+	return n.reputation.clear(address)
+}
+
+// LoadPeerBans restores the bans saved in the given file, and saves the bans
+// there from now on.
+func (n *P2PNetwork) LoadPeerBans(filename string) error {
+	return n.reputation.load(filename)
+}
+
+// GetPeerInfo describes the connections to the peers, for the node administrator.
+func (n *P2PNetwork) GetPeerInfo() []PeerInfo {
+	peers := n.peerSnapshot()
+	infos := make([]PeerInfo, 0, len(peers))
+	for _, peer := range peers {
+		infos = append(infos, PeerInfo{
+			Address:        string(peer.id),
+			Role:           PeerRoleMesh,
+			Outgoing:       peer.outgoing,
+			Version:        P2PProtocolVersion,
+			ConnectedSince: peer.createTime,
+			Traffic:        peer.traffic.snapshot(),
+		})
+	}
+	return infos
+}
+
+// DisconnectAddress closes the connection to the peer with the given peer ID.
+func (n *P2PNetwork) DisconnectAddress(address string) int {
+	n.peersLock.RLock()
+	peer, has := n.peers[PeerID(address)]
+	n.peersLock.RUnlock()
+	if !has {
+		return 0
+	}
+	peer.close()
+	return 1
+}
+
+// AddPriorityPeer is not supported: all the peers of the mesh are equal.
+func (n *P2PNetwork) AddPriorityPeer(host string) error {
+	return errP2PNoPriorityPeers
+}
+
+// DisconnectPeers closes the connections to all the peers.
+func (n *P2PNetwork) DisconnectPeers() {
+	for _, peer := range n.peerSnapshot() {
+		peer.close()
+	}
+}
+
+// Ready returns a chan that will be closed once the node is connected to a peer
+func (n *P2PNetwork) Ready() chan struct{} {
+	return n.readyChan
+}
+
+// RegisterHTTPHandler path accepts gorilla/mux path annotations
+func (n *P2PNetwork) RegisterHTTPHandler(path string, handler http.Handler) {
+	n.router.Handle(path, handler)
+}
+
+// RequestConnectOutgoing looks for more peers.
+// `replace` drop all connections first and find new peers.
+func (n *P2PNetwork) RequestConnectOutgoing(replace bool, quit <-chan struct{}) {
+	request := meshRequest{disconnect: replace}
+	if quit != nil {
+		request.done = make(chan struct{})
+	}
+	select {
+	case n.meshUpdateRequests <- request:
+	case <-quit:
+		return
+	}
+	if request.done != nil {
+		select {
+		case <-request.done:
+		case <-quit:
+		}
+	}
+}
+
+// requestMeshUpdate asks the mesh thread to look for more peers, if it is not about to already.
+func (n *P2PNetwork) requestMeshUpdate() {
+	select {
+	case n.meshUpdateRequests <- meshRequest{}:
+	default:
+	}
+}
+
+// GetPeers returns a snapshot of our Peer list, according to the specified options.
+func (n *P2PNetwork) GetPeers(options ...PeerOption) []Peer {
+	outPeers := make([]Peer, 0)
+	for _, option := range options {
+		switch option {
+		case PeersConnectedOut, PeersConnectedIn:
+			for _, peer := range n.peerSnapshot() {
+				if peer.outgoing == (option == PeersConnectedOut) {
+					outPeers = append(outPeers, Peer(peer))
+				}
+			}
+		case PeersPhonebookRelays, PeersPhonebookArchivers:
+			var role PhoneBookEntryRoles = PhoneBookEntryRelayRole
+			if option == PeersPhonebookArchivers {
+				role = PhoneBookEntryArchiverRole
+			}
+			for _, addr := range n.phonebook.GetAddresses(1000, role) {
+				outPeers = append(outPeers, &p2pHTTPPeer{rootURL: addr, client: http.Client{Transport: n.GetRoundTripper()}})
+			}
+		}
+	}
+	return outPeers
+}
+
+// Start threads, listen on sockets.
+func (n *P2PNetwork) Start() {
+	if n.config.NetAddress != "" {
+		listener, err := net.Listen("tcp", n.config.NetAddress)
+		if err != nil {
+			n.log.Errorf("network could not listen %v: %s", n.config.NetAddress, err)
+			return
+		}
+		listener = limitlistener.RejectingLimitListener(
+			listener, uint64(n.config.IncomingConnectionsLimit), n.log)
+		n.listener = n.requestsTracker.Listener(listener)
+		n.log.Debugf("listening on %s", n.listener.Addr().String())
+	}
+	if n.config.TLSCertFile != "" && n.config.TLSKeyFile != "" {
+		n.scheme = "https"
+	} else {
+		n.scheme = "http"
+	}
+	if n.listener != nil {
+		n.wg.Add(1)
+		go n.httpdThread()
+	}
+	n.meshUpdateRequests <- meshRequest{}
+	n.wg.Add(1)
+	go n.meshThread()
+	for i := 0; i < incomingThreads; i++ {
+		n.wg.Add(1)
+		go n.messageHandlerThread()
+	}
+
+	n.log.Infof("serving genesisID=%s on %#v with PeerID=%s", n.GenesisID, n.PublicAddress(), n.peerID)
+}
+
+func (n *P2PNetwork) httpdThread() {
+	defer n.wg.Done()
+	var err error
+	if n.config.TLSCertFile != "" && n.config.TLSKeyFile != "" {
+		err = n.server.ServeTLS(n.listener, n.config.TLSCertFile, n.config.TLSKeyFile)
+	} else {
+		err = n.server.Serve(n.listener)
+	}
+	if err != nil && err != http.ErrServerClosed {
+		n.log.Info("p2p net http server exited ", err)
+	}
+}
+
+// Stop closes network connections and stops threads.
+// Stop blocks until all activity on this node is done.
+func (n *P2PNetwork) Stop() {
+	n.handlers.ClearHandlers([]Tag{})
+	n.ctxCancel()
+
+	n.peersLock.Lock()
+	peers := make([]*p2pPeer, 0, len(n.peers))
+	for _, peer := range n.peers {
+		peers = append(peers, peer)
+	}
+	n.peers = make(map[PeerID]*p2pPeer)
+	n.peersLock.Unlock()
+	for _, peer := range peers {
+		peer.close()
+		peer.wg.Wait()
+	}
+
+	var listenAddr string
+	if n.listener != nil {
+		listenAddr = n.listener.Addr().String()
+	}
+	ctx, timeoutCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer timeoutCancel()
+	err := n.server.Shutdown(ctx)
+	if err != nil {
+		n.log.Warnf("problem shutting down %s: %v", listenAddr, err)
+	}
+	n.wg.Wait()
+
+	// Wait for the requestsTracker to finish up to avoid potential race condition
+	<-n.requestsTracker.getWaitUntilNoConnectionsChannel(5 * time.Millisecond)
+}
+
+// RegisterHandlers registers the set of given message handlers.
+func (n *P2PNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
+	n.handlers.RegisterHandlers(dispatch)
+}
+
+// ClearHandlers deregisters all the existing message handlers.
+func (n *P2PNetwork) ClearHandlers() {
+	n.handlers.ClearHandlers([]Tag{})
+}
+
+// GetRoundTripper returns a Transport that would limit the number of outgoing connections.
+func (n *P2PNetwork) GetRoundTripper() http.RoundTripper {
+	return &n.transport
+}
+
+// OnNetworkAdvance does nothing: unlike relays, the peers of the mesh are not
+// rotated when the network seems to be stuck.
+func (n *P2PNetwork) OnNetworkAdvance() {}
+
+// GetHTTPRequestConnection returns the underlying connection for the given request. Note that the request must be the same
+// request that was provided to the http handler ( or provide a fallback Context() to that )
+func (n *P2PNetwork) GetHTTPRequestConnection(request *http.Request) (conn net.Conn) {
+	if n.requestsTracker != nil {
+		conn = n.requestsTracker.GetRequestConnection(request)
+	}
+	return
+}
+
+// RegisterMessageInterest notifies the peers that this node wants to receive
+// messages with the specified tag, besides the ones peers send by default.
+func (n *P2PNetwork) RegisterMessageInterest(t protocol.Tag) error {
+	n.updateMessagesOfInterest(func(tags map[protocol.Tag]bool) { tags[t] = true })
+	return nil
+}
+
+// DeregisterMessageInterest notifies the peers that this node no longer wants
+// to receive messages with the specified tag.
+func (n *P2PNetwork) DeregisterMessageInterest(t protocol.Tag) error {
+	n.updateMessagesOfInterest(func(tags map[protocol.Tag]bool) { delete(tags, t) })
+	return nil
+}
+
+// updateMessagesOfInterest applies update to the tags of the messages the node
+// wants to receive, and sends them to the peers.
+func (n *P2PNetwork) updateMessagesOfInterest(update func(map[protocol.Tag]bool)) {
+	n.messagesOfInterestMu.Lock()
+	if n.messagesOfInterest == nil {
+		n.messagesOfInterest = make(map[protocol.Tag]bool)
+		for tag, flag := range defaultSendMessageTags {
+			n.messagesOfInterest[tag] = flag
+		}
+	}
+	update(n.messagesOfInterest)
+	n.messagesOfInterestEnc = MarshallMessageOfInterestMap(n.messagesOfInterest)
+	enc := n.messagesOfInterestEnc
+	n.messagesOfInterestMu.Unlock()
+
+	for _, peer := range n.peerSnapshot() {
+		peer.send(n.ctx, protocol.MsgOfInterestTag, enc, false)
+	}
+}
+
+// SubstituteGenesisID substitutes the "{genesisID}" with their network-specific genesisID.
+func (n *P2PNetwork) SubstituteGenesisID(rawURL string) string {
+	return strings.Replace(rawURL, "{genesisID}", n.GenesisID, -1)
+}
+
+// GetPeerData returns the peer data associated with a particular key.
+func (n *P2PNetwork) GetPeerData(peer Peer, key string) interface{} {
+	if p, ok := peer.(*p2pPeer); ok {
+		return p.getPeerData(key)
+	}
+	return nil
+}
+
+// SetPeerData sets the peer data associated with a particular key.
+func (n *P2PNetwork) SetPeerData(peer Peer, key string, value interface{}) {
+	if p, ok := peer.(*p2pPeer); ok {
+		p.setPeerData(key, value)
+	}
+}
+
+// ServeHTTP accepts the connections from peers, once they prove their identity.
+func (n *P2PNetwork) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	trackedRequest := n.requestsTracker.GetTrackedRequest(request)
+
+	reject := func(status int, reason string) {
+		n.log.Infof("rejected peer-to-peer connection from %s: %s", request.RemoteAddr, reason)
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "p2p handshake"})
+		response.WriteHeader(status)
+		response.Write([]byte(reason))
+	}
+	if otherGenesisID := request.Header.Get(GenesisHeader); otherGenesisID != n.GenesisID {
+		reject(http.StatusPreconditionFailed, "mismatching genesis ID "+filterASCII(otherGenesisID))
+		return
+	}
+	if otherVersion := request.Header.Get(ProtocolVersionHeader); otherVersion != P2PProtocolVersion {
+		reject(http.StatusPreconditionFailed, "unsupported version "+filterASCII(otherVersion))
+		return
+	}
+	otherID := PeerID(request.Header.Get(P2PPeerIDHeader))
+	if _, err := otherID.publicKey(); err != nil {
+		reject(http.StatusPreconditionFailed, err.Error())
+		return
+	}
+	challenge, err := parseP2PChallenge(request.Header.Get(P2PChallengeHeader))
+	if err != nil {
+		reject(http.StatusPreconditionFailed, err.Error())
+		return
+	}
+	if otherID == n.peerID {
+		reject(http.StatusLoopDetected, "connection to self")
+		return
+	}
+	if n.reputation.isBanned(string(otherID)) {
+		reject(http.StatusForbidden, "banned")
+		return
+	}
+	if n.hasPeer(otherID) {
+		reject(http.StatusConflict, "already connected")
+		return
+	}
+
+	nonce, encodedNonce := makeP2PChallenge()
+	sig := n.identity.Sign(p2pIdentityChallenge{nonce: challenge, genesisID: n.GenesisID, signer: n.peerID, verifier: otherID})
+	responseHeader := make(http.Header)
+	responseHeader.Set(ProtocolVersionHeader, P2PProtocolVersion)
+	responseHeader.Set(GenesisHeader, n.GenesisID)
+	responseHeader.Set(P2PPeerIDHeader, string(n.peerID))
+	responseHeader.Set(P2PChallengeHeader, encodedNonce)
+	responseHeader.Set(P2PSignatureHeader, base64.StdEncoding.EncodeToString(sig[:]))
+	conn, err := n.upgrader.Upgrade(response, request, responseHeader)
+	if err != nil {
+		n.log.Info("p2p upgrade fail ", err)
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "ws upgrade fail"})
+		return
+	}
+
+	// the first message from the peer is its signature of our challenge
+	conn.SetReadLimit(int64(len(crypto.Signature{})))
+	conn.SetReadDeadline(time.Now().Add(p2pHandshakeTimeout))
+	_, otherSig, err := conn.ReadMessage()
+	if err == nil {
+		err = verifyP2PChallenge(p2pIdentityChallenge{nonce: nonce, genesisID: n.GenesisID, signer: otherID, verifier: n.peerID}, otherSig)
+	}
+	if err != nil {
+		n.log.Infof("peer %s at %s failed the handshake: %v", otherID, request.RemoteAddr, err)
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "p2p handshake"})
+		conn.Close()
+		return
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	peer := makeP2PPeer(n, otherID, conn, trackedRequest.otherPublicAddr, false)
+	if n.addPeer(peer) {
+		n.log.With("event", "ConnectedIn").With("remote", request.RemoteAddr).Infof("Accepted incoming connection from peer %s", otherID)
+	}
+}
+
+// tryConnect connects to the peer at addr, and checks that it holds the key of the peer ID it claims.
+func (n *P2PNetwork) tryConnect(addr string) {
+	defer n.wg.Done()
+	defer func() {
+		n.peersLock.Lock()
+		delete(n.dialing, addr)
+		n.peersLock.Unlock()
+	}()
+
+	p2pAddr, err := n.addrToP2PAddr(addr)
+	if err != nil {
+		n.log.Warnf("could not parse addr %#v: %s", addr, err)
+		return
+	}
+	challenge, encodedChallenge := makeP2PChallenge()
+	requestHeader := make(http.Header)
+	requestHeader.Set(ProtocolVersionHeader, P2PProtocolVersion)
+	requestHeader.Set(GenesisHeader, n.GenesisID)
+	requestHeader.Set(P2PPeerIDHeader, string(n.peerID))
+	requestHeader.Set(P2PChallengeHeader, encodedChallenge)
+	requestHeader.Set(AddressHeader, n.PublicAddress())
+	requestHeader.Set(InstanceNameHeader, n.log.GetInstanceName())
+	SetUserAgentHeader(requestHeader)
+	websocketDialer := websocket.Dialer{
+		Proxy:             http.ProxyFromEnvironment,
+		HandshakeTimeout:  p2pHandshakeTimeout,
+		EnableCompression: false,
+		NetDialContext:    n.dialer.DialContext,
+		NetDial:           n.dialer.Dial,
+	}
+	conn, response, err := websocketDialer.DialContext(n.ctx, p2pAddr, requestHeader)
+	if err != nil {
+		if err == websocket.ErrBadHandshake && response.StatusCode == http.StatusLoopDetected {
+			n.peersLock.Lock()
+			n.selfAddrs[addr] = true
+			n.peersLock.Unlock()
+			return
+		}
+		n.log.Infof("p2p connect(%s) fail: %v", p2pAddr, err)
+		return
+	}
+
+	otherID := PeerID(response.Header.Get(P2PPeerIDHeader))
+	sig, err := base64.StdEncoding.DecodeString(response.Header.Get(P2PSignatureHeader))
+	if err == nil {
+		err = verifyP2PChallenge(p2pIdentityChallenge{nonce: challenge, genesisID: n.GenesisID, signer: otherID, verifier: n.peerID}, sig)
+	}
+	var otherChallenge [p2pChallengeLength]byte
+	if err == nil {
+		otherChallenge, err = parseP2PChallenge(response.Header.Get(P2PChallengeHeader))
+	}
+	if err != nil {
+		n.log.Warnf("peer at %s failed the handshake: %v", p2pAddr, err)
+		conn.Close()
+		return
+	}
+	if n.reputation.isBanned(string(otherID)) {
+		n.log.Infof("not connecting to banned peer %s at %s", otherID, p2pAddr)
+		conn.Close()
+		return
+	}
+	mySig := n.identity.Sign(p2pIdentityChallenge{nonce: otherChallenge, genesisID: n.GenesisID, signer: n.peerID, verifier: otherID})
+	conn.SetWriteDeadline(time.Now().Add(p2pHandshakeTimeout))
+	err = conn.WriteMessage(websocket.BinaryMessage, mySig[:])
+	if err != nil {
+		n.log.Infof("p2p connect(%s) fail: %v", p2pAddr, err)
+		conn.Close()
+		return
+	}
+
+	peer := makeP2PPeer(n, otherID, conn, addr, true)
+	if n.addPeer(peer) {
+		n.log.With("event", "ConnectedOut").With("remote", addr).Infof("Made outgoing connection to peer %s", otherID)
+	}
+}
+
+// addrToP2PAddr parses host:port or a URL and returns the URL to the peer-to-peer interface at that address.
+func (n *P2PNetwork) addrToP2PAddr(addr string) (string, error) {
+	parsedURL, err := ParseHostOrURL(addr)
+	if err != nil {
+		return "", err
+	}
+	parsedURL.Scheme = websocketsScheme[parsedURL.Scheme]
+	if parsedURL.Scheme == "" {
+		parsedURL.Scheme = "ws"
+	}
+	parsedURL.Path = strings.Replace(path.Join(parsedURL.Path, P2PNetworkPath), "{genesisID}", n.GenesisID, -1)
+	return parsedURL.String(), nil
+}
+
+// dialerID returns the peer ID of the node that opened the connection to the peer.
+func (n *P2PNetwork) dialerID(peer *p2pPeer) PeerID {
+	if peer.outgoing {
+		return n.peerID
+	}
+	return peer.id
+}
+
+// addPeer starts exchanging messages with a peer that proved its identity. It
+// returns false, and closes the connection, if the node is stopping or already
+// connected to the peer. When two nodes connect to each other at the same
+// time, both keep the connection opened by the node with the lowest peer ID.
+func (n *P2PNetwork) addPeer(peer *p2pPeer) bool {
+	n.peersLock.Lock()
+	existing, has := n.peers[peer.id]
+	if n.ctx.Err() != nil || (has && n.dialerID(existing) <= n.dialerID(peer)) {
+		n.peersLock.Unlock()
+		peer.conn.Close()
+		return false
+	}
+	if has {
+		existing.close()
+	}
+	n.peers[peer.id] = peer
+	numPeers := len(n.peers)
+	n.peersLock.Unlock()
+
+	peer.start()
+	n.messagesOfInterestMu.Lock()
+	messagesOfInterestEnc := n.messagesOfInterestEnc
+	n.messagesOfInterestMu.Unlock()
+	if messagesOfInterestEnc != nil {
+		peer.send(n.ctx, protocol.MsgOfInterestTag, messagesOfInterestEnc, false)
+	}
+	peer.send(n.ctx, protocol.PeerExchangeTag, n.peerExchangeMessage(peer), false)
+	n.readyOnce.Do(func() {
+		close(n.readyChan)
+	})
+	peers.Set(float64(numPeers), nil)
+	return true
+}
+
+// removePeer is called by the peer when its connection is closed.
+func (n *P2PNetwork) removePeer(peer *p2pPeer) {
+	peer.close()
+	n.peersLock.Lock()
+	removed := n.peers[peer.id] == peer
+	if removed {
+		delete(n.peers, peer.id)
+	}
+	numPeers := len(n.peers)
+	n.peersLock.Unlock()
+	if removed {
+		n.log.With("event", "Disconnected").Infof("Peer %s disconnected", peer.id)
+		peers.Set(float64(numPeers), nil)
+		n.requestMeshUpdate()
+	}
+}
+
+func (n *P2PNetwork) hasPeer(id PeerID) bool {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+	_, has := n.peers[id]
+	return has
+}
+
+func (n *P2PNetwork) peerSnapshot() []*p2pPeer {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+	peers := make([]*p2pPeer, 0, len(n.peers))
+	for _, peer := range n.peers {
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+// peerExchangeMessage returns the addresses of the peers other than the given one, one per line.
+func (n *P2PNetwork) peerExchangeMessage(to *p2pPeer) []byte {
+	var addrs []string
+	for _, peer := range n.peerSnapshot() {
+		if peer == to || peer.rootURL == "" {
+			continue
+		}
+		addrs = append(addrs, peer.rootURL)
+		if len(addrs) == p2pMaxPeerExchangeAddresses {
+			break
+		}
+	}
+	return []byte(strings.Join(addrs, "\n"))
+}
+
+// handlePeerExchange adds the addresses received from a peer to the phonebook,
+// in place of the ones it sent before. It is called by the read loop of the peer.
+func (n *P2PNetwork) handlePeerExchange(msg IncomingMessage) {
+	peer := msg.Sender.(*p2pPeer)
+	now := time.Now()
+	if !peer.lastPeerExchange.IsZero() && now.Sub(peer.lastPeerExchange) < p2pPeerExchangeInterval {
+		n.log.Infof("peer %s sent its peers again after %v", peer.id, now.Sub(peer.lastPeerExchange))
+		n.ReportPeer(peer, PeerSignalProtocolError)
+		return
+	}
+	peer.lastPeerExchange = now
+
+	var lines []string
+	if len(msg.Data) > 0 {
+		lines = strings.Split(string(msg.Data), "\n")
+	}
+	if len(lines) > p2pMaxPeerExchangeAddresses {
+		lines = lines[:p2pMaxPeerExchangeAddresses]
+	}
+	addrs := make([]string, 0, len(lines))
+	for _, addr := range lines {
+		if _, err := ParseHostOrURL(addr); err != nil {
+			n.log.Infof("peer %s sent a bad address %#v", peer.id, filterASCII(addr))
+			continue
+		}
+		addrs = append(addrs, addr)
+	}
+	n.replacePeerExchangeAddresses(peer.id, addrs)
+	n.requestMeshUpdate()
+}
+
+// replacePeerExchangeAddresses replaces the addresses in the phonebook learned from source with addrs, and drops
+// the addresses learned from the source that sent them the longest time ago if there are too many sources.
+func (n *P2PNetwork) replacePeerExchangeAddresses(source PeerID, addrs []string) {
+	n.peerExchangeMu.Lock()
+	defer n.peerExchangeMu.Unlock()
+	for i, id := range n.peerExchangeSources {
+		if id == source {
+			n.peerExchangeSources = append(n.peerExchangeSources[:i], n.peerExchangeSources[i+1:]...)
+			break
+		}
+	}
+	n.peerExchangeSources = append(n.peerExchangeSources, source)
+	if len(n.peerExchangeSources) > p2pMaxPeerExchangeSources {
+		dropped := n.peerExchangeSources[0]
+		n.peerExchangeSources = n.peerExchangeSources[1:]
+		n.phonebook.ReplacePeerList(nil, peerExchangeNetworkName(dropped), PhoneBookEntryRelayRole)
+	}
+	n.phonebook.ReplacePeerList(addrs, peerExchangeNetworkName(source), PhoneBookEntryRelayRole)
+}
+
+// peerExchangeNetworkName is the phonebook network name of the addresses learned from the given peer.
+func peerExchangeNetworkName(source PeerID) string {
+	return p2pPeerExchangeNetworkName + ":" + string(source)
+}
+
+// meshThread keeps the node connected to GossipFanout peers.
+func (n *P2PNetwork) meshThread() {
+	defer n.wg.Done()
+	timer := time.NewTicker(p2pMeshInterval)
+	defer timer.Stop()
+	for {
+		var request meshRequest
+		select {
+		case <-timer.C:
+		case request = <-n.meshUpdateRequests:
+		case <-n.ctx.Done():
+			return
+		}
+
+		if request.disconnect {
+			n.DisconnectPeers()
+		}
+		n.connectToMorePeers()
+		if request.done != nil {
+			close(request.done)
+		}
+	}
+}
+
+// connectToMorePeers connects to phonebook addresses until the node has GossipFanout outgoing connections.
+func (n *P2PNetwork) connectToMorePeers() {
+	addrs := n.phonebook.GetAddresses(1000, PhoneBookEntryRelayRole)
+
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+	connected := make(map[string]bool)
+	needed := n.config.GossipFanout - len(n.dialing)
+	for _, peer := range n.peers {
+		connected[peer.rootURL] = true
+		if peer.outgoing {
+			needed--
+		}
+	}
+	for _, addr := range addrs {
+		if needed <= 0 {
+			return
+		}
+		if connected[addr] || n.dialing[addr] || n.selfAddrs[addr] {
+			continue
+		}
+		n.dialing[addr] = true
+		needed--
+		n.wg.Add(1)
+		go n.tryConnect(addr)
+	}
+}
+
+func (n *P2PNetwork) messageHandlerThread() {
+	defer n.wg.Done()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case msg := <-n.readBuffer:
+			outmsg := n.handlers.Handle(msg)
+			switch outmsg.Action {
+			case Disconnect:
+				n.Disconnect(msg.Sender)
+			case Broadcast:
+				n.Broadcast(n.ctx, msg.Tag, msg.Data, false, msg.Sender)
+			case Respond:
+				err := msg.Sender.(*p2pPeer).Respond(n.ctx, msg, outmsg.Topics)
+				if err != nil && err != n.ctx.Err() {
+					n.log.Warnf("P2PNetwork.messageHandlerThread: p2pPeer.Respond returned unexpected error %v", err)
+				}
+			default:
+			}
+		}
+	}
+}
@@ -0,0 +1,395 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// p2pTestHarness runs peer-to-peer network nodes in the same process.
+type p2pTestHarness struct {
+	t     *testing.T
+	nodes []*P2PNetwork
+}
+
+// addNode starts a node that bootstraps from the addresses of the given nodes.
+func (h *p2pTestHarness) addNode(conf config.Local, bootstrap ...*P2PNetwork) *P2PNetwork {
+	var addrs []string
+	for _, node := range bootstrap {
+		addr, listening := node.Address()
+		require.True(h.t, listening)
+		addrs = append(addrs, addr)
+	}
+	log := logging.TestingLog(h.t)
+	log.SetLevel(logging.Level(conf.BaseLoggerDebugLevel))
+	node, err := NewP2PNetwork(log, conf, "", addrs, "go-test-network-genesis", config.Devtestnet)
+	require.NoError(h.t, err)
+	node.Start()
+	h.nodes = append(h.nodes, node)
+	return node
+}
+
+func (h *p2pTestHarness) stop() {
+	for _, node := range h.nodes {
+		node.Stop()
+	}
+}
+
+// waitPeers waits until every node has the given number of peers.
+func (h *p2pTestHarness) waitPeers(numPeers ...int) {
+	require.Eventually(h.t, func() bool {
+		for i, node := range h.nodes {
+			if len(node.peerSnapshot()) != numPeers[i] {
+				return false
+			}
+		}
+		return true
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+// relayingHandler relays the messages it receives and passes them to the test.
+func relayingHandler(received chan<- IncomingMessage) HandlerFunc {
+	return func(msg IncomingMessage) OutgoingMessage {
+		received <- msg
+		return Propagate(msg)
+	}
+}
+
+func TestP2PIdentity(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	identity, err := loadP2PIdentity(dir)
+	require.NoError(t, err)
+	info, err := os.Stat(filepath.Join(dir, config.P2PIdentityKeyFilename))
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	// the peer ID persists across restarts
+	again, err := loadP2PIdentity(dir)
+	require.NoError(t, err)
+	id := makePeerID(identity.SignatureVerifier)
+	require.Equal(t, id, makePeerID(again.SignatureVerifier))
+	pk, err := id.publicKey()
+	require.NoError(t, err)
+	require.Equal(t, identity.SignatureVerifier, pk)
+
+	ephemeral, err := loadP2PIdentity("")
+	require.NoError(t, err)
+	otherID := makePeerID(ephemeral.SignatureVerifier)
+	require.NotEqual(t, id, otherID)
+
+	_, err = PeerID("not a peer ID").publicKey()
+	require.Error(t, err)
+	_, err = PeerID(id[:10]).publicKey()
+	require.Error(t, err)
+
+	nonce, _ := makeP2PChallenge()
+	challenge := p2pIdentityChallenge{nonce: nonce, genesisID: "test", signer: id, verifier: otherID}
+	sig := identity.Sign(challenge)
+	require.NoError(t, verifyP2PChallenge(challenge, sig[:]))
+
+	// the signature is only good for the node it was made for
+	replayed := challenge
+	replayed.verifier = makePeerID(crypto.GenerateSignatureSecrets(crypto.Seed{1}).SignatureVerifier)
+	require.Error(t, verifyP2PChallenge(replayed, sig[:]))
+	impersonated := challenge
+	impersonated.signer = otherID
+	require.Error(t, verifyP2PChallenge(impersonated, sig[:]))
+	require.Error(t, verifyP2PChallenge(challenge, sig[:10]))
+}
+
+// Nodes bootstrapping from a single node find each other through peer exchange.
+func TestP2PNetworkPeerExchange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := p2pTestHarness{t: t}
+	defer h.stop()
+	conf := defaultConfig
+	conf.GossipFanout = 3
+	bootstrap := h.addNode(conf)
+	for i := 0; i < 3; i++ {
+		h.addNode(conf, bootstrap)
+	}
+	h.waitPeers(3, 3, 3, 3)
+
+	for _, node := range h.nodes {
+		select {
+		case <-node.Ready():
+		default:
+			require.FailNow(t, "node with peers is not ready")
+		}
+		for _, peer := range node.peerSnapshot() {
+			require.NotEqual(t, node.PeerID(), peer.PeerID())
+		}
+	}
+
+	// the peers of a node that leaves drop it
+	h.nodes[3].Stop()
+	h.nodes = h.nodes[:3]
+	h.waitPeers(2, 2, 2)
+}
+
+// Messages reach the nodes that are not connected to the sender through the
+// handlers of the nodes in between.
+func TestP2PNetworkRelay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// a line: 0 <- 1 <- 2 <- 3
+	h := p2pTestHarness{t: t}
+	defer h.stop()
+	conf := defaultConfig
+	// the first node does not connect out to the nodes it learns about
+	conf.GossipFanout = 0
+	prev := h.addNode(conf)
+	conf.GossipFanout = 1
+	for i := 0; i < 3; i++ {
+		prev = h.addNode(conf, prev)
+	}
+	h.waitPeers(1, 2, 2, 1)
+
+	received := make([]chan IncomingMessage, len(h.nodes))
+	for i, node := range h.nodes {
+		received[i] = make(chan IncomingMessage, 10)
+		node.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: relayingHandler(received[i])}})
+	}
+
+	data := []byte("a transaction")
+	require.NoError(t, h.nodes[3].Broadcast(context.Background(), protocol.TxnTag, data, true, nil))
+	for i := 0; i < 3; i++ {
+		select {
+		case msg := <-received[i]:
+			require.Equal(t, data, msg.Data)
+			require.Equal(t, GossipNode(h.nodes[i]), msg.Net)
+		case <-time.After(5 * time.Second):
+			require.FailNowf(t, "timeout", "node %d did not receive the message", i)
+		}
+	}
+
+	// the message does not come back to the nodes that have seen it
+	time.Sleep(100 * time.Millisecond)
+	for i := range h.nodes {
+		require.Len(t, received[i], 0)
+	}
+
+	// messages the handlers ignore are not relayed
+	h.nodes[1].ClearHandlers()
+	h.nodes[1].RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		received[1] <- msg
+		return OutgoingMessage{}
+	})}})
+	require.NoError(t, h.nodes[3].Broadcast(context.Background(), protocol.TxnTag, []byte("another transaction"), true, nil))
+	<-received[2]
+	<-received[1]
+	time.Sleep(100 * time.Millisecond)
+	require.Len(t, received[0], 0)
+}
+
+// A peer exchange replaces the addresses a peer sent before, and may not be repeated right away.
+func TestP2PNetworkPeerExchangeLimits(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node, err := NewP2PNetwork(logging.TestingLog(t), defaultConfig, "", nil, "go-test-network-genesis", config.Devtestnet)
+	require.NoError(t, err)
+	exchange := func(peer *p2pPeer, addrs ...string) {
+		data := []byte{}
+		for i, addr := range addrs {
+			if i > 0 {
+				data = append(data, '\n')
+			}
+			data = append(data, addr...)
+		}
+		node.handlePeerExchange(IncomingMessage{Sender: peer, Tag: protocol.PeerExchangeTag, Data: data})
+	}
+	addresses := func(prefix string, count int) (addrs []string) {
+		for i := 0; i < count; i++ {
+			addrs = append(addrs, fmt.Sprintf("%s-%d.example.com:4160", prefix, i))
+		}
+		return
+	}
+
+	// a peer contributes a bounded number of addresses
+	first := &p2pPeer{id: "first"}
+	exchange(first, addresses("first", 2*p2pMaxPeerExchangeAddresses)...)
+	require.Len(t, node.phonebook.GetAddresses(1000, PhoneBookEntryRelayRole), p2pMaxPeerExchangeAddresses)
+
+	// which it may not send again right away
+	exchange(first, addresses("again", 3)...)
+	require.ElementsMatch(t, addresses("first", p2pMaxPeerExchangeAddresses), node.phonebook.GetAddresses(1000, PhoneBookEntryRelayRole))
+
+	// but later, in place of the ones it sent before
+	first.lastPeerExchange = first.lastPeerExchange.Add(-p2pPeerExchangeInterval)
+	exchange(first, addresses("again", 3)...)
+	require.ElementsMatch(t, addresses("again", 3), node.phonebook.GetAddresses(1000, PhoneBookEntryRelayRole))
+
+	// the addresses of the oldest sources are dropped once there are too many sources
+	var expected []string
+	for i := 0; i < p2pMaxPeerExchangeSources; i++ {
+		name := fmt.Sprintf("source%d", i)
+		exchange(&p2pPeer{id: PeerID(name)}, addresses(name, 1)...)
+		expected = append(expected, addresses(name, 1)...)
+	}
+	require.ElementsMatch(t, expected, node.phonebook.GetAddresses(1000, PhoneBookEntryRelayRole))
+}
+
+// Peers only send the messages a node is interested in.
+func TestP2PNetworkMessagesOfInterest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := p2pTestHarness{t: t}
+	defer h.stop()
+	conf := defaultConfig
+	conf.GossipFanout = 1
+	receiver := h.addNode(conf)
+	sender := h.addNode(conf, receiver)
+	h.waitPeers(1, 1)
+
+	received := make(chan IncomingMessage, 10)
+	handler := HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		received <- msg
+		return OutgoingMessage{}
+	})
+	receiver.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.TxnTag, MessageHandler: handler}, {Tag: protocol.TxnAnnounceTag, MessageHandler: handler}})
+	peer := sender.peerSnapshot()[0]
+	require.Eventually(t, func() bool { return peer.interestedIn(protocol.CompactCertSigTag) }, 5*time.Second, 10*time.Millisecond)
+
+	// the announcements are not sent by default, unlike the transactions
+	require.NoError(t, sender.Broadcast(context.Background(), protocol.TxnAnnounceTag, []byte("an announcement"), true, nil))
+	require.NoError(t, sender.Broadcast(context.Background(), protocol.TxnTag, []byte("a transaction"), true, nil))
+	select {
+	case msg := <-received:
+		require.Equal(t, protocol.TxnTag, msg.Tag)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the transaction was not received")
+	}
+
+	require.NoError(t, receiver.RegisterMessageInterest(protocol.TxnAnnounceTag))
+	require.NoError(t, receiver.DeregisterMessageInterest(protocol.TxnTag))
+	require.Eventually(t, func() bool { return peer.interestedIn(protocol.TxnAnnounceTag) }, 5*time.Second, 10*time.Millisecond)
+	require.False(t, peer.interestedIn(protocol.TxnTag))
+	require.NoError(t, sender.Broadcast(context.Background(), protocol.TxnTag, []byte("another transaction"), true, nil))
+	require.NoError(t, sender.Broadcast(context.Background(), protocol.TxnAnnounceTag, []byte("an announcement"), true, nil))
+	select {
+	case msg := <-received:
+		require.Equal(t, protocol.TxnAnnounceTag, msg.Tag)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "the announcement was not received")
+	}
+}
+
+func TestP2PNetworkRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := p2pTestHarness{t: t}
+	defer h.stop()
+	conf := defaultConfig
+	conf.GossipFanout = 1
+	server := h.addNode(conf)
+	client := h.addNode(conf, server)
+	h.waitPeers(1, 1)
+
+	server.RegisterHandlers([]TaggedMessageHandler{{Tag: protocol.UniEnsBlockReqTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+		topics, err := UnmarshallTopics(msg.Data)
+		require.NoError(t, err)
+		round, found := topics.GetValue("round")
+		require.True(t, found)
+		return OutgoingMessage{Action: Respond, Topics: Topics{MakeTopic("block", append([]byte("block "), round...))}}
+	})}})
+
+	peers := client.GetPeers(PeersConnectedOut)
+	require.Len(t, peers, 1)
+	require.Empty(t, client.GetPeers(PeersConnectedIn))
+	require.Len(t, server.GetPeers(PeersConnectedIn), 1)
+	peer := peers[0].(UnicastPeer)
+	addr, _ := server.Address()
+	require.Equal(t, addr, peer.GetAddress())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	resp, err := peer.Request(ctx, protocol.UniEnsBlockReqTag, Topics{MakeTopic("round", []byte("7"))})
+	require.NoError(t, err)
+	block, found := resp.Topics.GetValue("block")
+	require.True(t, found)
+	require.Equal(t, []byte("block 7"), block)
+
+	client.SetPeerData(peer, "key", "value")
+	require.Equal(t, "value", client.GetPeerData(peer, "key"))
+}
+
+// A node cannot connect with the peer ID of another node.
+func TestP2PNetworkImpersonation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	h := p2pTestHarness{t: t}
+	defer h.stop()
+	conf := defaultConfig
+	node := h.addNode(conf)
+	victim, err := loadP2PIdentity("")
+	require.NoError(t, err)
+	attacker, err := loadP2PIdentity("")
+	require.NoError(t, err)
+
+	addr, _ := node.Address()
+	p2pAddr, err := node.addrToP2PAddr(addr)
+	require.NoError(t, err)
+	_, encodedChallenge := makeP2PChallenge()
+	header := make(http.Header)
+	header.Set(ProtocolVersionHeader, P2PProtocolVersion)
+	header.Set(GenesisHeader, node.GenesisID)
+	header.Set(P2PPeerIDHeader, string(makePeerID(victim.SignatureVerifier)))
+	header.Set(P2PChallengeHeader, encodedChallenge)
+	conn, response, err := websocket.DefaultDialer.Dial(p2pAddr, header)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	otherChallenge, err := parseP2PChallenge(response.Header.Get(P2PChallengeHeader))
+	require.NoError(t, err)
+	sig := attacker.Sign(p2pIdentityChallenge{nonce: otherChallenge, genesisID: node.GenesisID, signer: makePeerID(victim.SignatureVerifier), verifier: node.PeerID()})
+	require.NoError(t, conn.WriteMessage(websocket.BinaryMessage, sig[:]))
+	_, _, err = conn.ReadMessage()
+	require.Error(t, err)
+	require.Empty(t, node.peerSnapshot())
+
+	// nor with a different genesis
+	header.Set(GenesisHeader, "another-genesis")
+	_, response, err = websocket.DefaultDialer.Dial(p2pAddr, header)
+	require.Equal(t, websocket.ErrBadHandshake, err)
+	require.Equal(t, http.StatusPreconditionFailed, response.StatusCode)
+
+	// a node does not connect to itself
+	header.Set(GenesisHeader, node.GenesisID)
+	header.Set(P2PPeerIDHeader, string(node.PeerID()))
+	_, response, err = websocket.DefaultDialer.Dial(p2pAddr, header)
+	require.Equal(t, websocket.ErrBadHandshake, err)
+	require.Equal(t, http.StatusLoopDetected, response.StatusCode)
+	require.Empty(t, response.Header.Get(P2PSignatureHeader))
+}
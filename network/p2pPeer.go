@@ -0,0 +1,424 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+	"github.com/algorand/websocket"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// P2PProtocolVersion is the version of the peer-to-peer gossip protocol.
+const P2PProtocolVersion = "1.0"
+
+// p2pSendBufferLength is the number of messages queued for a peer, for each priority.
+const p2pSendBufferLength = 1000
+
+// p2pWriteTimeout is how long a message may take to be written to a peer.
+const p2pWriteTimeout = 30 * time.Second
+
+// p2pHTTPPeer is a phonebook address the node is not necessarily connected to. It implements HTTPPeer.
+type p2pHTTPPeer struct {
+	rootURL string
+	client  http.Client
+}
+
+// GetAddress returns the root url to use to connect to this peer.
+func (p *p2pHTTPPeer) GetAddress() string {
+	return p.rootURL
+}
+
+// GetHTTPClient returns a client for this peer.
+func (p *p2pHTTPPeer) GetHTTPClient() *http.Client {
+	return &p.client
+}
+
+// p2pPeer is a peer of the peer-to-peer network. It implements HTTPPeer and UnicastPeer.
+type p2pPeer struct {
+	net  *P2PNetwork
+	id   PeerID
+	conn *websocket.Conn
+
+	// rootURL is the address the peer serves HTTP at, or empty for incoming
+	// peers that do not listen.
+	rootURL  string
+	outgoing bool
+	client   http.Client
+
+	sendHighPrio chan []byte
+	sendBulk     chan []byte
+	closing      chan struct{}
+	closeOnce    sync.Once
+	wg           sync.WaitGroup
+
+	// repeatFilter holds the messages the peer sent, to catch the ones it sends again
+	repeatFilter *messageFilter
+
+	// messagesOfInterest holds the map[protocol.Tag]bool of the tags of the messages the peer wants to receive
+	messagesOfInterest atomic.Value
+	// lastPeerExchange is when the peer last sent the addresses of its peers. It is only accessed by the read loop.
+	lastPeerExchange time.Time
+
+	createTime time.Time
+	// traffic counts the bytes exchanged with the peer
+	traffic peerTraffic
+
+	requestNonce          uint64
+	responseChannels      map[uint64]chan *Response
+	responseChannelsMutex deadlock.Mutex
+
+	// clientDataStore holds the values of SetPeerData, locked by clientDataStoreMu
+	clientDataStore   map[string]interface{}
+	clientDataStoreMu deadlock.Mutex
+}
+
+func makeP2PPeer(net *P2PNetwork, id PeerID, conn *websocket.Conn, rootURL string, outgoing bool) *p2pPeer {
+	peer := &p2pPeer{
+		net:              net,
+		id:               id,
+		conn:             conn,
+		rootURL:          rootURL,
+		outgoing:         outgoing,
+		client:           http.Client{Transport: net.GetRoundTripper()},
+		sendHighPrio:     make(chan []byte, p2pSendBufferLength),
+		sendBulk:         make(chan []byte, p2pSendBufferLength),
+		closing:          make(chan struct{}),
+		responseChannels: make(map[uint64]chan *Response),
+		clientDataStore:  make(map[string]interface{}),
+		createTime:       time.Now(),
+	}
+	if net.config.PeerBanThreshold != 0 {
+		peer.repeatFilter = makeMessageFilter(peerRepeatFilterBucketCount, peerRepeatFilterBucketSize)
+	}
+	peer.messagesOfInterest.Store(defaultSendMessageTags)
+	return peer
+}
+
+func (p *p2pPeer) start() {
+	p.wg.Add(2)
+	go p.readLoop()
+	go p.writeLoop()
+}
+
+// GetAddress returns the root url to use to connect to this peer.
+func (p *p2pPeer) GetAddress() string {
+	return p.rootURL
+}
+
+// GetHTTPClient returns a client for this peer.
+func (p *p2pPeer) GetHTTPClient() *http.Client {
+	return &p.client
+}
+
+// Version returns the version of the peer-to-peer protocol.
+func (p *p2pPeer) Version() string {
+	return P2PProtocolVersion
+}
+
+// PeerID returns the peer ID of the peer.
+func (p *p2pPeer) PeerID() PeerID {
+	return p.id
+}
+
+// send queues a message made of a tag and a payload. Unless wait is set, the
+// message is dropped if the queue is full. Messages the peer is not interested
+// in are dropped as well.
+func (p *p2pPeer) send(ctx context.Context, tag protocol.Tag, data []byte, wait bool) error {
+	if !p.interestedIn(tag) {
+		return nil
+	}
+	msg := make([]byte, len(tag)+len(data))
+	copy(msg, tag)
+	copy(msg[len(tag):], data)
+
+	queue := p.sendBulk
+	if highPriorityTag([]protocol.Tag{tag}) {
+		queue = p.sendHighPrio
+	}
+	if !wait {
+		select {
+		case queue <- msg:
+			return nil
+		case <-p.closing:
+			return fmt.Errorf("peer closing %s", p.id)
+		default:
+			networkBroadcastsDropped.Inc(nil)
+			return errBcastQFull
+		}
+	}
+	select {
+	case queue <- msg:
+		return nil
+	case <-p.closing:
+		return fmt.Errorf("peer closing %s", p.id)
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// interestedIn returns whether the peer wants to receive messages with the given tag.
+func (p *p2pPeer) interestedIn(tag protocol.Tag) bool {
+	switch tag {
+	case protocol.PeerExchangeTag, protocol.MsgOfInterestTag:
+		return true
+	}
+	return p.messagesOfInterest.Load().(map[protocol.Tag]bool)[tag]
+}
+
+// handleMessageOfInterest sets the tags of the messages the peer wants to receive.
+func (p *p2pPeer) handleMessageOfInterest(msg IncomingMessage) {
+	tags, err := unmarshallMessageOfInterest(msg.Data)
+	if err != nil {
+		p.net.log.Warnf("p2pPeer readLoop: could not read the messages of interest of %s: %v", p.id, err)
+		p.net.ReportPeer(p, PeerSignalProtocolError)
+		return
+	}
+	p.messagesOfInterest.Store(tags)
+}
+
+// Unicast sends the given bytes to this specific peer. Does not wait for message to be sent.
+// (Implements UnicastPeer)
+func (p *p2pPeer) Unicast(ctx context.Context, msg []byte, tag protocol.Tag) error {
+	err := p.send(ctx, tag, msg, false)
+	if err != nil {
+		return fmt.Errorf("p2pPeer failed to unicast to %s: %v", p.id, err)
+	}
+	return nil
+}
+
+// Request submits the request to the peer, waits for a response
+func (p *p2pPeer) Request(ctx context.Context, tag Tag, topics Topics) (resp *Response, e error) {
+	nonce := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(nonce, atomic.AddUint64(&p.requestNonce, 1))
+	topics = append(topics, Topic{key: "nonce", data: nonce})
+	serializedMsg := topics.MarshallTopics()
+
+	hash := hashTopics(serializedMsg)
+	responseChannel := make(chan *Response, 1)
+	p.responseChannelsMutex.Lock()
+	p.responseChannels[hash] = responseChannel
+	p.responseChannelsMutex.Unlock()
+	defer p.getAndRemoveResponseChannel(hash)
+
+	err := p.send(ctx, tag, serializedMsg, true)
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case resp = <-responseChannel:
+		return resp, nil
+	case <-p.closing:
+		return nil, fmt.Errorf("peer closing %s", p.id)
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Respond sends the response of a request message
+func (p *p2pPeer) Respond(ctx context.Context, reqMsg IncomingMessage, responseTopics Topics) (e error) {
+	requestHashData := make([]byte, binary.MaxVarintLen64)
+	binary.PutUvarint(requestHashData, hashTopics(reqMsg.Data))
+	responseTopics = append(responseTopics, Topic{key: requestHashKey, data: requestHashData})
+	return p.send(ctx, protocol.TopicMsgRespTag, responseTopics.MarshallTopics(), true)
+}
+
+// getAndRemoveResponseChannel returns the channel and deletes the channel from the map
+func (p *p2pPeer) getAndRemoveResponseChannel(key uint64) (respChan chan *Response, found bool) {
+	p.responseChannelsMutex.Lock()
+	defer p.responseChannelsMutex.Unlock()
+	respChan, found = p.responseChannels[key]
+	delete(p.responseChannels, key)
+	return
+}
+
+func (p *p2pPeer) handleResponse(msg IncomingMessage) {
+	topics, err := UnmarshallTopics(msg.Data)
+	if err != nil {
+		p.net.log.Warnf("p2pPeer readLoop: could not read the message from %s: %v", p.id, err)
+		return
+	}
+	requestHash, found := topics.GetValue(requestHashKey)
+	if !found {
+		p.net.log.Warnf("p2pPeer readLoop: message from %s is missing the %s", p.id, requestHashKey)
+		return
+	}
+	hashKey, _ := binary.Uvarint(requestHash)
+	channel, found := p.getAndRemoveResponseChannel(hashKey)
+	if !found {
+		p.net.log.Warnf("p2pPeer readLoop: received a message response from %s for a stale request", p.id)
+		return
+	}
+	select {
+	case channel <- &Response{Topics: topics}:
+	default:
+	}
+}
+
+func (p *p2pPeer) readLoop() {
+	defer p.wg.Done()
+	defer p.net.removePeer(p)
+	p.conn.SetReadLimit(maxMessageLength)
+	slurper := MakeLimitedReaderSlurper(averageMessageLength, maxMessageLength)
+	for {
+		mtype, reader, err := p.conn.NextReader()
+		if err != nil {
+			if _, ok := err.(*websocket.CloseError); !ok {
+				select {
+				case <-p.closing:
+				default:
+					p.net.log.Infof("p2pPeer readLoop: peer %s read error: %v", p.id, err)
+				}
+			}
+			if err == websocket.ErrReadLimit {
+				p.net.ReportPeer(p, PeerSignalOversizedMessage)
+			}
+			return
+		}
+		if mtype != websocket.BinaryMessage {
+			p.net.log.Warnf("p2pPeer readLoop: peer %s sent non websocket-binary message: %#v", p.id, mtype)
+			networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "protocol"})
+			p.net.ReportPeer(p, PeerSignalProtocolError)
+			return
+		}
+		var tag [tagLength]byte
+		_, err = io.ReadFull(reader, tag[:])
+		if err != nil {
+			p.net.log.Infof("p2pPeer readLoop: peer %s read error: %v", p.id, err)
+			return
+		}
+		slurper.Reset()
+		err = slurper.Read(reader)
+		if err != nil {
+			p.net.log.Infof("p2pPeer readLoop: peer %s read error: %v", p.id, err)
+			if err == ErrIncomingMsgTooLarge || err == websocket.ErrReadLimit {
+				p.net.ReportPeer(p, PeerSignalOversizedMessage)
+			}
+			return
+		}
+
+		msg := IncomingMessage{
+			Sender:   p,
+			Tag:      Tag(tag[:]),
+			Data:     slurper.Bytes(),
+			Net:      p.net,
+			Received: time.Now().UnixNano(),
+		}
+		receivedBytes := uint64(len(msg.Data) + tagLength)
+		networkReceivedBytesTotal.AddUint64(receivedBytes, nil)
+		networkMessageReceivedTotal.AddUint64(1, nil)
+		networkReceivedBytesByTag.Add(string(msg.Tag), receivedBytes)
+		p.traffic.addReceived(msg.Tag, receivedBytes)
+		networkMessageReceivedByTag.Add(string(msg.Tag), 1)
+
+		switch msg.Tag {
+		case protocol.TopicMsgRespTag:
+			p.handleResponse(msg)
+			continue
+		case protocol.PeerExchangeTag:
+			p.net.handlePeerExchange(msg)
+			continue
+		case protocol.MsgOfInterestTag:
+			p.handleMessageOfInterest(msg)
+			continue
+		}
+		if p.repeatFilter != nil && dedupSafeTag(msg.Tag) && p.repeatFilter.CheckIncomingMessage(msg.Tag, msg.Data, true, false) {
+			p.net.ReportPeer(p, PeerSignalDuplicateMessage)
+		}
+		if dedupSafeTag(msg.Tag) && p.net.seenMessages.CheckIncomingMessage(msg.Tag, msg.Data, true, true) {
+			duplicateNetworkMessageReceivedTotal.Inc(nil)
+			duplicateNetworkMessageReceivedBytesTotal.AddUint64(receivedBytes, nil)
+			continue
+		}
+
+		select {
+		case p.net.readBuffer <- msg:
+		case <-p.closing:
+			return
+		}
+	}
+}
+
+func (p *p2pPeer) writeLoop() {
+	defer p.wg.Done()
+	defer p.net.removePeer(p)
+	for {
+		var msg []byte
+		// votes and proposals go first
+		select {
+		case msg = <-p.sendHighPrio:
+		default:
+			select {
+			case msg = <-p.sendHighPrio:
+			case msg = <-p.sendBulk:
+			case <-p.closing:
+				return
+			}
+		}
+
+		p.conn.SetWriteDeadline(time.Now().Add(p2pWriteTimeout))
+		err := p.conn.WriteMessage(websocket.BinaryMessage, msg)
+		if err != nil {
+			select {
+			case <-p.closing:
+			default:
+				p.net.log.Infof("p2pPeer writeLoop: peer %s write error: %v", p.id, err)
+			}
+			return
+		}
+		tag := string(msg[:tagLength])
+		networkSentBytesTotal.AddUint64(uint64(len(msg)), nil)
+		networkSentBytesByTag.Add(tag, uint64(len(msg)))
+		p.traffic.addSent(protocol.Tag(tag), uint64(len(msg)))
+		networkMessageSentTotal.AddUint64(1, nil)
+		networkMessageSentByTag.Add(tag, 1)
+	}
+}
+
+// close closes the connection to the peer. It does not wait for the read and write loops to exit.
+func (p *p2pPeer) close() {
+	p.closeOnce.Do(func() {
+		close(p.closing)
+		p.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(peerShutdownDisconnectionAckDuration))
+		p.conn.CloseWithoutFlush()
+	})
+}
+
+func (p *p2pPeer) getPeerData(key string) interface{} {
+	p.clientDataStoreMu.Lock()
+	defer p.clientDataStoreMu.Unlock()
+	return p.clientDataStore[key]
+}
+
+func (p *p2pPeer) setPeerData(key string, value interface{}) {
+	p.clientDataStoreMu.Lock()
+	defer p.clientDataStoreMu.Unlock()
+	if value == nil {
+		delete(p.clientDataStore, key)
+	} else {
+		p.clientDataStore[key] = value
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// The roles of the peers listed by GetPeerInfo.
+const (
+	// PeerRoleRelay is a relay the node connected to
+	PeerRoleRelay = "relay"
+	// PeerRoleClient is a node that connected to this relay
+	PeerRoleClient = "client"
+	// PeerRolePriority is a node that connected to this relay, and that is listed as a priority peer
+	PeerRolePriority = "priority"
+	// PeerRoleMesh is a peer of the peer-to-peer network
+	PeerRoleMesh = "mesh"
+)
+
+// PeerInfo describes a connection to a peer, for the node administrator.
+type PeerInfo struct {
+	// Address is the address the peer is known by, as used by the peer bans:
+	// the phonebook address of a relay, the remote host of an incoming
+	// connection, or the peer ID on the peer-to-peer network.
+	Address  string
+	Role     string
+	Outgoing bool
+	// Version is the network protocol version of the connection
+	Version        string
+	ConnectedSince time.Time
+	// LastPingRoundTripTime is zero until the peer answered a ping
+	LastPingRoundTripTime time.Duration
+	Traffic               []TagTraffic
+}
+
+// TagTraffic is the number of bytes exchanged with a peer in messages of a tag.
+type TagTraffic struct {
+	Tag      protocol.Tag
+	Received uint64
+	Sent     uint64
+}
+
+// peerTraffic counts the bytes exchanged with a peer, by tag. The zero value is ready to use.
+type peerTraffic struct {
+	mu       deadlock.Mutex
+	received map[protocol.Tag]uint64
+	sent     map[protocol.Tag]uint64
+}
+
+func (t *peerTraffic) addReceived(tag protocol.Tag, n uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.received == nil {
+		t.received = make(map[protocol.Tag]uint64)
+	}
+	t.received[tag] += n
+}
+
+func (t *peerTraffic) addSent(tag protocol.Tag, n uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.sent == nil {
+		t.sent = make(map[protocol.Tag]uint64)
+	}
+	t.sent[tag] += n
+}
+
+// snapshot returns the traffic of every tag, sorted by tag.
+func (t *peerTraffic) snapshot() []TagTraffic {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	byTag := make(map[protocol.Tag]*TagTraffic)
+	get := func(tag protocol.Tag) *TagTraffic {
+		if byTag[tag] == nil {
+			byTag[tag] = &TagTraffic{Tag: tag}
+		}
+		return byTag[tag]
+	}
+	for tag, n := range t.received {
+		get(tag).Received = n
+	}
+	for tag, n := range t.sent {
+		get(tag).Sent = n
+	}
+	traffic := make([]TagTraffic, 0, len(byTag))
+	for _, tt := range byTag {
+		traffic = append(traffic, *tt)
+	}
+	sort.Slice(traffic, func(i, j int) bool { return traffic[i].Tag < traffic[j].Tag })
+	return traffic
+}
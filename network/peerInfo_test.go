@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestPeerTraffic(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var traffic peerTraffic
+	require.Empty(t, traffic.snapshot())
+	traffic.addReceived(protocol.TxnTag, 10)
+	traffic.addReceived(protocol.TxnTag, 5)
+	traffic.addSent(protocol.AgreementVoteTag, 7)
+	require.Equal(t, []TagTraffic{
+		{Tag: protocol.AgreementVoteTag, Sent: 7},
+		{Tag: protocol.TxnTag, Received: 15},
+	}, traffic.snapshot())
+}
+
+func TestWebsocketNetworkPeerInfo(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netB.Stop()
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	require.NoError(t, netB.Broadcast(context.Background(), protocol.TxnTag, []byte("hello"), true, nil))
+	require.Eventually(t, func() bool {
+		infos := netA.GetPeerInfo()
+		return len(infos) == 1 && tagTraffic(infos[0].Traffic, protocol.TxnTag).Received > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	infos := netA.GetPeerInfo()
+	require.Equal(t, "127.0.0.1", infos[0].Address)
+	require.Equal(t, PeerRoleClient, infos[0].Role)
+	require.False(t, infos[0].Outgoing)
+	require.NotEmpty(t, infos[0].Version)
+	require.WithinDuration(t, time.Now(), infos[0].ConnectedSince, time.Minute)
+	require.Equal(t, TagTraffic{Tag: protocol.TxnTag, Received: uint64(len(protocol.TxnTag) + len("hello"))}, tagTraffic(infos[0].Traffic, protocol.TxnTag))
+
+	infos = netB.GetPeerInfo()
+	require.Len(t, infos, 1)
+	require.Equal(t, addrA, infos[0].Address)
+	require.Equal(t, PeerRoleRelay, infos[0].Role)
+	require.True(t, infos[0].Outgoing)
+
+	// the relay answers the pings of the client
+	require.Zero(t, infos[0].LastPingRoundTripTime)
+	peers := netB.GetPeers(PeersConnectedOut)
+	require.Len(t, peers, 1)
+	require.True(t, peers[0].(*wsPeer).sendPing())
+	require.Eventually(t, func() bool { return netB.GetPeerInfo()[0].LastPingRoundTripTime > 0 }, 5*time.Second, 10*time.Millisecond)
+
+	require.Error(t, netA.AddPriorityPeer("localhost"))
+	require.NoError(t, netA.AddPriorityPeer("127.0.0.1"))
+	require.Equal(t, PeerRolePriority, netA.GetPeerInfo()[0].Role)
+
+	require.Equal(t, 0, netA.DisconnectAddress("1.2.3.4"))
+	require.Equal(t, 1, netA.DisconnectAddress("127.0.0.1"))
+	require.Eventually(t, func() bool { return len(netA.GetPeerInfo()) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func tagTraffic(traffic []TagTraffic, tag protocol.Tag) TagTraffic {
+	for _, traffic := range traffic {
+		if traffic.Tag == tag {
+			return traffic
+		}
+	}
+	return TagTraffic{}
+}
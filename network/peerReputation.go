@@ -0,0 +1,285 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+var networkPeersBannedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_peers_banned_total", Description: "Number of peers banned for misbehaving"})
+
+// PeerSignal is a misbehavior of a peer. Every signal adds a penalty to the
+// reputation of the peer, and a peer whose penalties reach the ban threshold
+// gets banned for a while.
+type PeerSignal int
+
+const (
+	// PeerSignalInvalidTxn is raised for a transaction message holding no transaction. Transactions that cannot
+	// be decoded or fail verification are not penalized, since an honest peer may relay them across a consensus
+	// upgrade.
+	PeerSignalInvalidTxn PeerSignal = iota
+	// PeerSignalInvalidVote is raised for a malformed or invalid vote or bundle
+	PeerSignalInvalidVote
+	// PeerSignalInvalidProposal is raised for a malformed or invalid proposal
+	PeerSignalInvalidProposal
+	// PeerSignalOversizedMessage is raised for a message larger than the maximal message length
+	PeerSignalOversizedMessage
+	// PeerSignalDuplicateMessage is raised when a peer sends again a message it already sent
+	PeerSignalDuplicateMessage
+	// PeerSignalProtocolError is raised for a message that does not follow the network protocol
+	PeerSignalProtocolError
+
+	peerSignalCount
+)
+
+var peerSignalNames = [peerSignalCount]string{"InvalidTxn", "InvalidVote", "InvalidProposal", "OversizedMessage", "DuplicateMessage", "ProtocolError"}
+
+// peerSignalPenalties is the penalty of each signal. Duplicates are cheap since
+// an honest peer may occasionally resend a message, but a peer that keeps doing
+// it is wasting our bandwidth.
+var peerSignalPenalties = [peerSignalCount]float64{20, 20, 20, 50, 1, 50}
+
+func (s PeerSignal) String() string {
+	if s < 0 || s >= peerSignalCount {
+		return "Unknown"
+	}
+	return peerSignalNames[s]
+}
+
+// peerReputationHalfLife is how long it takes for the penalties of a peer to fade by half.
+const peerReputationHalfLife = 10 * time.Minute
+
+// maxTrackedPeerScores bounds the number of peers with penalties kept in memory.
+// Past it, the scores that have mostly faded away are forgotten, or else the lowest one.
+const maxTrackedPeerScores = 1000
+
+// maxPeerBans bounds the number of bans kept in memory. Past it, the expired bans
+// are dropped, or else the one expiring first.
+const maxPeerBans = 1000
+
+// PeerBan is a temporary ban of a peer.
+type PeerBan struct {
+	// Address is the address of the peer, or its peer ID on the peer-to-peer network
+	Address string `json:"address"`
+	// Reason is the signal that got the peer banned
+	Reason string `json:"reason"`
+	// Expires is when the ban is lifted
+	Expires time.Time `json:"expires"`
+}
+
+type peerScore struct {
+	penalty float64
+	updated time.Time
+}
+
+// decayed returns the penalty left at the given time.
+func (s peerScore) decayed(now time.Time) float64 {
+	return s.penalty * math.Exp2(-float64(now.Sub(s.updated))/float64(peerReputationHalfLife))
+}
+
+// peerReputation scores the peers from the signals raised about them, and
+// keeps the bans of the peers that reached the threshold.
+type peerReputation struct {
+	mu          deadlock.Mutex
+	log         logging.Logger
+	threshold   float64
+	banDuration time.Duration
+
+	scores map[string]peerScore
+	bans   map[string]PeerBan
+
+	// filename is where the bans are saved, if set
+	filename string
+}
+
+func makePeerReputation(log logging.Logger, cfg config.Local) *peerReputation {
+	return &peerReputation{
+		log:         log,
+		threshold:   float64(cfg.PeerBanThreshold),
+		banDuration: time.Duration(cfg.PeerBanDurationSeconds) * time.Second,
+		scores:      make(map[string]peerScore),
+		bans:        make(map[string]PeerBan),
+	}
+}
+
+// load restores the bans saved in filename, and saves the bans there from now on.
+// A missing file holds no bans.
+func (r *peerReputation) load(filename string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.filename = filename
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var bans []PeerBan
+	err = json.Unmarshal(data, &bans)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, ban := range bans {
+		if ban.Expires.After(now) {
+			r.addBan(ban, now)
+		}
+	}
+	return nil
+}
+
+// save writes the bans to the file given to load. It is called with r.mu held.
+func (r *peerReputation) save() {
+	if r.filename == "" {
+		return
+	}
+	data, err := json.Marshal(r.snapshot())
+	if err == nil {
+		err = ioutil.WriteFile(r.filename, data, 0600)
+	}
+	if err != nil {
+		r.log.Warnf("unable to save the peer bans to %s: %v", r.filename, err)
+	}
+}
+
+// snapshot returns the bans that have not expired, sorted by address. It is called with r.mu held.
+func (r *peerReputation) snapshot() []PeerBan {
+	now := time.Now()
+	bans := make([]PeerBan, 0, len(r.bans))
+	for address, ban := range r.bans {
+		if !ban.Expires.After(now) {
+			delete(r.bans, address)
+			continue
+		}
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool { return bans[i].Address < bans[j].Address })
+	return bans
+}
+
+// report adds the penalty of signal to the peer at address, and returns true
+// if the peer got banned.
+func (r *peerReputation) report(address string, signal PeerSignal) bool {
+	if r.threshold == 0 || address == "" || signal < 0 || signal >= peerSignalCount {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	score := peerScore{penalty: r.scores[address].decayed(now) + peerSignalPenalties[signal], updated: now}
+	// penalties are counted in whole points, so that signals raised in a quick
+	// succession reach the threshold despite the decay in between
+	if math.Round(score.penalty) < r.threshold {
+		if _, tracked := r.scores[address]; !tracked && len(r.scores) >= maxTrackedPeerScores {
+			r.forgetFadedScores(now)
+		}
+		r.scores[address] = score
+		return false
+	}
+
+	delete(r.scores, address)
+	r.addBan(PeerBan{Address: address, Reason: signal.String(), Expires: now.Add(r.banDuration)}, now)
+	r.log.Infof("banning peer %s until %v after %s", address, r.bans[address].Expires, signal)
+	networkPeersBannedTotal.Inc(map[string]string{"reason": signal.String()})
+	r.save()
+	return true
+}
+
+// forgetFadedScores drops the scores down to a single penalty point, or the
+// lowest score if none is. It is called with r.mu held.
+func (r *peerReputation) forgetFadedScores(now time.Time) {
+	lowestAddress, lowestPenalty := "", math.Inf(1)
+	for address, score := range r.scores {
+		penalty := score.decayed(now)
+		if penalty < 1 {
+			delete(r.scores, address)
+			continue
+		}
+		if penalty < lowestPenalty {
+			lowestAddress, lowestPenalty = address, penalty
+		}
+	}
+	if len(r.scores) >= maxTrackedPeerScores {
+		delete(r.scores, lowestAddress)
+	}
+}
+
+// addBan keeps the given ban, making room for it past maxPeerBans. It is called with r.mu held.
+func (r *peerReputation) addBan(ban PeerBan, now time.Time) {
+	if _, banned := r.bans[ban.Address]; !banned && len(r.bans) >= maxPeerBans {
+		var firstExpiring string
+		for address, other := range r.bans {
+			if !other.Expires.After(now) {
+				delete(r.bans, address)
+				continue
+			}
+			if firstExpiring == "" || other.Expires.Before(r.bans[firstExpiring].Expires) {
+				firstExpiring = address
+			}
+		}
+		if len(r.bans) >= maxPeerBans {
+			delete(r.bans, firstExpiring)
+		}
+	}
+	r.bans[ban.Address] = ban
+}
+
+// isBanned returns true if the peer at address is banned.
+func (r *peerReputation) isBanned(address string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ban, banned := r.bans[address]
+	return banned && ban.Expires.After(time.Now())
+}
+
+// list returns the current bans.
+func (r *peerReputation) list() []PeerBan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.snapshot()
+}
+
+// clear lifts the ban of the peer at address, or all the bans if address is
+// empty, and returns the number of bans lifted.
+func (r *peerReputation) clear(address string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	bans := r.snapshot()
+	cleared := 0
+	for _, ban := range bans {
+		if address == "" || ban.Address == address {
+			delete(r.bans, ban.Address)
+			cleared++
+		}
+	}
+	if cleared > 0 {
+		r.save()
+	}
+	return cleared
+}
@@ -0,0 +1,221 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/algorand/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// peerBanTestConfig returns a config with banning enabled, which it is not by default.
+func peerBanTestConfig() config.Local {
+	cfg := config.GetDefaultLocal()
+	cfg.PeerBanThreshold = 100
+	return cfg
+}
+
+func TestPeerReputationBan(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	r := makePeerReputation(logging.TestingLog(t), peerBanTestConfig())
+	for i := 0; i < 4; i++ {
+		require.False(t, r.report("1.2.3.4", PeerSignalInvalidTxn))
+	}
+	require.False(t, r.isBanned("1.2.3.4"))
+	require.True(t, r.report("1.2.3.4", PeerSignalInvalidTxn))
+	require.True(t, r.isBanned("1.2.3.4"))
+	require.False(t, r.isBanned("5.6.7.8"))
+
+	bans := r.list()
+	require.Len(t, bans, 1)
+	require.Equal(t, "1.2.3.4", bans[0].Address)
+	require.Equal(t, "InvalidTxn", bans[0].Reason)
+	require.WithinDuration(t, time.Now().Add(time.Hour), bans[0].Expires, time.Minute)
+
+	require.Equal(t, 0, r.clear("5.6.7.8"))
+	require.Equal(t, 1, r.clear("1.2.3.4"))
+	require.False(t, r.isBanned("1.2.3.4"))
+
+	// the penalties start over after a ban
+	require.False(t, r.report("1.2.3.4", PeerSignalOversizedMessage))
+	require.True(t, r.report("1.2.3.4", PeerSignalOversizedMessage))
+
+	// expired bans are lifted
+	r.bans["1.2.3.4"] = PeerBan{Address: "1.2.3.4", Expires: time.Now().Add(-time.Second)}
+	require.False(t, r.isBanned("1.2.3.4"))
+	require.Empty(t, r.list())
+}
+
+func TestPeerReputationDecay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	r := makePeerReputation(logging.TestingLog(t), peerBanTestConfig())
+	require.False(t, r.report("1.2.3.4", PeerSignalOversizedMessage))
+	// half of the penalty is left after a half-life
+	score := r.scores["1.2.3.4"]
+	score.updated = score.updated.Add(-peerReputationHalfLife)
+	r.scores["1.2.3.4"] = score
+	require.False(t, r.report("1.2.3.4", PeerSignalOversizedMessage))
+	require.InDelta(t, 75, r.scores["1.2.3.4"].penalty, 0.1)
+	require.True(t, r.report("1.2.3.4", PeerSignalOversizedMessage))
+
+	// faded scores are forgotten once too many peers are tracked
+	for i := 0; i < maxTrackedPeerScores; i++ {
+		r.scores[string(rune(i))] = peerScore{penalty: 10, updated: time.Now().Add(-10 * peerReputationHalfLife)}
+	}
+	require.False(t, r.report("5.6.7.8", PeerSignalDuplicateMessage))
+	require.Len(t, r.scores, 1)
+}
+
+func TestPeerReputationBounds(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	r := makePeerReputation(logging.TestingLog(t), peerBanTestConfig())
+	now := time.Now()
+
+	// the lowest score is forgotten when none has faded
+	for i := 0; i < maxTrackedPeerScores; i++ {
+		r.scores[fmt.Sprintf("score-%d", i)] = peerScore{penalty: float64(10 + i%50), updated: now}
+	}
+	r.scores["lowest"] = peerScore{penalty: 5, updated: now}
+	delete(r.scores, "score-0")
+	require.False(t, r.report("1.2.3.4", PeerSignalDuplicateMessage))
+	require.Len(t, r.scores, maxTrackedPeerScores)
+	require.Contains(t, r.scores, "1.2.3.4")
+	require.NotContains(t, r.scores, "lowest")
+
+	// the ban expiring first is dropped when none has expired
+	for i := 0; i < maxPeerBans; i++ {
+		address := fmt.Sprintf("ban-%d", i)
+		r.bans[address] = PeerBan{Address: address, Expires: now.Add(time.Hour + time.Duration(i)*time.Second)}
+	}
+	for i := 0; i < 4; i++ {
+		r.report("5.6.7.8", PeerSignalProtocolError)
+	}
+	require.True(t, r.isBanned("5.6.7.8"))
+	require.Len(t, r.bans, maxPeerBans)
+	require.False(t, r.isBanned("ban-0"))
+	require.True(t, r.isBanned("ban-1"))
+
+	// expired bans are dropped first
+	r.bans["ban-1"] = PeerBan{Address: "ban-1", Expires: now.Add(-time.Second)}
+	for i := 0; i < 4; i++ {
+		r.report("9.9.9.9", PeerSignalProtocolError)
+	}
+	require.Len(t, r.bans, maxPeerBans)
+	require.True(t, r.isBanned("ban-2"))
+	require.True(t, r.isBanned("9.9.9.9"))
+}
+
+func TestPeerReputationDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// banning is disabled by default
+	r := makePeerReputation(logging.TestingLog(t), config.GetDefaultLocal())
+	for i := 0; i < 100; i++ {
+		require.False(t, r.report("1.2.3.4", PeerSignalProtocolError))
+	}
+	require.Empty(t, r.list())
+	require.Empty(t, r.scores)
+}
+
+func TestPeerReputationPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	filename := filepath.Join(t.TempDir(), config.PeerBansFilename)
+	r := makePeerReputation(logging.TestingLog(t), peerBanTestConfig())
+	require.NoError(t, r.load(filename))
+	require.Empty(t, r.list())
+	for i := 0; i < 2; i++ {
+		r.report("1.2.3.4", PeerSignalProtocolError)
+		r.report("5.6.7.8", PeerSignalProtocolError)
+	}
+	r.bans["9.9.9.9"] = PeerBan{Address: "9.9.9.9", Expires: time.Now().Add(-time.Second)}
+
+	restarted := makePeerReputation(logging.TestingLog(t), peerBanTestConfig())
+	require.NoError(t, restarted.load(filename))
+	require.Equal(t, []string{"1.2.3.4", "5.6.7.8"}, banAddresses(restarted.list()))
+
+	require.Equal(t, 1, restarted.clear("1.2.3.4"))
+	restarted = makePeerReputation(logging.TestingLog(t), peerBanTestConfig())
+	require.NoError(t, restarted.load(filename))
+	require.Equal(t, []string{"5.6.7.8"}, banAddresses(restarted.list()))
+
+	require.NoError(t, ioutil.WriteFile(filename, []byte("not json"), 0600))
+	require.Error(t, restarted.load(filename))
+}
+
+func banAddresses(bans []PeerBan) (addresses []string) {
+	for _, ban := range bans {
+		addresses = append(addresses, ban.Address)
+	}
+	return addresses
+}
+
+// A banned peer is disconnected and cannot connect again until the ban is lifted.
+func TestWebsocketNetworkBanPeer(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := defaultConfig
+	cfg.PeerBanThreshold = peerBanTestConfig().PeerBanThreshold
+	cfg.GossipFanout = 1
+	netA := makeTestWebsocketNodeWithConfig(t, cfg)
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestWebsocketNodeWithConfig(t, cfg)
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netB.Stop()
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	peers := netA.GetPeers(PeersConnectedIn)
+	require.Len(t, peers, 1)
+	for i := 0; i < 5; i++ {
+		netA.ReportPeer(peers[0], PeerSignalInvalidVote)
+	}
+	require.Eventually(t, func() bool { return len(netA.GetPeers(PeersConnectedIn)) == 0 }, 5*time.Second, 10*time.Millisecond)
+	bans := netA.GetPeerBans()
+	require.Len(t, bans, 1)
+	require.Equal(t, "127.0.0.1", bans[0].Address)
+	require.Equal(t, "InvalidVote", bans[0].Reason)
+
+	gossipAddr, err := netB.addrToGossipAddr(addrA)
+	require.NoError(t, err)
+	_, response, err := websocket.DefaultDialer.Dial(gossipAddr, nil)
+	require.Equal(t, websocket.ErrBadHandshake, err)
+	require.Equal(t, http.StatusForbidden, response.StatusCode)
+
+	require.Equal(t, 1, netA.ClearPeerBans(""))
+	netB.RequestConnectOutgoing(false, nil)
+	require.Eventually(t, func() bool { return len(netA.GetPeers(PeersConnectedIn)) == 1 }, 5*time.Second, 10*time.Millisecond)
+}
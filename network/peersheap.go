@@ -74,7 +74,7 @@ func (ph peersHeap) Less(i, j int) bool {
 }
 
 func checkPrioPeers(wn *WebsocketNetwork, wp *wsPeer) bool {
-	pp := wn.config.PriorityPeers
+	pp := wn.getPriorityPeers()
 	if pp == nil {
 		return false
 	}
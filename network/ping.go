@@ -0,0 +1,61 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// pingHandler echoes the data of a ping back to the peer.
+func pingHandler(message IncomingMessage) OutgoingMessage {
+	if len(message.Data) > pingLength {
+		return OutgoingMessage{}
+	}
+	peer := message.Sender.(*wsPeer)
+	tbytes := []byte(protocol.PingReplyTag)
+	mbytes := make([]byte, len(tbytes)+len(message.Data))
+	copy(mbytes, tbytes)
+	copy(mbytes[len(tbytes):], message.Data)
+	var digest crypto.Digest // leave blank, ping message too short
+	peer.writeNonBlock(context.Background(), mbytes, false, digest, time.Now())
+	return OutgoingMessage{}
+}
+
+// pingReplyHandler records the round trip time of the ping the peer answered.
+func pingReplyHandler(message IncomingMessage) OutgoingMessage {
+	now := time.Now()
+	peer := message.Sender.(*wsPeer)
+	peer.pingLock.Lock()
+	defer peer.pingLock.Unlock()
+	if !peer.pingInFlight || !bytes.Equal(peer.pingData, message.Data) {
+		peer.net.log.Debugf("unexpected ping reply from %s", peer.rootURL)
+		return OutgoingMessage{}
+	}
+	peer.pingInFlight = false
+	peer.lastPingRoundTripTime = now.Sub(peer.pingSent)
+	return OutgoingMessage{}
+}
+
+var pingHandlers = []TaggedMessageHandler{
+	{protocol.PingTag, HandlerFunc(pingHandler)},
+	{protocol.PingReplyTag, HandlerFunc(pingReplyHandler)},
+}
@@ -0,0 +1,380 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+// When EnableTxnInventory is set, the node advertises it with the TxnInventoryHeader,
+// and the transaction groups are relayed by inventory to the peers that negotiated
+// one of the txnInventoryProtocolVersions and advertised the header as well:
+// rather than pushing the protocol.TxnTag message of a group, the node announces
+// the ID of the group, the hash of the message payload, in a batch of IDs sent
+// with the protocol.TxnAnnounceTag. The peer requests the groups it has not seen
+// yet with a protocol.TxnRequestTag message, and receives them as regular
+// protocol.TxnTag messages. Both messages are a concatenation of IDs:
+//
+//   "TA" | ID (32 bytes) | ID | ...
+//   "TQ" | ID (32 bytes) | ID | ...
+//
+// The groups for which the TxnPushFilter returns true are pushed to every peer
+// as before, so that they propagate without the extra round trip.
+
+// txnInventoryProtocolVersions are the protocol versions whose peers accept transaction group announcements.
+var txnInventoryProtocolVersions = map[string]bool{
+	"2.3": true,
+}
+
+// txnAnnounceInterval is how often the pending announcements are sent to the peers.
+const txnAnnounceInterval = 20 * time.Millisecond
+
+// maxTxnInventoryBatch is the maximal number of IDs in an announcement or a request.
+const maxTxnInventoryBatch = 256
+
+// txnInventoryRetention is how long the announced groups are kept to serve the requests of the peers.
+const txnInventoryRetention = 30 * time.Second
+
+// maxTxnInventoryGroups bounds the number of announced groups kept in memory.
+// Past it, the groups are pushed rather than announced.
+const maxTxnInventoryGroups = 20000
+
+// txnRequestTimeout is how long a requested group is waited for, before it is
+// requested again from the next peer that announces it.
+const txnRequestTimeout = 2 * time.Second
+
+var networkTxnGroupsAnnouncedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_groups_announced_total", Description: "Number of transaction group announcements sent to peers"})
+var networkTxnGroupsRequestedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_groups_requested_total", Description: "Number of announced transaction groups requested from peers"})
+var networkTxnGroupsServedTotal = metrics.MakeCounter(metrics.MetricName{Name: "algod_network_txn_groups_served_total", Description: "Number of announced transaction groups sent to the peers that requested them"})
+
+// TxnPushFilter tells whether a transaction group, given as the payload of a
+// protocol.TxnTag message, is to be pushed to every peer rather than announced.
+type TxnPushFilter func(data []byte) bool
+
+// announcedTxnGroup is a group the node announced, ready to be sent to the peers that request it.
+type announcedTxnGroup struct {
+	// msg is the protocol.TxnTag message of the group, tag included
+	msg       []byte
+	digest    crypto.Digest
+	announced time.Time
+}
+
+// txnInventory keeps the transaction groups the node announced, and the IDs of
+// the groups it received or requested.
+type txnInventory struct {
+	mu     deadlock.Mutex
+	groups map[crypto.Digest]announcedTxnGroup
+	// requested holds when the groups being waited for were requested
+	requested map[crypto.Digest]time.Time
+	// received holds the IDs of the groups received from the peers
+	received *messageFilter
+}
+
+func makeTxnInventory() *txnInventory {
+	return &txnInventory{
+		groups:    make(map[crypto.Digest]announcedTxnGroup),
+		requested: make(map[crypto.Digest]time.Time),
+		received:  makeMessageFilter(2, maxTxnInventoryGroups),
+	}
+}
+
+// add keeps the message of a group to serve the requests for the group with
+// the given ID, and returns false if there is no room left for it.
+func (inv *txnInventory) add(id crypto.Digest, msg []byte, digest crypto.Digest) bool {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	now := time.Now()
+	if len(inv.groups) >= maxTxnInventoryGroups {
+		inv.prune(now)
+		if len(inv.groups) >= maxTxnInventoryGroups {
+			return false
+		}
+	}
+	inv.groups[id] = announcedTxnGroup{msg: msg, digest: digest, announced: now}
+	return true
+}
+
+// get returns the announced group with the given ID.
+func (inv *txnInventory) get(id crypto.Digest) (group announcedTxnGroup, ok bool) {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	group, ok = inv.groups[id]
+	return
+}
+
+// receive records that the group with the given ID was received.
+func (inv *txnInventory) receive(id crypto.Digest) {
+	inv.received.CheckDigest(id, true, false)
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	delete(inv.requested, id)
+}
+
+// request returns the IDs of the announced groups that are neither known nor
+// already being waited for, and records them as requested.
+func (inv *txnInventory) request(ids []crypto.Digest) []crypto.Digest {
+	inv.mu.Lock()
+	defer inv.mu.Unlock()
+	now := time.Now()
+	if len(inv.requested) >= maxTxnInventoryGroups {
+		inv.prune(now)
+	}
+	wanted := ids[:0]
+	for _, id := range ids {
+		if _, announced := inv.groups[id]; announced {
+			continue
+		}
+		if requested, has := inv.requested[id]; has && now.Sub(requested) < txnRequestTimeout {
+			continue
+		}
+		if inv.received.CheckDigest(id, false, false) {
+			continue
+		}
+		inv.requested[id] = now
+		wanted = append(wanted, id)
+	}
+	return wanted
+}
+
+// prune drops the groups kept past txnInventoryRetention and the requests past
+// txnRequestTimeout. It is called with inv.mu held.
+func (inv *txnInventory) prune(now time.Time) {
+	for id, group := range inv.groups {
+		if now.Sub(group.announced) >= txnInventoryRetention {
+			delete(inv.groups, id)
+		}
+	}
+	for id, requested := range inv.requested {
+		if now.Sub(requested) >= txnRequestTimeout {
+			delete(inv.requested, id)
+		}
+	}
+}
+
+// encodeTxnInventoryMessage makes a message with the given tag listing the given IDs.
+func encodeTxnInventoryMessage(tag protocol.Tag, ids []crypto.Digest) []byte {
+	msg := make([]byte, 0, len(tag)+len(ids)*crypto.DigestSize)
+	msg = append(msg, tag...)
+	for _, id := range ids {
+		msg = append(msg, id[:]...)
+	}
+	return msg
+}
+
+// decodeTxnInventoryMessage returns the IDs listed in the payload of an
+// announcement or a request, or false if the payload is malformed.
+func decodeTxnInventoryMessage(data []byte) ([]crypto.Digest, bool) {
+	if len(data) == 0 || len(data)%crypto.DigestSize != 0 || len(data)/crypto.DigestSize > maxTxnInventoryBatch {
+		return nil, false
+	}
+	ids := make([]crypto.Digest, len(data)/crypto.DigestSize)
+	for i := range ids {
+		copy(ids[i][:], data[i*crypto.DigestSize:])
+	}
+	return ids, true
+}
+
+// inventoryBroadcast is a broadcast to the peers that relay the transaction groups by inventory.
+type inventoryBroadcast struct {
+	// ids are the IDs of the groups announced rather than sent
+	ids []crypto.Digest
+	// tags, data and digests are the messages left to send
+	tags           []protocol.Tag
+	data           [][]byte
+	digests        []crypto.Digest
+	compressedData [][]byte
+}
+
+// makeInventoryBroadcast keeps in the inventory the transaction groups of a
+// broadcast that are announced rather than sent, and splits the broadcast
+// between the announcements and the messages left to send.
+func (wn *WebsocketNetwork) makeInventoryBroadcast(tags []protocol.Tag, data [][]byte, digests []crypto.Digest) *inventoryBroadcast {
+	var inventory inventoryBroadcast
+	for i, msg := range data {
+		if tags[i] == protocol.TxnTag {
+			payload := msg[len(tags[i]):]
+			if wn.txnPushFilter == nil || !wn.txnPushFilter(payload) {
+				id := crypto.Hash(payload)
+				if wn.txnInventory.add(id, msg, digests[i]) {
+					inventory.ids = append(inventory.ids, id)
+					continue
+				}
+			}
+		}
+		inventory.tags = append(inventory.tags, tags[i])
+		inventory.data = append(inventory.data, msg)
+		inventory.digests = append(inventory.digests, digests[i])
+	}
+	return &inventory
+}
+
+// txnAnnounceThread sends the pending announcements to the peers, and prunes the inventory.
+func (wn *WebsocketNetwork) txnAnnounceThread() {
+	defer wn.wg.Done()
+	ticker := time.NewTicker(txnAnnounceInterval)
+	defer ticker.Stop()
+	lastPrune := time.Now()
+	var peers []*wsPeer
+	lastPeersChangeCounter := int32(-1)
+	for {
+		select {
+		case now := <-ticker.C:
+			if now.Sub(lastPrune) >= time.Second {
+				wn.txnInventory.mu.Lock()
+				wn.txnInventory.prune(now)
+				wn.txnInventory.mu.Unlock()
+				lastPrune = now
+			}
+		case <-wn.ctx.Done():
+			return
+		}
+		if curPeersChangeCounter := atomic.LoadInt32(&wn.peersChangeCounter); curPeersChangeCounter != lastPeersChangeCounter {
+			peers, lastPeersChangeCounter = wn.peerSnapshot(peers)
+		}
+		for _, peer := range peers {
+			if peer.txnInventory {
+				peer.flushTxnAnnouncements()
+			}
+		}
+	}
+}
+
+// hasTxnInventoryHeader tells whether the peer advertised the inventory relay in the given headers.
+func hasTxnInventoryHeader(headers http.Header) bool {
+	return headers.Get(TxnInventoryHeader) == "true"
+}
+
+// SetTxnPushFilter sets the filter of the transaction groups that are pushed to
+// every peer rather than announced, when EnableTxnInventory is set. With no
+// filter, every group is announced.
+func (wn *WebsocketNetwork) SetTxnPushFilter(filter TxnPushFilter) {
+	wn.txnPushFilter = filter
+}
+
+// queueTxnAnnouncements adds the given IDs to the next announcement to the peer,
+// and sends it right away once it is full.
+func (wp *wsPeer) queueTxnAnnouncements(ids []crypto.Digest) {
+	wp.txnAnnounceLock.Lock()
+	wp.pendingTxnAnnouncements = append(wp.pendingTxnAnnouncements, ids...)
+	full := len(wp.pendingTxnAnnouncements) >= maxTxnInventoryBatch
+	wp.txnAnnounceLock.Unlock()
+	if full {
+		wp.flushTxnAnnouncements()
+	}
+}
+
+// flushTxnAnnouncements sends the pending announcements to the peer.
+func (wp *wsPeer) flushTxnAnnouncements() {
+	wp.txnAnnounceLock.Lock()
+	pending := wp.pendingTxnAnnouncements
+	wp.pendingTxnAnnouncements = nil
+	wp.txnAnnounceLock.Unlock()
+	for len(pending) > 0 {
+		batch := pending
+		if len(batch) > maxTxnInventoryBatch {
+			batch = batch[:maxTxnInventoryBatch]
+		}
+		pending = pending[len(batch):]
+		// the peer misses the groups of an announcement that does not fit in its queue, as it would miss the groups themselves
+		if wp.writeNonBlock(context.Background(), encodeTxnInventoryMessage(protocol.TxnAnnounceTag, batch), false, crypto.Digest{}, time.Now()) {
+			networkTxnGroupsAnnouncedTotal.AddUint64(uint64(len(batch)), nil)
+		}
+	}
+}
+
+// txnInventoryHandlers handle the announcements and the requests of the peers.
+// The messages of the peers that do not relay by inventory are ignored.
+var txnInventoryHandlers = []TaggedMessageHandler{
+	{protocol.TxnAnnounceTag, HandlerFunc(txnAnnounceHandler)},
+	{protocol.TxnRequestTag, HandlerFunc(txnRequestHandler)},
+}
+
+func txnAnnounceHandler(message IncomingMessage) OutgoingMessage {
+	message.Sender.(*wsPeer).handleTxnAnnounce(message)
+	return OutgoingMessage{}
+}
+
+func txnRequestHandler(message IncomingMessage) OutgoingMessage {
+	message.Sender.(*wsPeer).handleTxnRequest(message)
+	return OutgoingMessage{}
+}
+
+// handleTxnAnnounce requests the announced groups the node has not seen yet.
+func (wp *wsPeer) handleTxnAnnounce(msg IncomingMessage) {
+	if !wp.txnInventory {
+		return
+	}
+	ids, ok := decodeTxnInventoryMessage(msg.Data)
+	if !ok {
+		wp.net.log.Warnf("bad transaction group announcement of %d bytes from %s", len(msg.Data), wp.conn.RemoteAddr().String())
+		wp.net.reportPeer(wp, PeerSignalProtocolError)
+		return
+	}
+	ids = wp.net.txnInventory.request(ids)
+	if len(ids) == 0 {
+		return
+	}
+	if wp.writeNonBlock(context.Background(), encodeTxnInventoryMessage(protocol.TxnRequestTag, ids), false, crypto.Digest{}, time.Now()) {
+		networkTxnGroupsRequestedTotal.AddUint64(uint64(len(ids)), nil)
+	}
+}
+
+// handleTxnRequest sends the requested groups the node still has. The others
+// were received by the peer from another node by now, or are gone.
+func (wp *wsPeer) handleTxnRequest(msg IncomingMessage) {
+	if !wp.txnInventory {
+		return
+	}
+	ids, ok := decodeTxnInventoryMessage(msg.Data)
+	if !ok {
+		wp.net.log.Warnf("bad transaction group request of %d bytes from %s", len(msg.Data), wp.conn.RemoteAddr().String())
+		wp.net.reportPeer(wp, PeerSignalProtocolError)
+		return
+	}
+	msgs := make([][]byte, 0, len(ids))
+	digests := make([]crypto.Digest, 0, len(ids))
+	for _, id := range ids {
+		group, ok := wp.net.txnInventory.get(id)
+		if !ok {
+			continue
+		}
+		msgs = append(msgs, group.msg)
+		digests = append(digests, group.digest)
+	}
+	if len(msgs) == 0 {
+		return
+	}
+	if wp.compressOutgoing {
+		tags := make([]protocol.Tag, len(msgs))
+		for i := range tags {
+			tags[i] = protocol.TxnTag
+		}
+		msgs = compressMessages(tags, msgs)
+	}
+	if wp.writeNonBlockMsgs(context.Background(), msgs, false, digests, time.Now()) {
+		networkTxnGroupsServedTotal.AddUint64(uint64(len(msgs)), nil)
+	}
+}
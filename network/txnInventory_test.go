@@ -0,0 +1,208 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxnInventoryMessages(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ids := []crypto.Digest{crypto.Hash([]byte("a")), crypto.Hash([]byte("b"))}
+	msg := encodeTxnInventoryMessage(protocol.TxnAnnounceTag, ids)
+	require.Equal(t, protocol.TxnAnnounceTag, protocol.Tag(msg[:tagLength]))
+	decoded, ok := decodeTxnInventoryMessage(msg[tagLength:])
+	require.True(t, ok)
+	require.Equal(t, ids, decoded)
+
+	_, ok = decodeTxnInventoryMessage(nil)
+	require.False(t, ok)
+	_, ok = decodeTxnInventoryMessage(msg[tagLength : len(msg)-1])
+	require.False(t, ok)
+	tooMany := make([]crypto.Digest, maxTxnInventoryBatch+1)
+	_, ok = decodeTxnInventoryMessage(encodeTxnInventoryMessage(protocol.TxnRequestTag, tooMany)[tagLength:])
+	require.False(t, ok)
+}
+
+func TestTxnInventoryRequest(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	inv := makeTxnInventory()
+	announced := crypto.Hash([]byte("announced"))
+	received := crypto.Hash([]byte("received"))
+	unknown := crypto.Hash([]byte("unknown"))
+	require.True(t, inv.add(announced, []byte("TXannounced"), crypto.Digest{}))
+	inv.receive(received)
+
+	// only the groups the node has not seen are requested, once
+	require.Equal(t, []crypto.Digest{unknown}, inv.request([]crypto.Digest{announced, received, unknown}))
+	require.Empty(t, inv.request([]crypto.Digest{unknown}))
+
+	// until the request times out
+	inv.requested[unknown] = time.Now().Add(-txnRequestTimeout)
+	require.Equal(t, []crypto.Digest{unknown}, inv.request([]crypto.Digest{unknown}))
+	inv.receive(unknown)
+	require.Empty(t, inv.requested)
+	require.Empty(t, inv.request([]crypto.Digest{unknown}))
+
+	group, ok := inv.get(announced)
+	require.True(t, ok)
+	require.Equal(t, []byte("TXannounced"), group.msg)
+
+	// the announced groups are served for a while
+	group.announced = time.Now().Add(-txnInventoryRetention)
+	inv.groups[announced] = group
+	inv.prune(time.Now())
+	_, ok = inv.get(announced)
+	require.False(t, ok)
+
+	// and are pushed once the inventory is full
+	for i := 0; i < maxTxnInventoryGroups; i++ {
+		inv.groups[crypto.Hash([]byte{byte(i), byte(i >> 8)})] = announcedTxnGroup{announced: time.Now()}
+	}
+	require.False(t, inv.add(announced, []byte("TXannounced"), crypto.Digest{}))
+}
+
+func TestWebsocketNetworkTxnInventory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.config.EnableTxnInventory = true
+	pushed := []byte("pushed")
+	netA.SetTxnPushFilter(func(data []byte) bool { return bytes.Equal(data, pushed) })
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	netB.config.EnableTxnInventory = true
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netB.Stop()
+
+	received := make(chan []byte, 10)
+	netB.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			received <- msg.Data
+			return OutgoingMessage{}
+		})},
+	})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	peersA, _ := netA.peerSnapshot(nil)
+	require.Len(t, peersA, 1)
+	require.True(t, peersA[0].txnInventory)
+	peersB, _ := netB.peerSnapshot(nil)
+	require.Len(t, peersB, 1)
+	require.True(t, peersB[0].txnInventory)
+
+	waitReceived := func(expected []byte) {
+		select {
+		case data := <-received:
+			require.Equal(t, expected, data)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timeout waiting for the transaction group")
+		}
+	}
+
+	// the announced group is requested by B
+	announced := []byte("announced")
+	require.NoError(t, netA.Broadcast(context.Background(), protocol.TxnTag, announced, true, nil))
+	waitReceived(announced)
+	require.Greater(t, tagTraffic(peersA[0].traffic.snapshot(), protocol.TxnRequestTag).Received, uint64(0))
+	require.Eventually(t, func() bool {
+		return tagTraffic(peersA[0].traffic.snapshot(), protocol.TxnAnnounceTag).Sent > 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// B does not request again a group it already has
+	require.Empty(t, netB.txnInventory.request([]crypto.Digest{crypto.Hash(announced)}))
+
+	// the groups that pass the push filter are pushed, without announcement
+	announcements := tagTraffic(peersA[0].traffic.snapshot(), protocol.TxnAnnounceTag).Sent
+	require.NoError(t, netA.Broadcast(context.Background(), protocol.TxnTag, pushed, true, nil))
+	waitReceived(pushed)
+	require.Equal(t, announcements, tagTraffic(peersA[0].traffic.snapshot(), protocol.TxnAnnounceTag).Sent)
+}
+
+func TestWebsocketNetworkTxnInventoryNotAdvertised(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	netA := makeTestWebsocketNode(t)
+	netA.config.GossipFanout = 1
+	netA.config.EnableTxnInventory = true
+	netA.Start()
+	defer netA.Stop()
+	netB := makeTestWebsocketNode(t)
+	netB.config.GossipFanout = 1
+	addrA, postListen := netA.Address()
+	require.True(t, postListen)
+	netB.phonebook.ReplacePeerList([]string{addrA}, "default", PhoneBookEntryRelayRole)
+	netB.Start()
+	defer netB.Stop()
+
+	received := make(chan []byte, 10)
+	netB.RegisterHandlers([]TaggedMessageHandler{
+		{Tag: protocol.TxnTag, MessageHandler: HandlerFunc(func(msg IncomingMessage) OutgoingMessage {
+			received <- msg.Data
+			return OutgoingMessage{}
+		})},
+	})
+
+	readyTimeout := time.NewTimer(2 * time.Second)
+	waitReady(t, netA, readyTimeout.C)
+	waitReady(t, netB, readyTimeout.C)
+
+	// B did not advertise the inventory relay, so neither node relays by inventory
+	peersA, _ := netA.peerSnapshot(nil)
+	require.Len(t, peersA, 1)
+	require.False(t, peersA[0].txnInventory)
+	peersB, _ := netB.peerSnapshot(nil)
+	require.Len(t, peersB, 1)
+	require.False(t, peersB[0].txnInventory)
+
+	// A pushes the groups to B
+	group := []byte("group")
+	require.NoError(t, netA.Broadcast(context.Background(), protocol.TxnTag, group, true, nil))
+	select {
+	case data := <-received:
+		require.Equal(t, group, data)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timeout waiting for the transaction group")
+	}
+	require.Zero(t, tagTraffic(peersA[0].traffic.snapshot(), protocol.TxnAnnounceTag).Sent)
+
+	// B ignores the announcements, and does not request the groups
+	unknown := crypto.Hash([]byte("unknown"))
+	peersB[0].handleTxnAnnounce(IncomingMessage{Sender: peersB[0], Tag: protocol.TxnAnnounceTag, Data: unknown[:]})
+	require.Zero(t, tagTraffic(peersB[0].traffic.snapshot(), protocol.TxnRequestTag).Sent)
+	require.Equal(t, []crypto.Digest{unknown}, netB.txnInventory.request([]crypto.Digest{unknown}))
+}
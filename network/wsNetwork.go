@@ -212,6 +212,28 @@ type GossipNode interface {
 	// SetPeerData attaches a piece of data to a peer.
 	// Other services inside go-algorand may attach data to a peer that gets garbage collected when the peer is closed.
 	SetPeerData(peer Peer, key string, value interface{})
+
+	// ReportPeer lowers the reputation of a peer that misbehaved. Once its
+	// reputation is low enough, the peer is disconnected and banned for a while.
+	ReportPeer(peer Peer, signal PeerSignal)
+
+	// GetPeerBans returns the peers currently banned.
+	GetPeerBans() []PeerBan
+
+	// ClearPeerBans lifts the ban of the peer with the given address, or all
+	// the bans if the address is empty. It returns the number of bans lifted.
+	ClearPeerBans(address string) int
+
+	// GetPeerInfo describes the connections to the peers, for the node administrator.
+	GetPeerInfo() []PeerInfo
+
+	// DisconnectAddress closes the connections to the peer with the given
+	// address, as listed by GetPeerInfo. It returns the number of connections closed.
+	DisconnectAddress(address string) int
+
+	// AddPriorityPeer gives priority to the incoming connections from the
+	// given host, like the PriorityPeers of the configuration.
+	AddPriorityPeer(host string) error
 }
 
 // IncomingMessage represents a message arriving from some peer in our p2p network
@@ -363,6 +385,13 @@ type WebsocketNetwork struct {
 
 	incomingMsgFilter *messageFilter // message filter to remove duplicate incoming messages from different peers
 
+	// reputation tracks the misbehaving peers, and bans them
+	reputation *peerReputation
+
+	// txnInventory keeps the transaction groups announced to the peers, and the ones announced by the peers
+	txnInventory  *txnInventory
+	txnPushFilter TxnPushFilter
+
 	eventualReadyDelay time.Duration
 
 	relayMessages bool // True if we should relay messages from other nodes (nominally true for relays, false otherwise)
@@ -371,6 +400,11 @@ type WebsocketNetwork struct {
 	prioTracker      *prioTracker
 	prioResponseChan chan *wsPeer
 
+	// priorityPeers holds the map[string]bool of the hosts whose incoming connections are given
+	// priority. It starts as the PriorityPeers of the configuration, and is replaced as a whole
+	// by AddPriorityPeer so that it can be read without locking.
+	priorityPeers atomic.Value
+
 	// outgoingMessagesBufferSize is the size used for outgoing messages.
 	outgoingMessagesBufferSize int
 
@@ -698,6 +732,7 @@ func (wn *WebsocketNetwork) setup() {
 	wn.upgrader.WriteBufferSize = 4096
 	wn.upgrader.EnableCompression = false
 	wn.lastPeerConnectionsSent = time.Now()
+	wn.priorityPeers.Store(wn.config.PriorityPeers)
 	wn.router = mux.NewRouter()
 	wn.router.Handle(GossipNetworkPath, wn)
 	wn.requestsTracker = makeRequestsTracker(wn.router, wn.log, wn.config)
@@ -756,6 +791,8 @@ func (wn *WebsocketNetwork) setup() {
 	if wn.config.EnableIncomingMessageFilter {
 		wn.incomingMsgFilter = makeMessageFilter(wn.config.IncomingMessageFilterBucketCount, wn.config.IncomingMessageFilterBucketSize)
 	}
+	wn.reputation = makePeerReputation(wn.log, wn.config)
+	wn.txnInventory = makeTxnInventory()
 	wn.connPerfMonitor = makeConnectionPerformanceMonitor([]Tag{protocol.AgreementVoteTag, protocol.TxnTag})
 	wn.lastNetworkAdvance = time.Now().UTC()
 	wn.handlers.log = wn.log
@@ -806,9 +843,13 @@ func (wn *WebsocketNetwork) Start() {
 		wn.scheme = "http"
 	}
 	wn.meshUpdateRequests <- meshRequest{false, nil}
+	if wn.config.EnablePingHandler {
+		wn.RegisterHandlers(pingHandlers)
+	}
 	if wn.prioScheme != nil {
 		wn.RegisterHandlers(prioHandlers)
 	}
+	wn.RegisterHandlers(txnInventoryHandlers)
 	if wn.listener != nil {
 		wn.wg.Add(1)
 		go wn.httpdThread()
@@ -832,6 +873,10 @@ func (wn *WebsocketNetwork) Start() {
 		wn.wg.Add(1)
 		go wn.prioWeightRefresh()
 	}
+	if wn.config.EnableTxnInventory {
+		wn.wg.Add(1)
+		go wn.txnAnnounceThread()
+	}
 
 	go wn.postMessagesOfInterestThread()
 
@@ -913,7 +958,7 @@ func (wn *WebsocketNetwork) RegisterHandlers(dispatch []TaggedMessageHandler) {
 // ClearHandlers deregisters all the existing message handlers.
 func (wn *WebsocketNetwork) ClearHandlers() {
 	// exclude the internal handlers. These would get cleared out when Stop is called.
-	wn.handlers.ClearHandlers([]Tag{protocol.PingTag, protocol.PingReplyTag, protocol.NetPrioResponseTag})
+	wn.handlers.ClearHandlers([]Tag{protocol.PingTag, protocol.PingReplyTag, protocol.NetPrioResponseTag, protocol.TxnAnnounceTag, protocol.TxnRequestTag})
 }
 
 func (wn *WebsocketNetwork) setHeaders(header http.Header) {
@@ -923,6 +968,9 @@ func (wn *WebsocketNetwork) setHeaders(header http.Header) {
 	header.Set(InstanceNameHeader, localInstanceName)
 	header.Set(AddressHeader, wn.PublicAddress())
 	header.Set(NodeRandomHeader, wn.RandomID)
+	if wn.config.EnableTxnInventory {
+		header.Set(TxnInventoryHeader, "true")
+	}
 }
 
 // checkServerResponseVariables check that the version and random-id in the request headers matches the server ones.
@@ -966,6 +1014,20 @@ func getCommonHeaders(headers http.Header) (otherTelemetryGUID, otherInstanceNam
 
 // checkIncomingConnectionLimits perform the connection limits counting for the incoming connections.
 func (wn *WebsocketNetwork) checkIncomingConnectionLimits(response http.ResponseWriter, request *http.Request, remoteHost, otherTelemetryGUID, otherInstanceName string) int {
+	if wn.reputation.isBanned(remoteHost) {
+		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "banned"})
+		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
+			telemetryspec.ConnectPeerFailEventDetails{
+				Address:      remoteHost,
+				HostName:     otherTelemetryGUID,
+				Incoming:     true,
+				InstanceName: otherInstanceName,
+				Reason:       "Banned",
+			})
+		response.WriteHeader(http.StatusForbidden)
+		return http.StatusForbidden
+	}
+
 	if wn.numIncomingPeers() >= wn.config.IncomingConnectionsLimit {
 		networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "incoming_connection_limit"})
 		wn.log.EventWithDetails(telemetryspec.Network, telemetryspec.ConnectPeerFailEvent,
@@ -1146,6 +1208,7 @@ func (wn *WebsocketNetwork) ServeHTTP(response http.ResponseWriter, request *htt
 		prioChallenge:     challenge,
 		createTime:        trackedRequest.created,
 		version:           matchingVersion,
+		txnInventory:      hasTxnInventoryHeader(request.Header),
 	}
 	peer.TelemetryGUID = trackedRequest.otherTelemetryGUID
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -1237,7 +1300,7 @@ func (wn *WebsocketNetwork) messageHandlerThread(peersConnectivityCheckCh <-chan
 
 // checkPeersConnectivity tests the last timestamp where each of these
 // peers was communicated with, and disconnect the peer if it has been too long since
-// last time.
+// last time. The other peers are pinged, to keep track of their round trip time.
 func (wn *WebsocketNetwork) checkPeersConnectivity() {
 	wn.peersLock.Lock()
 	defer wn.peersLock.Unlock()
@@ -1249,6 +1312,11 @@ func (wn *WebsocketNetwork) checkPeersConnectivity() {
 			wn.wg.Add(1)
 			go wn.disconnectThread(peer, disconnectIdleConn)
 			networkIdlePeerDrops.Inc(nil)
+			continue
+		}
+		// measure the round trip time to the peer
+		if wn.config.EnablePingHandler {
+			peer.sendPing()
 		}
 	}
 }
@@ -1445,6 +1513,12 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 			digests[i] = crypto.Hash(mbytes)
 		}
 	}
+	// compressedData holds the messages sent to the peers that accept compressed messages.
+	// It is computed once, for the first such peer.
+	var compressedData [][]byte
+	// the transaction groups are announced rather than sent to the peers that relay them by inventory.
+	// The announcements and the messages left to send are computed once, for the first such peer.
+	var inventory *inventoryBroadcast
 
 	// first send to all the easy outbound peers who don't block, get them started.
 	sentMessageCount := 0
@@ -1455,7 +1529,28 @@ func (wn *WebsocketNetwork) innerBroadcast(request broadcastRequest, prio bool,
 		if peer == request.except {
 			continue
 		}
-		ok := peer.writeNonBlockMsgs(request.ctx, data, prio, digests, request.enqueueTime)
+		peerTags, peerData, peerDigests, peerCompressedData := request.tags, data, digests, &compressedData
+		if peer.txnInventory {
+			if inventory == nil {
+				inventory = wn.makeInventoryBroadcast(request.tags, data, digests)
+			}
+			if len(inventory.ids) > 0 {
+				peer.queueTxnAnnouncements(inventory.ids)
+				if len(inventory.data) == 0 {
+					// every message was announced
+					sentMessageCount++
+					continue
+				}
+				peerTags, peerData, peerDigests, peerCompressedData = inventory.tags, inventory.data, inventory.digests, &inventory.compressedData
+			}
+		}
+		if peer.compressOutgoing {
+			if *peerCompressedData == nil {
+				*peerCompressedData = compressMessages(peerTags, peerData)
+			}
+			peerData = *peerCompressedData
+		}
+		ok := peer.writeNonBlockMsgs(request.ctx, peerData, prio, peerDigests, request.enqueueTime)
 		if ok {
 			sentMessageCount++
 			continue
@@ -1629,6 +1724,9 @@ func (wn *WebsocketNetwork) checkNewConnectionsNeeded() bool {
 			// filter out self-public address, so we won't try to connect to outselves.
 			continue
 		}
+		if wn.reputation.isBanned(na) {
+			continue
+		}
 		gossipAddr, ok := wn.tryConnectReserveAddr(na)
 		if ok {
 			wn.wg.Add(1)
@@ -1841,14 +1939,16 @@ const ProtocolVersionHeader = "X-Algorand-Version"
 const ProtocolAcceptVersionHeader = "X-Algorand-Accept-Version"
 
 // SupportedProtocolVersions contains the list of supported protocol versions by this node ( in order of preference ).
-var SupportedProtocolVersions = []string{"2.1"}
+var SupportedProtocolVersions = []string{"2.3", "2.2", "2.1"}
 
 // ProtocolVersion is the current version attached to the ProtocolVersionHeader header
 /* Version history:
  *  1   Catchup service over websocket connections with unicast messages between peers
  *  2.1 Introduced topic key/data pairs and enabled services over the gossip connections
+ *  2.2 Introduced compressed messages, see compressionProtocolVersions
+ *  2.3 Introduced the inventory relay of transaction groups, see txnInventoryProtocolVersions
  */
-const ProtocolVersion = "2.1"
+const ProtocolVersion = "2.3"
 
 // TelemetryIDHeader HTTP header for telemetry-id for logging
 const TelemetryIDHeader = "X-Algorand-TelId"
@@ -1865,6 +1965,9 @@ const AddressHeader = "X-Algorand-Location"
 // InstanceNameHeader HTTP header by which an inbound connection reports an ID to distinguish multiple local nodes.
 const InstanceNameHeader = "X-Algorand-InstanceName"
 
+// TxnInventoryHeader HTTP header by which a node advertises that it relays the transaction groups by inventory.
+const TxnInventoryHeader = "X-Algorand-TxnInventory"
+
 // PriorityChallengeHeader HTTP header informs a client about the challenge it should sign to increase network priority.
 const PriorityChallengeHeader = "X-Algorand-PriorityChallenge"
 
@@ -2090,6 +2193,7 @@ func (wn *WebsocketNetwork) tryConnect(addr, gossipAddr string) {
 		connMonitor:                 wn.connPerfMonitor,
 		throttledOutgoingConnection: throttledConnection,
 		version:                     matchingVersion,
+		txnInventory:                hasTxnInventoryHeader(response.Header),
 	}
 	peer.TelemetryGUID, peer.InstanceName, _ = getCommonHeaders(response.Header)
 	peer.init(wn.config, wn.outgoingMessagesBufferSize)
@@ -2168,6 +2272,125 @@ func NewWebsocketGossipNode(log logging.Logger, config config.Local, phonebookAd
 	return NewWebsocketNetwork(log, config, phonebookAddresses, genesisID, networkID, nil)
 }
 
+// ReportPeer lowers the reputation of a peer that misbehaved. A peer that gets
+// banned is disconnected, along with the other connections from its address.
+func (wn *WebsocketNetwork) ReportPeer(peer Peer, signal PeerSignal) {
+	wp, ok := peer.(*wsPeer)
+	if !ok {
+		return
+	}
+	if wn.reportPeer(wp, signal) {
+		wn.wg.Add(1)
+		go wn.disconnectThread(wp, disconnectBanned)
+	}
+}
+
+// reportPeer lowers the reputation of wp, and returns true if it got banned.
+// The other connections from the address of wp are then disconnected, but not
+// wp itself, since reportPeer is also called from the read loop of wp.
+func (wn *WebsocketNetwork) reportPeer(wp *wsPeer, signal PeerSignal) bool {
+	address := wp.reputationAddress()
+	if !wn.reputation.report(address, signal) {
+		return false
+	}
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	for _, peer := range wn.peers {
+		if peer != wp && peer.reputationAddress() == address {
+			wn.wg.Add(1)
+			go wn.disconnectThread(peer, disconnectBanned)
+		}
+	}
+	return true
+}
+
+// GetPeerBans returns the peers currently banned.
+func (wn *WebsocketNetwork) GetPeerBans() []PeerBan {
+	return wn.reputation.list()
+}
+
+// ClearPeerBans lifts the ban of the peer with the given address, or all the
+// bans if the address is empty. It returns the number of bans lifted.
+func (wn *WebsocketNetwork) ClearPeerBans(address string) int {
+	return wn.reputation.clear(address)
+}
+
+// LoadPeerBans restores the bans saved in the given file, and saves the bans
+// there from now on.
+func (wn *WebsocketNetwork) LoadPeerBans(filename string) error {
+	return wn.reputation.load(filename)
+}
+
+// GetPeerInfo describes the connections to the peers, for the node administrator.
+func (wn *WebsocketNetwork) GetPeerInfo() []PeerInfo {
+	wn.peersLock.RLock()
+	defer wn.peersLock.RUnlock()
+	infos := make([]PeerInfo, 0, len(wn.peers))
+	for _, peer := range wn.peers {
+		role := PeerRoleClient
+		if peer.outgoing {
+			role = PeerRoleRelay
+		} else if checkPrioPeers(wn, peer) {
+			role = PeerRolePriority
+		}
+		_, roundTripTime := peer.pingTimes()
+		infos = append(infos, PeerInfo{
+			Address:               peer.reputationAddress(),
+			Role:                  role,
+			Outgoing:              peer.outgoing,
+			Version:               peer.version,
+			ConnectedSince:        peer.createTime,
+			LastPingRoundTripTime: roundTripTime,
+			Traffic:               peer.traffic.snapshot(),
+		})
+	}
+	return infos
+}
+
+// DisconnectAddress closes the connections to the peer with the given address,
+// as listed by GetPeerInfo. It returns the number of connections closed.
+func (wn *WebsocketNetwork) DisconnectAddress(address string) int {
+	var peers []*wsPeer
+	wn.peersLock.RLock()
+	for _, peer := range wn.peers {
+		if peer.reputationAddress() == address {
+			peers = append(peers, peer)
+		}
+	}
+	wn.peersLock.RUnlock()
+	for _, peer := range peers {
+		wn.disconnect(peer, disconnectAdminRequest)
+	}
+	return len(peers)
+}
+
+// AddPriorityPeer gives priority to the incoming connections from the given
+// host, like the PriorityPeers of the configuration.
+func (wn *WebsocketNetwork) AddPriorityPeer(host string) error {
+	if net.ParseIP(host) == nil {
+		return fmt.Errorf("priority peers are given by IP address, not %s", filterASCII(host))
+	}
+	wn.peersLock.Lock()
+	defer wn.peersLock.Unlock()
+	// the current map is read without locking, so a copy replaces it.
+	current := wn.getPriorityPeers()
+	priorityPeers := make(map[string]bool, len(current)+1)
+	for addr, prio := range current {
+		priorityPeers[addr] = prio
+	}
+	priorityPeers[host] = true
+	wn.priorityPeers.Store(priorityPeers)
+	heap.Init(peersHeap{wn})
+	return nil
+}
+
+// getPriorityPeers returns the hosts whose incoming connections are given priority.
+// The returned map must not be modified.
+func (wn *WebsocketNetwork) getPriorityPeers() map[string]bool {
+	priorityPeers, _ := wn.priorityPeers.Load().(map[string]bool)
+	return priorityPeers
+}
+
 // SetPrioScheme specifies the network priority scheme for a network node
 func (wn *WebsocketNetwork) SetPrioScheme(s NetPrioScheme) {
 	wn.prioScheme = s
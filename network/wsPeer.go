@@ -47,6 +47,11 @@ const averageMessageLength = 2 * 1024    // Most of the messages are smaller tha
 // buffer and starve messages from other peers.
 const msgsInReadBufferPerPeer = 10
 
+// peerRepeatFilterBucketCount and peerRepeatFilterBucketSize size the filter of
+// the recent messages of each peer, used to catch the peers repeating themselves.
+const peerRepeatFilterBucketCount = 2
+const peerRepeatFilterBucketSize = 256
+
 var networkSentBytesTotal = metrics.MakeCounter(metrics.NetworkSentBytesTotal)
 var networkSentBytesByTag = metrics.NewTagCounter("algod_network_sent_bytes_{TAG}", "Number of bytes that were sent over the network for {TAG} messages")
 var networkReceivedBytesTotal = metrics.MakeCounter(metrics.NetworkReceivedBytesTotal)
@@ -124,6 +129,8 @@ const disconnectLeastPerformingPeer disconnectReason = "LeastPerformingPeer"
 const disconnectCliqueResolve disconnectReason = "CliqueResolving"
 const disconnectRequestReceived disconnectReason = "DisconnectRequest"
 const disconnectStaleWrite disconnectReason = "DisconnectStaleWrite"
+const disconnectBanned disconnectReason = "Banned"
+const disconnectAdminRequest disconnectReason = "AdminRequest"
 
 // Response is the structure holding the response from the server
 type Response struct {
@@ -171,6 +178,11 @@ type wsPeer struct {
 
 	incomingMsgFilter *messageFilter
 	outgoingMsgFilter *messageFilter
+	// repeatFilter holds the messages the peer sent, to catch the ones it sends again
+	repeatFilter *messageFilter
+
+	// traffic counts the bytes exchanged with the peer
+	traffic peerTraffic
 
 	processed chan struct{}
 
@@ -196,6 +208,16 @@ type wsPeer struct {
 	// peer version ( this is one of the version supported by the current node and listed in SupportedProtocolVersions )
 	version string
 
+	// compressOutgoing is set when the messages of the compressibleTags are to be compressed before being sent to the peer.
+	compressOutgoing bool
+
+	// txnInventory is set when the transaction groups are announced to the peer rather than pushed,
+	// once both the node and the peer advertised the inventory relay with the TxnInventoryHeader.
+	txnInventory bool
+	// pendingTxnAnnouncements are the IDs of the transaction groups to announce with the next announcement.
+	pendingTxnAnnouncements []crypto.Digest
+	txnAnnounceLock         deadlock.Mutex
+
 	// responseChannels used by the client to wait on the response of the request
 	responseChannels map[uint64]chan *Response
 
@@ -288,6 +310,11 @@ func (wp *wsPeer) Unicast(ctx context.Context, msg []byte, tag protocol.Tag) err
 	if tag != protocol.MsgDigestSkipTag && len(msg) >= messageFilterSize {
 		digest = crypto.Hash(mbytes)
 	}
+	if wp.compressOutgoing && compressibleTags[tag] {
+		if compressed, ok := compressMessage(mbytes); ok {
+			mbytes = compressed
+		}
+	}
 
 	ok := wp.writeNonBlock(ctx, mbytes, false, digest, time.Now())
 	if !ok {
@@ -342,6 +369,8 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	wp.responseChannels = make(map[uint64]chan *Response)
 	wp.sendMessageTag = defaultSendMessageTags
 	wp.clientDataStore = make(map[string]interface{})
+	wp.compressOutgoing = config.EnableGossipCompression && compressionProtocolVersions[wp.version]
+	wp.txnInventory = wp.txnInventory && config.EnableTxnInventory && txnInventoryProtocolVersions[wp.version]
 
 	// processed is a channel that messageHandlerThread writes to
 	// when it's done with one of our messages, so that we can queue
@@ -355,6 +384,9 @@ func (wp *wsPeer) init(config config.Local, sendBufferLength int) {
 	if config.EnableOutgoingNetworkMessageFiltering {
 		wp.outgoingMsgFilter = makeMessageFilter(config.OutgoingMessageFilterBucketCount, config.OutgoingMessageFilterBucketSize)
 	}
+	if config.PeerBanThreshold != 0 {
+		wp.repeatFilter = makeMessageFilter(peerRepeatFilterBucketCount, peerRepeatFilterBucketSize)
+	}
 
 	wp.wg.Add(2)
 	go wp.readLoop()
@@ -366,7 +398,20 @@ func (wp *wsPeer) OriginAddress() string {
 	return wp.originAddress
 }
 
+// reputationAddress returns the address the reputation of the peer is tracked
+// by: the phonebook address of an outgoing connection, or the remote host of an
+// incoming one.
+func (wp *wsPeer) reputationAddress() string {
+	if wp.outgoing {
+		return wp.rootURL
+	}
+	return wp.originAddress
+}
+
 func (wp *wsPeer) reportReadErr(err error) {
+	if err == ErrIncomingMsgTooLarge || err == websocket.ErrReadLimit {
+		wp.net.reportPeer(wp, PeerSignalOversizedMessage)
+	}
 	// only report error if we haven't already closed the peer
 	if atomic.LoadInt32(&wp.didInnerClose) == 0 {
 		_, _, line, _ := runtime.Caller(1)
@@ -408,6 +453,7 @@ func (wp *wsPeer) readLoop() {
 		if mtype != websocket.BinaryMessage {
 			wp.net.log.Errorf("peer sent non websocket-binary message: %#v", mtype)
 			networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "protocol"})
+			wp.net.reportPeer(wp, PeerSignalProtocolError)
 			return
 		}
 		var tag [2]byte
@@ -429,10 +475,24 @@ func (wp *wsPeer) readLoop() {
 		msg.Data = slurper.Bytes()
 		msg.Net = wp.net
 		atomic.StoreInt64(&wp.lastPacketTime, msg.Received)
-		networkReceivedBytesTotal.AddUint64(uint64(len(msg.Data)+2), nil)
+		receivedBytes := uint64(len(msg.Data) + 2)
+		if msg.Tag == protocol.CompressedMsgTag {
+			msg.Tag, msg.Data, err = decompressMessage(msg.Data)
+			if err != nil {
+				wp.net.log.Warnf("wsPeer readLoop: could not decompress the message from: %s %v", wp.conn.RemoteAddr().String(), err)
+				networkConnectionsDroppedTotal.Inc(map[string]string{"reason": "protocol"})
+				wp.net.reportPeer(wp, PeerSignalProtocolError)
+				cleanupCloseError = disconnectBadData
+				return
+			}
+			networkReceivedCompressedBytesByTag.Add(string(msg.Tag), receivedBytes)
+			networkReceivedCompressedRawBytesByTag.Add(string(msg.Tag), uint64(len(msg.Data)+2))
+		}
+		networkReceivedBytesTotal.AddUint64(receivedBytes, nil)
 		networkMessageReceivedTotal.AddUint64(1, nil)
-		networkReceivedBytesByTag.Add(string(tag[:]), uint64(len(msg.Data)+2))
-		networkMessageReceivedByTag.Add(string(tag[:]), 1)
+		networkReceivedBytesByTag.Add(string(msg.Tag), receivedBytes)
+		wp.traffic.addReceived(msg.Tag, receivedBytes)
+		networkMessageReceivedByTag.Add(string(msg.Tag), 1)
 		msg.Sender = wp
 
 		// for outgoing connections, we want to notify the connection monitor that we've received
@@ -477,6 +537,18 @@ func (wp *wsPeer) readLoop() {
 			// network maintenance message handled immediately instead of handing off to general handlers
 			wp.handleFilterMessage(msg)
 			continue
+		case protocol.TxnTag:
+			// the announcements of the groups received are not requested. Nodes that
+			// do not relay by inventory ignore the announcements, and skip hashing every group.
+			if wp.net.config.EnableTxnInventory {
+				wp.net.txnInventory.receive(crypto.Hash(msg.Data))
+			}
+		}
+		if len(msg.Data) > 0 && wp.repeatFilter != nil && dedupSafeTag(msg.Tag) {
+			if wp.repeatFilter.CheckIncomingMessage(msg.Tag, msg.Data, true, false) && wp.net.reportPeer(wp, PeerSignalDuplicateMessage) {
+				cleanupCloseError = disconnectBanned
+				return
+			}
 		}
 		if len(msg.Data) > 0 && wp.incomingMsgFilter != nil && dedupSafeTag(msg.Tag) {
 			if wp.incomingMsgFilter.CheckIncomingMessage(msg.Tag, msg.Data, true, true) {
@@ -597,7 +669,23 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	}
 	// the tags are always 2 char long; note that this is safe since it's only being used for messages that we have generated locally.
 	tag := protocol.Tag(msg.data[:2])
-	if !wp.sendMessageTag[tag] {
+	// compressed messages are filtered and accounted for by the tag of the message they hold.
+	var rawLength uint64
+	compressed := tag == protocol.CompressedMsgTag
+	if compressed {
+		tag, rawLength, _, _ = compressedMessageInfo(msg.data[tagLength:])
+	}
+	interestTag := tag
+	if tag == protocol.TxnAnnounceTag {
+		// the announcements go to the peers interested in the transactions.
+		interestTag = protocol.TxnTag
+	}
+	interested := wp.sendMessageTag[interestTag]
+	if tag == protocol.TxnRequestTag {
+		// the requests go to the peers that relay by inventory, whatever their messages of interest.
+		interested = wp.txnInventory
+	}
+	if !interested {
 		// the peer isn't interested in this message.
 		return disconnectReasonNone
 	}
@@ -624,6 +712,11 @@ func (wp *wsPeer) writeLoopSendMsg(msg sendMessage) disconnectReason {
 	atomic.StoreInt64(&wp.lastPacketTime, time.Now().UnixNano())
 	networkSentBytesTotal.AddUint64(uint64(len(msg.data)), nil)
 	networkSentBytesByTag.Add(string(tag), uint64(len(msg.data)))
+	wp.traffic.addSent(tag, uint64(len(msg.data)))
+	if compressed {
+		networkSentCompressedBytesByTag.Add(string(tag), uint64(len(msg.data)))
+		networkSentCompressedRawBytesByTag.Add(string(tag), rawLength+tagLength)
+	}
 	networkMessageSentTotal.AddUint64(1, nil)
 	networkMessageSentByTag.Add(string(tag), 1)
 	networkMessageQueueMicrosTotal.AddUint64(uint64(time.Now().Sub(msg.peerEnqueued).Nanoseconds()/1000), nil)
@@ -0,0 +1,249 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package blockstream delivers committed blocks, together with the state
+// delta they applied, to any number of subscribers.
+package blockstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// SubscriptionBufferSize is the number of blocks that are queued for a
+// subscriber before it is considered lagging. Nothing is queued for a lagging
+// subscriber; it catches up by reading from the ledger instead.
+const SubscriptionBufferSize = 32
+
+// ErrClosed is returned by Subscription.Next once the subscription was closed.
+var ErrClosed = errors.New("block stream subscription closed")
+
+// Ledger is the subset of the ledger used to serve the rounds that are no
+// longer queued for a subscriber.
+type Ledger interface {
+	Latest() basics.Round
+	Block(rnd basics.Round) (bookkeeping.Block, error)
+	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
+}
+
+// Entry is a committed block along with the state delta it applied.
+type Entry struct {
+	Block bookkeeping.Block
+	Delta ledgercore.StateDelta
+}
+
+// RoundNotAvailableError is returned when a subscriber asks for a round whose
+// state delta is no longer held by the ledger.
+type RoundNotAvailableError struct {
+	Round basics.Round
+	Err   error
+}
+
+func (e *RoundNotAvailableError) Error() string {
+	return fmt.Sprintf("round %d is no longer available for streaming: %v", e.Round, e.Err)
+}
+
+func (e *RoundNotAvailableError) Unwrap() error {
+	return e.Err
+}
+
+// Hub fans out the blocks it is notified about to its subscribers. It
+// implements ledger.BlockListener.
+type Hub struct {
+	ledger Ledger
+
+	mu          deadlock.Mutex
+	subscribers map[*Subscription]struct{}
+}
+
+// MakeHub creates a Hub that falls back to the given ledger for rounds that
+// are not queued for a subscriber.
+func MakeHub(l Ledger) *Hub {
+	return &Hub{
+		ledger:      l,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+// OnNewBlock implements the ledger.BlockListener interface.
+func (h *Hub) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for s := range h.subscribers {
+		s.push(Entry{Block: block, Delta: delta})
+	}
+}
+
+// Subscribe returns a subscription that delivers every block starting at
+// round from. A zero from starts at the block following the latest one.
+func (h *Hub) Subscribe(from basics.Round) *Subscription {
+	if from == 0 {
+		from = h.ledger.Latest() + 1
+	}
+	s := &Subscription{
+		hub:    h,
+		next:   from,
+		notify: make(chan struct{}, 1),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subscribers[s] = struct{}{}
+	return s
+}
+
+// Subscribers returns the number of active subscriptions.
+func (h *Hub) Subscribers() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subscribers)
+}
+
+func (h *Hub) unsubscribe(s *Subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.subscribers, s)
+}
+
+// Subscription is a single consumer of a Hub. It is not safe to call Next
+// from more than one goroutine at a time.
+type Subscription struct {
+	hub *Hub
+
+	// next is the round that Next is going to return. It is only accessed by
+	// the consumer goroutine.
+	next basics.Round
+
+	// notify is signalled whenever an entry is queued.
+	notify chan struct{}
+
+	// the fields below are protected by hub.mu.
+	queue   []Entry
+	lagging bool
+	closed  bool
+}
+
+// push queues an entry for the subscriber, or marks it as lagging if its
+// queue is full. The hub lock is expected to be held.
+func (s *Subscription) push(e Entry) {
+	if !s.lagging {
+		if len(s.queue) >= SubscriptionBufferSize {
+			// drop the queue; the subscriber would read these rounds from
+			// the ledger instead.
+			s.queue = nil
+			s.lagging = true
+		} else {
+			s.queue = append(s.queue, e)
+		}
+	}
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// pop returns the queued entry for the round s.next, if there is one.
+func (s *Subscription) pop() (e Entry, ok bool, closed bool) {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	if s.closed {
+		return Entry{}, false, true
+	}
+	// entries for rounds that were already delivered from the ledger are
+	// of no use anymore.
+	for len(s.queue) > 0 && s.queue[0].Block.Round() < s.next {
+		s.queue = s.queue[1:]
+	}
+	if s.lagging && len(s.queue) == 0 {
+		// the subscriber caught up with what the ledger served; resume
+		// queueing notifications.
+		s.lagging = false
+	}
+	if len(s.queue) > 0 && s.queue[0].Block.Round() == s.next {
+		e = s.queue[0]
+		s.queue = s.queue[1:]
+		return e, true, false
+	}
+	return Entry{}, false, false
+}
+
+// Next blocks until the block of the next round is available and returns it.
+// Rounds are delivered in order and without gaps. If the subscriber falls so
+// far behind that the ledger no longer holds the state delta of the next
+// round, a *RoundNotAvailableError is returned.
+func (s *Subscription) Next(ctx context.Context) (Entry, error) {
+	for {
+		e, ok, closed := s.pop()
+		if closed {
+			return Entry{}, ErrClosed
+		}
+		if ok {
+			s.next++
+			return e, nil
+		}
+
+		if s.next <= s.hub.ledger.Latest() {
+			e, err := s.fromLedger(s.next)
+			if err != nil {
+				return Entry{}, err
+			}
+			s.next++
+			return e, nil
+		}
+
+		select {
+		case <-s.notify:
+		case <-ctx.Done():
+			return Entry{}, ctx.Err()
+		}
+	}
+}
+
+func (s *Subscription) fromLedger(rnd basics.Round) (Entry, error) {
+	delta, err := s.hub.ledger.GetStateDeltaForRound(rnd)
+	if err != nil {
+		return Entry{}, &RoundNotAvailableError{Round: rnd, Err: err}
+	}
+	block, err := s.hub.ledger.Block(rnd)
+	if err != nil {
+		return Entry{}, &RoundNotAvailableError{Round: rnd, Err: err}
+	}
+	return Entry{Block: block, Delta: delta}, nil
+}
+
+// Close removes the subscription from the hub. Pending and future calls to
+// Next return ErrClosed.
+func (s *Subscription) Close() {
+	s.hub.unsubscribe(s)
+
+	s.hub.mu.Lock()
+	s.closed = true
+	s.queue = nil
+	s.hub.mu.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
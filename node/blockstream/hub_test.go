@@ -0,0 +1,178 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package blockstream
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testLedger keeps the state deltas of the rounds after oldest.
+type testLedger struct {
+	mu     sync.Mutex
+	latest basics.Round
+	oldest basics.Round
+	reads  int
+}
+
+func (l *testLedger) Latest() basics.Round {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.latest
+}
+
+func (l *testLedger) Block(rnd basics.Round) (bookkeeping.Block, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if rnd > l.latest {
+		return bookkeeping.Block{}, fmt.Errorf("no block %d", rnd)
+	}
+	var blk bookkeeping.Block
+	blk.BlockHeader.Round = rnd
+	return blk, nil
+}
+
+func (l *testLedger) GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reads++
+	if rnd <= l.oldest || rnd > l.latest {
+		return ledgercore.StateDelta{}, fmt.Errorf("no delta %d", rnd)
+	}
+	return ledgercore.StateDelta{PrevTimestamp: int64(rnd)}, nil
+}
+
+// addBlock appends a block to the ledger and notifies the hub about it.
+func (l *testLedger) addBlock(h *Hub) {
+	l.mu.Lock()
+	l.latest++
+	rnd := l.latest
+	l.mu.Unlock()
+
+	var blk bookkeeping.Block
+	blk.BlockHeader.Round = rnd
+	h.OnNewBlock(blk, ledgercore.StateDelta{PrevTimestamp: int64(rnd)})
+}
+
+func requireNext(t *testing.T, s *Subscription, rnd basics.Round) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	e, err := s.Next(ctx)
+	require.NoError(t, err)
+	require.Equal(t, rnd, e.Block.Round())
+	require.Equal(t, int64(rnd), e.Delta.PrevTimestamp)
+}
+
+func TestHubLiveBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l := &testLedger{latest: 10}
+	h := MakeHub(l)
+
+	s := h.Subscribe(0)
+	require.Equal(t, 1, h.Subscribers())
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for rnd := basics.Round(11); rnd <= 20; rnd++ {
+			requireNext(t, s, rnd)
+		}
+	}()
+	for i := 0; i < 10; i++ {
+		l.addBlock(h)
+	}
+	<-done
+
+	s.Close()
+	require.Zero(t, h.Subscribers())
+	_, err := s.Next(context.Background())
+	require.ErrorIs(t, err, ErrClosed)
+}
+
+func TestHubResume(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l := &testLedger{latest: 10, oldest: 5}
+	h := MakeHub(l)
+
+	s := h.Subscribe(8)
+	defer s.Close()
+	for rnd := basics.Round(8); rnd <= 10; rnd++ {
+		requireNext(t, s, rnd)
+	}
+	l.addBlock(h)
+	requireNext(t, s, 11)
+
+	old := h.Subscribe(3)
+	defer old.Close()
+	_, err := old.Next(context.Background())
+	var notAvailable *RoundNotAvailableError
+	require.ErrorAs(t, err, &notAvailable)
+	require.Equal(t, basics.Round(3), notAvailable.Round)
+}
+
+func TestHubLaggingSubscriber(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l := &testLedger{latest: 10}
+	h := MakeHub(l)
+
+	s := h.Subscribe(0)
+	defer s.Close()
+
+	// overflow the queue of the subscriber; the missed rounds are then read
+	// from the ledger.
+	const blocks = 2*SubscriptionBufferSize + 5
+	for i := 0; i < blocks; i++ {
+		l.addBlock(h)
+	}
+	for rnd := basics.Round(11); rnd <= 10+blocks; rnd++ {
+		requireNext(t, s, rnd)
+	}
+	require.NotZero(t, l.reads)
+
+	// once caught up, blocks are queued again.
+	reads := l.reads
+	l.addBlock(h)
+	requireNext(t, s, 11+blocks)
+	require.Equal(t, reads, l.reads)
+}
+
+func TestHubCancel(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	l := &testLedger{latest: 10}
+	h := MakeHub(l)
+	s := h.Subscribe(0)
+	defer s.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := s.Next(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+}
@@ -44,9 +44,11 @@ import (
 	"github.com/algorand/go-algorand/data/transactions/verify"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/simulation"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
 	"github.com/algorand/go-algorand/network/messagetracer"
+	"github.com/algorand/go-algorand/node/blockstream"
 	"github.com/algorand/go-algorand/node/indexer"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/rpcs"
@@ -114,6 +116,8 @@ type AlgorandFullNode struct {
 
 	indexer *indexer.Indexer
 
+	blockStream *blockstream.Hub
+
 	rootDir     string
 	genesisID   string
 	genesisHash crypto.Digest
@@ -175,13 +179,32 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.config = cfg
 
 	// tie network, block fetcher, and agreement services together
-	p2pNode, err := network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
+	var err error
+	peerBansFile := filepath.Join(rootDir, genesis.ID(), config.PeerBansFilename)
+	if cfg.EnableP2P {
+		var p2pNode *network.P2PNetwork
+		p2pNode, err = network.NewP2PNetwork(node.log, node.config, rootDir, phonebookAddresses, genesis.ID(), genesis.Network)
+		if err != nil {
+			log.Errorf("could not create peer-to-peer node: %v", err)
+			return nil, err
+		}
+		err = p2pNode.LoadPeerBans(peerBansFile)
+		node.net = p2pNode
+	} else {
+		var wsNode *network.WebsocketNetwork
+		wsNode, err = network.NewWebsocketNetwork(node.log, node.config, phonebookAddresses, genesis.ID(), genesis.Network, node)
+		if err != nil {
+			log.Errorf("could not create websocket node: %v", err)
+			return nil, err
+		}
+		wsNode.SetPrioScheme(node)
+		wsNode.SetTxnPushFilter(data.TxnPushFilter(cfg.TxnInventoryPushFeePerByte))
+		err = wsNode.LoadPeerBans(peerBansFile)
+		node.net = wsNode
+	}
 	if err != nil {
-		log.Errorf("could not create websocket node: %v", err)
-		return nil, err
+		log.Warnf("could not load the peer bans from %s: %v", peerBansFile, err)
 	}
-	p2pNode.SetPrioScheme(node)
-	node.net = p2pNode
 
 	accountListener := makeTopAccountListener(log)
 
@@ -213,9 +236,12 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	node.transactionPool = pools.MakeTransactionPool(node.ledger.Ledger, cfg, node.log)
 
+	node.blockStream = blockstream.MakeHub(node.ledger)
+
 	blockListeners := []ledger.BlockListener{
 		node.transactionPool,
 		node,
+		node.blockStream,
 	}
 
 	if node.config.EnableTopAccountsReporting {
@@ -233,9 +259,9 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		}
 	}
 
-	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, p2pNode, node.genesisID)
-	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, p2pNode, node.genesisID)
-	rpcs.RegisterTxService(node.transactionPool, p2pNode, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
+	node.blockService = rpcs.MakeBlockService(node.log, cfg, node.ledger, node.net, node.genesisID)
+	node.ledgerService = rpcs.MakeLedgerService(cfg, node.ledger, node.net, node.genesisID)
+	rpcs.RegisterTxService(node.transactionPool, node.net, node.genesisID, cfg.TxPoolSize, cfg.TxSyncServeResponseSize)
 
 	crashPathname := filepath.Join(genesisDir, config.CrashFilename)
 	crashAccess, err := db.MakeAccessor(crashPathname, false, false)
@@ -268,7 +294,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	node.agreementService = agreement.MakeService(agreementParameters)
 
 	node.catchupBlockAuth = blockAuthenticatorImpl{Ledger: node.ledger, AsyncVoteVerifier: agreement.MakeAsyncVoteVerifier(node.lowPriorityCryptoVerificationPool)}
-	node.catchupService = catchup.MakeService(node.log, node.config, p2pNode, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
+	node.catchupService = catchup.MakeService(node.log, node.config, node.net, node.ledger, node.catchupBlockAuth, agreementLedger.UnmatchedPendingCertificates, node.lowPriorityCryptoVerificationPool)
 	node.txPoolSyncerService = rpcs.MakeTxSyncer(node.transactionPool, node.net, node.txHandler.SolicitedTxHandler(), time.Duration(cfg.TxSyncIntervalSeconds)*time.Second, time.Duration(cfg.TxSyncTimeoutSeconds)*time.Second, cfg.TxSyncServeResponseSize)
 
 	registry, err := ensureParticipationDB(genesisDir, node.log)
@@ -292,7 +318,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 		return nil, err
 	}
 	if catchpointCatchupState != ledger.CatchpointCatchupStateInactive {
-		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log, node.net, node.ledger.Ledger, node.config)
+		node.catchpointCatchupService, err = catchup.MakeResumedCatchpointCatchupService(context.Background(), node, node.log, node.net, node.ledger.Ledger, node.config, genesisDir)
 		if err != nil {
 			log.Errorf("unable to create catchpoint catchup service: %v", err)
 			return nil, err
@@ -301,6 +327,7 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 
 	node.tracer = messagetracer.NewTracer(log).Init(cfg)
 	gossip.SetTrace(agreementParameters.Network, node.tracer)
+	node.txHandler.SetTrace(node.tracer)
 
 	compactCertPathname := filepath.Join(genesisDir, config.CompactCertFilename)
 	compactCertAccess, err := db.MakeAccessor(compactCertPathname, false, false)
@@ -347,6 +374,38 @@ func bootstrapData(genesis bookkeeping.Genesis, log logging.Logger) (bookkeeping
 	return bookkeeping.MakeTimestampedGenesisBalances(genalloc, feeSink, rewardsPool, genesis.Timestamp), nil
 }
 
+// GetPeerBans returns the gossip peers currently banned for misbehaving.
+func (node *AlgorandFullNode) GetPeerBans() []network.PeerBan {
+	return node.net.GetPeerBans()
+}
+
+// ClearPeerBans lifts the ban of the gossip peer with the given address, or all
+// the bans if the address is empty. It returns the number of bans lifted.
+func (node *AlgorandFullNode) ClearPeerBans(address string) int {
+	return node.net.ClearPeerBans(address)
+}
+
+// GetPeerInfo describes the connections to the gossip peers.
+func (node *AlgorandFullNode) GetPeerInfo() []network.PeerInfo {
+	return node.net.GetPeerInfo()
+}
+
+// DisconnectPeer closes the connections to the gossip peer with the given
+// address, and returns the number of connections closed.
+func (node *AlgorandFullNode) DisconnectPeer(address string) int {
+	return node.net.DisconnectAddress(address)
+}
+
+// AddPriorityPeer gives priority to the incoming gossip connections from the given host.
+func (node *AlgorandFullNode) AddPriorityPeer(host string) error {
+	return node.net.AddPriorityPeer(host)
+}
+
+// AgreementTimeline returns the timelines of the agreement protocol in the recent rounds.
+func (node *AlgorandFullNode) AgreementTimeline() []agreement.RoundTimeline {
+	return node.agreementService.Timeline()
+}
+
 // Config returns a copy of the node's Local configuration
 func (node *AlgorandFullNode) Config() config.Local {
 	return node.config
@@ -465,6 +524,9 @@ func (node *AlgorandFullNode) Stop() {
 	if node.indexer != nil {
 		node.indexer.Shutdown()
 	}
+	if node.tracer != nil {
+		node.tracer.Close()
+	}
 }
 
 // note: unlike the other two functions, this accepts a whole filename
@@ -562,6 +624,12 @@ func (node *AlgorandFullNode) broadcastSignedTxGroup(txgroup []transactions.Sign
 	return nil
 }
 
+// Simulate evaluates a transaction group on top of the latest ledger state without
+// broadcasting it or committing it to the ledger. Unsigned transactions are accepted.
+func (node *AlgorandFullNode) Simulate(txgroup []transactions.SignedTxn) (simulation.Result, error) {
+	return simulation.MakeSimulator(node.ledger.Ledger).SimulateTransactionGroup(txgroup)
+}
+
 // ListTxns returns SignedTxns associated with a specific account in a range of Rounds (inclusive).
 // TxnWithStatus returns the round in which a particular transaction appeared,
 // since that information is not part of the SignedTxn itself.
@@ -1136,6 +1204,12 @@ func (node *AlgorandFullNode) Indexer() (*indexer.Indexer, error) {
 	return nil, fmt.Errorf("indexer is not active")
 }
 
+// BlockStream returns the hub that streams committed blocks and their state
+// deltas to subscribers.
+func (node *AlgorandFullNode) BlockStream() *blockstream.Hub {
+	return node.blockStream
+}
+
 // GetTransactionByID gets transaction by ID
 // this function is intended to be called externally via the REST api interface.
 func (node *AlgorandFullNode) GetTransactionByID(txid transactions.Txid, rnd basics.Round) (TxnWithStatus, error) {
@@ -1150,9 +1224,10 @@ func (node *AlgorandFullNode) GetTransactionByID(txid transactions.Txid, rnd bas
 	}, nil
 }
 
-// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
+// StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint. If source isn't empty,
+// the ledger is loaded from the catchpoint file at that location rather than downloaded from the peers.
 // this function is intended to be called externally via the REST api interface.
-func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
+func (node *AlgorandFullNode) StartCatchup(catchpoint string, source string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.indexer != nil {
@@ -1167,7 +1242,7 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 		return MakeCatchpointUnableToStartError(stats.CatchpointLabel, catchpoint)
 	}
 	var err error
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, node.ledger.Ledger, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, source, node, node.log, node.net, node.ledger.Ledger, node.config, filepath.Join(node.rootDir, node.genesisID))
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -1314,6 +1389,19 @@ func (node *AlgorandFullNode) AssembleBlock(round basics.Round) (agreement.Valid
 	return validatedBlock{vb: lvb}, nil
 }
 
+// StartSpeculativeBlockAssembly implements agreement.SpeculativeBlockFactory.StartSpeculativeBlockAssembly.
+func (node *AlgorandFullNode) StartSpeculativeBlockAssembly(ctx context.Context, vb agreement.ValidatedBlock) {
+	if !node.config.EnableSpeculativeBlockAssembly {
+		return
+	}
+	lvb, ok := vb.(validatedBlock)
+	if !ok {
+		node.log.Warnf("AlgorandFullNode.StartSpeculativeBlockAssembly: unexpected validated block type %T", vb)
+		return
+	}
+	go node.transactionPool.AssembleSpeculativeBlock(ctx, lvb.vb)
+}
+
 // VotingKeys implements the key manager's VotingKeys method, and provides additional validation with the ledger.
 // that allows us to load multiple overlapping keys for the same account, and filter these per-round basis.
 func (node *AlgorandFullNode) VotingKeys(votingRound, keysRound basics.Round) []account.ParticipationRecordForRound {
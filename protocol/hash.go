@@ -51,6 +51,7 @@ const (
 	MerkleArrayNode                  HashID = "MA"
 	MerkleVectorCommitmentBottomLeaf HashID = "MB"
 	Message                          HashID = "MX"
+	NetIdentityChallenge             HashID = "NIC"
 	NetPrioResponse                  HashID = "NPR"
 	OneTimeSigKey1                   HashID = "OT1"
 	OneTimeSigKey2                   HashID = "OT2"
@@ -28,12 +28,16 @@ const (
 	UnknownMsgTag      Tag = "??"
 	AgreementVoteTag   Tag = "AV"
 	CompactCertSigTag  Tag = "CS"
+	CompressedMsgTag   Tag = "CZ"
 	MsgOfInterestTag   Tag = "MI"
 	MsgDigestSkipTag   Tag = "MS"
 	NetPrioResponseTag Tag = "NP"
 	PingTag            Tag = "pi"
 	PingReplyTag       Tag = "pj"
 	ProposalPayloadTag Tag = "PP"
+	PeerExchangeTag    Tag = "PX"
+	TxnAnnounceTag     Tag = "TA"
+	TxnRequestTag      Tag = "TQ"
 	TopicMsgRespTag    Tag = "TS"
 	TxnTag             Tag = "TX"
 	UniCatchupReqTag   Tag = "UC" //Replaced by UniEnsBlockReqTag. Only for backward compatibility.
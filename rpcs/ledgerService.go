@@ -35,18 +35,33 @@ import (
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
 )
 
 const (
 	// LedgerResponseContentType is the HTTP Content-Type header for a raw ledger block
 	LedgerResponseContentType = "application/x-algorand-ledger-v2.1"
 
+	// LedgerManifestResponseContentType is the HTTP Content-Type header for a catchpoint file manifest
+	LedgerManifestResponseContentType = "application/x-algorand-ledger-manifest-v1"
+
+	// LedgerChunkResponseContentType is the HTTP Content-Type header for a single chunk of a catchpoint file, which is
+	// a gzip member holding a single section of the catchpoint tar archive.
+	LedgerChunkResponseContentType = "application/x-algorand-ledger-chunk-v1"
+
 	ledgerServerMaxBodyLength = 512 // we don't really pass meaningful content here, so 512 bytes should be a safe limit
 
 	// LedgerServiceLedgerPath is the path to register LedgerService as a handler for when using gorilla/mux
 	// e.g. .Handle(LedgerServiceLedgerPath, &ls)
 	LedgerServiceLedgerPath = "/v{version:[0-9.]+}/{genesisID}/ledger/{round:[0-9a-z]+}"
 
+	// LedgerServiceManifestPath is the path to register LedgerService as a handler for the catchpoint file manifests
+	LedgerServiceManifestPath = "/v{version:[0-9.]+}/{genesisID}/ledger/{round:[0-9a-z]+}/{manifest:manifest}"
+
+	// LedgerServiceChunkPath is the path to register LedgerService as a handler for the catchpoint file chunks,
+	// where chunk is the index of the chunk in the catchpoint file manifest
+	LedgerServiceChunkPath = "/v{version:[0-9.]+}/{genesisID}/ledger/{round:[0-9a-z]+}/chunk/{chunk:[0-9]+}"
+
 	// maxCatchpointFileSize is the default catchpoint file size, if we can't get a concreate number from the ledger.
 	maxCatchpointFileSize = 512 * 1024 * 1024 // 512MB
 
@@ -76,6 +91,8 @@ func MakeLedgerService(config config.Local, ledger *data.Ledger, net network.Gos
 	// the underlying gorilla/mux doesn't support "unregister", so we're forced to implement it ourselves.
 	if service.enableService {
 		net.RegisterHTTPHandler(LedgerServiceLedgerPath, service)
+		net.RegisterHTTPHandler(LedgerServiceManifestPath, service)
+		net.RegisterHTTPHandler(LedgerServiceChunkPath, service)
 	}
 	return service
 }
@@ -97,6 +114,8 @@ func (ls *LedgerService) Stop() {
 
 // ServerHTTP returns ledgers for a particular round
 // Either /v{version}/{genesisID}/ledger/{round} or ?r={round}&v={version}
+// The manifest of the catchpoint file is served at /v{version}/{genesisID}/ledger/{round}/manifest,
+// and its chunks at /v{version}/{genesisID}/ledger/{round}/chunk/{chunk}.
 // Uses gorilla/mux for path argument parsing.
 func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.Request) {
 	ls.stopping.Add(1)
@@ -172,6 +191,14 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		response.Write([]byte(fmt.Sprintf("specified round number could not be parsed using base 36 : %v", err)))
 		return
 	}
+	if _, hasManifest := pathVars["manifest"]; hasManifest {
+		ls.serveManifest(response, basics.Round(round))
+		return
+	}
+	if chunkStr, hasChunk := pathVars["chunk"]; hasChunk {
+		ls.serveChunk(response, basics.Round(round), chunkStr)
+		return
+	}
 	cs, err := ls.ledger.GetCatchpointStream(basics.Round(round))
 	if err != nil {
 		switch err.(type) {
@@ -226,3 +253,50 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP : unable to write decompressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
 	}
 }
+
+// serveManifest writes the manifest of the catchpoint file for the given round.
+func (ls *LedgerService) serveManifest(response http.ResponseWriter, round basics.Round) {
+	manifest, err := ls.ledger.GetCatchpointManifest(round)
+	if err != nil {
+		ls.writeCatchpointError(response, round, err)
+		return
+	}
+	response.Header().Set("Content-Type", LedgerManifestResponseContentType)
+	_, err = response.Write(protocol.Encode(&manifest))
+	if err != nil {
+		logging.Base().Infof("LedgerService.ServeHTTP : unable to write catchpoint manifest for round %d : %v", round, err)
+	}
+}
+
+// serveChunk writes the given chunk of the catchpoint file for the given round.
+func (ls *LedgerService) serveChunk(response http.ResponseWriter, round basics.Round, chunkStr string) {
+	chunkIndex, err := strconv.ParseUint(chunkStr, 10, 64)
+	if err != nil {
+		response.WriteHeader(http.StatusBadRequest)
+		response.Write([]byte(fmt.Sprintf("specified chunk index could not be parsed : %v", err)))
+		return
+	}
+	member, _, err := ls.ledger.GetCatchpointChunk(round, chunkIndex)
+	if err != nil {
+		ls.writeCatchpointError(response, round, err)
+		return
+	}
+	response.Header().Set("Content-Type", LedgerChunkResponseContentType)
+	response.Header().Set("Content-Length", strconv.Itoa(len(member)))
+	_, err = response.Write(member)
+	if err != nil {
+		logging.Base().Infof("LedgerService.ServeHTTP : unable to write chunk %d of catchpoint file for round %d : %v", chunkIndex, round, err)
+	}
+}
+
+func (ls *LedgerService) writeCatchpointError(response http.ResponseWriter, round basics.Round, err error) {
+	switch err.(type) {
+	case ledgercore.ErrNoEntry:
+		response.WriteHeader(http.StatusNotFound)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d is not available", round)))
+	default:
+		logging.Base().Warnf("ServeHTTP : failed to retrieve catchpoint %d %v", round, err)
+		response.WriteHeader(http.StatusInternalServerError)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be retrieved due to internal error : %v", round, err)))
+	}
+}
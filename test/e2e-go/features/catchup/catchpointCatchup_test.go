@@ -215,7 +215,7 @@ func TestBasicCatchpointCatchup(t *testing.T) {
 	a.NoError(err)
 	a.NotNil(primaryNodeStatus.LastCatchpoint)
 	log.Infof("primary node latest catchpoint - %s!\n", *primaryNodeStatus.LastCatchpoint)
-	secondNodeRestClient.Catchup(*primaryNodeStatus.LastCatchpoint)
+	secondNodeRestClient.Catchup(*primaryNodeStatus.LastCatchpoint, "")
 
 	currentRound = primaryNodeStatus.LastRound
 	targetRound = currentRound + 1
@@ -53,6 +53,22 @@ if [[ $RES != *"${EXPECTED}"* ]]; then
     false
 fi
 
+# 1 + 2 = 3, with the method and named arguments taken from a contract description
+cat > "${TEMPDIR}/contract.json" <<EOF
+{
+  "name": "AbiMethodExample",
+  "methods": [
+    {"name": "add", "args": [{"type": "uint64", "name": "a"}, {"type": "uint64", "name": "b"}], "returns": {"type": "uint64", "desc": "The sum"}}
+  ]
+}
+EOF
+RES=$(${gcmd} app method --contract "${TEMPDIR}/contract.json" --method add --args-by-name --arg b=2 --arg a=1 --app-id $APPID --from $ACCOUNT 2>&1 || true)
+EXPECTED="method add(uint64,uint64)uint64 succeeded with output: 3"
+if [[ $RES != *"${EXPECTED}"* ]]; then
+    date '+app-abi-method-test FAIL the method call to add from the contract description should not fail %Y%m%d_%H%M%S'
+    false
+fi
+
 # 18446744073709551614 + 1 = 18446744073709551615
 RES=$(${gcmd} app method --method "add(uint64,uint64)uint64" --arg 18446744073709551614 --arg 1 --app-id $APPID --from $ACCOUNT 2>&1 || true)
 EXPECTED="method add(uint64,uint64)uint64 succeeded with output: 18446744073709551615"
@@ -34,6 +34,10 @@ var numRegex = regexp.MustCompile(`^\d+$`)
 var filename = flag.String("file", "", "Name of the input cadaver file (otherwise, use stdin)")
 var versionCheck = flag.Bool("version", false, "Display current coroner build version and exit")
 var printmsgpack = flag.Bool("msgpack", false, "If provided, emit msgpack instead of a string")
+var replay = flag.Bool("replay", false, "If provided, replay the cadaver and report where the state machine diverges from the recording")
+var fuzzIterations = flag.Int("fuzz", 0, "If positive, replay the cadaver this many times with reordered events and report safety violations")
+var fuzzSeed = flag.Int64("fuzz-seed", 0, "The seed of the event reorderings")
+var fuzzWindow = flag.Int("fuzz-window", 4, "How far an event may be moved when reordering events")
 
 var skipHead = flag.String("skip-head", "", "The first round to trim before")
 var skipTail = flag.String("skip-tail", "", "The last round to trim after")
@@ -100,6 +104,23 @@ func main() {
 		filter.Last = basics.Round(parseRoundBound(*skipTail))
 	}
 
+	if *replay || *fuzzIterations > 0 {
+		var report agreement.ReplayReport
+		if *replay {
+			report = autopsy.Replay(filter)
+		} else {
+			report = autopsy.Fuzz(*fuzzSeed, *fuzzIterations, *fuzzWindow)
+		}
+		for _, d := range report.Divergences {
+			log.Println("coroner:", d)
+		}
+		log.Printf("coroner: %d runs, %d events, %d divergences\n", report.Runs, report.Events, len(report.Divergences))
+		if len(report.Divergences) > 0 {
+			os.Exit(1)
+		}
+		return
+	}
+
 	var commitHash string
 	if *printmsgpack {
 		commitHash = autopsy.DumpMessagePack(filter, os.Stdout)